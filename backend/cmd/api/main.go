@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"log"
 	"main/internal/bootstrap"
 	"main/internal/config"
@@ -8,11 +9,24 @@ import (
 	"main/internal/redis"
 	"main/internal/server"
 	"main/internal/smtp"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
 
 	_ "github.com/jackc/pgx/v5/stdlib"
 )
 
 func main() {
+	if err := run(); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("Server stopped")
+}
+
+// run serves until SIGINT or SIGTERM, the workers are stopped and waited for
+// before the connections are closed
+func run() error {
 	cfg := config.Load()
 
 	dbConn := db.NewDbConnection(cfg.DB.ConnString())
@@ -24,18 +38,32 @@ func main() {
 	defer redisConn.Close()
 
 	smtpClient := smtp.NewSMTPclient(cfg.SMTP)
+	defer smtpClient.Close()
 
-	adapters := bootstrap.NewAdapters(dbConn, redisConn, smtpClient)
-	services := bootstrap.NewServices(adapters)
-	handlers := bootstrap.NewHandlers(services)
+	adapters := bootstrap.NewAdapters(dbConn, redisConn, smtpClient, &cfg)
+	services := bootstrap.NewServices(adapters, &cfg)
+	handlers := bootstrap.NewHandlers(services, &cfg)
 	middlewares := bootstrap.NewMiddlewares(services, &cfg)
 
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	var workers sync.WaitGroup
+	workers.Go(func() { services.OutboxService.Run(ctx) })
+	workers.Go(func() { services.WebhookService.Run(ctx) })
+	workers.Go(func() { services.EmergencyAccessService.Run(ctx) })
+
 	srv := server.New(cfg.AppPort)
 	srv.RegisterHandlersAndMiddlewares(handlers, middlewares)
+	srv.RegisterVaultSocketRoute(handlers, middlewares)
 	srv.RegisterStaticRoute()
 	srv.RegisterSpaRoute("./public")
 	srv.RegisterSwaggerRoute()
+	srv.RegisterMetricsRoute(middlewares)
 
 	log.Printf("Server running on :%s", cfg.AppPort)
-	log.Fatal(srv.Start(true))
+	err := srv.Start(ctx, true)
+	stop()
+	workers.Wait()
+	return err
 }
@@ -0,0 +1,53 @@
+// Command vaultblobs moves the vault contents stored in Postgres to the blob
+// store of VAULT_BLOB_BACKEND while the API keeps running. With encryption
+// enabled it also encrypts the contents stored before it was. It can be run
+// again, it only migrates what is left.
+package main
+
+import (
+	"context"
+	"log"
+	"main/internal/adapters/repository"
+	"main/internal/bootstrap"
+	"main/internal/config"
+	"main/internal/db"
+	"os"
+	"os/signal"
+	"syscall"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+)
+
+const batchSize = 100
+
+func main() {
+	cfg := config.Load()
+
+	dbConn := db.NewDbConnection(cfg.DB.ConnString())
+	defer dbConn.Close()
+
+	db.MigrateDB(dbConn)
+
+	migrator := repository.NewVaultBlobMigratorPg(
+		dbConn,
+		bootstrap.NewVaultBlobStore(&cfg),
+		bootstrap.NewVaultKeyStore(dbConn, &cfg),
+	)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	total := 0
+	for ctx.Err() == nil {
+		n, err := migrator.Migrate(ctx, batchSize)
+		if err != nil {
+			log.Fatalf("migration failed after %d rows: %v", total, err)
+		}
+		if n == 0 {
+			break
+		}
+		total += n
+		log.Printf("%d rows migrated", total)
+	}
+	log.Printf("finished, %d rows migrated", total)
+}
@@ -0,0 +1,55 @@
+// Command vaultkeys wraps all data keys with VAULT_KEK_ACTIVE while the API
+// keeps running, after which the previous KEK can be removed from the
+// keyring. The vaults stored before encryption was enabled are encrypted by
+// the vaultblobs command.
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"main/internal/bootstrap"
+	"main/internal/config"
+	"main/internal/db"
+	"os"
+	"os/signal"
+	"syscall"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+)
+
+const batchSize = 100
+
+func main() {
+	if len(os.Args) != 2 || os.Args[1] != "rewrap" {
+		fmt.Fprintln(os.Stderr, "usage: vaultkeys rewrap")
+		os.Exit(2)
+	}
+
+	cfg := config.Load()
+
+	dbConn := db.NewDbConnection(cfg.DB.ConnString())
+	defer dbConn.Close()
+
+	keys := bootstrap.NewVaultKeyStore(dbConn, &cfg)
+	if !keys.Enabled() {
+		log.Fatalf("VAULT_KEKS or VAULT_KEK_FILE must be set")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	total := 0
+	for ctx.Err() == nil {
+		n, err := keys.RewrapKeys(ctx, batchSize)
+		if err != nil {
+			log.Fatalf("rewrap failed after %d keys: %v", total, err)
+		}
+		if n == 0 {
+			break
+		}
+		total += n
+		log.Printf("rewrap: %d keys done", total)
+	}
+	log.Printf("rewrap: finished, %d keys", total)
+}
@@ -0,0 +1,109 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"main/internal/core/ports"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// BlobStoreFS stores the blobs as files under a directory, the key being the
+// relative path
+type BlobStoreFS struct {
+	root string
+}
+
+func NewBlobStoreFS(root string) (*BlobStoreFS, error) {
+	if err := os.MkdirAll(root, 0o700); err != nil {
+		return nil, err
+	}
+	return &BlobStoreFS{root: root}, nil
+}
+
+// Put writes to a temporary file that is renamed once synced, so a crash
+// leaves either no blob or the whole one
+func (s *BlobStoreFS) Put(ctx context.Context, key string, content io.Reader, size int64) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(dir, ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	written, err := io.Copy(tmp, content)
+	if err != nil {
+		return err
+	}
+	if size >= 0 && written != size {
+		return fmt.Errorf("blob %s has %d bytes, expected %d", key, written, size)
+	}
+	if err := tmp.Sync(); err != nil {
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return err
+	}
+
+	// The rename itself is only durable once the directory is synced
+	return syncDir(dir)
+}
+
+func (s *BlobStoreFS) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ports.ErrBlobNotFound
+	}
+	return file, err
+}
+
+func (s *BlobStoreFS) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// path rejects the keys that would escape the root
+func (s *BlobStoreFS) path(key string) (string, error) {
+	if key == "" || !filepath.IsLocal(key) || strings.HasPrefix(filepath.Base(key), ".tmp-") {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
+
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
@@ -0,0 +1,67 @@
+package eventbus
+
+import (
+	"context"
+	"main/internal/core/domain"
+	"sync"
+)
+
+// subscriberBuffer is how many events a subscriber can lag behind before
+// it's dropped, the client then reconnects and catches up with Last-Event-ID
+const subscriberBuffer = 64
+
+// hub fans the events received by this replica out to its local subscribers,
+// so a replica needs a single pub/sub connection whatever the client count
+type hub struct {
+	mu          sync.Mutex
+	subscribers map[string]map[chan domain.VaultEvent]struct{}
+}
+
+func newHub() *hub {
+	return &hub{subscribers: map[string]map[chan domain.VaultEvent]struct{}{}}
+}
+
+func (h *hub) subscribe(ctx context.Context, userID string) <-chan domain.VaultEvent {
+	ch := make(chan domain.VaultEvent, subscriberBuffer)
+
+	h.mu.Lock()
+	if h.subscribers[userID] == nil {
+		h.subscribers[userID] = map[chan domain.VaultEvent]struct{}{}
+	}
+	h.subscribers[userID][ch] = struct{}{}
+	h.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		h.remove(userID, ch)
+	}()
+
+	return ch
+}
+
+func (h *hub) broadcast(event domain.VaultEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for ch := range h.subscribers[event.UserID] {
+		select {
+		case ch <- event:
+		default:
+			h.remove(event.UserID, ch)
+		}
+	}
+}
+
+// remove closes the channel unless it's already gone. The caller must hold the lock.
+func (h *hub) remove(userID string, ch chan domain.VaultEvent) {
+	if _, ok := h.subscribers[userID][ch]; !ok {
+		return
+	}
+	delete(h.subscribers[userID], ch)
+	if len(h.subscribers[userID]) == 0 {
+		delete(h.subscribers, userID)
+	}
+	close(ch)
+}
@@ -0,0 +1,48 @@
+package eventbus
+
+import (
+	"context"
+	"main/internal/core/domain"
+	"testing"
+)
+
+func TestHub_BroadcastToUser(t *testing.T) {
+	h := newHub()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	mine := h.subscribe(ctx, "1")
+	other := h.subscribe(ctx, "2")
+
+	h.broadcast(domain.VaultEvent{ID: 1, UserID: "1"})
+
+	if e := <-mine; e.ID != 1 {
+		t.Errorf("expected event 1, got %+v", e)
+	}
+	select {
+	case e := <-other:
+		t.Errorf("unexpected event for another user %+v", e)
+	default:
+	}
+
+	cancel()
+	if _, ok := <-mine; ok {
+		t.Error("expected the channel to be closed with the context")
+	}
+}
+
+func TestHub_DropsSlowSubscriber(t *testing.T) {
+	h := newHub()
+	events := h.subscribe(context.Background(), "1")
+
+	for i := range subscriberBuffer + 1 {
+		h.broadcast(domain.VaultEvent{ID: int64(i + 1), UserID: "1"})
+	}
+
+	received := 0
+	for range events {
+		received++
+	}
+	if received != subscriberBuffer {
+		t.Errorf("expected the buffered %d events before the close, got %d", subscriberBuffer, received)
+	}
+}
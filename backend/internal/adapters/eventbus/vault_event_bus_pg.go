@@ -0,0 +1,168 @@
+package eventbus
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"log"
+	"main/internal/core/domain"
+	db "main/internal/db/sqlc"
+	"main/internal/utils"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const vaultEventsNotifyChannel = "vault_events"
+
+// VaultEventBusPg stores events in vault_events for replay and delivers them
+// with NOTIFY, for deployments without Redis pub/sub
+type VaultEventBusPg struct {
+	queries    *db.Queries
+	connString string
+	hub        *hub
+	replaySize int
+	retention  time.Duration
+
+	start    sync.Once
+	startErr error
+}
+
+func NewVaultEventBusPg(dbConn *sql.DB, connString string, replaySize int, retention time.Duration) *VaultEventBusPg {
+	return &VaultEventBusPg{
+		queries:    db.New(dbConn),
+		connString: connString,
+		hub:        newHub(),
+		replaySize: replaySize,
+		retention:  retention,
+	}
+}
+
+func (b *VaultEventBusPg) Publish(ctx context.Context, event domain.VaultEvent) error {
+	userID, err := utils.Int32FromString(event.UserID)
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	row, err := b.queries.CreateVaultEvent(ctx, db.CreateVaultEventParams{
+		UserID:  userID,
+		Type:    string(event.Type),
+		Payload: payload,
+	})
+	if err != nil {
+		return err
+	}
+	event.ID = row.ID
+	event.CreatedAt = row.CreatedAt
+
+	notification, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	if err := b.queries.NotifyVaultEvent(ctx, string(notification)); err != nil {
+		return err
+	}
+
+	return b.queries.PruneVaultEvents(ctx, db.PruneVaultEventsParams{
+		UserID: userID,
+		Before: time.Now().Add(-b.retention),
+	})
+}
+
+func (b *VaultEventBusPg) Subscribe(ctx context.Context, userID string) (<-chan domain.VaultEvent, error) {
+	b.start.Do(func() { b.startErr = b.listen() })
+	if b.startErr != nil {
+		return nil, b.startErr
+	}
+	return b.hub.subscribe(ctx, userID), nil
+}
+
+func (b *VaultEventBusPg) EventsSince(ctx context.Context, userID string, lastID int64) ([]domain.VaultEvent, error) {
+	id, err := utils.Int32FromString(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := b.queries.GetVaultEventsSince(ctx, db.GetVaultEventsSinceParams{
+		UserID:   id,
+		LastID:   lastID,
+		PageSize: int32(b.replaySize),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]domain.VaultEvent, 0, len(rows))
+	for _, row := range rows {
+		var event domain.VaultEvent
+		if err := json.Unmarshal(row.Payload, &event); err != nil {
+			return nil, err
+		}
+		event.ID = row.ID
+		event.CreatedAt = row.CreatedAt
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// listen holds a dedicated connection in LISTEN, reconnecting when it drops.
+// Events published while reconnecting are only available through replay.
+func (b *VaultEventBusPg) listen() error {
+	conn, err := b.connect()
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		for attempt := 0; ; attempt++ {
+			if conn != nil {
+				attempt = 0
+				err := b.receive(conn)
+				conn.Close(context.Background())
+				log.Printf("vault events: lost the LISTEN connection: %v", err)
+			}
+
+			time.Sleep(utils.ExponentialBackoff(attempt, time.Second, 30*time.Second))
+			if conn, err = b.connect(); err != nil {
+				log.Printf("vault events: failed to reconnect: %v", err)
+			}
+		}
+	}()
+
+	return nil
+}
+
+func (b *VaultEventBusPg) connect() (*pgx.Conn, error) {
+	ctx := context.Background()
+	conn, err := pgx.Connect(ctx, b.connString)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := conn.Exec(ctx, "LISTEN "+vaultEventsNotifyChannel); err != nil {
+		conn.Close(ctx)
+		return nil, err
+	}
+	return conn, nil
+}
+
+func (b *VaultEventBusPg) receive(conn *pgx.Conn) error {
+	for {
+		notification, err := conn.WaitForNotification(context.Background())
+		if err != nil {
+			return err
+		}
+
+		var event domain.VaultEvent
+		if err := json.Unmarshal([]byte(notification.Payload), &event); err != nil {
+			log.Printf("vault events: invalid notification: %v", err)
+			continue
+		}
+		b.hub.broadcast(event)
+	}
+}
@@ -0,0 +1,132 @@
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"main/internal/core/domain"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+const vaultEventsPrefix = "vault_events:"
+
+// Redis keys and channels, the pattern subscription only sees channels
+func vaultEventsChannel(userID string) string {
+	return vaultEventsPrefix + userID
+}
+
+func vaultEventsSeqKey(userID string) string {
+	return fmt.Sprintf("%s%s:seq", vaultEventsPrefix, userID)
+}
+
+func vaultEventsLogKey(userID string) string {
+	return fmt.Sprintf("%s%s:log", vaultEventsPrefix, userID)
+}
+
+// VaultEventBusRedis publishes on a channel per user and keeps the last
+// events of each user in a capped list for replay
+type VaultEventBusRedis struct {
+	rdb        *redis.Client
+	hub        *hub
+	replaySize int
+	retention  time.Duration
+
+	start    sync.Once
+	startErr error
+}
+
+func NewVaultEventBusRedis(rdb *redis.Client, replaySize int, retention time.Duration) *VaultEventBusRedis {
+	return &VaultEventBusRedis{
+		rdb:        rdb,
+		hub:        newHub(),
+		replaySize: replaySize,
+		retention:  retention,
+	}
+}
+
+// publishEventScript assigns the ID, logs and publishes the event in one
+// step, so the events of a user are logged and delivered in the order of
+// their IDs even with several replicas publishing. ARGV[1] is the event
+// marshalled with ID 0, which is its first field.
+var publishEventScript = redis.NewScript(`
+local id = redis.call('INCR', KEYS[1])
+local data = '{"id":' .. id .. string.sub(ARGV[1], string.len('{"id":0') + 1)
+redis.call('LPUSH', KEYS[2], data)
+redis.call('LTRIM', KEYS[2], 0, tonumber(ARGV[2]) - 1)
+redis.call('PEXPIRE', KEYS[2], ARGV[3])
+redis.call('PUBLISH', ARGV[4], data)
+return id
+`)
+
+func (b *VaultEventBusRedis) Publish(ctx context.Context, event domain.VaultEvent) error {
+	event.ID = 0
+	event.CreatedAt = time.Now()
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	return publishEventScript.Run(ctx, b.rdb,
+		[]string{vaultEventsSeqKey(event.UserID), vaultEventsLogKey(event.UserID)},
+		data, b.replaySize, b.retention.Milliseconds(), vaultEventsChannel(event.UserID),
+	).Err()
+}
+
+func (b *VaultEventBusRedis) Subscribe(ctx context.Context, userID string) (<-chan domain.VaultEvent, error) {
+	b.start.Do(func() { b.startErr = b.listen() })
+	if b.startErr != nil {
+		return nil, b.startErr
+	}
+	return b.hub.subscribe(ctx, userID), nil
+}
+
+func (b *VaultEventBusRedis) EventsSince(ctx context.Context, userID string, lastID int64) ([]domain.VaultEvent, error) {
+	entries, err := b.rdb.LRange(ctx, vaultEventsLogKey(userID), 0, -1).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	var events []domain.VaultEvent
+	for _, entry := range entries {
+		var event domain.VaultEvent
+		if err := json.Unmarshal([]byte(entry), &event); err != nil {
+			return nil, err
+		}
+		if event.ID > lastID {
+			events = append(events, event)
+		}
+	}
+	// The list is newest first
+	slices.Reverse(events)
+	return events, nil
+}
+
+// listen subscribes this replica to the channels of every user. go-redis
+// resubscribes by itself when the connection drops.
+func (b *VaultEventBusRedis) listen() error {
+	ctx := context.Background()
+	pubsub := b.rdb.PSubscribe(ctx, vaultEventsPrefix+"*")
+	if _, err := pubsub.Receive(ctx); err != nil {
+		pubsub.Close()
+		return err
+	}
+
+	go func() {
+		for msg := range pubsub.Channel() {
+			var event domain.VaultEvent
+			if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
+				log.Printf("vault events: invalid message on %s: %v", msg.Channel, err)
+				continue
+			}
+			b.hub.broadcast(event)
+		}
+	}()
+
+	return nil
+}
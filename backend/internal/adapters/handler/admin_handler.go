@@ -0,0 +1,151 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"main/internal/core/domain"
+	"main/internal/core/services"
+	"main/internal/oapi"
+)
+
+// AdminHandler serves the /admin endpoints, AuthMiddleware only lets admins through
+type AdminHandler struct {
+	outboxService         *services.OutboxService
+	vaultIntegrityService *services.VaultIntegrityService
+}
+
+func NewAdminHandler(outboxService *services.OutboxService, vaultIntegrityService *services.VaultIntegrityService) *AdminHandler {
+	return &AdminHandler{
+		outboxService:         outboxService,
+		vaultIntegrityService: vaultIntegrityService,
+	}
+}
+
+func (h *AdminHandler) ListOutboxMessages(ctx context.Context, request oapi.ListOutboxMessagesRequestObject) (oapi.ListOutboxMessagesResponseObject, error) {
+	status := domain.OutboxStatusDead
+	if request.Params.Status != nil {
+		status = domain.OutboxStatus(*request.Params.Status)
+	}
+
+	limit := 50
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+
+	messages, err := h.outboxService.ListMessages(ctx, status, limit)
+	if err != nil {
+		return oapi.ListOutboxMessages500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	response := make([]oapi.OutboxMessageResponse, 0, len(messages))
+	for _, m := range messages {
+		response = append(response, mapToAPIOutboxMessage(m))
+	}
+
+	return oapi.ListOutboxMessages200JSONResponse(response), nil
+}
+
+func (h *AdminHandler) RequeueOutboxMessage(ctx context.Context, request oapi.RequeueOutboxMessageRequestObject) (oapi.RequeueOutboxMessageResponseObject, error) {
+	err := h.outboxService.RequeueMessage(ctx, request.MessageID.String())
+	if errors.Is(err, services.ErrOutboxMessageNotFound) {
+		return oapi.RequeueOutboxMessage404JSONResponse{
+			Code:    404,
+			Message: err.Error(),
+		}, nil
+	}
+	if err != nil {
+		return oapi.RequeueOutboxMessage500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.RequeueOutboxMessage204Response{}, nil
+}
+
+func (h *AdminHandler) VerifyVaults(ctx context.Context, request oapi.VerifyVaultsRequestObject) (oapi.VerifyVaultsResponseObject, error) {
+	var after string
+	if request.Params.After != nil {
+		after = *request.Params.After
+	}
+
+	limit := 100
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+
+	rollback := request.Params.Rollback != nil && *request.Params.Rollback
+
+	report, err := h.vaultIntegrityService.Verify(ctx, after, limit, rollback)
+	if err != nil {
+		return oapi.VerifyVaults500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	response := oapi.VerifyVaults200JSONResponse{
+		Checked:  report.Checked,
+		Problems: make([]oapi.VaultIntegrityProblem, 0, len(report.Problems)),
+	}
+	for _, p := range report.Problems {
+		response.Problems = append(response.Problems, mapToAPIVaultIntegrityProblem(p))
+	}
+	if report.Next != "" {
+		response.Next = &report.Next
+	}
+
+	return response, nil
+}
+
+func mapToAPIVaultIntegrityProblem(p domain.VaultIntegrityProblem) oapi.VaultIntegrityProblem {
+	problem := oapi.VaultIntegrityProblem{
+		VaultId:  p.VaultID,
+		UserId:   p.UserID,
+		Revision: p.Revision,
+		Status:   oapi.VaultIntegrityProblemStatus(p.Status),
+	}
+
+	if p.Error != "" {
+		problem.Error = &p.Error
+	}
+	if p.Status == domain.VaultRestored {
+		problem.RestoredFrom = &p.RestoredFrom
+		problem.RestoredRevision = &p.RestoredRevision
+	}
+
+	return problem
+}
+
+func mapToAPIOutboxMessage(m domain.OutboxMessage) oapi.OutboxMessageResponse {
+	response := oapi.OutboxMessageResponse{
+		Id:            m.PublicID,
+		Kind:          string(m.Kind),
+		Status:        oapi.OutboxMessageResponseStatus(m.Status),
+		Attempts:      m.Attempts,
+		NextAttemptAt: m.NextAttemptAt.Unix(),
+		CreatedAt:     m.CreatedAt.Unix(),
+	}
+
+	if recipient := m.RecipientEmail(); recipient != "" {
+		response.Recipient = &recipient
+	}
+	if m.LastError != "" {
+		response.LastError = &m.LastError
+	}
+	if !m.SentAt.IsZero() {
+		sentAt := m.SentAt.Unix()
+		response.SentAt = &sentAt
+	}
+
+	return response
+}
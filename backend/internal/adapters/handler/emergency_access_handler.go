@@ -0,0 +1,388 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"main/internal/adapters/middleware"
+	"main/internal/core/domain"
+	"main/internal/core/services"
+	"main/internal/oapi"
+	"time"
+)
+
+type EmergencyAccessHandler struct {
+	emergencyAccessService *services.EmergencyAccessService
+}
+
+func NewEmergencyAccessHandler(emergencyAccessService *services.EmergencyAccessService) *EmergencyAccessHandler {
+	return &EmergencyAccessHandler{emergencyAccessService: emergencyAccessService}
+}
+
+func (h *EmergencyAccessHandler) ListEmergencyAccess(ctx context.Context, request oapi.ListEmergencyAccessRequestObject) (oapi.ListEmergencyAccessResponseObject, error) {
+	access, ok := middleware.GetAccessSession(ctx)
+	if !ok || access == nil {
+		return oapi.ListEmergencyAccess401JSONResponse{
+			UnauthorizedJSONResponse: oapi.UnauthorizedJSONResponse{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		}, nil
+	}
+
+	granted, err := h.emergencyAccessService.ListGranted(ctx, access.UserID)
+	if err != nil {
+		return oapi.ListEmergencyAccess500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+	trusted, err := h.emergencyAccessService.ListTrusted(ctx, access.UserID)
+	if err != nil {
+		return oapi.ListEmergencyAccess500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	response := oapi.EmergencyAccessListResponse{
+		Granted: make([]oapi.EmergencyAccessResponse, 0, len(granted)),
+		Trusted: make([]oapi.EmergencyAccessResponse, 0, len(trusted)),
+	}
+	for _, a := range granted {
+		response.Granted = append(response.Granted, mapToAPIEmergencyAccess(&a))
+	}
+	for _, a := range trusted {
+		response.Trusted = append(response.Trusted, mapToAPIEmergencyAccess(&a))
+	}
+
+	return oapi.ListEmergencyAccess200JSONResponse(response), nil
+}
+
+func (h *EmergencyAccessHandler) InviteEmergencyContact(ctx context.Context, request oapi.InviteEmergencyContactRequestObject) (oapi.InviteEmergencyContactResponseObject, error) {
+	access, ok := middleware.GetAccessSession(ctx)
+	if !ok || access == nil {
+		return oapi.InviteEmergencyContact401JSONResponse{
+			UnauthorizedJSONResponse: oapi.UnauthorizedJSONResponse{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		}, nil
+	}
+
+	emergencyAccess, err := h.emergencyAccessService.Invite(ctx, access.UserID, domain.EmergencyAccess{
+		GranteeEmail:   string(request.Body.Email),
+		VaultID:        request.Body.VaultId.String(),
+		Mode:           domain.EmergencyAccessMode(request.Body.Mode),
+		WaitPeriod:     time.Duration(request.Body.WaitDays) * 24 * time.Hour,
+		WrappedKey:     request.Body.WrappedKey,
+		KeyVersion:     request.Body.KeyVersion,
+		UserKeyVersion: request.Body.UserKeyVersion,
+	})
+	switch {
+	case err == nil:
+	case errors.Is(err, services.ErrInvalidEmergencyAccessMode),
+		errors.Is(err, services.ErrInvalidEmergencyAccessWait),
+		errors.Is(err, services.ErrEmergencyAccessSelf),
+		errors.Is(err, services.ErrInvalidWrappedKey):
+		return oapi.InviteEmergencyContact400JSONResponse{
+			BadRequestJSONResponse: oapi.BadRequestJSONResponse{
+				Code:    400,
+				Message: err.Error(),
+			},
+		}, nil
+	case errors.Is(err, services.ErrEmergencyAccessVaultNotOwns):
+		return oapi.InviteEmergencyContact403JSONResponse{
+			ForbiddenJSONResponse: oapi.ForbiddenJSONResponse{
+				Code:    403,
+				Message: err.Error(),
+			},
+		}, nil
+	case errors.Is(err, services.ErrVaultNotFound), errors.Is(err, services.ErrUserKeyNotFound):
+		return oapi.InviteEmergencyContact404JSONResponse{
+			Code:    404,
+			Message: err.Error(),
+		}, nil
+	case errors.Is(err, services.ErrEmergencyAccessExists),
+		errors.Is(err, services.ErrVaultNotShared),
+		errors.Is(err, services.ErrVaultKeyRotationRequired),
+		errors.Is(err, services.ErrVaultKeyVersionMismatch),
+		errors.Is(err, services.ErrUserKeyVersionMismatch):
+		return oapi.InviteEmergencyContact409JSONResponse{
+			Code:    409,
+			Message: err.Error(),
+		}, nil
+	default:
+		return oapi.InviteEmergencyContact500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.InviteEmergencyContact201JSONResponse(mapToAPIEmergencyAccess(emergencyAccess)), nil
+}
+
+func (h *EmergencyAccessHandler) RevokeEmergencyAccess(ctx context.Context, request oapi.RevokeEmergencyAccessRequestObject) (oapi.RevokeEmergencyAccessResponseObject, error) {
+	access, ok := middleware.GetAccessSession(ctx)
+	if !ok || access == nil {
+		return oapi.RevokeEmergencyAccess401JSONResponse{
+			UnauthorizedJSONResponse: oapi.UnauthorizedJSONResponse{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		}, nil
+	}
+
+	err := h.emergencyAccessService.Revoke(ctx, access.UserID, request.EmergencyAccessID.String())
+	switch {
+	case err == nil:
+	case errors.Is(err, services.ErrEmergencyAccessNotFound):
+		return oapi.RevokeEmergencyAccess404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: err.Error(),
+			},
+		}, nil
+	default:
+		return oapi.RevokeEmergencyAccess500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.RevokeEmergencyAccess204Response{}, nil
+}
+
+func (h *EmergencyAccessHandler) AcceptEmergencyAccess(ctx context.Context, request oapi.AcceptEmergencyAccessRequestObject) (oapi.AcceptEmergencyAccessResponseObject, error) {
+	access, ok := middleware.GetAccessSession(ctx)
+	if !ok || access == nil {
+		return oapi.AcceptEmergencyAccess401JSONResponse{
+			UnauthorizedJSONResponse: oapi.UnauthorizedJSONResponse{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		}, nil
+	}
+
+	emergencyAccess, err := h.emergencyAccessService.Accept(ctx, access.UserID, request.EmergencyAccessID.String())
+	switch {
+	case err == nil:
+	case errors.Is(err, services.ErrEmergencyAccessNotFound):
+		return oapi.AcceptEmergencyAccess404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: err.Error(),
+			},
+		}, nil
+	case errors.Is(err, services.ErrEmergencyAccessStatus):
+		return oapi.AcceptEmergencyAccess409JSONResponse{
+			Code:    409,
+			Message: err.Error(),
+		}, nil
+	default:
+		return oapi.AcceptEmergencyAccess500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.AcceptEmergencyAccess200JSONResponse(mapToAPIEmergencyAccess(emergencyAccess)), nil
+}
+
+func (h *EmergencyAccessHandler) RequestEmergencyAccess(ctx context.Context, request oapi.RequestEmergencyAccessRequestObject) (oapi.RequestEmergencyAccessResponseObject, error) {
+	access, ok := middleware.GetAccessSession(ctx)
+	if !ok || access == nil {
+		return oapi.RequestEmergencyAccess401JSONResponse{
+			UnauthorizedJSONResponse: oapi.UnauthorizedJSONResponse{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		}, nil
+	}
+
+	emergencyAccess, err := h.emergencyAccessService.Request(ctx, access.UserID, request.EmergencyAccessID.String())
+	switch {
+	case err == nil:
+	case errors.Is(err, services.ErrEmergencyAccessNotFound):
+		return oapi.RequestEmergencyAccess404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: err.Error(),
+			},
+		}, nil
+	case errors.Is(err, services.ErrEmergencyAccessStatus):
+		return oapi.RequestEmergencyAccess409JSONResponse{
+			Code:    409,
+			Message: err.Error(),
+		}, nil
+	default:
+		return oapi.RequestEmergencyAccess500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.RequestEmergencyAccess200JSONResponse(mapToAPIEmergencyAccess(emergencyAccess)), nil
+}
+
+func (h *EmergencyAccessHandler) ApproveEmergencyAccess(ctx context.Context, request oapi.ApproveEmergencyAccessRequestObject) (oapi.ApproveEmergencyAccessResponseObject, error) {
+	access, ok := middleware.GetAccessSession(ctx)
+	if !ok || access == nil {
+		return oapi.ApproveEmergencyAccess401JSONResponse{
+			UnauthorizedJSONResponse: oapi.UnauthorizedJSONResponse{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		}, nil
+	}
+
+	emergencyAccess, err := h.emergencyAccessService.Approve(ctx, access.UserID, request.EmergencyAccessID.String())
+	switch {
+	case err == nil:
+	case errors.Is(err, services.ErrEmergencyAccessNotFound):
+		return oapi.ApproveEmergencyAccess404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: err.Error(),
+			},
+		}, nil
+	case errors.Is(err, services.ErrEmergencyAccessStatus):
+		return oapi.ApproveEmergencyAccess409JSONResponse{
+			Code:    409,
+			Message: err.Error(),
+		}, nil
+	default:
+		return oapi.ApproveEmergencyAccess500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.ApproveEmergencyAccess200JSONResponse(mapToAPIEmergencyAccess(emergencyAccess)), nil
+}
+
+func (h *EmergencyAccessHandler) RejectEmergencyAccess(ctx context.Context, request oapi.RejectEmergencyAccessRequestObject) (oapi.RejectEmergencyAccessResponseObject, error) {
+	access, ok := middleware.GetAccessSession(ctx)
+	if !ok || access == nil {
+		return oapi.RejectEmergencyAccess401JSONResponse{
+			UnauthorizedJSONResponse: oapi.UnauthorizedJSONResponse{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		}, nil
+	}
+
+	emergencyAccess, err := h.emergencyAccessService.Reject(ctx, access.UserID, request.EmergencyAccessID.String())
+	switch {
+	case err == nil:
+	case errors.Is(err, services.ErrEmergencyAccessNotFound):
+		return oapi.RejectEmergencyAccess404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: err.Error(),
+			},
+		}, nil
+	case errors.Is(err, services.ErrEmergencyAccessStatus):
+		return oapi.RejectEmergencyAccess409JSONResponse{
+			Code:    409,
+			Message: err.Error(),
+		}, nil
+	default:
+		return oapi.RejectEmergencyAccess500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.RejectEmergencyAccess200JSONResponse(mapToAPIEmergencyAccess(emergencyAccess)), nil
+}
+
+func (h *EmergencyAccessHandler) UpdateEmergencyAccessKey(ctx context.Context, request oapi.UpdateEmergencyAccessKeyRequestObject) (oapi.UpdateEmergencyAccessKeyResponseObject, error) {
+	access, ok := middleware.GetAccessSession(ctx)
+	if !ok || access == nil {
+		return oapi.UpdateEmergencyAccessKey401JSONResponse{
+			UnauthorizedJSONResponse: oapi.UnauthorizedJSONResponse{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		}, nil
+	}
+
+	emergencyAccess, err := h.emergencyAccessService.UpdateKey(ctx, access.UserID, request.EmergencyAccessID.String(), request.Body.KeyVersion, domain.VaultMemberKey{
+		WrappedKey:     request.Body.WrappedKey,
+		UserKeyVersion: request.Body.UserKeyVersion,
+	})
+	switch {
+	case err == nil:
+	case errors.Is(err, services.ErrInvalidWrappedKey):
+		return oapi.UpdateEmergencyAccessKey400JSONResponse{
+			BadRequestJSONResponse: oapi.BadRequestJSONResponse{
+				Code:    400,
+				Message: err.Error(),
+			},
+		}, nil
+	case errors.Is(err, services.ErrEmergencyAccessNotFound), errors.Is(err, services.ErrVaultNotFound):
+		return oapi.UpdateEmergencyAccessKey404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: err.Error(),
+			},
+		}, nil
+	case errors.Is(err, services.ErrVaultKeyVersionMismatch), errors.Is(err, services.ErrUserKeyVersionMismatch):
+		return oapi.UpdateEmergencyAccessKey409JSONResponse{
+			Code:    409,
+			Message: err.Error(),
+		}, nil
+	default:
+		return oapi.UpdateEmergencyAccessKey500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.UpdateEmergencyAccessKey200JSONResponse(mapToAPIEmergencyAccess(emergencyAccess)), nil
+}
+
+func mapToAPIEmergencyAccess(access *domain.EmergencyAccess) oapi.EmergencyAccessResponse {
+	response := oapi.EmergencyAccessResponse{
+		Id:             access.ID,
+		GrantorEmail:   access.GrantorEmail,
+		GrantorName:    access.GrantorName,
+		GranteeEmail:   access.GranteeEmail,
+		GranteeName:    access.GranteeName,
+		VaultId:        access.VaultID,
+		VaultName:      access.VaultName,
+		Mode:           oapi.EmergencyAccessMode(access.Mode),
+		Status:         oapi.EmergencyAccessStatus(access.Status),
+		WaitDays:       int(access.WaitPeriod / (24 * time.Hour)),
+		KeyVersion:     access.KeyVersion,
+		UserKeyVersion: access.UserKeyVersion,
+		CreatedAt:      access.CreatedAt.Unix(),
+	}
+	if !access.RequestedAt.IsZero() {
+		requestedAt := access.RequestedAt.Unix()
+		response.RequestedAt = &requestedAt
+	}
+	if grantsAt := access.GrantsAt(); !grantsAt.IsZero() {
+		grantsAtUnix := grantsAt.Unix()
+		response.GrantsAt = &grantsAtUnix
+	}
+	return response
+}
@@ -0,0 +1,127 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"main/internal/adapters/middleware"
+	"main/internal/core/services"
+	"main/internal/oapi"
+)
+
+type InviteHandler struct {
+	inviteService *services.InviteService
+	authService   *services.AuthService
+}
+
+func NewInviteHandler(inviteService *services.InviteService, authService *services.AuthService) *InviteHandler {
+	return &InviteHandler{inviteService: inviteService, authService: authService}
+}
+
+func (h *InviteHandler) CreateInvite(ctx context.Context, request oapi.CreateInviteRequestObject) (oapi.CreateInviteResponseObject, error) {
+	session, ok := middleware.GetAccessSession(ctx)
+	if !ok || session == nil {
+		return oapi.CreateInvite401JSONResponse{
+			Code:    401,
+			Message: "User not authenticated",
+		}, nil
+	}
+
+	var email string
+	if request.Body.Email != nil {
+		email = string(*request.Body.Email)
+	}
+
+	invite, err := h.inviteService.CreateInvite(ctx, session.UserID, email)
+	if err != nil {
+		return oapi.CreateInvite400JSONResponse{
+			BadRequestJSONResponse: oapi.BadRequestJSONResponse{
+				Code:    400,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	response := mapToAPIInvite(invite.Invite)
+	response.Token = &invite.Token
+	return oapi.CreateInvite201JSONResponse(response), nil
+}
+
+func (h *InviteHandler) ListInvites(ctx context.Context, request oapi.ListInvitesRequestObject) (oapi.ListInvitesResponseObject, error) {
+	session, ok := middleware.GetAccessSession(ctx)
+	if !ok || session == nil {
+		return oapi.ListInvites401JSONResponse{
+			Code:    401,
+			Message: "User not authenticated",
+		}, nil
+	}
+
+	isAdmin, err := h.authService.IsAdmin(ctx, session.UserID)
+	if err != nil {
+		return oapi.ListInvites500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	invites, err := h.inviteService.ListInvites(ctx, session.UserID, isAdmin)
+	if err != nil {
+		return oapi.ListInvites500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	response := make([]oapi.InviteResponse, 0, len(invites))
+	for _, i := range invites {
+		response = append(response, mapToAPIInvite(i))
+	}
+
+	return oapi.ListInvites200JSONResponse(response), nil
+}
+
+func (h *InviteHandler) RevokeInvite(ctx context.Context, request oapi.RevokeInviteRequestObject) (oapi.RevokeInviteResponseObject, error) {
+	session, ok := middleware.GetAccessSession(ctx)
+	if !ok || session == nil {
+		return oapi.RevokeInvite401JSONResponse{
+			Code:    401,
+			Message: "User not authenticated",
+		}, nil
+	}
+
+	isAdmin, err := h.authService.IsAdmin(ctx, session.UserID)
+	if err != nil {
+		return oapi.RevokeInvite500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	err = h.inviteService.RevokeInvite(ctx, session.UserID, request.InviteID.String(), isAdmin)
+	switch {
+	case err == nil:
+		return oapi.RevokeInvite204Response{}, nil
+	case errors.Is(err, services.ErrInviteNotFound):
+		return oapi.RevokeInvite404JSONResponse{
+			Code:    404,
+			Message: err.Error(),
+		}, nil
+	case errors.Is(err, services.ErrInviteForbidden):
+		return oapi.RevokeInvite403JSONResponse{
+			Code:    403,
+			Message: err.Error(),
+		}, nil
+	default:
+		return oapi.RevokeInvite400JSONResponse{
+			BadRequestJSONResponse: oapi.BadRequestJSONResponse{
+				Code:    400,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+}
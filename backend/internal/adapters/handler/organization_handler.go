@@ -0,0 +1,796 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"main/internal/adapters/middleware"
+	"main/internal/core/domain"
+	"main/internal/core/services"
+	"main/internal/oapi"
+	"time"
+)
+
+type OrganizationHandler struct {
+	organizationService *services.OrganizationService
+	vaultService        *services.VaultService
+}
+
+func NewOrganizationHandler(organizationService *services.OrganizationService, vaultService *services.VaultService) *OrganizationHandler {
+	return &OrganizationHandler{organizationService: organizationService, vaultService: vaultService}
+}
+
+func (h *OrganizationHandler) ListOrganizations(ctx context.Context, request oapi.ListOrganizationsRequestObject) (oapi.ListOrganizationsResponseObject, error) {
+	access, ok := middleware.GetAccessSession(ctx)
+	if !ok || access == nil {
+		return oapi.ListOrganizations401JSONResponse{
+			UnauthorizedJSONResponse: oapi.UnauthorizedJSONResponse{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		}, nil
+	}
+
+	orgs, err := h.organizationService.List(ctx, access.UserID)
+	if err != nil {
+		return oapi.ListOrganizations500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	response := make([]oapi.OrganizationResponse, 0, len(orgs))
+	for _, org := range orgs {
+		response = append(response, mapToAPIOrganization(&org))
+	}
+
+	return oapi.ListOrganizations200JSONResponse(response), nil
+}
+
+func (h *OrganizationHandler) CreateOrganization(ctx context.Context, request oapi.CreateOrganizationRequestObject) (oapi.CreateOrganizationResponseObject, error) {
+	access, ok := middleware.GetAccessSession(ctx)
+	if !ok || access == nil {
+		return oapi.CreateOrganization401JSONResponse{
+			UnauthorizedJSONResponse: oapi.UnauthorizedJSONResponse{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		}, nil
+	}
+
+	org, err := h.organizationService.Create(ctx, access.UserID, request.Body.Name)
+	if errors.Is(err, services.ErrInvalidOrganizationName) {
+		return oapi.CreateOrganization400JSONResponse{
+			BadRequestJSONResponse: oapi.BadRequestJSONResponse{
+				Code:    400,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+	if err != nil {
+		return oapi.CreateOrganization500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.CreateOrganization201JSONResponse(mapToAPIOrganization(org)), nil
+}
+
+func (h *OrganizationHandler) AcceptOrganizationInvite(ctx context.Context, request oapi.AcceptOrganizationInviteRequestObject) (oapi.AcceptOrganizationInviteResponseObject, error) {
+	access, ok := middleware.GetAccessSession(ctx)
+	if !ok || access == nil {
+		return oapi.AcceptOrganizationInvite401JSONResponse{
+			UnauthorizedJSONResponse: oapi.UnauthorizedJSONResponse{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		}, nil
+	}
+
+	org, err := h.organizationService.AcceptInvite(ctx, access.UserID, request.Body.Token)
+	switch {
+	case err == nil:
+	case errors.Is(err, services.ErrOrganizationInviteNotFound), errors.Is(err, services.ErrOrganizationNotFound):
+		return oapi.AcceptOrganizationInvite404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: err.Error(),
+			},
+		}, nil
+	case errors.Is(err, services.ErrOrganizationInviteEmailMismatch), errors.Is(err, services.ErrEmailDomainNotAllowed):
+		return oapi.AcceptOrganizationInvite403JSONResponse{
+			ForbiddenJSONResponse: oapi.ForbiddenJSONResponse{
+				Code:    403,
+				Message: err.Error(),
+			},
+		}, nil
+	case errors.Is(err, services.ErrOrganizationMemberExists):
+		return oapi.AcceptOrganizationInvite409JSONResponse{
+			Code:    409,
+			Message: err.Error(),
+		}, nil
+	default:
+		return oapi.AcceptOrganizationInvite500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.AcceptOrganizationInvite200JSONResponse(mapToAPIOrganization(org)), nil
+}
+
+func (h *OrganizationHandler) GetUserPolicies(ctx context.Context, request oapi.GetUserPoliciesRequestObject) (oapi.GetUserPoliciesResponseObject, error) {
+	access, ok := middleware.GetAccessSession(ctx)
+	if !ok || access == nil {
+		return oapi.GetUserPolicies401JSONResponse{
+			UnauthorizedJSONResponse: oapi.UnauthorizedJSONResponse{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		}, nil
+	}
+
+	policies, err := h.organizationService.UserPolicies(ctx, access.UserID)
+	if err != nil {
+		return oapi.GetUserPolicies500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.GetUserPolicies200JSONResponse(mapToAPIOrganizationPolicies(&policies)), nil
+}
+
+func (h *OrganizationHandler) GetOrganization(ctx context.Context, request oapi.GetOrganizationRequestObject) (oapi.GetOrganizationResponseObject, error) {
+	org, ok := middleware.GetOrganization(ctx)
+	if !ok {
+		return oapi.GetOrganization404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: services.ErrOrganizationNotFound.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.GetOrganization200JSONResponse(mapToAPIOrganization(org)), nil
+}
+
+func (h *OrganizationHandler) UpdateOrganization(ctx context.Context, request oapi.UpdateOrganizationRequestObject) (oapi.UpdateOrganizationResponseObject, error) {
+	org, ok := middleware.GetOrganization(ctx)
+	if !ok {
+		return oapi.UpdateOrganization404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: services.ErrOrganizationNotFound.Error(),
+			},
+		}, nil
+	}
+
+	org, err := h.organizationService.Rename(ctx, org, request.Body.Name)
+	switch {
+	case err == nil:
+	case errors.Is(err, services.ErrInvalidOrganizationName):
+		return oapi.UpdateOrganization400JSONResponse{
+			BadRequestJSONResponse: oapi.BadRequestJSONResponse{
+				Code:    400,
+				Message: err.Error(),
+			},
+		}, nil
+	case errors.Is(err, services.ErrOrganizationNotFound):
+		return oapi.UpdateOrganization404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: err.Error(),
+			},
+		}, nil
+	default:
+		return oapi.UpdateOrganization500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.UpdateOrganization200JSONResponse(mapToAPIOrganization(org)), nil
+}
+
+func (h *OrganizationHandler) DeleteOrganization(ctx context.Context, request oapi.DeleteOrganizationRequestObject) (oapi.DeleteOrganizationResponseObject, error) {
+	org, ok := middleware.GetOrganization(ctx)
+	if !ok {
+		return oapi.DeleteOrganization404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: services.ErrOrganizationNotFound.Error(),
+			},
+		}, nil
+	}
+
+	err := h.organizationService.Delete(ctx, org.ID)
+	if errors.Is(err, services.ErrOrganizationNotFound) {
+		return oapi.DeleteOrganization404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+	if err != nil {
+		return oapi.DeleteOrganization500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.DeleteOrganization204Response{}, nil
+}
+
+func (h *OrganizationHandler) ListOrganizationMembers(ctx context.Context, request oapi.ListOrganizationMembersRequestObject) (oapi.ListOrganizationMembersResponseObject, error) {
+	org, ok := middleware.GetOrganization(ctx)
+	if !ok {
+		return oapi.ListOrganizationMembers404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: services.ErrOrganizationNotFound.Error(),
+			},
+		}, nil
+	}
+
+	members, err := h.organizationService.ListMembers(ctx, org.ID)
+	if err != nil {
+		return oapi.ListOrganizationMembers500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	response := make([]oapi.OrganizationMemberResponse, 0, len(members))
+	for _, m := range members {
+		response = append(response, mapToAPIOrganizationMember(&m))
+	}
+
+	return oapi.ListOrganizationMembers200JSONResponse(response), nil
+}
+
+func (h *OrganizationHandler) UpdateOrganizationMember(ctx context.Context, request oapi.UpdateOrganizationMemberRequestObject) (oapi.UpdateOrganizationMemberResponseObject, error) {
+	org, ok := middleware.GetOrganization(ctx)
+	if !ok {
+		return oapi.UpdateOrganizationMember404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: services.ErrOrganizationNotFound.Error(),
+			},
+		}, nil
+	}
+
+	member, err := h.organizationService.UpdateMemberRole(ctx, org, request.MemberID.String(), domain.OrganizationRole(request.Body.Role))
+	switch {
+	case err == nil:
+	case errors.Is(err, services.ErrInvalidOrganizationRole):
+		return oapi.UpdateOrganizationMember400JSONResponse{
+			BadRequestJSONResponse: oapi.BadRequestJSONResponse{
+				Code:    400,
+				Message: err.Error(),
+			},
+		}, nil
+	case errors.Is(err, services.ErrOrganizationForbidden):
+		return oapi.UpdateOrganizationMember403JSONResponse{
+			ForbiddenJSONResponse: oapi.ForbiddenJSONResponse{
+				Code:    403,
+				Message: err.Error(),
+			},
+		}, nil
+	case errors.Is(err, services.ErrOrganizationMemberNotFound):
+		return oapi.UpdateOrganizationMember404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: err.Error(),
+			},
+		}, nil
+	case errors.Is(err, services.ErrLastOrganizationOwner):
+		return oapi.UpdateOrganizationMember409JSONResponse{
+			Code:    409,
+			Message: err.Error(),
+		}, nil
+	default:
+		return oapi.UpdateOrganizationMember500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.UpdateOrganizationMember200JSONResponse(mapToAPIOrganizationMember(member)), nil
+}
+
+func (h *OrganizationHandler) RemoveOrganizationMember(ctx context.Context, request oapi.RemoveOrganizationMemberRequestObject) (oapi.RemoveOrganizationMemberResponseObject, error) {
+	org, ok := middleware.GetOrganization(ctx)
+	if !ok {
+		return oapi.RemoveOrganizationMember404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: services.ErrOrganizationNotFound.Error(),
+			},
+		}, nil
+	}
+
+	err := h.organizationService.RemoveMember(ctx, org, request.MemberID.String())
+	switch {
+	case err == nil:
+	case errors.Is(err, services.ErrOrganizationForbidden):
+		return oapi.RemoveOrganizationMember403JSONResponse{
+			ForbiddenJSONResponse: oapi.ForbiddenJSONResponse{
+				Code:    403,
+				Message: err.Error(),
+			},
+		}, nil
+	case errors.Is(err, services.ErrOrganizationMemberNotFound):
+		return oapi.RemoveOrganizationMember404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: err.Error(),
+			},
+		}, nil
+	case errors.Is(err, services.ErrLastOrganizationOwner):
+		return oapi.RemoveOrganizationMember409JSONResponse{
+			Code:    409,
+			Message: err.Error(),
+		}, nil
+	default:
+		return oapi.RemoveOrganizationMember500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.RemoveOrganizationMember204Response{}, nil
+}
+
+func (h *OrganizationHandler) ListOrganizationInvites(ctx context.Context, request oapi.ListOrganizationInvitesRequestObject) (oapi.ListOrganizationInvitesResponseObject, error) {
+	org, ok := middleware.GetOrganization(ctx)
+	if !ok {
+		return oapi.ListOrganizationInvites404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: services.ErrOrganizationNotFound.Error(),
+			},
+		}, nil
+	}
+
+	invites, err := h.organizationService.ListInvites(ctx, org.ID)
+	if err != nil {
+		return oapi.ListOrganizationInvites500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	response := make([]oapi.OrganizationInviteResponse, 0, len(invites))
+	for _, invite := range invites {
+		response = append(response, mapToAPIOrganizationInvite(&invite))
+	}
+
+	return oapi.ListOrganizationInvites200JSONResponse(response), nil
+}
+
+func (h *OrganizationHandler) CreateOrganizationInvite(ctx context.Context, request oapi.CreateOrganizationInviteRequestObject) (oapi.CreateOrganizationInviteResponseObject, error) {
+	org, ok := middleware.GetOrganization(ctx)
+	if !ok {
+		return oapi.CreateOrganizationInvite404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: services.ErrOrganizationNotFound.Error(),
+			},
+		}, nil
+	}
+
+	invite, err := h.organizationService.Invite(ctx, org, string(request.Body.Email), domain.OrganizationRole(request.Body.Role))
+	switch {
+	case err == nil:
+	case errors.Is(err, services.ErrInvalidOrganizationRole), errors.Is(err, services.ErrEmailDomainNotAllowed):
+		return oapi.CreateOrganizationInvite400JSONResponse{
+			BadRequestJSONResponse: oapi.BadRequestJSONResponse{
+				Code:    400,
+				Message: err.Error(),
+			},
+		}, nil
+	case errors.Is(err, services.ErrOrganizationForbidden):
+		return oapi.CreateOrganizationInvite403JSONResponse{
+			ForbiddenJSONResponse: oapi.ForbiddenJSONResponse{
+				Code:    403,
+				Message: err.Error(),
+			},
+		}, nil
+	case errors.Is(err, services.ErrOrganizationNotFound):
+		return oapi.CreateOrganizationInvite404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: err.Error(),
+			},
+		}, nil
+	case errors.Is(err, services.ErrOrganizationMemberExists):
+		return oapi.CreateOrganizationInvite409JSONResponse{
+			Code:    409,
+			Message: err.Error(),
+		}, nil
+	default:
+		return oapi.CreateOrganizationInvite500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	response := mapToAPIOrganizationInvite(&invite.OrganizationInvite)
+	response.Token = &invite.Token
+	return oapi.CreateOrganizationInvite201JSONResponse(response), nil
+}
+
+func (h *OrganizationHandler) RevokeOrganizationInvite(ctx context.Context, request oapi.RevokeOrganizationInviteRequestObject) (oapi.RevokeOrganizationInviteResponseObject, error) {
+	org, ok := middleware.GetOrganization(ctx)
+	if !ok {
+		return oapi.RevokeOrganizationInvite404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: services.ErrOrganizationNotFound.Error(),
+			},
+		}, nil
+	}
+
+	err := h.organizationService.RevokeInvite(ctx, org.ID, request.InviteID.String())
+	if errors.Is(err, services.ErrOrganizationInviteNotFound) {
+		return oapi.RevokeOrganizationInvite404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+	if err != nil {
+		return oapi.RevokeOrganizationInvite500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.RevokeOrganizationInvite204Response{}, nil
+}
+
+func (h *OrganizationHandler) ListOrganizationGroups(ctx context.Context, request oapi.ListOrganizationGroupsRequestObject) (oapi.ListOrganizationGroupsResponseObject, error) {
+	org, ok := middleware.GetOrganization(ctx)
+	if !ok {
+		return oapi.ListOrganizationGroups404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: services.ErrOrganizationNotFound.Error(),
+			},
+		}, nil
+	}
+
+	groups, err := h.organizationService.ListGroups(ctx, org.ID)
+	if err != nil {
+		return oapi.ListOrganizationGroups500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	response := make([]oapi.OrganizationGroupResponse, 0, len(groups))
+	for _, group := range groups {
+		response = append(response, mapToAPIOrganizationGroup(&group))
+	}
+
+	return oapi.ListOrganizationGroups200JSONResponse(response), nil
+}
+
+func (h *OrganizationHandler) CreateOrganizationGroup(ctx context.Context, request oapi.CreateOrganizationGroupRequestObject) (oapi.CreateOrganizationGroupResponseObject, error) {
+	org, ok := middleware.GetOrganization(ctx)
+	if !ok {
+		return oapi.CreateOrganizationGroup404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: services.ErrOrganizationNotFound.Error(),
+			},
+		}, nil
+	}
+
+	var memberIDs []string
+	if request.Body.MemberIds != nil {
+		memberIDs = *request.Body.MemberIds
+	}
+
+	group, err := h.organizationService.CreateGroup(ctx, org.ID, request.Body.Name, memberIDs)
+	switch {
+	case err == nil:
+	case errors.Is(err, services.ErrInvalidOrganizationGroupName), errors.Is(err, services.ErrInvalidOrganizationGroupMembers):
+		return oapi.CreateOrganizationGroup400JSONResponse{
+			BadRequestJSONResponse: oapi.BadRequestJSONResponse{
+				Code:    400,
+				Message: err.Error(),
+			},
+		}, nil
+	case errors.Is(err, services.ErrOrganizationGroupExists):
+		return oapi.CreateOrganizationGroup409JSONResponse{
+			Code:    409,
+			Message: err.Error(),
+		}, nil
+	default:
+		return oapi.CreateOrganizationGroup500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.CreateOrganizationGroup201JSONResponse(mapToAPIOrganizationGroup(group)), nil
+}
+
+func (h *OrganizationHandler) UpdateOrganizationGroup(ctx context.Context, request oapi.UpdateOrganizationGroupRequestObject) (oapi.UpdateOrganizationGroupResponseObject, error) {
+	org, ok := middleware.GetOrganization(ctx)
+	if !ok {
+		return oapi.UpdateOrganizationGroup404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: services.ErrOrganizationNotFound.Error(),
+			},
+		}, nil
+	}
+
+	var memberIDs []string
+	if request.Body.MemberIds != nil {
+		memberIDs = *request.Body.MemberIds
+	}
+
+	group, err := h.organizationService.UpdateGroup(ctx, org.ID, request.GroupID.String(), request.Body.Name, memberIDs)
+	switch {
+	case err == nil:
+	case errors.Is(err, services.ErrInvalidOrganizationGroupName), errors.Is(err, services.ErrInvalidOrganizationGroupMembers):
+		return oapi.UpdateOrganizationGroup400JSONResponse{
+			BadRequestJSONResponse: oapi.BadRequestJSONResponse{
+				Code:    400,
+				Message: err.Error(),
+			},
+		}, nil
+	case errors.Is(err, services.ErrOrganizationGroupNotFound):
+		return oapi.UpdateOrganizationGroup404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: err.Error(),
+			},
+		}, nil
+	case errors.Is(err, services.ErrOrganizationGroupExists):
+		return oapi.UpdateOrganizationGroup409JSONResponse{
+			Code:    409,
+			Message: err.Error(),
+		}, nil
+	default:
+		return oapi.UpdateOrganizationGroup500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.UpdateOrganizationGroup200JSONResponse(mapToAPIOrganizationGroup(group)), nil
+}
+
+func (h *OrganizationHandler) DeleteOrganizationGroup(ctx context.Context, request oapi.DeleteOrganizationGroupRequestObject) (oapi.DeleteOrganizationGroupResponseObject, error) {
+	org, ok := middleware.GetOrganization(ctx)
+	if !ok {
+		return oapi.DeleteOrganizationGroup404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: services.ErrOrganizationNotFound.Error(),
+			},
+		}, nil
+	}
+
+	err := h.organizationService.DeleteGroup(ctx, org.ID, request.GroupID.String())
+	if errors.Is(err, services.ErrOrganizationGroupNotFound) {
+		return oapi.DeleteOrganizationGroup404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+	if err != nil {
+		return oapi.DeleteOrganizationGroup500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.DeleteOrganizationGroup204Response{}, nil
+}
+
+func (h *OrganizationHandler) GetOrganizationPolicies(ctx context.Context, request oapi.GetOrganizationPoliciesRequestObject) (oapi.GetOrganizationPoliciesResponseObject, error) {
+	org, ok := middleware.GetOrganization(ctx)
+	if !ok {
+		return oapi.GetOrganizationPolicies404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: services.ErrOrganizationNotFound.Error(),
+			},
+		}, nil
+	}
+
+	policies, err := h.organizationService.GetPolicies(ctx, org.ID)
+	if err != nil {
+		return oapi.GetOrganizationPolicies500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.GetOrganizationPolicies200JSONResponse(mapToAPIOrganizationPolicies(policies)), nil
+}
+
+func (h *OrganizationHandler) UpdateOrganizationPolicies(ctx context.Context, request oapi.UpdateOrganizationPoliciesRequestObject) (oapi.UpdateOrganizationPoliciesResponseObject, error) {
+	org, ok := middleware.GetOrganization(ctx)
+	if !ok {
+		return oapi.UpdateOrganizationPolicies404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: services.ErrOrganizationNotFound.Error(),
+			},
+		}, nil
+	}
+
+	policies, err := h.organizationService.UpdatePolicies(ctx, org.ID, domain.OrganizationPolicies{
+		Require2FA:          request.Body.Require2fa,
+		MinKDFIterations:    request.Body.MinKdfIterations,
+		AllowedEmailDomains: request.Body.AllowedEmailDomains,
+		SessionMaxLifetime:  time.Duration(request.Body.SessionMaxLifetime) * time.Second,
+	})
+	switch {
+	case err == nil:
+	case errors.Is(err, services.ErrTwoFactorUnavailable),
+		errors.Is(err, services.ErrInvalidKDFIterations),
+		errors.Is(err, services.ErrInvalidSessionMaxLifetime):
+		return oapi.UpdateOrganizationPolicies400JSONResponse{
+			BadRequestJSONResponse: oapi.BadRequestJSONResponse{
+				Code:    400,
+				Message: err.Error(),
+			},
+		}, nil
+	default:
+		return oapi.UpdateOrganizationPolicies500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.UpdateOrganizationPolicies200JSONResponse(mapToAPIOrganizationPolicies(policies)), nil
+}
+
+// CreateOrganizationVault creates a shared vault in the organization, owned
+// by the admin creating it
+func (h *OrganizationHandler) CreateOrganizationVault(ctx context.Context, request oapi.CreateOrganizationVaultRequestObject) (oapi.CreateOrganizationVaultResponseObject, error) {
+	org, ok := middleware.GetOrganization(ctx)
+	if !ok {
+		return oapi.CreateOrganizationVault404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: services.ErrOrganizationNotFound.Error(),
+			},
+		}, nil
+	}
+
+	vaultType := domain.VaultTypeWork
+	if request.Body.Type != nil {
+		vaultType = domain.VaultType(*request.Body.Type)
+	}
+
+	vault, err := h.vaultService.CreateVault(ctx, org.Membership.UserID, org.ID, request.Body.Name, vaultType)
+	if errors.Is(err, services.ErrInvalidVaultName) {
+		return oapi.CreateOrganizationVault400JSONResponse{
+			BadRequestJSONResponse: oapi.BadRequestJSONResponse{
+				Code:    400,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+	if err != nil {
+		return oapi.CreateOrganizationVault500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.CreateOrganizationVault201JSONResponse(mapToAPIVault(vault)), nil
+}
+
+// mapToAPIOrganization maps an organization with the membership of the user
+func mapToAPIOrganization(org *domain.Organization) oapi.OrganizationResponse {
+	response := oapi.OrganizationResponse{
+		Id:        org.ID,
+		Name:      org.Name,
+		CreatedAt: org.CreatedAt.Unix(),
+	}
+	if org.Membership != nil {
+		response.MemberId = org.Membership.ID
+		response.Role = oapi.OrganizationRole(org.Membership.Role)
+	}
+	return response
+}
+
+func mapToAPIOrganizationMember(member *domain.OrganizationMember) oapi.OrganizationMemberResponse {
+	return oapi.OrganizationMemberResponse{
+		Id:        member.ID,
+		UserId:    member.UserPublicID,
+		Email:     member.Email,
+		Name:      member.Name,
+		Role:      oapi.OrganizationRole(member.Role),
+		CreatedAt: member.CreatedAt.Unix(),
+	}
+}
+
+func mapToAPIOrganizationInvite(invite *domain.OrganizationInvite) oapi.OrganizationInviteResponse {
+	return oapi.OrganizationInviteResponse{
+		Id:        invite.ID,
+		Email:     invite.Email,
+		Role:      oapi.OrganizationRole(invite.Role),
+		CreatedAt: invite.CreatedAt.Unix(),
+		ExpiresAt: invite.ExpiresAt.Unix(),
+	}
+}
+
+func mapToAPIOrganizationGroup(group *domain.OrganizationGroup) oapi.OrganizationGroupResponse {
+	memberIDs := group.MemberIDs
+	if memberIDs == nil {
+		memberIDs = []string{}
+	}
+	return oapi.OrganizationGroupResponse{
+		Id:        group.ID,
+		Name:      group.Name,
+		MemberIds: memberIDs,
+		CreatedAt: group.CreatedAt.Unix(),
+	}
+}
+
+// mapToAPIOrganizationPolicies maps the session lifetime to seconds
+func mapToAPIOrganizationPolicies(policies *domain.OrganizationPolicies) oapi.OrganizationPolicies {
+	domains := policies.AllowedEmailDomains
+	if domains == nil {
+		domains = []string{}
+	}
+	return oapi.OrganizationPolicies{
+		Require2fa:          policies.Require2FA,
+		MinKdfIterations:    policies.MinKDFIterations,
+		AllowedEmailDomains: domains,
+		SessionMaxLifetime:  int(policies.SessionMaxLifetime / time.Second),
+	}
+}
@@ -0,0 +1,103 @@
+package handler
+
+import (
+	"context"
+	"main/internal/adapters/middleware"
+	"main/internal/core/domain"
+	"main/internal/core/services"
+	"main/internal/oapi"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SecurityEventHandler struct {
+	securityEventService *services.SecurityEventService
+}
+
+func NewSecurityEventHandler(securityEventService *services.SecurityEventService) *SecurityEventHandler {
+	return &SecurityEventHandler{securityEventService: securityEventService}
+}
+
+func (h *SecurityEventHandler) ListUserEvents(ctx context.Context, request oapi.ListUserEventsRequestObject) (oapi.ListUserEventsResponseObject, error) {
+	session, ok := middleware.GetAccessSession(ctx)
+	if !ok || session == nil {
+		return oapi.ListUserEvents401JSONResponse{
+			UnauthorizedJSONResponse: oapi.UnauthorizedJSONResponse{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		}, nil
+	}
+
+	filter := domain.SecurityEventFilter{}
+	if request.Params.Type != nil {
+		for _, t := range *request.Params.Type {
+			filter.Types = append(filter.Types, domain.SecurityEventType(t))
+		}
+	}
+	if request.Params.From != nil {
+		filter.Since = time.Unix(*request.Params.From, 0)
+	}
+	if request.Params.To != nil {
+		filter.Until = time.Unix(*request.Params.To, 0)
+	}
+	if request.Params.Cursor != nil {
+		filter.After = request.Params.Cursor.String()
+	}
+	if request.Params.Limit != nil {
+		filter.Limit = *request.Params.Limit
+	}
+
+	if !filter.Since.IsZero() && !filter.Until.IsZero() && !filter.Since.Before(filter.Until) {
+		return oapi.ListUserEvents400JSONResponse{
+			BadRequestJSONResponse: oapi.BadRequestJSONResponse{
+				Code:    400,
+				Message: "from must be before to",
+			},
+		}, nil
+	}
+
+	events, nextCursor, err := h.securityEventService.ListEvents(ctx, session.UserID, filter)
+	if err != nil {
+		return oapi.ListUserEvents500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	response := oapi.ListUserEvents200JSONResponse{
+		Events: make([]oapi.SecurityEventResponse, 0, len(events)),
+	}
+	for _, e := range events {
+		response.Events = append(response.Events, mapToAPISecurityEvent(e))
+	}
+	if nextCursor != "" {
+		cursor, err := uuid.Parse(nextCursor)
+		if err != nil {
+			return nil, err
+		}
+		response.NextCursor = &cursor
+	}
+
+	return response, nil
+}
+
+func mapToAPISecurityEvent(e domain.SecurityEvent) oapi.SecurityEventResponse {
+	metadata := e.Metadata
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+
+	return oapi.SecurityEventResponse{
+		Id:        e.PublicID,
+		Type:      oapi.SecurityEventType(e.Type),
+		Ip:        e.IP,
+		UserAgent: e.UserAgent,
+		DeviceId:  e.DeviceID,
+		Metadata:  metadata,
+		CreatedAt: e.CreatedAt.Unix(),
+	}
+}
@@ -0,0 +1,182 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"main/internal/adapters/middleware"
+	"main/internal/core/domain"
+	"main/internal/core/services"
+	"main/internal/oapi"
+	"time"
+)
+
+// sendCacheControl keeps caches from storing the payload, a cached copy
+// would outlive the last view
+const sendCacheControl = "private, no-store"
+
+type SendHandler struct {
+	sendService *services.SendService
+}
+
+func NewSendHandler(sendService *services.SendService) *SendHandler {
+	return &SendHandler{sendService: sendService}
+}
+
+func (h *SendHandler) ListSends(ctx context.Context, request oapi.ListSendsRequestObject) (oapi.ListSendsResponseObject, error) {
+	access, ok := middleware.GetAccessSession(ctx)
+	if !ok || access == nil {
+		return oapi.ListSends401JSONResponse{
+			UnauthorizedJSONResponse: oapi.UnauthorizedJSONResponse{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		}, nil
+	}
+
+	sends, err := h.sendService.List(ctx, access.UserID)
+	if err != nil {
+		return oapi.ListSends500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	response := make([]oapi.SendResponse, 0, len(sends))
+	for _, send := range sends {
+		response = append(response, mapToAPISend(&send))
+	}
+
+	return oapi.ListSends200JSONResponse(response), nil
+}
+
+func (h *SendHandler) CreateSend(ctx context.Context, request oapi.CreateSendRequestObject) (oapi.CreateSendResponseObject, error) {
+	access, ok := middleware.GetAccessSession(ctx)
+	if !ok || access == nil {
+		return oapi.CreateSend401JSONResponse{
+			UnauthorizedJSONResponse: oapi.UnauthorizedJSONResponse{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		}, nil
+	}
+
+	password := ""
+	if request.Body.Password != nil {
+		password = *request.Body.Password
+	}
+
+	send, err := h.sendService.Create(ctx, access.UserID, request.Body.Payload, time.Duration(request.Body.ExpiresIn)*time.Second, request.Body.MaxViews, password)
+	switch {
+	case err == nil:
+	case errors.Is(err, services.ErrInvalidSendPayload),
+		errors.Is(err, services.ErrInvalidSendExpiry),
+		errors.Is(err, services.ErrInvalidSendViews):
+		return oapi.CreateSend400JSONResponse{
+			BadRequestJSONResponse: oapi.BadRequestJSONResponse{
+				Code:    400,
+				Message: err.Error(),
+			},
+		}, nil
+	case errors.Is(err, services.ErrTooManySends):
+		return oapi.CreateSend429JSONResponse{
+			Code:    429,
+			Message: err.Error(),
+		}, nil
+	default:
+		return oapi.CreateSend500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.CreateSend201JSONResponse(mapToAPISend(send)), nil
+}
+
+// ViewSend is public, the ID of the send is the credential
+func (h *SendHandler) ViewSend(ctx context.Context, request oapi.ViewSendRequestObject) (oapi.ViewSendResponseObject, error) {
+	password := ""
+	if request.Params.XSendPassword != nil {
+		password = *request.Params.XSendPassword
+	}
+
+	send, err := h.sendService.View(ctx, request.SendID, password)
+	switch {
+	case err == nil:
+	case errors.Is(err, services.ErrSendPasswordRequired), errors.Is(err, services.ErrSendPasswordMismatch):
+		return oapi.ViewSend401JSONResponse{
+			Code:    401,
+			Message: err.Error(),
+		}, nil
+	case errors.Is(err, services.ErrSendNotFound):
+		return oapi.ViewSend404JSONResponse{
+			Code:    404,
+			Message: err.Error(),
+		}, nil
+	default:
+		return oapi.ViewSend500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.ViewSend200JSONResponse{
+		Body: oapi.SendContentResponse{
+			Payload:   send.Payload,
+			ViewsLeft: send.ViewsLeft,
+			ExpiresAt: send.ExpiresAt.Unix(),
+		},
+		Headers: oapi.ViewSend200ResponseHeaders{
+			CacheControl: sendCacheControl,
+		},
+	}, nil
+}
+
+func (h *SendHandler) RevokeSend(ctx context.Context, request oapi.RevokeSendRequestObject) (oapi.RevokeSendResponseObject, error) {
+	access, ok := middleware.GetAccessSession(ctx)
+	if !ok || access == nil {
+		return oapi.RevokeSend401JSONResponse{
+			UnauthorizedJSONResponse: oapi.UnauthorizedJSONResponse{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		}, nil
+	}
+
+	err := h.sendService.Revoke(ctx, access.UserID, request.SendID)
+	switch {
+	case err == nil:
+	case errors.Is(err, services.ErrSendNotFound):
+		return oapi.RevokeSend404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: err.Error(),
+			},
+		}, nil
+	default:
+		return oapi.RevokeSend500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.RevokeSend204Response{}, nil
+}
+
+func mapToAPISend(send *domain.Send) oapi.SendResponse {
+	return oapi.SendResponse{
+		Id:          send.ID,
+		MaxViews:    send.MaxViews,
+		ViewsLeft:   send.ViewsLeft,
+		HasPassword: send.HasPassword(),
+		ExpiresAt:   send.ExpiresAt.Unix(),
+		CreatedAt:   send.CreatedAt.Unix(),
+	}
+}
@@ -39,7 +39,17 @@ func (h *UserHandler) ListUsers(ctx context.Context, request oapi.ListUsersReque
 }
 
 func (h *UserHandler) CreateUser(ctx context.Context, request oapi.CreateUserRequestObject) (oapi.CreateUserResponseObject, error) {
-	_, err := h.userService.CreateUser(ctx, request.Body.Name, string(request.Body.Email), request.Body.Password)
+	var inviteToken string
+	if request.Body.InviteToken != nil {
+		inviteToken = *request.Body.InviteToken
+	}
+
+	locale := "en"
+	if request.Body.Locale != nil {
+		locale = *request.Body.Locale
+	}
+
+	_, err := h.userService.CreateUser(ctx, request.Body.Name, string(request.Body.Email), request.Body.Password, locale, inviteToken)
 	if err != nil {
 		return oapi.CreateUser400JSONResponse{
 			BadRequestJSONResponse: oapi.BadRequestJSONResponse{
@@ -0,0 +1,198 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"main/internal/adapters/middleware"
+	"main/internal/core/domain"
+	"main/internal/core/services"
+	"main/internal/oapi"
+)
+
+type UserKeyHandler struct {
+	userKeyService *services.UserKeyService
+}
+
+func NewUserKeyHandler(userKeyService *services.UserKeyService) *UserKeyHandler {
+	return &UserKeyHandler{userKeyService: userKeyService}
+}
+
+func (h *UserKeyHandler) ListUserKeys(ctx context.Context, request oapi.ListUserKeysRequestObject) (oapi.ListUserKeysResponseObject, error) {
+	session, ok := middleware.GetAccessSession(ctx)
+	if !ok || session == nil {
+		return oapi.ListUserKeys401JSONResponse{
+			UnauthorizedJSONResponse: oapi.UnauthorizedJSONResponse{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		}, nil
+	}
+
+	keys, err := h.userKeyService.ListKeys(ctx, session.UserID)
+	if err != nil {
+		return oapi.ListUserKeys500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	response := make([]oapi.UserKeyResponse, 0, len(keys))
+	for _, k := range keys {
+		response = append(response, mapToAPIUserKey(k))
+	}
+
+	return oapi.ListUserKeys200JSONResponse(response), nil
+}
+
+func (h *UserKeyHandler) PublishUserKey(ctx context.Context, request oapi.PublishUserKeyRequestObject) (oapi.PublishUserKeyResponseObject, error) {
+	session, ok := middleware.GetAccessSession(ctx)
+	if !ok || session == nil {
+		return oapi.PublishUserKey401JSONResponse{
+			UnauthorizedJSONResponse: oapi.UnauthorizedJSONResponse{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		}, nil
+	}
+
+	key, err := h.userKeyService.PublishKey(ctx, session.UserID, domain.UserKey{
+		Version:             request.Body.Version,
+		Algorithm:           domain.UserKeyAlgorithm(request.Body.Algorithm),
+		PublicKey:           request.Body.PublicKey,
+		EncryptedPrivateKey: request.Body.EncryptedPrivateKey,
+	})
+	switch {
+	case err == nil:
+	case errors.Is(err, services.ErrInvalidPublicKey), errors.Is(err, services.ErrInvalidPrivateKey):
+		return oapi.PublishUserKey400JSONResponse{
+			BadRequestJSONResponse: oapi.BadRequestJSONResponse{
+				Code:    400,
+				Message: err.Error(),
+			},
+		}, nil
+	case errors.Is(err, services.ErrUserKeyVersionMismatch):
+		current, err := h.userKeyService.CurrentKey(ctx, session.UserID)
+		if err != nil {
+			return nil, err
+		}
+		var version int
+		if current != nil {
+			version = current.Version
+		}
+		return oapi.PublishUserKey409JSONResponse{
+			Code:    409,
+			Message: services.ErrUserKeyVersionMismatch.Error(),
+			Version: version,
+		}, nil
+	default:
+		return oapi.PublishUserKey500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.PublishUserKey201JSONResponse(mapToAPIUserKey(*key)), nil
+}
+
+func (h *UserKeyHandler) GetUserKey(ctx context.Context, request oapi.GetUserKeyRequestObject) (oapi.GetUserKeyResponseObject, error) {
+	session, ok := middleware.GetAccessSession(ctx)
+	if !ok || session == nil {
+		return oapi.GetUserKey401JSONResponse{
+			UnauthorizedJSONResponse: oapi.UnauthorizedJSONResponse{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		}, nil
+	}
+
+	key, err := h.userKeyService.GetKey(ctx, session.UserID, request.Version)
+	switch {
+	case err == nil:
+	case errors.Is(err, services.ErrUserKeyNotFound):
+		return oapi.GetUserKey404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: err.Error(),
+			},
+		}, nil
+	default:
+		return oapi.GetUserKey500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.GetUserKey200JSONResponse(mapToAPIUserKey(*key)), nil
+}
+
+func (h *UserKeyHandler) LookupPublicKey(ctx context.Context, request oapi.LookupPublicKeyRequestObject) (oapi.LookupPublicKeyResponseObject, error) {
+	session, ok := middleware.GetAccessSession(ctx)
+	if !ok || session == nil {
+		return oapi.LookupPublicKey401JSONResponse{
+			UnauthorizedJSONResponse: oapi.UnauthorizedJSONResponse{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		}, nil
+	}
+
+	if (request.Params.Email == nil) == (request.Params.UserId == nil) {
+		return oapi.LookupPublicKey400JSONResponse{
+			BadRequestJSONResponse: oapi.BadRequestJSONResponse{
+				Code:    400,
+				Message: "Either email or userId is required",
+			},
+		}, nil
+	}
+	var email, publicID string
+	if request.Params.Email != nil {
+		email = string(*request.Params.Email)
+	} else {
+		publicID = request.Params.UserId.String()
+	}
+
+	key, err := h.userKeyService.LookupPublicKey(ctx, email, publicID)
+	switch {
+	case err == nil:
+	case errors.Is(err, services.ErrUserKeyNotFound):
+		return oapi.LookupPublicKey404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: err.Error(),
+			},
+		}, nil
+	default:
+		return oapi.LookupPublicKey500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.LookupPublicKey200JSONResponse{
+		UserId:      key.UserID,
+		Version:     key.Version,
+		Algorithm:   oapi.UserKeyAlgorithm(key.Algorithm),
+		PublicKey:   key.PublicKey,
+		Fingerprint: key.Fingerprint,
+		CreatedAt:   key.CreatedAt.Unix(),
+	}, nil
+}
+
+func mapToAPIUserKey(key domain.UserKey) oapi.UserKeyResponse {
+	return oapi.UserKeyResponse{
+		Version:             key.Version,
+		Algorithm:           oapi.UserKeyAlgorithm(key.Algorithm),
+		PublicKey:           key.PublicKey,
+		EncryptedPrivateKey: key.EncryptedPrivateKey,
+		Fingerprint:         key.Fingerprint,
+		CreatedAt:           key.CreatedAt.Unix(),
+	}
+}
@@ -3,6 +3,7 @@ package handler
 import (
 	"main/internal/core/domain"
 	"main/internal/oapi"
+	"time"
 
 	"github.com/oapi-codegen/runtime/types"
 )
@@ -11,10 +12,38 @@ func mapToAPIUser(u domain.User) oapi.UserResponse {
 	id := u.PublicID
 	name := u.Name
 	email := types.Email(u.Email)
+	locale := u.Locale
 
 	return oapi.UserResponse{
-		Id:    id,
-		Name:  &name,
-		Email: email,
+		Id:     id,
+		Name:   &name,
+		Email:  email,
+		Locale: &locale,
 	}
 }
+
+func mapToAPIInvite(i domain.Invite) oapi.InviteResponse {
+	response := oapi.InviteResponse{
+		Id:        i.PublicID,
+		CreatedAt: i.CreatedAt.Unix(),
+		ExpiresAt: i.ExpiresAt.Unix(),
+	}
+
+	if i.Email != "" {
+		email := types.Email(i.Email)
+		response.Email = &email
+	}
+
+	switch {
+	case !i.UsedAt.IsZero():
+		response.Status = oapi.InviteResponseStatusUsed
+	case !i.RevokedAt.IsZero():
+		response.Status = oapi.InviteResponseStatusRevoked
+	case time.Now().After(i.ExpiresAt):
+		response.Status = oapi.InviteResponseStatusExpired
+	default:
+		response.Status = oapi.InviteResponseStatusPending
+	}
+
+	return response
+}
@@ -0,0 +1,156 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"main/internal/adapters/middleware"
+	"main/internal/core/domain"
+	"main/internal/core/services"
+	"main/internal/oapi"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type VaultEventHandler struct {
+	vaultEventService *services.VaultEventService
+}
+
+func NewVaultEventHandler(vaultEventService *services.VaultEventService) *VaultEventHandler {
+	return &VaultEventHandler{vaultEventService: vaultEventService}
+}
+
+func (h *VaultEventHandler) StreamVaultEvents(ctx context.Context, request oapi.StreamVaultEventsRequestObject) (oapi.StreamVaultEventsResponseObject, error) {
+	access, ok := middleware.GetAccessSession(ctx)
+	if !ok || access == nil {
+		return oapi.StreamVaultEvents401JSONResponse{
+			UnauthorizedJSONResponse: oapi.UnauthorizedJSONResponse{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		}, nil
+	}
+
+	var lastEventID int64
+	if request.Params.LastEventID != nil && *request.Params.LastEventID != "" {
+		id, err := strconv.ParseInt(*request.Params.LastEventID, 10, 64)
+		if err != nil || id < 0 {
+			return oapi.StreamVaultEvents400JSONResponse{
+				BadRequestJSONResponse: oapi.BadRequestJSONResponse{
+					Code:    400,
+					Message: "Invalid Last-Event-ID",
+				},
+			}, nil
+		}
+		lastEventID = id
+	}
+
+	events, err := h.vaultEventService.Subscribe(ctx, access.UserID, lastEventID)
+	if err != nil {
+		return oapi.StreamVaultEvents500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return vaultEventStream{
+		events:    events,
+		heartbeat: h.vaultEventService.Heartbeat(),
+		deviceID:  access.DeviceID,
+		expiresAt: access.ExpiresAt,
+	}, nil
+}
+
+// vaultEventStream writes the events as Server-Sent Events until the client
+// goes away, the access token expires or the session of the device is revoked
+type vaultEventStream struct {
+	events    <-chan domain.VaultEvent
+	heartbeat time.Duration
+	deviceID  string
+	expiresAt time.Time
+}
+
+type vaultEventData struct {
+	VaultID   string `json:"vaultId,omitempty"`
+	Revision  int64  `json:"revision,omitempty"`
+	Cursor    int64  `json:"cursor,omitempty"`
+	DeviceID  string `json:"deviceId,omitempty"`
+	CreatedAt int64  `json:"createdAt"`
+}
+
+func (s vaultEventStream) VisitStreamVaultEventsResponse(w http.ResponseWriter) error {
+	controller := http.NewResponseController(w)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	// Tell nginx not to buffer the stream
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := fmt.Fprint(w, "retry: 3000\n\n"); err != nil {
+		return err
+	}
+	if err := controller.Flush(); err != nil {
+		return err
+	}
+
+	heartbeat := time.NewTicker(s.heartbeat)
+	defer heartbeat.Stop()
+	// The client reconnects with a refreshed token and catches up with Last-Event-ID
+	var expired <-chan time.Time
+	if !s.expiresAt.IsZero() {
+		timer := time.NewTimer(time.Until(s.expiresAt))
+		defer timer.Stop()
+		expired = timer.C
+	}
+
+	for {
+		select {
+		case event, ok := <-s.events:
+			if !ok {
+				return nil
+			}
+			// The other devices of the user are none of this stream's business
+			if event.Type == domain.VaultEventSessionRevoked && event.DeviceID != s.deviceID {
+				continue
+			}
+			if err := writeVaultEvent(w, event); err != nil {
+				return err
+			}
+			if event.Type == domain.VaultEventSessionRevoked {
+				controller.Flush()
+				return nil
+			}
+		case <-heartbeat.C:
+			if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+				return err
+			}
+		case <-expired:
+			return nil
+		}
+
+		if err := controller.Flush(); err != nil {
+			return err
+		}
+	}
+}
+
+func writeVaultEvent(w http.ResponseWriter, event domain.VaultEvent) error {
+	data, err := json.Marshal(vaultEventData{
+		VaultID:   event.VaultID,
+		Revision:  event.Revision,
+		Cursor:    event.Cursor,
+		DeviceID:  event.DeviceID,
+		CreatedAt: event.CreatedAt.Unix(),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)
+	return err
+}
@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"main/internal/core/domain"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestVaultEventStream_SessionRevokedOnlyForOwnDevice(t *testing.T) {
+	events := make(chan domain.VaultEvent, 3)
+	events <- domain.VaultEvent{ID: 1, Type: domain.VaultEventSessionRevoked, DeviceID: "other-device"}
+	events <- domain.VaultEvent{ID: 2, Type: domain.VaultEventUpdated, VaultID: "vault", Revision: 4, DeviceID: "other-device"}
+	events <- domain.VaultEvent{ID: 3, Type: domain.VaultEventSessionRevoked, DeviceID: "device"}
+
+	stream := vaultEventStream{events: events, heartbeat: time.Minute, deviceID: "device"}
+	w := httptest.NewRecorder()
+	if err := stream.VisitStreamVaultEventsResponse(w); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	body := w.Body.String()
+	if strings.Contains(body, "id: 1\n") {
+		t.Errorf("expected the revocation of another device to be skipped, got %q", body)
+	}
+	if !strings.Contains(body, "id: 2\n") {
+		t.Errorf("expected the vault update to be sent, got %q", body)
+	}
+	if !strings.Contains(body, "id: 3\n") {
+		t.Errorf("expected the revocation of the device to be sent, got %q", body)
+	}
+}
@@ -2,12 +2,18 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"main/internal/adapters/middleware"
+	"main/internal/core/domain"
 	"main/internal/core/services"
 	"main/internal/oapi"
 	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"strings"
+	"time"
 )
 
 type VaultHandler struct {
@@ -27,7 +33,34 @@ func (h *VaultHandler) GetUserVault(ctx context.Context, request oapi.GetUserVau
 		}, nil
 	}
 
-	vault, err := h.vaultService.GetVaultByUserID(ctx, access.UserID)
+	vault, err := h.vaultService.DefaultVault(ctx, access.UserID)
+	if err != nil {
+		return oapi.GetUserVault500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+	if !vault.HasContent() {
+		return oapi.GetUserVault404JSONResponse{
+			Code:    404,
+			Message: "Vault not found",
+		}, nil
+	}
+
+	// The content is only opened in storage when it is sent
+	if vaultNotModified(vault, request.Params.IfNoneMatch, request.Params.IfModifiedSince) {
+		return oapi.GetUserVault304Response{
+			Headers: oapi.GetUserVault304ResponseHeaders{
+				ETag:         domain.VaultETag(vault),
+				LastModified: vault.UpdatedAt.UTC().Format(http.TimeFormat),
+				CacheControl: vaultCacheControl,
+			},
+		}, nil
+	}
+
+	vault, content, err := h.vaultService.OpenVault(ctx, vault.ID)
 	if err != nil {
 		return oapi.GetUserVault500JSONResponse{
 			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
@@ -43,7 +76,9 @@ func (h *VaultHandler) GetUserVault(ctx context.Context, request oapi.GetUserVau
 		}, nil
 	}
 
-	return oapi.GetUserVault200MultipartResponse(func(writer *multipart.Writer) error {
+	body := func(writer *multipart.Writer) error {
+		defer content.Close()
+
 		// --- Part 1: updatedAt field ---
 		updatedAtPart, err := writer.CreateFormField("updatedAt") // name="updatedAt"
 		if err != nil {
@@ -55,18 +90,40 @@ func (h *VaultHandler) GetUserVault(ctx context.Context, request oapi.GetUserVau
 			return err
 		}
 
-		// --- Part 2: Binary vault ---
-		filePart, err := writer.CreateFormFile("vaultFile", "vault.zip") // sets name & filename
+		// --- Part 2: revision field ---
+		revisionPart, err := writer.CreateFormField("revision")
 		if err != nil {
 			return err
 		}
 
-		if _, err := filePart.Write(vault.Vault); err != nil {
+		if _, err := revisionPart.Write([]byte(fmt.Sprintf("%d", vault.Revision))); err != nil {
+			return err
+		}
+
+		// --- Part 3: Binary vault ---
+		// Like CreateFormFile, with the digest so the client can check what it received
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", `form-data; name="vaultFile"; filename="vault.zip"`)
+		header.Set("Content-Type", "application/octet-stream")
+		header.Set("Content-Digest", domain.VaultContentDigest(vault.SHA256))
+		filePart, err := writer.CreatePart(header)
+		if err != nil {
 			return err
 		}
 
-		return nil
-	}), nil
+		// Streamed from storage, the vault is never whole in memory
+		_, err = io.Copy(filePart, content)
+		return err
+	}
+
+	return oapi.GetUserVault200MultipartResponse{
+		Body: body,
+		Headers: oapi.GetUserVault200ResponseHeaders{
+			ETag:         domain.VaultETag(vault),
+			LastModified: vault.UpdatedAt.UTC().Format(http.TimeFormat),
+			CacheControl: vaultCacheControl,
+		},
+	}, nil
 }
 
 func (h *VaultHandler) PollUserVault(ctx context.Context, request oapi.PollUserVaultRequestObject) (oapi.PollUserVaultResponseObject, error) {
@@ -78,7 +135,32 @@ func (h *VaultHandler) PollUserVault(ctx context.Context, request oapi.PollUserV
 		}, nil
 	}
 
-	vaultUpdateAt, err := h.vaultService.GetVaultUpdatedAtByUserID(ctx, access.UserID)
+	revision, err := h.vaultService.DefaultVault(ctx, access.UserID)
+	if err == nil && revision != nil && request.Params.Since != nil && request.Params.Wait != nil {
+		wait, parseErr := time.ParseDuration(*request.Params.Wait)
+		if parseErr != nil || wait < 0 {
+			return oapi.PollUserVault400JSONResponse{
+				BadRequestJSONResponse: oapi.BadRequestJSONResponse{
+					Code:    400,
+					Message: "Invalid wait, use a duration like 30s",
+				},
+			}, nil
+		}
+
+		revision, err = h.vaultService.WaitForUpdate(ctx, access.UserID, revision.ID, *request.Params.Since, wait)
+		if errors.Is(err, services.ErrTooManyVaultPollers) {
+			return oapi.PollUserVault429JSONResponse{
+				TooManyRequestsJSONResponse: oapi.TooManyRequestsJSONResponse{
+					Code:    429,
+					Message: err.Error(),
+				},
+			}, nil
+		}
+		if ctx.Err() != nil {
+			// The client went away, there is nobody to answer
+			return nil, ctx.Err()
+		}
+	}
 
 	if err != nil {
 		return oapi.PollUserVault500JSONResponse{
@@ -88,16 +170,22 @@ func (h *VaultHandler) PollUserVault(ctx context.Context, request oapi.PollUserV
 			},
 		}, nil
 	}
-	if vaultUpdateAt == nil {
+	if revision == nil {
 		return oapi.PollUserVault404JSONResponse{
 			Code:    404,
 			Message: "Vault not found",
 		}, nil
 	}
 
-	return oapi.PollUserVault200JSONResponse{
-		UpdatedAt: vaultUpdateAt.Unix(),
-	}, nil
+	response := oapi.PollUserVault200JSONResponse{
+		Headers: oapi.PollUserVault200ResponseHeaders{
+			ETag: domain.VaultETag(revision),
+		},
+	}
+	response.Body.Revision = revision.Revision
+	response.Body.UpdatedAt = revision.UpdatedAt.Unix()
+	response.Body.ContentDigest = domain.VaultContentDigest(revision.SHA256)
+	return response, nil
 }
 
 func (h *VaultHandler) InsertUserVault(ctx context.Context, request oapi.InsertUserVaultRequestObject) (oapi.InsertUserVaultResponseObject, error) {
@@ -109,19 +197,610 @@ func (h *VaultHandler) InsertUserVault(ctx context.Context, request oapi.InsertU
 		}, nil
 	}
 
-	vaultBytes, err := io.ReadAll(request.Body)
+	if request.Params.IfMatch == nil {
+		return oapi.InsertUserVault428JSONResponse{
+			Code:    428,
+			Message: "If-Match is required, use the ETag of the vault the upload is based on",
+		}, nil
+	}
+	expectedRevision, ok := domain.ParseVaultETag(*request.Params.IfMatch)
+	if !ok {
+		return oapi.InsertUserVault400JSONResponse{
+			BadRequestJSONResponse: oapi.BadRequestJSONResponse{
+				Code:    400,
+				Message: "Invalid If-Match",
+			},
+		}, nil
+	}
+
+	content, err := vaultBody(request.Body, request.Params.ContentDigest)
+	if err != nil {
+		return oapi.InsertUserVault400JSONResponse{
+			BadRequestJSONResponse: oapi.BadRequestJSONResponse{
+				Code:    400,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	inserted, err := h.vaultService.InsertDefaultVault(ctx, access.UserID, content, expectedRevision)
+	if errors.Is(err, services.ErrVaultRevisionMismatch) {
+		current, err := h.vaultService.DefaultVault(ctx, access.UserID)
+		if err != nil {
+			return nil, err
+		}
+		return oapi.InsertUserVault412JSONResponse{
+			Body:    vaultConflict(current),
+			Headers: oapi.InsertUserVault412ResponseHeaders{ETag: domain.VaultETag(current)},
+		}, nil
+	}
+	if errors.Is(err, services.ErrVaultDigestMismatch) {
+		return oapi.InsertUserVault400JSONResponse{
+			BadRequestJSONResponse: oapi.BadRequestJSONResponse{
+				Code:    400,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+	if errors.Is(err, services.ErrVaultTooLarge) {
+		return oapi.InsertUserVault413JSONResponse{
+			PayloadTooLargeJSONResponse: oapi.PayloadTooLargeJSONResponse{
+				Code:    413,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+	if errors.Is(err, services.ErrVaultQuotaExceeded) {
+		return oapi.InsertUserVault507JSONResponse{
+			QuotaExceededJSONResponse: oapi.QuotaExceededJSONResponse{
+				Code:    507,
+				Message: err.Error(),
+			},
+		}, nil
+	}
 	if err != nil {
 		return oapi.InsertUserVault500JSONResponse{
 			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
 				Code:    500,
-				Message: "Failed to read request body",
+				Message: err.Error(),
 			},
 		}, nil
 	}
 
-	_, err = h.vaultService.InsertVaultByUserID(ctx, access.UserID, vaultBytes)
+	return oapi.InsertUserVault204Response{
+		Headers: oapi.InsertUserVault204ResponseHeaders{
+			ETag: domain.VaultETag(inserted),
+		},
+	}, nil
+}
+
+func (h *VaultHandler) ListVaultVersions(ctx context.Context, request oapi.ListVaultVersionsRequestObject) (oapi.ListVaultVersionsResponseObject, error) {
+	access, ok := middleware.GetAccessSession(ctx)
+	if !ok || access == nil {
+		return oapi.ListVaultVersions401JSONResponse{
+			UnauthorizedJSONResponse: oapi.UnauthorizedJSONResponse{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		}, nil
+	}
+
+	versions := []domain.VaultVersion{}
+	vault, err := h.vaultService.DefaultVault(ctx, access.UserID)
+	if err == nil && vault != nil {
+		versions, err = h.vaultService.ListVersions(ctx, vault.ID)
+	}
 	if err != nil {
-		return oapi.InsertUserVault500JSONResponse{
+		return oapi.ListVaultVersions500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	response := make([]oapi.VaultVersionResponse, 0, len(versions))
+	for _, v := range versions {
+		response = append(response, oapi.VaultVersionResponse{
+			Revision:  v.Revision,
+			Size:      v.Size,
+			Sha256:    v.SHA256,
+			DeviceId:  v.DeviceID,
+			CreatedAt: v.CreatedAt.Unix(),
+		})
+	}
+
+	return oapi.ListVaultVersions200JSONResponse(response), nil
+}
+
+func (h *VaultHandler) GetVaultVersion(ctx context.Context, request oapi.GetVaultVersionRequestObject) (oapi.GetVaultVersionResponseObject, error) {
+	access, ok := middleware.GetAccessSession(ctx)
+	if !ok || access == nil {
+		return oapi.GetVaultVersion401JSONResponse{
+			UnauthorizedJSONResponse: oapi.UnauthorizedJSONResponse{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		}, nil
+	}
+
+	vault, err := h.vaultService.DefaultVault(ctx, access.UserID)
+	if err != nil {
+		return oapi.GetVaultVersion500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+	if vault == nil {
+		return oapi.GetVaultVersion404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: services.ErrVaultVersionNotFound.Error(),
+			},
+		}, nil
+	}
+
+	version, content, err := h.vaultService.OpenVersion(ctx, vault.ID, request.Revision)
+	switch {
+	case err == nil:
+	case errors.Is(err, services.ErrVaultVersionNotFound):
+		return oapi.GetVaultVersion404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: err.Error(),
+			},
+		}, nil
+	default:
+		return oapi.GetVaultVersion500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.GetVaultVersion200ApplicationoctetStreamResponse{
+		Body: content,
+		Headers: oapi.GetVaultVersion200ResponseHeaders{
+			CacheControl:  vaultCacheControl,
+			ContentDigest: domain.VaultContentDigest(version.SHA256),
+		},
+		ContentLength: int64(version.Size),
+	}, nil
+}
+
+func (h *VaultHandler) RestoreVaultVersion(ctx context.Context, request oapi.RestoreVaultVersionRequestObject) (oapi.RestoreVaultVersionResponseObject, error) {
+	access, ok := middleware.GetAccessSession(ctx)
+	if !ok || access == nil {
+		return oapi.RestoreVaultVersion401JSONResponse{
+			UnauthorizedJSONResponse: oapi.UnauthorizedJSONResponse{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		}, nil
+	}
+
+	if request.Params.IfMatch == nil {
+		return oapi.RestoreVaultVersion428JSONResponse{
+			Code:    428,
+			Message: "If-Match is required, use the ETag of the current vault",
+		}, nil
+	}
+	expectedRevision, ok := domain.ParseVaultETag(*request.Params.IfMatch)
+	if !ok {
+		return oapi.RestoreVaultVersion400JSONResponse{
+			BadRequestJSONResponse: oapi.BadRequestJSONResponse{
+				Code:    400,
+				Message: "Invalid If-Match",
+			},
+		}, nil
+	}
+
+	vault, err := h.vaultService.DefaultVault(ctx, access.UserID)
+	if err != nil {
+		return oapi.RestoreVaultVersion500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+	if vault == nil {
+		return oapi.RestoreVaultVersion404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: services.ErrVaultVersionNotFound.Error(),
+			},
+		}, nil
+	}
+
+	restored, err := h.vaultService.RestoreVersion(ctx, access.UserID, vault.ID, request.Revision, expectedRevision)
+	switch {
+	case err == nil:
+	case errors.Is(err, services.ErrVaultVersionNotFound):
+		return oapi.RestoreVaultVersion404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: err.Error(),
+			},
+		}, nil
+	case errors.Is(err, services.ErrVaultRevisionMismatch):
+		current, err := h.vaultService.GetVault(ctx, vault.ID)
+		if err != nil {
+			return nil, err
+		}
+		return oapi.RestoreVaultVersion412JSONResponse{
+			Body:    vaultConflict(current),
+			Headers: oapi.RestoreVaultVersion412ResponseHeaders{ETag: domain.VaultETag(current)},
+		}, nil
+	case errors.Is(err, services.ErrVaultQuotaExceeded):
+		return oapi.RestoreVaultVersion507JSONResponse{
+			QuotaExceededJSONResponse: oapi.QuotaExceededJSONResponse{
+				Code:    507,
+				Message: err.Error(),
+			},
+		}, nil
+	default:
+		return oapi.RestoreVaultVersion500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.RestoreVaultVersion204Response{
+		Headers: oapi.RestoreVaultVersion204ResponseHeaders{
+			ETag: domain.VaultETag(restored),
+		},
+	}, nil
+}
+
+func (h *VaultHandler) GetVaultUsage(ctx context.Context, request oapi.GetVaultUsageRequestObject) (oapi.GetVaultUsageResponseObject, error) {
+	access, ok := middleware.GetAccessSession(ctx)
+	if !ok || access == nil {
+		return oapi.GetVaultUsage401JSONResponse{
+			UnauthorizedJSONResponse: oapi.UnauthorizedJSONResponse{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		}, nil
+	}
+
+	usage, err := h.vaultService.GetUsage(ctx, access.UserID)
+	if err != nil {
+		return oapi.GetVaultUsage500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	maxSize, quota := h.vaultService.Quota()
+	return oapi.GetVaultUsage200JSONResponse{
+		VaultBytes:    usage.VaultBytes,
+		HistoryBytes:  usage.HistoryBytes,
+		ItemBytes:     usage.ItemBytes,
+		UsedBytes:     usage.Total(),
+		QuotaBytes:    quota,
+		MaxVaultBytes: int64(maxSize),
+	}, nil
+}
+
+func (h *VaultHandler) ListVaults(ctx context.Context, request oapi.ListVaultsRequestObject) (oapi.ListVaultsResponseObject, error) {
+	access, ok := middleware.GetAccessSession(ctx)
+	if !ok || access == nil {
+		return oapi.ListVaults401JSONResponse{
+			UnauthorizedJSONResponse: oapi.UnauthorizedJSONResponse{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		}, nil
+	}
+
+	vaults, err := h.vaultService.ListVaults(ctx, access.UserID)
+	if err != nil {
+		return oapi.ListVaults500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	response := make([]oapi.VaultResponse, 0, len(vaults))
+	for _, v := range vaults {
+		response = append(response, mapToAPIVault(&v))
+	}
+
+	return oapi.ListVaults200JSONResponse(response), nil
+}
+
+func (h *VaultHandler) CreateVault(ctx context.Context, request oapi.CreateVaultRequestObject) (oapi.CreateVaultResponseObject, error) {
+	access, ok := middleware.GetAccessSession(ctx)
+	if !ok || access == nil {
+		return oapi.CreateVault401JSONResponse{
+			UnauthorizedJSONResponse: oapi.UnauthorizedJSONResponse{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		}, nil
+	}
+
+	vaultType := domain.VaultTypePersonal
+	if request.Body.Type != nil {
+		vaultType = domain.VaultType(*request.Body.Type)
+	}
+
+	vault, err := h.vaultService.CreateVault(ctx, access.UserID, "", request.Body.Name, vaultType)
+	if errors.Is(err, services.ErrInvalidVaultName) {
+		return oapi.CreateVault400JSONResponse{
+			BadRequestJSONResponse: oapi.BadRequestJSONResponse{
+				Code:    400,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+	if err != nil {
+		return oapi.CreateVault500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.CreateVault201JSONResponse(mapToAPIVault(vault)), nil
+}
+
+func (h *VaultHandler) GetVault(ctx context.Context, request oapi.GetVaultRequestObject) (oapi.GetVaultResponseObject, error) {
+	vault, ok := middleware.GetVault(ctx)
+	if !ok {
+		return oapi.GetVault404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: services.ErrVaultNotFound.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.GetVault200JSONResponse(mapToAPIVault(vault)), nil
+}
+
+func (h *VaultHandler) UpdateVault(ctx context.Context, request oapi.UpdateVaultRequestObject) (oapi.UpdateVaultResponseObject, error) {
+	vault, ok := middleware.GetVault(ctx)
+	if !ok {
+		return oapi.UpdateVault404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: services.ErrVaultNotFound.Error(),
+			},
+		}, nil
+	}
+
+	// Omitted fields are left as they are
+	name, vaultType := vault.Name, vault.Type
+	if request.Body.Name != nil {
+		name = *request.Body.Name
+	}
+	if request.Body.Type != nil {
+		vaultType = domain.VaultType(*request.Body.Type)
+	}
+
+	updated, err := h.vaultService.UpdateVault(ctx, vault.ID, name, vaultType)
+	switch {
+	case err == nil:
+		updated.Membership = vault.Membership
+	case errors.Is(err, services.ErrInvalidVaultName):
+		return oapi.UpdateVault400JSONResponse{
+			BadRequestJSONResponse: oapi.BadRequestJSONResponse{
+				Code:    400,
+				Message: err.Error(),
+			},
+		}, nil
+	case errors.Is(err, services.ErrVaultNotFound):
+		return oapi.UpdateVault404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: err.Error(),
+			},
+		}, nil
+	default:
+		return oapi.UpdateVault500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.UpdateVault200JSONResponse(mapToAPIVault(updated)), nil
+}
+
+func (h *VaultHandler) DeleteVault(ctx context.Context, request oapi.DeleteVaultRequestObject) (oapi.DeleteVaultResponseObject, error) {
+	vault, ok := middleware.GetVault(ctx)
+	if !ok {
+		return oapi.DeleteVault404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: services.ErrVaultNotFound.Error(),
+			},
+		}, nil
+	}
+	// Managers of a shared vault can do anything else with it
+	if access, ok := middleware.GetAccessSession(ctx); !ok || !vault.OwnedBy(access.UserID) {
+		return oapi.DeleteVault403JSONResponse{
+			ForbiddenJSONResponse: oapi.ForbiddenJSONResponse{
+				Code:    403,
+				Message: services.ErrVaultOwnerOnly.Error(),
+			},
+		}, nil
+	}
+
+	err := h.vaultService.DeleteVault(ctx, vault.ID)
+	switch {
+	case err == nil:
+	case errors.Is(err, services.ErrDefaultVaultUndeletable):
+		return oapi.DeleteVault409JSONResponse{
+			Code:    409,
+			Message: err.Error(),
+		}, nil
+	case errors.Is(err, services.ErrVaultNotFound):
+		return oapi.DeleteVault404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: err.Error(),
+			},
+		}, nil
+	default:
+		return oapi.DeleteVault500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.DeleteVault204Response{}, nil
+}
+
+func (h *VaultHandler) GetVaultContent(ctx context.Context, request oapi.GetVaultContentRequestObject) (oapi.GetVaultContentResponseObject, error) {
+	owned, ok := middleware.GetVault(ctx)
+	if !ok {
+		return oapi.GetVaultContent404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: services.ErrVaultNotFound.Error(),
+			},
+		}, nil
+	}
+
+	if !owned.HasContent() {
+		return oapi.GetVaultContent404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: "Vault has no content yet",
+			},
+		}, nil
+	}
+
+	// The vault loaded by the middleware is enough to answer a revalidation,
+	// the content is only opened in storage when it is sent
+	if vaultNotModified(owned, request.Params.IfNoneMatch, request.Params.IfModifiedSince) {
+		return oapi.GetVaultContent304Response{
+			Headers: oapi.GetVaultContent304ResponseHeaders{
+				ETag:         domain.VaultETag(owned),
+				LastModified: owned.UpdatedAt.UTC().Format(http.TimeFormat),
+				CacheControl: vaultCacheControl,
+			},
+		}, nil
+	}
+
+	vault, content, err := h.vaultService.OpenVault(ctx, owned.ID)
+	if err != nil {
+		return oapi.GetVaultContent500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+	if vault == nil {
+		return oapi.GetVaultContent404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: "Vault has no content yet",
+			},
+		}, nil
+	}
+
+	return oapi.GetVaultContent200ApplicationoctetStreamResponse{
+		Body: content,
+		Headers: oapi.GetVaultContent200ResponseHeaders{
+			ETag:          domain.VaultETag(vault),
+			LastModified:  vault.UpdatedAt.UTC().Format(http.TimeFormat),
+			CacheControl:  vaultCacheControl,
+			ContentDigest: domain.VaultContentDigest(vault.SHA256),
+		},
+		ContentLength: int64(vault.Size),
+	}, nil
+}
+
+func (h *VaultHandler) PutVaultContent(ctx context.Context, request oapi.PutVaultContentRequestObject) (oapi.PutVaultContentResponseObject, error) {
+	vault, ok := middleware.GetVault(ctx)
+	if !ok {
+		return oapi.PutVaultContent404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: services.ErrVaultNotFound.Error(),
+			},
+		}, nil
+	}
+
+	if request.Params.IfMatch == nil {
+		return oapi.PutVaultContent428JSONResponse{
+			Code:    428,
+			Message: "If-Match is required, use the ETag of the vault the upload is based on",
+		}, nil
+	}
+	expectedRevision, ok := domain.ParseVaultETag(*request.Params.IfMatch)
+	if !ok {
+		return oapi.PutVaultContent400JSONResponse{
+			BadRequestJSONResponse: oapi.BadRequestJSONResponse{
+				Code:    400,
+				Message: "Invalid If-Match",
+			},
+		}, nil
+	}
+
+	content, err := vaultBody(request.Body, request.Params.ContentDigest)
+	if err != nil {
+		return oapi.PutVaultContent400JSONResponse{
+			BadRequestJSONResponse: oapi.BadRequestJSONResponse{
+				Code:    400,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	inserted, err := h.vaultService.InsertVault(ctx, vault.Membership.UserID, vault.ID, content, expectedRevision)
+	switch {
+	case err == nil:
+	case errors.Is(err, services.ErrVaultRevisionMismatch):
+		current, err := h.vaultService.GetVault(ctx, vault.ID)
+		if err != nil {
+			return nil, err
+		}
+		return oapi.PutVaultContent412JSONResponse{
+			Body:    vaultConflict(current),
+			Headers: oapi.PutVaultContent412ResponseHeaders{ETag: domain.VaultETag(current)},
+		}, nil
+	case errors.Is(err, services.ErrVaultDigestMismatch):
+		return oapi.PutVaultContent400JSONResponse{
+			BadRequestJSONResponse: oapi.BadRequestJSONResponse{
+				Code:    400,
+				Message: err.Error(),
+			},
+		}, nil
+	case errors.Is(err, services.ErrVaultTooLarge):
+		return oapi.PutVaultContent413JSONResponse{
+			PayloadTooLargeJSONResponse: oapi.PayloadTooLargeJSONResponse{
+				Code:    413,
+				Message: err.Error(),
+			},
+		}, nil
+	case errors.Is(err, services.ErrVaultQuotaExceeded):
+		return oapi.PutVaultContent507JSONResponse{
+			QuotaExceededJSONResponse: oapi.QuotaExceededJSONResponse{
+				Code:    507,
+				Message: err.Error(),
+			},
+		}, nil
+	default:
+		return oapi.PutVaultContent500JSONResponse{
 			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
 				Code:    500,
 				Message: err.Error(),
@@ -129,5 +808,121 @@ func (h *VaultHandler) InsertUserVault(ctx context.Context, request oapi.InsertU
 		}, nil
 	}
 
-	return oapi.InsertUserVault204Response{}, nil
+	return oapi.PutVaultContent204Response{
+		Headers: oapi.PutVaultContent204ResponseHeaders{
+			ETag: domain.VaultETag(inserted),
+		},
+	}, nil
+}
+
+var errInvalidContentDigest = errors.New("Invalid Content-Digest, a sha-256 digest is required")
+
+// vaultBody streams an uploaded vault, to be checked against the optional
+// Content-Digest header while it's stored. The body is bounded by
+// middleware.MaxBytesMiddleware, a larger one fails with
+// services.ErrVaultTooLarge.
+func vaultBody(body io.Reader, contentDigest *string) (domain.VaultContent, error) {
+	content := domain.VaultContent{Reader: maxBytesBody{body}, Size: -1}
+	if contentDigest == nil {
+		return content, nil
+	}
+
+	expected, ok := domain.ParseContentDigest(*contentDigest)
+	if !ok {
+		return domain.VaultContent{}, errInvalidContentDigest
+	}
+	content.SHA256 = expected
+	return content, nil
+}
+
+// maxBytesBody reports a body cut by http.MaxBytesReader as
+// services.ErrVaultTooLarge
+type maxBytesBody struct {
+	body io.Reader
+}
+
+func (b maxBytesBody) Read(p []byte) (int, error) {
+	n, err := b.body.Read(p)
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		return n, services.ErrVaultTooLarge
+	}
+	return n, err
+}
+
+// mapToAPIVault maps a vault without membership as one of the user, like
+// the vault they just created
+func mapToAPIVault(vault *domain.Vault) oapi.VaultResponse {
+	role, status := domain.VaultRoleManage, domain.VaultMemberAccepted
+	if vault.Membership != nil {
+		role, status = vault.Membership.Role, vault.Membership.Status
+	}
+
+	response := oapi.VaultResponse{
+		Id:               vault.ID,
+		Name:             vault.Name,
+		Type:             oapi.VaultType(vault.Type),
+		IsDefault:        vault.Default,
+		Role:             oapi.VaultRole(role),
+		MembershipStatus: oapi.VaultMemberStatus(status),
+		KeyVersion:       vault.KeyVersion,
+		RotationRequired: vault.RotationRequired,
+		Revision:         vault.Revision,
+		Size:             int64(vault.Size),
+		CreatedAt:        vault.CreatedAt.Unix(),
+		UpdatedAt:        vault.UpdatedAt.Unix(),
+	}
+	if vault.HasContent() {
+		digest := domain.VaultContentDigest(vault.SHA256)
+		response.ContentDigest = &digest
+	}
+	if vault.OrganizationID != "" {
+		response.OrganizationId = &vault.OrganizationID
+	}
+	return response
+}
+
+// vaultConflict tells a rejected write the revision to base the retry on, 0
+// when the vault doesn't exist
+func vaultConflict(current *domain.Vault) oapi.VaultConflictResponse {
+	var revision int64
+	if current != nil {
+		revision = current.Revision
+	}
+	return oapi.VaultConflictResponse{
+		Code:     412,
+		Message:  services.ErrVaultRevisionMismatch.Error(),
+		Revision: revision,
+	}
+}
+
+// vaultCacheControl keeps shared and browser caches from storing the vault,
+// clients keep their own copy and revalidate it with If-None-Match
+const vaultCacheControl = "private, no-store"
+
+// vaultNotModified evaluates the preconditions of a download, If-Modified-Since
+// is ignored when If-None-Match is sent
+func vaultNotModified(vault *domain.Vault, ifNoneMatch, ifModifiedSince *string) bool {
+	if ifNoneMatch != nil {
+		etag := domain.VaultETag(vault)
+		for _, candidate := range strings.Split(*ifNoneMatch, ",") {
+			// If-None-Match uses the weak comparison
+			candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+			if candidate == "*" || candidate == etag {
+				return true
+			}
+		}
+		return false
+	}
+
+	if ifModifiedSince != nil {
+		since, err := http.ParseTime(*ifModifiedSince)
+		if err != nil {
+			return false
+		}
+		// HTTP dates have no sub-second part
+		return !vault.UpdatedAt.Truncate(time.Second).After(since)
+	}
+
+	return false
 }
@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"main/internal/core/domain"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestVaultNotModified(t *testing.T) {
+	updatedAt := time.Date(2023, 3, 13, 9, 4, 5, 123000, time.UTC)
+	vault := &domain.Vault{Revision: 3, SHA256: "9f86d081", UpdatedAt: updatedAt}
+	etag := domain.VaultETag(vault)
+
+	if revision, ok := domain.ParseVaultETag(etag); !ok || revision != 3 {
+		t.Fatalf("expected the ETag %s to carry revision 3, got %d", etag, revision)
+	}
+	if revision, ok := domain.ParseVaultETag("*"); !ok || revision != domain.AnyVaultRevision {
+		t.Fatalf("expected * to match any revision, got %d", revision)
+	}
+
+	str := func(s string) *string { return &s }
+	tests := []struct {
+		name            string
+		ifNoneMatch     *string
+		ifModifiedSince *string
+		want            bool
+	}{
+		{"no preconditions", nil, nil, false},
+		{"matching etag", str(`"1-0", ` + etag), nil, true},
+		{"weak matching etag", str("W/" + etag), nil, true},
+		{"any", str("*"), nil, true},
+		{"stale etag", str(`"2-0"`), nil, false},
+		{"etag wins over date", str(`"2-0"`), str(updatedAt.Format(http.TimeFormat)), false},
+		{"not modified since", nil, str(updatedAt.Format(http.TimeFormat)), true},
+		{"modified since", nil, str(updatedAt.Add(-time.Second).Format(http.TimeFormat)), false},
+		{"invalid date", nil, str("yesterday"), false},
+	}
+	for _, tt := range tests {
+		if got := vaultNotModified(vault, tt.ifNoneMatch, tt.ifModifiedSince); got != tt.want {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestContentDigest(t *testing.T) {
+	// RFC 9530 example, the SHA-256 of {"hello": "world"}
+	const sha = "5f8f04f6a3a892aaabbddb6cf273894493773960d4a325b105fee46eef4304f1"
+	header := domain.VaultContentDigest(sha)
+	if header != "sha-256=:X48E9qOokqqrvdts8nOJRJN3OWDUoyWxBf7kbu9DBPE=:" {
+		t.Fatalf("unexpected Content-Digest %s", header)
+	}
+
+	tests := []struct {
+		header string
+		want   string
+		ok     bool
+	}{
+		{header, sha, true},
+		{"sha-512=:YQ==:, " + header, sha, true},
+		{"SHA-256=:X48E9qOokqqrvdts8nOJRJN3OWDUoyWxBf7kbu9DBPE=:", sha, true},
+		{"sha-512=:YQ==:", "", false},
+		{"sha-256=X48E9qOokqqrvdts8nOJRJN3OWDUoyWxBf7kbu9DBPE=", "", false},
+		{"sha-256=:YQ==:", "", false},
+	}
+	for _, tt := range tests {
+		if got, ok := domain.ParseContentDigest(tt.header); got != tt.want || ok != tt.ok {
+			t.Errorf("%s: expected %q %v, got %q %v", tt.header, tt.want, tt.ok, got, ok)
+		}
+	}
+}
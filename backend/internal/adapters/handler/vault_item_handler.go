@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"main/internal/adapters/middleware"
+	"main/internal/core/domain"
+	"main/internal/core/services"
+	"main/internal/oapi"
+)
+
+type VaultItemHandler struct {
+	vaultItemService *services.VaultItemService
+}
+
+func NewVaultItemHandler(vaultItemService *services.VaultItemService) *VaultItemHandler {
+	return &VaultItemHandler{vaultItemService: vaultItemService}
+}
+
+func (h *VaultItemHandler) SyncUserVault(ctx context.Context, request oapi.SyncUserVaultRequestObject) (oapi.SyncUserVaultResponseObject, error) {
+	access, ok := middleware.GetAccessSession(ctx)
+	if !ok || access == nil {
+		return oapi.SyncUserVault401JSONResponse{
+			UnauthorizedJSONResponse: oapi.UnauthorizedJSONResponse{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		}, nil
+	}
+
+	var changes []domain.VaultItemChange
+	if request.Body.Changes != nil {
+		for _, c := range *request.Body.Changes {
+			change := domain.VaultItemChange{ID: c.Id, BaseRevision: c.BaseRevision}
+			if c.Data != nil {
+				change.Data = *c.Data
+			}
+			if c.Deleted != nil {
+				change.Deleted = *c.Deleted
+			}
+			changes = append(changes, change)
+		}
+	}
+
+	limit := 0
+	if request.Body.Limit != nil {
+		limit = *request.Body.Limit
+	}
+
+	result, err := h.vaultItemService.Sync(ctx, access.UserID, request.Body.Cursor, changes, limit)
+	switch {
+	case err == nil:
+	case errors.Is(err, services.ErrInvalidVaultSync):
+		return oapi.SyncUserVault400JSONResponse{
+			BadRequestJSONResponse: oapi.BadRequestJSONResponse{
+				Code:    400,
+				Message: err.Error(),
+			},
+		}, nil
+	case errors.Is(err, services.ErrVaultQuotaExceeded):
+		return oapi.SyncUserVault507JSONResponse{
+			QuotaExceededJSONResponse: oapi.QuotaExceededJSONResponse{
+				Code:    507,
+				Message: err.Error(),
+			},
+		}, nil
+	default:
+		return oapi.SyncUserVault500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	response := oapi.SyncUserVault200JSONResponse{
+		Cursor:  result.Cursor,
+		HasMore: result.HasMore,
+		Items:   make([]oapi.VaultItem, 0, len(result.Items)),
+		Results: make([]oapi.VaultItemResult, 0, len(result.Results)),
+	}
+	for _, item := range result.Items {
+		response.Items = append(response.Items, mapToAPIVaultItem(item))
+	}
+	for _, r := range result.Results {
+		response.Results = append(response.Results, mapToAPIVaultItemResult(r))
+	}
+
+	return response, nil
+}
+
+func mapToAPIVaultItem(i domain.VaultItem) oapi.VaultItem {
+	item := oapi.VaultItem{
+		Id:        i.ID,
+		Revision:  i.Revision,
+		Deleted:   i.Deleted,
+		UpdatedAt: i.UpdatedAt.Unix(),
+	}
+	if !i.Deleted {
+		item.Data = &i.Data
+	}
+	return item
+}
+
+func mapToAPIVaultItemResult(r domain.VaultItemResult) oapi.VaultItemResult {
+	result := oapi.VaultItemResult{
+		Id:       r.ID,
+		Status:   oapi.Applied,
+		Revision: r.Revision,
+	}
+	if !r.Applied {
+		result.Status = oapi.Conflict
+		if r.Current != nil {
+			current := mapToAPIVaultItem(*r.Current)
+			result.Current = &current
+		}
+	}
+	return result
+}
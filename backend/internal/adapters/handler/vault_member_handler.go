@@ -0,0 +1,315 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"main/internal/adapters/middleware"
+	"main/internal/core/domain"
+	"main/internal/core/services"
+	"main/internal/oapi"
+)
+
+type VaultMemberHandler struct {
+	vaultMemberService *services.VaultMemberService
+}
+
+func NewVaultMemberHandler(vaultMemberService *services.VaultMemberService) *VaultMemberHandler {
+	return &VaultMemberHandler{vaultMemberService: vaultMemberService}
+}
+
+func (h *VaultMemberHandler) ListVaultMembers(ctx context.Context, request oapi.ListVaultMembersRequestObject) (oapi.ListVaultMembersResponseObject, error) {
+	vault, ok := middleware.GetVault(ctx)
+	if !ok {
+		return oapi.ListVaultMembers404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: services.ErrVaultNotFound.Error(),
+			},
+		}, nil
+	}
+
+	members, err := h.vaultMemberService.ListMembers(ctx, vault.ID)
+	if err != nil {
+		return oapi.ListVaultMembers500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	response := make([]oapi.VaultMemberResponse, 0, len(members))
+	for _, m := range members {
+		response = append(response, mapToAPIVaultMember(&m))
+	}
+
+	return oapi.ListVaultMembers200JSONResponse(response), nil
+}
+
+func (h *VaultMemberHandler) InviteVaultMember(ctx context.Context, request oapi.InviteVaultMemberRequestObject) (oapi.InviteVaultMemberResponseObject, error) {
+	vault, ok := middleware.GetVault(ctx)
+	if !ok {
+		return oapi.InviteVaultMember404JSONResponse{
+			Code:    404,
+			Message: services.ErrVaultNotFound.Error(),
+		}, nil
+	}
+
+	member, err := h.vaultMemberService.Invite(ctx, vault, vault.Membership.UserID, domain.VaultMember{
+		Email:          string(request.Body.Email),
+		Role:           domain.VaultRole(request.Body.Role),
+		WrappedKey:     request.Body.WrappedKey,
+		KeyVersion:     request.Body.KeyVersion,
+		UserKeyVersion: request.Body.UserKeyVersion,
+	})
+	switch {
+	case err == nil:
+	case errors.Is(err, services.ErrInvalidVaultRole), errors.Is(err, services.ErrInvalidWrappedKey):
+		return oapi.InviteVaultMember400JSONResponse{
+			BadRequestJSONResponse: oapi.BadRequestJSONResponse{
+				Code:    400,
+				Message: err.Error(),
+			},
+		}, nil
+	case errors.Is(err, services.ErrUserKeyNotFound):
+		return oapi.InviteVaultMember404JSONResponse{
+			Code:    404,
+			Message: err.Error(),
+		}, nil
+	case errors.Is(err, services.ErrVaultMemberExists),
+		errors.Is(err, services.ErrVaultNotShared),
+		errors.Is(err, services.ErrVaultInviteeNotInOrg),
+		errors.Is(err, services.ErrVaultKeyRotationRequired),
+		errors.Is(err, services.ErrVaultKeyVersionMismatch),
+		errors.Is(err, services.ErrUserKeyVersionMismatch):
+		return oapi.InviteVaultMember409JSONResponse{
+			Code:    409,
+			Message: err.Error(),
+		}, nil
+	default:
+		return oapi.InviteVaultMember500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.InviteVaultMember201JSONResponse(mapToAPIVaultMember(member)), nil
+}
+
+func (h *VaultMemberHandler) UpdateVaultMember(ctx context.Context, request oapi.UpdateVaultMemberRequestObject) (oapi.UpdateVaultMemberResponseObject, error) {
+	vault, ok := middleware.GetVault(ctx)
+	if !ok {
+		return oapi.UpdateVaultMember404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: services.ErrVaultNotFound.Error(),
+			},
+		}, nil
+	}
+
+	member, err := h.vaultMemberService.UpdateRole(ctx, vault, request.MemberID.String(), domain.VaultRole(request.Body.Role))
+	switch {
+	case err == nil:
+	case errors.Is(err, services.ErrInvalidVaultRole):
+		return oapi.UpdateVaultMember400JSONResponse{
+			BadRequestJSONResponse: oapi.BadRequestJSONResponse{
+				Code:    400,
+				Message: err.Error(),
+			},
+		}, nil
+	case errors.Is(err, services.ErrVaultMemberNotFound):
+		return oapi.UpdateVaultMember404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: err.Error(),
+			},
+		}, nil
+	case errors.Is(err, services.ErrVaultOwnerImmutable):
+		return oapi.UpdateVaultMember409JSONResponse{
+			Code:    409,
+			Message: err.Error(),
+		}, nil
+	default:
+		return oapi.UpdateVaultMember500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.UpdateVaultMember200JSONResponse(mapToAPIVaultMember(member)), nil
+}
+
+func (h *VaultMemberHandler) RemoveVaultMember(ctx context.Context, request oapi.RemoveVaultMemberRequestObject) (oapi.RemoveVaultMemberResponseObject, error) {
+	vault, ok := middleware.GetVault(ctx)
+	if !ok {
+		return oapi.RemoveVaultMember404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: services.ErrVaultNotFound.Error(),
+			},
+		}, nil
+	}
+
+	err := h.vaultMemberService.Remove(ctx, vault, request.MemberID.String())
+	switch {
+	case err == nil:
+	case errors.Is(err, services.ErrVaultForbidden):
+		return oapi.RemoveVaultMember403JSONResponse{
+			ForbiddenJSONResponse: oapi.ForbiddenJSONResponse{
+				Code:    403,
+				Message: err.Error(),
+			},
+		}, nil
+	case errors.Is(err, services.ErrVaultMemberNotFound):
+		return oapi.RemoveVaultMember404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: err.Error(),
+			},
+		}, nil
+	case errors.Is(err, services.ErrVaultOwnerImmutable):
+		return oapi.RemoveVaultMember409JSONResponse{
+			Code:    409,
+			Message: err.Error(),
+		}, nil
+	default:
+		return oapi.RemoveVaultMember500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.RemoveVaultMember204Response{}, nil
+}
+
+func (h *VaultMemberHandler) AcceptVaultInvite(ctx context.Context, request oapi.AcceptVaultInviteRequestObject) (oapi.AcceptVaultInviteResponseObject, error) {
+	session, ok := middleware.GetAccessSession(ctx)
+	if !ok || session == nil {
+		return oapi.AcceptVaultInvite401JSONResponse{
+			UnauthorizedJSONResponse: oapi.UnauthorizedJSONResponse{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		}, nil
+	}
+
+	member, err := h.vaultMemberService.Accept(ctx, session.UserID, request.VaultID.String())
+	if errors.Is(err, services.ErrVaultInviteNotFound) {
+		return oapi.AcceptVaultInvite404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+	if err != nil {
+		return oapi.AcceptVaultInvite500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.AcceptVaultInvite200JSONResponse(mapToAPIVaultMember(member)), nil
+}
+
+// GetVaultKey answers from the membership the middleware loaded
+func (h *VaultMemberHandler) GetVaultKey(ctx context.Context, request oapi.GetVaultKeyRequestObject) (oapi.GetVaultKeyResponseObject, error) {
+	vault, ok := middleware.GetVault(ctx)
+	if !ok {
+		return oapi.GetVaultKey404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: services.ErrVaultNotFound.Error(),
+			},
+		}, nil
+	}
+
+	member := vault.Membership
+	response := oapi.VaultKeyResponse{
+		KeyVersion:       member.KeyVersion,
+		UserKeyVersion:   member.UserKeyVersion,
+		RotationRequired: vault.RotationRequired,
+	}
+	if member.WrappedKey != nil {
+		response.WrappedKey = &member.WrappedKey
+	}
+
+	return oapi.GetVaultKey200JSONResponse(response), nil
+}
+
+func (h *VaultMemberHandler) RotateVaultKey(ctx context.Context, request oapi.RotateVaultKeyRequestObject) (oapi.RotateVaultKeyResponseObject, error) {
+	vault, ok := middleware.GetVault(ctx)
+	if !ok {
+		return oapi.RotateVaultKey404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: services.ErrVaultNotFound.Error(),
+			},
+		}, nil
+	}
+
+	keys := make([]domain.VaultMemberKey, 0, len(request.Body.Keys))
+	for _, k := range request.Body.Keys {
+		keys = append(keys, domain.VaultMemberKey{
+			MemberID:       k.MemberId,
+			WrappedKey:     k.WrappedKey,
+			UserKeyVersion: k.UserKeyVersion,
+		})
+	}
+
+	err := h.vaultMemberService.RotateKey(ctx, vault, request.Body.KeyVersion, keys)
+	switch {
+	case err == nil:
+	case errors.Is(err, services.ErrInvalidWrappedKey):
+		return oapi.RotateVaultKey400JSONResponse{
+			BadRequestJSONResponse: oapi.BadRequestJSONResponse{
+				Code:    400,
+				Message: err.Error(),
+			},
+		}, nil
+	case errors.Is(err, services.ErrVaultKeyVersionMismatch),
+		errors.Is(err, services.ErrVaultMemberKeysMismatch),
+		errors.Is(err, services.ErrUserKeyNotFound),
+		errors.Is(err, services.ErrUserKeyVersionMismatch):
+		return oapi.RotateVaultKey409JSONResponse{
+			Code:    409,
+			Message: err.Error(),
+		}, nil
+	default:
+		return oapi.RotateVaultKey500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.RotateVaultKey204Response{}, nil
+}
+
+func mapToAPIVaultMember(member *domain.VaultMember) oapi.VaultMemberResponse {
+	response := oapi.VaultMemberResponse{
+		Id:             member.ID,
+		UserId:         member.UserPublicID,
+		Email:          member.Email,
+		Name:           member.Name,
+		Role:           oapi.VaultRole(member.Role),
+		Status:         oapi.VaultMemberStatus(member.Status),
+		KeyVersion:     member.KeyVersion,
+		UserKeyVersion: member.UserKeyVersion,
+		CreatedAt:      member.CreatedAt.Unix(),
+	}
+	if !member.AcceptedAt.IsZero() {
+		acceptedAt := member.AcceptedAt.Unix()
+		response.AcceptedAt = &acceptedAt
+	}
+	return response
+}
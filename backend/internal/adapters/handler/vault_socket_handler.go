@@ -0,0 +1,300 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"main/internal/adapters/middleware"
+	"main/internal/core/domain"
+	"main/internal/core/services"
+	"net/http"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+)
+
+// socketProtocolVersion is sent in every message, a client speaking another
+// version gets an error and is disconnected
+const socketProtocolVersion = 1
+
+const socketWriteTimeout = 10 * time.Second
+
+// Client messages
+const (
+	socketSubscribe = "subscribe"
+	socketAck       = "ack"
+	socketPing      = "ping"
+)
+
+// Server messages
+const (
+	socketSubscribed   = "subscribed"
+	socketVaultChanged = "vault.changed"
+	socketLock         = "lock"
+	socketReauth       = "reauthenticate"
+	socketPong         = "pong"
+	socketError        = "error"
+)
+
+// socketMessage is the envelope of the vault WebSocket protocol, only the
+// fields of the given type are set
+type socketMessage struct {
+	V    int    `json:"v"`
+	Type string `json:"type"`
+	// subscribe: resume after this event, like Last-Event-ID for SSE
+	LastEventID int64 `json:"lastEventId,omitempty"`
+	// vault.changed, ack refers to the vault as well, the default vault
+	// when it's omitted
+	EventID   int64  `json:"eventId,omitempty"`
+	VaultID   string `json:"vaultId,omitempty"`
+	Revision  int64  `json:"revision,omitempty"`
+	Cursor    int64  `json:"cursor,omitempty"`
+	DeviceID  string `json:"deviceId,omitempty"`
+	CreatedAt int64  `json:"createdAt,omitempty"`
+	// lock, reauthenticate and error
+	Reason string `json:"reason,omitempty"`
+}
+
+// VaultSocketHandler serves the vault sync WebSocket of the native clients.
+// It is a plain http.Handler as the upgrade needs the request, the access
+// token is checked by middleware.RequireAccessToken.
+//
+// After subscribing the client gets a vault.changed message for every write
+// not acknowledged yet. It acknowledges the revision it holds of a vault with
+// ack, the server then skips the notifications of that vault up to that
+// revision, e.g. its own uploads. The server sends lock when the session
+// of the device is revoked and reauthenticate when the access token
+// expires, then closes.
+type VaultSocketHandler struct {
+	vaultEventService *services.VaultEventService
+	vaultService      *services.VaultService
+	originPatterns    []string
+}
+
+func NewVaultSocketHandler(vaultEventService *services.VaultEventService, vaultService *services.VaultService, originPatterns []string) *VaultSocketHandler {
+	return &VaultSocketHandler{
+		vaultEventService: vaultEventService,
+		vaultService:      vaultService,
+		originPatterns:    originPatterns,
+	}
+}
+
+func (h *VaultSocketHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	access, ok := middleware.GetAccessSession(r.Context())
+	if !ok || access == nil {
+		http.Error(w, "User not authenticated", http.StatusUnauthorized)
+		return
+	}
+
+	conn, err := websocket.Accept(w, r, &websocket.AcceptOptions{OriginPatterns: h.originPatterns})
+	if err != nil {
+		// Accept already wrote the error response
+		return
+	}
+	defer conn.CloseNow()
+
+	session := &vaultSocketSession{
+		conn:              conn,
+		vaultEventService: h.vaultEventService,
+		vaultService:      h.vaultService,
+		access:            access,
+	}
+	// The reader stops with ctx, which would drop the connection before the
+	// close frame is sent
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	status, reason := session.run(ctx)
+	conn.Close(status, reason)
+}
+
+type vaultSocketSession struct {
+	conn              *websocket.Conn
+	vaultEventService *services.VaultEventService
+	vaultService      *services.VaultService
+	access            *domain.AccessSession
+
+	events <-chan domain.VaultEvent
+	// acked holds the acknowledged revision of every vault by its ID, the
+	// acks without a vault ID of clients from before named vaults are
+	// recorded for the default vault
+	acked map[string]int64
+}
+
+// run serves the connection until one of the sides ends it and returns the
+// close status to send
+func (s *vaultSocketSession) run(ctx context.Context) (websocket.StatusCode, string) {
+	incoming := make(chan socketMessage)
+	readErr := make(chan error, 1)
+	go func() {
+		for {
+			var message socketMessage
+			if err := wsjson.Read(ctx, s.conn, &message); err != nil {
+				readErr <- err
+				return
+			}
+			select {
+			case incoming <- message:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	heartbeat := time.NewTicker(s.vaultEventService.Heartbeat())
+	defer heartbeat.Stop()
+	var expired <-chan time.Time
+	if !s.access.ExpiresAt.IsZero() {
+		timer := time.NewTimer(time.Until(s.access.ExpiresAt))
+		defer timer.Stop()
+		expired = timer.C
+	}
+
+	for {
+		select {
+		case err := <-readErr:
+			if status := websocket.CloseStatus(err); status != -1 {
+				return status, ""
+			}
+			var syntaxErr *json.SyntaxError
+			if errors.As(err, &syntaxErr) {
+				return websocket.StatusUnsupportedData, "Invalid message"
+			}
+			return websocket.StatusNormalClosure, ""
+
+		case message := <-incoming:
+			if message.V != socketProtocolVersion {
+				s.write(ctx, socketMessage{Type: socketError, Reason: "Unsupported protocol version"})
+				return websocket.StatusPolicyViolation, "Unsupported protocol version"
+			}
+			if err := s.handle(ctx, message); err != nil {
+				return websocket.StatusInternalError, "Internal error"
+			}
+
+		case event, ok := <-s.events:
+			if !ok {
+				// The subscriber fell behind, the client resumes from its last event
+				return websocket.StatusTryAgainLater, "Reconnect to resume"
+			}
+			if status, reason, done := s.forward(ctx, event); done {
+				return status, reason
+			}
+
+		case <-heartbeat.C:
+			pingCtx, cancelPing := context.WithTimeout(ctx, socketWriteTimeout)
+			err := s.conn.Ping(pingCtx)
+			cancelPing()
+			if err != nil {
+				return websocket.StatusGoingAway, ""
+			}
+
+		case <-expired:
+			s.write(ctx, socketMessage{Type: socketReauth, Reason: "Access token expired"})
+			return websocket.StatusPolicyViolation, "Access token expired"
+
+		case <-ctx.Done():
+			return websocket.StatusGoingAway, ""
+		}
+	}
+}
+
+func (s *vaultSocketSession) handle(ctx context.Context, message socketMessage) error {
+	switch message.Type {
+	case socketSubscribe:
+		if s.events != nil {
+			return s.write(ctx, socketMessage{Type: socketError, Reason: "Already subscribed"})
+		}
+		if message.LastEventID < 0 {
+			return s.write(ctx, socketMessage{Type: socketError, Reason: "Invalid lastEventId"})
+		}
+
+		events, err := s.vaultEventService.Subscribe(ctx, s.access.UserID, message.LastEventID)
+		if err != nil {
+			log.Printf("failed to subscribe to vault events: %v", err)
+			return err
+		}
+		s.events = events
+		return s.write(ctx, socketMessage{Type: socketSubscribed, LastEventID: message.LastEventID})
+
+	case socketAck:
+		vaultID := message.VaultID
+		if vaultID == "" {
+			vault, err := s.vaultService.DefaultVault(ctx, s.access.UserID)
+			if err != nil {
+				log.Printf("failed to get the default vault: %v", err)
+				return err
+			}
+			if vault == nil {
+				// Nothing was written yet that the ack could refer to
+				return nil
+			}
+			vaultID = vault.ID
+		} else if _, ok := s.acked[vaultID]; !ok {
+			// Only the vaults the user can read are kept, so the acks can't
+			// grow the map without bound
+			_, err := s.vaultService.AuthorizeVault(ctx, s.access.UserID, vaultID, domain.VaultRoleRead)
+			if errors.Is(err, services.ErrVaultNotFound) || errors.Is(err, services.ErrVaultForbidden) {
+				return nil
+			}
+			if err != nil {
+				log.Printf("failed to authorize the acknowledged vault: %v", err)
+				return err
+			}
+		}
+
+		if s.acked == nil {
+			s.acked = map[string]int64{}
+		}
+		s.acked[vaultID] = max(s.acked[vaultID], message.Revision)
+		return nil
+
+	case socketPing:
+		return s.write(ctx, socketMessage{Type: socketPong})
+
+	default:
+		return s.write(ctx, socketMessage{Type: socketError, Reason: "Unknown message type"})
+	}
+}
+
+// forward sends the event to the client, done is set when the connection
+// has to be closed afterwards
+func (s *vaultSocketSession) forward(ctx context.Context, event domain.VaultEvent) (status websocket.StatusCode, reason string, done bool) {
+	switch event.Type {
+	case domain.VaultEventSessionRevoked:
+		if event.DeviceID != s.access.DeviceID {
+			return 0, "", false
+		}
+		s.write(ctx, socketMessage{Type: socketLock, Reason: "Session revoked"})
+		return websocket.StatusPolicyViolation, "Session revoked", true
+
+	case domain.VaultEventUpdated:
+		// Item writes have no revision and are always sent
+		if event.Revision != 0 && event.Revision <= s.acked[event.VaultID] {
+			return 0, "", false
+		}
+		err := s.write(ctx, socketMessage{
+			Type:      socketVaultChanged,
+			EventID:   event.ID,
+			VaultID:   event.VaultID,
+			Revision:  event.Revision,
+			Cursor:    event.Cursor,
+			DeviceID:  event.DeviceID,
+			CreatedAt: event.CreatedAt.Unix(),
+		})
+		if err != nil {
+			return websocket.StatusGoingAway, "", true
+		}
+	}
+
+	return 0, "", false
+}
+
+func (s *vaultSocketSession) write(ctx context.Context, message socketMessage) error {
+	ctx, cancel := context.WithTimeout(ctx, socketWriteTimeout)
+	defer cancel()
+
+	message.V = socketProtocolVersion
+	return wsjson.Write(ctx, s.conn, message)
+}
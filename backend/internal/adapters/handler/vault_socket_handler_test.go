@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"context"
+	"main/internal/adapters/middleware"
+	"main/internal/core/domain"
+	"main/internal/core/ports"
+	"main/internal/core/services"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/coder/websocket"
+	"github.com/coder/websocket/wsjson"
+)
+
+// socketVaultRepository holds the vaults by ID, every vault has its owner as
+// the only member
+type socketVaultRepository struct {
+	ports.VaultRepository
+	vaults map[string]*domain.Vault
+}
+
+func (r *socketVaultRepository) GetVault(ctx context.Context, vaultID string) (*domain.Vault, error) {
+	vault, ok := r.vaults[vaultID]
+	if !ok {
+		return nil, nil
+	}
+	copied := *vault
+	return &copied, nil
+}
+
+func (r *socketVaultRepository) GetDefaultVault(ctx context.Context, userID string) (*domain.Vault, error) {
+	for _, vault := range r.vaults {
+		if vault.Default && vault.UserID == userID {
+			copied := *vault
+			return &copied, nil
+		}
+	}
+	return nil, nil
+}
+
+type socketVaultMemberRepository struct {
+	ports.VaultMemberRepository
+	vaults *socketVaultRepository
+}
+
+func (r *socketVaultMemberRepository) GetMember(ctx context.Context, vaultID, userID string) (*domain.VaultMember, error) {
+	vault, ok := r.vaults.vaults[vaultID]
+	if !ok || vault.UserID != userID {
+		return nil, nil
+	}
+	return &domain.VaultMember{VaultID: vaultID, UserID: userID, Role: domain.VaultRoleManage, Status: domain.VaultMemberAccepted}, nil
+}
+
+type socketEmergencyAccessRepository struct {
+	ports.EmergencyAccessRepository
+}
+
+func (socketEmergencyAccessRepository) GetGrantedAccess(ctx context.Context, vaultID, granteeID string) (*domain.EmergencyAccess, error) {
+	return nil, nil
+}
+
+// socketVaultEventBus delivers what is sent on live to the subscriber
+type socketVaultEventBus struct {
+	ports.VaultEventBus
+	live chan domain.VaultEvent
+}
+
+func (b *socketVaultEventBus) Subscribe(ctx context.Context, userID string) (<-chan domain.VaultEvent, error) {
+	return b.live, nil
+}
+
+// newSocketVaultService serves the default vault of user and a vault of
+// another user
+func newSocketVaultService() *services.VaultService {
+	repo := &socketVaultRepository{vaults: map[string]*domain.Vault{
+		"default": {ID: "default", UserID: "user", Default: true, Revision: 3},
+		"foreign": {ID: "foreign", UserID: "other", Default: true, Revision: 5},
+	}}
+	return services.NewVaultService(repo, nil, &socketVaultMemberRepository{vaults: repo}, socketEmergencyAccessRepository{}, nil, nil, nil, nil, services.VaultConfig{})
+}
+
+func TestVaultSocketSession_IgnoresAckOfUnreadableVault(t *testing.T) {
+	s := &vaultSocketSession{
+		vaultService: newSocketVaultService(),
+		access:       &domain.AccessSession{UserID: "user", DeviceID: "device"},
+	}
+
+	for _, vaultID := range []string{"foreign", "missing", "default"} {
+		if err := s.handle(context.Background(), socketMessage{V: socketProtocolVersion, Type: socketAck, VaultID: vaultID, Revision: 3}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if len(s.acked) != 1 || s.acked["default"] != 3 {
+		t.Errorf("expected only the ack of the own vault to be kept, got %v", s.acked)
+	}
+}
+
+func TestVaultSocketHandler_AckWithoutVaultIDSkipsDefaultVault(t *testing.T) {
+	bus := &socketVaultEventBus{live: make(chan domain.VaultEvent, 2)}
+	h := NewVaultSocketHandler(services.NewVaultEventService(bus, time.Minute), newSocketVaultService(), nil)
+	access := &domain.AccessSession{UserID: "user", DeviceID: "device"}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		h.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), middleware.SessionContextKey, access)))
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	conn, _, err := websocket.Dial(ctx, "ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	defer conn.CloseNow()
+
+	send := func(message socketMessage) {
+		message.V = socketProtocolVersion
+		if err := wsjson.Write(ctx, conn, message); err != nil {
+			t.Fatalf("failed to send %s: %v", message.Type, err)
+		}
+	}
+	receive := func(messageType string) socketMessage {
+		var message socketMessage
+		if err := wsjson.Read(ctx, conn, &message); err != nil {
+			t.Fatalf("failed to receive %s: %v", messageType, err)
+		}
+		if message.Type != messageType {
+			t.Fatalf("expected %s, got %+v", messageType, message)
+		}
+		return message
+	}
+
+	send(socketMessage{Type: socketSubscribe})
+	receive(socketSubscribed)
+	// An older client acknowledges its upload of the default vault, the pong
+	// tells that the ack was handled
+	send(socketMessage{Type: socketAck, Revision: 3})
+	send(socketMessage{Type: socketPing})
+	receive(socketPong)
+
+	bus.live <- domain.VaultEvent{ID: 1, Type: domain.VaultEventUpdated, VaultID: "default", Revision: 3, DeviceID: "device"}
+	bus.live <- domain.VaultEvent{ID: 2, Type: domain.VaultEventUpdated, VaultID: "default", Revision: 4, DeviceID: "other-device"}
+
+	if changed := receive(socketVaultChanged); changed.EventID != 2 || changed.Revision != 4 {
+		t.Errorf("expected the acknowledged revision to be skipped, got %+v", changed)
+	}
+}
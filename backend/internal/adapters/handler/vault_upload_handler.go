@@ -0,0 +1,318 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"io"
+	"main/internal/adapters/middleware"
+	"main/internal/core/domain"
+	"main/internal/core/services"
+	"main/internal/oapi"
+	"net/http"
+)
+
+type VaultUploadHandler struct {
+	vaultUploadService *services.VaultUploadService
+	vaultService       *services.VaultService
+}
+
+func NewVaultUploadHandler(vaultUploadService *services.VaultUploadService, vaultService *services.VaultService) *VaultUploadHandler {
+	return &VaultUploadHandler{
+		vaultUploadService: vaultUploadService,
+		vaultService:       vaultService,
+	}
+}
+
+func (h *VaultUploadHandler) CreateVaultUpload(ctx context.Context, request oapi.CreateVaultUploadRequestObject) (oapi.CreateVaultUploadResponseObject, error) {
+	access, ok := middleware.GetAccessSession(ctx)
+	if !ok || access == nil {
+		return oapi.CreateVaultUpload401JSONResponse{
+			UnauthorizedJSONResponse: oapi.UnauthorizedJSONResponse{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		}, nil
+	}
+
+	var vaultID string
+	if request.Body.VaultId != nil {
+		vaultID = request.Body.VaultId.String()
+	}
+
+	upload, err := h.vaultUploadService.CreateUpload(ctx, access.UserID, vaultID, request.Body.Size, request.Body.Sha256)
+	switch {
+	case err == nil:
+	case errors.Is(err, services.ErrVaultNotFound):
+		return oapi.CreateVaultUpload404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: err.Error(),
+			},
+		}, nil
+	case errors.Is(err, services.ErrVaultTooLarge):
+		return oapi.CreateVaultUpload413JSONResponse{
+			PayloadTooLargeJSONResponse: oapi.PayloadTooLargeJSONResponse{
+				Code:    413,
+				Message: err.Error(),
+			},
+		}, nil
+	case errors.Is(err, services.ErrVaultQuotaExceeded):
+		return oapi.CreateVaultUpload507JSONResponse{
+			QuotaExceededJSONResponse: oapi.QuotaExceededJSONResponse{
+				Code:    507,
+				Message: err.Error(),
+			},
+		}, nil
+	case errors.Is(err, services.ErrTooManyVaultUploads):
+		return oapi.CreateVaultUpload429JSONResponse{
+			TooManyRequestsJSONResponse: oapi.TooManyRequestsJSONResponse{
+				Code:    429,
+				Message: err.Error(),
+			},
+		}, nil
+	default:
+		return oapi.CreateVaultUpload500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.CreateVaultUpload201JSONResponse(toVaultUploadResponse(upload)), nil
+}
+
+func (h *VaultUploadHandler) GetVaultUpload(ctx context.Context, request oapi.GetVaultUploadRequestObject) (oapi.GetVaultUploadResponseObject, error) {
+	access, ok := middleware.GetAccessSession(ctx)
+	if !ok || access == nil {
+		return oapi.GetVaultUpload401JSONResponse{
+			UnauthorizedJSONResponse: oapi.UnauthorizedJSONResponse{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		}, nil
+	}
+
+	upload, err := h.vaultUploadService.GetUpload(ctx, access.UserID, request.UploadId)
+	switch {
+	case err == nil:
+	case errors.Is(err, services.ErrVaultUploadNotFound):
+		return oapi.GetVaultUpload404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: err.Error(),
+			},
+		}, nil
+	default:
+		return oapi.GetVaultUpload500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.GetVaultUpload200JSONResponse(toVaultUploadResponse(upload)), nil
+}
+
+func (h *VaultUploadHandler) AppendVaultUpload(ctx context.Context, request oapi.AppendVaultUploadRequestObject) (oapi.AppendVaultUploadResponseObject, error) {
+	access, ok := middleware.GetAccessSession(ctx)
+	if !ok || access == nil {
+		return oapi.AppendVaultUpload401JSONResponse{
+			UnauthorizedJSONResponse: oapi.UnauthorizedJSONResponse{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		}, nil
+	}
+
+	// The body is bounded by middleware.MaxBytesMiddleware
+	chunk, err := io.ReadAll(request.Body)
+	var tooLarge *http.MaxBytesError
+	if errors.As(err, &tooLarge) {
+		return oapi.AppendVaultUpload413JSONResponse{
+			PayloadTooLargeJSONResponse: oapi.PayloadTooLargeJSONResponse{
+				Code:    413,
+				Message: services.ErrVaultTooLarge.Error(),
+			},
+		}, nil
+	}
+	if err != nil {
+		// Whatever arrived of the chunk is dropped, the client resends it
+		return oapi.AppendVaultUpload400JSONResponse{
+			BadRequestJSONResponse: oapi.BadRequestJSONResponse{
+				Code:    400,
+				Message: "Failed to read request body",
+			},
+		}, nil
+	}
+	if len(chunk) == 0 {
+		return oapi.AppendVaultUpload400JSONResponse{
+			BadRequestJSONResponse: oapi.BadRequestJSONResponse{
+				Code:    400,
+				Message: "Empty chunk",
+			},
+		}, nil
+	}
+
+	upload, err := h.vaultUploadService.AppendChunk(ctx, access.UserID, request.UploadId, request.Params.Offset, chunk)
+	switch {
+	case err == nil:
+	case errors.Is(err, services.ErrVaultUploadOffsetMismatch):
+		return oapi.AppendVaultUpload409JSONResponse(toVaultUploadResponse(upload)), nil
+	case errors.Is(err, services.ErrVaultUploadNotFound):
+		return oapi.AppendVaultUpload404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: err.Error(),
+			},
+		}, nil
+	case errors.Is(err, services.ErrVaultUploadChunkTooLarge):
+		return oapi.AppendVaultUpload413JSONResponse{
+			PayloadTooLargeJSONResponse: oapi.PayloadTooLargeJSONResponse{
+				Code:    413,
+				Message: err.Error(),
+			},
+		}, nil
+	default:
+		return oapi.AppendVaultUpload500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.AppendVaultUpload200JSONResponse(toVaultUploadResponse(upload)), nil
+}
+
+func (h *VaultUploadHandler) CancelVaultUpload(ctx context.Context, request oapi.CancelVaultUploadRequestObject) (oapi.CancelVaultUploadResponseObject, error) {
+	access, ok := middleware.GetAccessSession(ctx)
+	if !ok || access == nil {
+		return oapi.CancelVaultUpload401JSONResponse{
+			UnauthorizedJSONResponse: oapi.UnauthorizedJSONResponse{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		}, nil
+	}
+
+	err := h.vaultUploadService.CancelUpload(ctx, access.UserID, request.UploadId)
+	switch {
+	case err == nil:
+	case errors.Is(err, services.ErrVaultUploadNotFound):
+		return oapi.CancelVaultUpload404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: err.Error(),
+			},
+		}, nil
+	default:
+		return oapi.CancelVaultUpload500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.CancelVaultUpload204Response{}, nil
+}
+
+func (h *VaultUploadHandler) CommitVaultUpload(ctx context.Context, request oapi.CommitVaultUploadRequestObject) (oapi.CommitVaultUploadResponseObject, error) {
+	access, ok := middleware.GetAccessSession(ctx)
+	if !ok || access == nil {
+		return oapi.CommitVaultUpload401JSONResponse{
+			UnauthorizedJSONResponse: oapi.UnauthorizedJSONResponse{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		}, nil
+	}
+
+	if request.Params.IfMatch == nil {
+		return oapi.CommitVaultUpload428JSONResponse{
+			Code:    428,
+			Message: "If-Match is required, use the ETag of the vault the upload is based on",
+		}, nil
+	}
+	expectedRevision, ok := domain.ParseVaultETag(*request.Params.IfMatch)
+	if !ok {
+		return oapi.CommitVaultUpload400JSONResponse{
+			BadRequestJSONResponse: oapi.BadRequestJSONResponse{
+				Code:    400,
+				Message: "Invalid If-Match",
+			},
+		}, nil
+	}
+
+	upload, inserted, err := h.vaultUploadService.CommitUpload(ctx, access.UserID, request.UploadId, expectedRevision)
+	switch {
+	case err == nil:
+	case errors.Is(err, services.ErrVaultUploadIncomplete):
+		return oapi.CommitVaultUpload409JSONResponse(toVaultUploadResponse(upload)), nil
+	case errors.Is(err, services.ErrVaultRevisionMismatch):
+		current, err := h.currentVault(ctx, access.UserID, upload.VaultID)
+		if err != nil {
+			return nil, err
+		}
+		return oapi.CommitVaultUpload412JSONResponse{
+			Body:    vaultConflict(current),
+			Headers: oapi.CommitVaultUpload412ResponseHeaders{ETag: domain.VaultETag(current)},
+		}, nil
+	case errors.Is(err, services.ErrVaultUploadNotFound):
+		return oapi.CommitVaultUpload404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: err.Error(),
+			},
+		}, nil
+	case errors.Is(err, services.ErrVaultUploadHashMismatch):
+		return oapi.CommitVaultUpload422JSONResponse{
+			Code:    422,
+			Message: err.Error(),
+		}, nil
+	case errors.Is(err, services.ErrVaultQuotaExceeded):
+		return oapi.CommitVaultUpload507JSONResponse{
+			QuotaExceededJSONResponse: oapi.QuotaExceededJSONResponse{
+				Code:    507,
+				Message: err.Error(),
+			},
+		}, nil
+	default:
+		return oapi.CommitVaultUpload500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	return oapi.CommitVaultUpload204Response{
+		Headers: oapi.CommitVaultUpload204ResponseHeaders{
+			ETag: domain.VaultETag(inserted),
+		},
+	}, nil
+}
+
+// currentVault returns the vault an upload is committed to, nil when it
+// doesn't exist
+func (h *VaultUploadHandler) currentVault(ctx context.Context, userID, vaultID string) (*domain.Vault, error) {
+	if vaultID == "" {
+		return h.vaultService.DefaultVault(ctx, userID)
+	}
+	return h.vaultService.GetVault(ctx, vaultID)
+}
+
+func toVaultUploadResponse(upload *domain.VaultUpload) oapi.VaultUploadResponse {
+	response := oapi.VaultUploadResponse{
+		Id:        upload.ID,
+		Size:      upload.Size,
+		Offset:    upload.Offset,
+		ExpiresAt: upload.ExpiresAt.Unix(),
+	}
+	if upload.VaultID != "" {
+		response.VaultId = &upload.VaultID
+	}
+	return response
+}
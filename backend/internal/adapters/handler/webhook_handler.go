@@ -0,0 +1,221 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"main/internal/adapters/middleware"
+	"main/internal/core/domain"
+	"main/internal/core/services"
+	"main/internal/oapi"
+)
+
+type WebhookHandler struct {
+	webhookService *services.WebhookService
+	authService    *services.AuthService
+}
+
+func NewWebhookHandler(webhookService *services.WebhookService, authService *services.AuthService) *WebhookHandler {
+	return &WebhookHandler{
+		webhookService: webhookService,
+		authService:    authService,
+	}
+}
+
+func (h *WebhookHandler) CreateWebhook(ctx context.Context, request oapi.CreateWebhookRequestObject) (oapi.CreateWebhookResponseObject, error) {
+	session, ok := middleware.GetAccessSession(ctx)
+	if !ok || session == nil {
+		return oapi.CreateWebhook401JSONResponse{
+			UnauthorizedJSONResponse: oapi.UnauthorizedJSONResponse{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		}, nil
+	}
+
+	scope := domain.WebhookScopeUser
+	if request.Body.Scope != nil {
+		scope = domain.WebhookScope(*request.Body.Scope)
+	}
+
+	var events []domain.SecurityEventType
+	if request.Body.Events != nil {
+		for _, e := range *request.Body.Events {
+			events = append(events, domain.SecurityEventType(e))
+		}
+	}
+
+	isAdmin, err := h.authService.IsAdmin(ctx, session.UserID)
+	if err != nil {
+		return oapi.CreateWebhook500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	subscription, err := h.webhookService.CreateSubscription(ctx, session.UserID, request.Body.Url, scope, events, isAdmin)
+	switch {
+	case err == nil:
+	case errors.Is(err, services.ErrWebhookForbidden):
+		return oapi.CreateWebhook403JSONResponse{
+			ForbiddenJSONResponse: oapi.ForbiddenJSONResponse{
+				Code:    403,
+				Message: err.Error(),
+			},
+		}, nil
+	default:
+		return oapi.CreateWebhook400JSONResponse{
+			BadRequestJSONResponse: oapi.BadRequestJSONResponse{
+				Code:    400,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	// The secret is shown once, the subscriber needs it to verify signatures
+	response := mapToAPIWebhook(*subscription)
+	response.Secret = &subscription.Secret
+	return oapi.CreateWebhook201JSONResponse(response), nil
+}
+
+func (h *WebhookHandler) ListWebhooks(ctx context.Context, request oapi.ListWebhooksRequestObject) (oapi.ListWebhooksResponseObject, error) {
+	session, ok := middleware.GetAccessSession(ctx)
+	if !ok || session == nil {
+		return oapi.ListWebhooks401JSONResponse{
+			UnauthorizedJSONResponse: oapi.UnauthorizedJSONResponse{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		}, nil
+	}
+
+	subscriptions, err := h.webhookService.ListSubscriptions(ctx, session.UserID)
+	if err != nil {
+		return oapi.ListWebhooks500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	response := make([]oapi.WebhookResponse, 0, len(subscriptions))
+	for _, s := range subscriptions {
+		response = append(response, mapToAPIWebhook(s))
+	}
+
+	return oapi.ListWebhooks200JSONResponse(response), nil
+}
+
+func (h *WebhookHandler) DeleteWebhook(ctx context.Context, request oapi.DeleteWebhookRequestObject) (oapi.DeleteWebhookResponseObject, error) {
+	session, ok := middleware.GetAccessSession(ctx)
+	if !ok || session == nil {
+		return oapi.DeleteWebhook401JSONResponse{
+			UnauthorizedJSONResponse: oapi.UnauthorizedJSONResponse{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		}, nil
+	}
+
+	err := h.webhookService.DeleteSubscription(ctx, session.UserID, request.WebhookID.String())
+	switch {
+	case err == nil:
+		return oapi.DeleteWebhook204Response{}, nil
+	case errors.Is(err, services.ErrWebhookNotFound):
+		return oapi.DeleteWebhook404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: err.Error(),
+			},
+		}, nil
+	default:
+		return oapi.DeleteWebhook500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+}
+
+func (h *WebhookHandler) ListWebhookDeliveries(ctx context.Context, request oapi.ListWebhookDeliveriesRequestObject) (oapi.ListWebhookDeliveriesResponseObject, error) {
+	session, ok := middleware.GetAccessSession(ctx)
+	if !ok || session == nil {
+		return oapi.ListWebhookDeliveries401JSONResponse{
+			UnauthorizedJSONResponse: oapi.UnauthorizedJSONResponse{
+				Code:    401,
+				Message: "User not authenticated",
+			},
+		}, nil
+	}
+
+	limit := 50
+	if request.Params.Limit != nil {
+		limit = *request.Params.Limit
+	}
+
+	deliveries, err := h.webhookService.ListDeliveries(ctx, session.UserID, request.WebhookID.String(), limit)
+	switch {
+	case err == nil:
+	case errors.Is(err, services.ErrWebhookNotFound):
+		return oapi.ListWebhookDeliveries404JSONResponse{
+			NotFoundJSONResponse: oapi.NotFoundJSONResponse{
+				Code:    404,
+				Message: err.Error(),
+			},
+		}, nil
+	default:
+		return oapi.ListWebhookDeliveries500JSONResponse{
+			InternalServerErrorJSONResponse: oapi.InternalServerErrorJSONResponse{
+				Code:    500,
+				Message: err.Error(),
+			},
+		}, nil
+	}
+
+	response := make([]oapi.WebhookDeliveryResponse, 0, len(deliveries))
+	for _, d := range deliveries {
+		response = append(response, mapToAPIWebhookDelivery(d))
+	}
+
+	return oapi.ListWebhookDeliveries200JSONResponse(response), nil
+}
+
+func mapToAPIWebhook(s domain.WebhookSubscription) oapi.WebhookResponse {
+	events := make([]oapi.SecurityEventType, 0, len(s.Events))
+	for _, e := range s.Events {
+		events = append(events, oapi.SecurityEventType(e))
+	}
+
+	return oapi.WebhookResponse{
+		Id:        s.PublicID,
+		Url:       s.URL,
+		Scope:     oapi.WebhookResponseScope(s.Scope),
+		Events:    events,
+		CreatedAt: s.CreatedAt.Unix(),
+	}
+}
+
+func mapToAPIWebhookDelivery(d domain.WebhookDelivery) oapi.WebhookDeliveryResponse {
+	response := oapi.WebhookDeliveryResponse{
+		Id:            d.PublicID,
+		Event:         oapi.SecurityEventType(d.EventType),
+		Status:        oapi.WebhookDeliveryResponseStatus(d.Status),
+		Attempts:      d.Attempts,
+		NextAttemptAt: d.NextAttemptAt.Unix(),
+		CreatedAt:     d.CreatedAt.Unix(),
+	}
+	if d.ResponseStatus != 0 {
+		response.ResponseStatus = &d.ResponseStatus
+	}
+	if d.LastError != "" {
+		response.LastError = &d.LastError
+	}
+	if !d.DeliveredAt.IsZero() {
+		deliveredAt := d.DeliveredAt.Unix()
+		response.DeliveredAt = &deliveredAt
+	}
+	return response
+}
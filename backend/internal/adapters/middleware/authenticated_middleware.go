@@ -3,8 +3,10 @@ package middleware
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"main/internal/core/domain"
+	"main/internal/core/services"
 	"main/internal/oapi"
 	"net/http"
 	"strings"
@@ -13,14 +15,40 @@ import (
 const (
 	SessionContextKey       contextKey = "session"
 	TokenResponseContextKey contextKey = "token_response"
+	VaultContextKey         contextKey = "vault"
+	OrganizationContextKey  contextKey = "organization"
 	SessionCookieName       string     = "SESSION_ID"
 )
 
 func (m *Middleware) AuthMiddleware(next oapi.StrictHandlerFunc, operationID string) oapi.StrictHandlerFunc {
 	return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
 		switch operationID {
-		case "GetCurrentUser", "ListUsers", "LogoutUser", "InsertUserVault", "GetUserVault", "PollUserVault":
+		case "GetCurrentUser", "ListUsers", "LogoutUser", "InsertUserVault", "GetUserVault", "PollUserVault",
+			"ListVaultVersions", "GetVaultVersion", "RestoreVaultVersion", "SyncUserVault", "StreamVaultEvents", "GetVaultUsage",
+			"CreateVaultUpload", "GetVaultUpload", "AppendVaultUpload", "CancelVaultUpload", "CommitVaultUpload",
+			"CreateInvite", "ListInvites", "RevokeInvite", "ListUserEvents",
+			"ListWebhooks", "CreateWebhook", "DeleteWebhook", "ListWebhookDeliveries",
+			"ListVaults", "CreateVault", "ListUserKeys", "PublishUserKey", "GetUserKey", "LookupPublicKey",
+			"AcceptVaultInvite", "ListOrganizations", "CreateOrganization", "AcceptOrganizationInvite", "GetUserPolicies",
+			"ListEmergencyAccess", "InviteEmergencyContact", "RevokeEmergencyAccess", "AcceptEmergencyAccess", "RequestEmergencyAccess",
+			"ApproveEmergencyAccess", "RejectEmergencyAccess", "UpdateEmergencyAccessKey",
+			"ListSends", "CreateSend", "RevokeSend":
 			return m.hasAccessToken(next, ctx, w, r, request)
+		case "GetVault", "GetVaultContent", "ListVaultMembers", "RemoveVaultMember", "GetVaultKey":
+			return m.hasVaultAccess(domain.VaultRoleRead, next, ctx, w, r, request)
+		case "PutVaultContent":
+			return m.hasVaultAccess(domain.VaultRoleWrite, next, ctx, w, r, request)
+		case "UpdateVault", "DeleteVault", "InviteVaultMember", "UpdateVaultMember", "RotateVaultKey":
+			return m.hasVaultAccess(domain.VaultRoleManage, next, ctx, w, r, request)
+		case "GetOrganization", "ListOrganizationMembers", "RemoveOrganizationMember", "ListOrganizationGroups", "GetOrganizationPolicies":
+			return m.hasOrganizationAccess(domain.OrganizationRoleMember, next, ctx, w, r, request)
+		case "UpdateOrganization", "UpdateOrganizationMember", "ListOrganizationInvites", "CreateOrganizationInvite", "RevokeOrganizationInvite",
+			"CreateOrganizationGroup", "UpdateOrganizationGroup", "DeleteOrganizationGroup", "UpdateOrganizationPolicies", "CreateOrganizationVault":
+			return m.hasOrganizationAccess(domain.OrganizationRoleAdmin, next, ctx, w, r, request)
+		case "DeleteOrganization":
+			return m.hasOrganizationAccess(domain.OrganizationRoleOwner, next, ctx, w, r, request)
+		case "ListOutboxMessages", "RequeueOutboxMessage", "VerifyVaults":
+			return m.hasAdminAccessToken(next, ctx, w, r, request)
 		case "RefreshToken":
 			return m.hasRefreshToken(next, ctx, w, r, request)
 		default:
@@ -50,40 +78,173 @@ func (m *Middleware) hasRefreshToken(next oapi.StrictHandlerFunc, ctx context.Co
 
 	ctx = context.WithValue(ctx, SessionContextKey, session)
 	ctx = context.WithValue(ctx, TokenResponseContextKey, tokenResponse)
+	ctx = withDeviceID(ctx, session.DeviceID)
 
 	return next(ctx, w, r, request)
 }
 
 func (m *Middleware) hasAccessToken(next oapi.StrictHandlerFunc, ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-	tokenBase64, err := getBase64TokenFromRequest(r)
+	ctx, err := m.authenticateAccessToken(ctx, r)
 	if err != nil {
-		writeError(w, "no valid authentication method detected")
+		writeError(w, err.Error())
 		return nil, nil
 	}
 
+	return next(ctx, w, r, request)
+}
+
+// RequireAccessToken guards the routes outside of the OpenAPI handler, like
+// the vault WebSocket, with the same checks as the access token operations
+func (m *Middleware) RequireAccessToken(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, err := m.authenticateAccessToken(r.Context(), r)
+		if err != nil {
+			writeError(w, err.Error())
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// RequireAdminAccessToken guards the routes outside of the OpenAPI handler,
+// like the expvar metrics, with the same checks as the admin operations
+func (m *Middleware) RequireAdminAccessToken(next http.Handler) http.Handler {
+	return m.RequireAccessToken(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		session, ok := GetAccessSession(r.Context())
+		if !ok || session == nil {
+			writeError(w, "Unauthorized")
+			return
+		}
+
+		isAdmin, err := m.AuthService.IsAdmin(r.Context(), session.UserID)
+		if err != nil || !isAdmin {
+			writeErrorWithCode(w, 403, "Admin privileges required")
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	}))
+}
+
+// authenticateAccessToken returns ctx completed with the access session of
+// the request
+func (m *Middleware) authenticateAccessToken(ctx context.Context, r *http.Request) (context.Context, error) {
+	tokenBase64, err := getBase64TokenFromRequest(r)
+	if err != nil {
+		return nil, errors.New("no valid authentication method detected")
+	}
+
 	tokenResponse, err := domain.NewTokensFromBase64(tokenBase64)
 	if err != nil {
-		writeError(w, "invalid token format")
-		return nil, nil
+		return nil, errors.New("invalid token format")
 	}
 
 	session, err := m.AuthService.GetAccessSessionByToken(ctx, tokenResponse.AccessToken)
 	if err != nil {
-		writeError(w, "invalid or expired token")
-		return nil, nil
+		return nil, errors.New("invalid or expired token")
 	}
 
 	ctx = context.WithValue(ctx, SessionContextKey, session)
 	ctx = context.WithValue(ctx, TokenResponseContextKey, tokenResponse)
+	return withDeviceID(ctx, session.DeviceID), nil
+}
 
-	return next(ctx, w, r, request)
+// hasAdminAccessToken requires a valid access token belonging to an instance admin
+func (m *Middleware) hasAdminAccessToken(next oapi.StrictHandlerFunc, ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+	return m.hasAccessToken(func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		session, ok := GetAccessSession(ctx)
+		if !ok || session == nil {
+			writeError(w, "Unauthorized")
+			return nil, nil
+		}
+
+		isAdmin, err := m.AuthService.IsAdmin(ctx, session.UserID)
+		if err != nil || !isAdmin {
+			writeErrorWithCode(w, 403, "Admin privileges required")
+			return nil, nil
+		}
+
+		return next(ctx, w, r, request)
+	}, ctx, w, r, request)
+}
+
+// hasVaultAccess requires a valid access token of a member of the vault in
+// the path with at least role, the vaults of other users are hidden as not
+// found. The vault is passed on to the handler with the membership, see
+// GetVault.
+func (m *Middleware) hasVaultAccess(role domain.VaultRole, next oapi.StrictHandlerFunc, ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+	return m.hasAccessToken(func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		session, ok := GetAccessSession(ctx)
+		if !ok || session == nil {
+			writeError(w, "Unauthorized")
+			return nil, nil
+		}
+
+		vault, err := m.VaultService.AuthorizeVault(ctx, session.UserID, r.PathValue("vaultID"), role)
+		if errors.Is(err, services.ErrVaultNotFound) {
+			writeErrorWithCode(w, 404, err.Error())
+			return nil, nil
+		}
+		if errors.Is(err, services.ErrVaultForbidden) {
+			writeErrorWithCode(w, 403, err.Error())
+			return nil, nil
+		}
+		if err != nil {
+			writeErrorWithCode(w, 500, err.Error())
+			return nil, nil
+		}
+
+		return next(context.WithValue(ctx, VaultContextKey, vault), w, r, request)
+	}, ctx, w, r, request)
+}
+
+// hasOrganizationAccess requires a valid access token of a member of the
+// organization in the path with at least role, the organizations of other
+// users are hidden as not found. The organization is passed on to the
+// handler with the membership, see GetOrganization.
+func (m *Middleware) hasOrganizationAccess(role domain.OrganizationRole, next oapi.StrictHandlerFunc, ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+	return m.hasAccessToken(func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		session, ok := GetAccessSession(ctx)
+		if !ok || session == nil {
+			writeError(w, "Unauthorized")
+			return nil, nil
+		}
+
+		organization, err := m.OrganizationService.Authorize(ctx, session.UserID, r.PathValue("organizationID"), role)
+		if errors.Is(err, services.ErrOrganizationNotFound) {
+			writeErrorWithCode(w, 404, err.Error())
+			return nil, nil
+		}
+		if errors.Is(err, services.ErrOrganizationForbidden) {
+			writeErrorWithCode(w, 403, err.Error())
+			return nil, nil
+		}
+		if err != nil {
+			writeErrorWithCode(w, 500, err.Error())
+			return nil, nil
+		}
+
+		return next(context.WithValue(ctx, OrganizationContextKey, organization), w, r, request)
+	}, ctx, w, r, request)
+}
+
+// withDeviceID completes the client info with the device of the session
+func withDeviceID(ctx context.Context, deviceID string) context.Context {
+	client := domain.ClientInfoFromContext(ctx)
+	client.DeviceID = deviceID
+	return domain.WithClientInfo(ctx, client)
 }
 
 func writeError(w http.ResponseWriter, message string) {
+	writeErrorWithCode(w, 401, message)
+}
+
+func writeErrorWithCode(w http.ResponseWriter, code int, message string) {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(code)
 	json.NewEncoder(w).Encode(oapi.ErrorResponse{
-		Code:    401,
+		Code:    code,
 		Message: message,
 	})
 }
@@ -125,6 +286,19 @@ func GetRefreshSession(ctx context.Context) (*domain.RefreshSession, bool) {
 	return session, ok
 }
 
+// GetVault returns the vault checked by the vault operations
+func GetVault(ctx context.Context) (*domain.Vault, bool) {
+	vault, ok := ctx.Value(VaultContextKey).(*domain.Vault)
+	return vault, ok && vault != nil
+}
+
+// GetOrganization returns the organization checked by the organization
+// operations
+func GetOrganization(ctx context.Context) (*domain.Organization, bool) {
+	organization, ok := ctx.Value(OrganizationContextKey).(*domain.Organization)
+	return organization, ok && organization != nil
+}
+
 func GetTokenResponse(ctx context.Context) (*domain.Tokens, bool) {
 	token, ok := ctx.Value(TokenResponseContextKey).(*domain.Tokens)
 	return token, ok
@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"context"
+	"main/internal/core/domain"
 	"net"
 	"net/http"
 	"strings"
@@ -21,6 +22,7 @@ func (m *Middleware) ClientInfoMiddleware(next http.Handler) http.Handler {
 
 		ctx := context.WithValue(r.Context(), IpContextKey, ip)
 		ctx = context.WithValue(ctx, UserAgentContextKey, ua)
+		ctx = domain.WithClientInfo(ctx, domain.ClientInfo{IP: ip, UserAgent: ua})
 
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
@@ -7,8 +7,9 @@ import (
 func (m *Middleware) CORSMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", m.Config.AppFrontendUrl)
-		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, If-Match, If-None-Match, If-Modified-Since, Content-Digest, Last-Event-ID, X-Send-Password")
+		w.Header().Set("Access-Control-Expose-Headers", "ETag, Content-Digest, Last-Modified")
 		w.Header().Set("Access-Control-Allow-Credentials", "true") // if sending cookies
 
 		// Handle preflight request
@@ -0,0 +1,22 @@
+package middleware
+
+import (
+	"net/http"
+)
+
+// MaxBytesMiddleware bounds every request body by the vault size limit, the
+// largest body the API accepts. A body announcing more is refused right
+// away, a longer one fails on read with *http.MaxBytesError.
+func (m *Middleware) MaxBytesMiddleware(next http.Handler) http.Handler {
+	limit := int64(m.Config.Vault.MaxSize)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.ContentLength > limit {
+			writeErrorWithCode(w, http.StatusRequestEntityTooLarge, "Request body too large")
+			return
+		}
+
+		r.Body = http.MaxBytesReader(w, r.Body, limit)
+		next.ServeHTTP(w, r)
+	})
+}
@@ -6,10 +6,13 @@ import (
 )
 
 type Middleware struct {
-	Config      *config.Config
-	AuthService *services.AuthService
+	Config       *config.Config
+	AuthService  *services.AuthService
+	VaultService *services.VaultService
+
+	OrganizationService *services.OrganizationService
 }
 
-func NewMiddleware(AuthService *services.AuthService, config *config.Config) *Middleware {
-	return &Middleware{AuthService: AuthService, Config: config}
+func NewMiddleware(AuthService *services.AuthService, VaultService *services.VaultService, OrganizationService *services.OrganizationService, config *config.Config) *Middleware {
+	return &Middleware{AuthService: AuthService, VaultService: VaultService, OrganizationService: OrganizationService, Config: config}
 }
@@ -3,6 +3,7 @@ package middleware
 import (
 	"encoding/json"
 	"main/internal/oapi"
+	"mime"
 	"net/http"
 
 	"github.com/getkin/kin-openapi/openapi3"
@@ -11,7 +12,7 @@ import (
 )
 
 func (m *Middleware) OapiRequestValidatorMiddleware(next http.Handler, spec *openapi3.T) http.Handler {
-	return nethttpmiddleware.OapiRequestValidatorWithOptions(spec, &nethttpmiddleware.Options{
+	options := nethttpmiddleware.Options{
 		ErrorHandler: func(w http.ResponseWriter, message string, statusCode int) {
 			w.Header().Set("Content-Type", "application/json")
 			w.WriteHeader(statusCode)
@@ -22,5 +23,20 @@ func (m *Middleware) OapiRequestValidatorMiddleware(next http.Handler, spec *ope
 		}, Options: openapi3filter.Options{
 			AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
 		},
-	})(next)
+	}
+	validator := nethttpmiddleware.OapiRequestValidatorWithOptions(spec, &options)(next)
+
+	// Validating a body reads it whole into memory, binary uploads have no
+	// schema to check and are streamed to the handler instead
+	binaryOptions := options
+	binaryOptions.Options.ExcludeRequestBody = true
+	binaryValidator := nethttpmiddleware.OapiRequestValidatorWithOptions(spec, &binaryOptions)(next)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type")); mediaType == "application/octet-stream" {
+			binaryValidator.ServeHTTP(w, r)
+			return
+		}
+		validator.ServeHTTP(w, r)
+	})
 }
@@ -0,0 +1,65 @@
+package notifier
+
+import (
+	"main/internal/core/domain"
+	"net/url"
+)
+
+// mailRenderer turns a notification into the email sent to the user, it's
+// shared by the notifiers that deliver the full message
+type mailRenderer struct {
+	templates   *MailTemplates
+	frontendURL string
+}
+
+func newMailRenderer(templates *MailTemplates, frontendURL string) mailRenderer {
+	return mailRenderer{templates: templates, frontendURL: frontendURL}
+}
+
+func (r mailRenderer) render(kind string, payload domain.NotificationPayload) (*Email, error) {
+	data := TemplateData{
+		Name:         payload.Recipient.Name,
+		Email:        payload.Recipient.Email,
+		Locale:       payload.Recipient.Locale,
+		Code:         payload.Code,
+		Organization: payload.Organization,
+		Link:         notificationLink(r.frontendURL, kind, payload.Code),
+		AppURL:       r.frontendURL,
+	}
+	if request := payload.EmergencyAccess; request != nil {
+		data.Contact, data.Vault, data.WaitDays = request.Contact, request.Vault, request.WaitDays
+	}
+	return r.templates.Render(kind, data)
+}
+
+// notificationLink is the frontend page a notification points the user to
+func notificationLink(frontendURL, kind, code string) string {
+	switch kind {
+	case MessageRegistrationIntent:
+		return frontendLink(frontendURL, "/confirm", "code", code)
+	case MessageInvite:
+		return frontendLink(frontendURL, "/register", "invite", code)
+	case MessageOrganizationInvite:
+		return frontendLink(frontendURL, "/organizations/join", "token", code)
+	case MessageEmergencyAccessRequest:
+		return frontendLink(frontendURL, "/emergency-access", "id", code)
+	default:
+		return frontendLink(frontendURL, "/login", "", "")
+	}
+}
+
+// frontendLink builds a link to a frontend page, with an optional query parameter
+func frontendLink(frontendURL, path, key, value string) string {
+	link, err := url.Parse(frontendURL)
+	if err != nil {
+		return frontendURL + path
+	}
+
+	link.Path = link.JoinPath(path).Path
+	if key != "" {
+		query := link.Query()
+		query.Set(key, value)
+		link.RawQuery = query.Encode()
+	}
+	return link.String()
+}
@@ -0,0 +1,225 @@
+package notifier
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"os"
+	"strings"
+	texttemplate "text/template"
+)
+
+//go:embed templates
+var embeddedTemplates embed.FS
+
+// Message kinds, each one needs a <kind>.txt and a <kind>.html template per locale
+const (
+	MessageRegistrationIntent     = "registration_intent"
+	MessageRegistrationSuccess    = "registration_success"
+	MessageInvite                 = "invite"
+	MessageOrganizationInvite     = "organization_invite"
+	MessageEmergencyAccessRequest = "emergency_access_request"
+)
+
+var messageKinds = []string{
+	MessageRegistrationIntent,
+	MessageRegistrationSuccess,
+	MessageInvite,
+	MessageOrganizationInvite,
+	MessageEmergencyAccessRequest,
+}
+
+type Email struct {
+	Subject string
+	Text    string
+	HTML    string
+}
+
+type TemplateData struct {
+	Name   string
+	Email  string
+	Locale string
+	Code   string
+	// Organization is the name of the organization of an organization invite
+	Organization string
+	// Contact, Vault and WaitDays describe an emergency access request: the
+	// trusted contact, the vault and the days left to reject it
+	Contact  string
+	Vault    string
+	WaitDays int
+	Link     string
+	AppURL   string
+}
+
+type mailTemplate struct {
+	text *texttemplate.Template
+	html *htmltemplate.Template
+}
+
+// MailTemplates renders the notifier emails. Templates are embedded in the
+// binary and every file can be overridden by the same path in overrideDir,
+// e.g. <overrideDir>/it/invite.html or <overrideDir>/layout.html.
+type MailTemplates struct {
+	defaultLocale string
+	// locale -> message kind -> template
+	locales map[string]map[string]*mailTemplate
+}
+
+func NewMailTemplates(overrideDir, defaultLocale string) (*MailTemplates, error) {
+	embedded, err := fs.Sub(embeddedTemplates, "templates")
+	if err != nil {
+		return nil, err
+	}
+
+	sources := []fs.FS{embedded}
+	if overrideDir != "" {
+		sources = append([]fs.FS{os.DirFS(overrideDir)}, sources...)
+	}
+
+	layout, err := readTemplateFile(sources, "layout.html")
+	if err != nil {
+		return nil, err
+	}
+
+	t := &MailTemplates{
+		defaultLocale: defaultLocale,
+		locales:       make(map[string]map[string]*mailTemplate),
+	}
+
+	for _, locale := range listLocales(sources) {
+		for _, kind := range messageKinds {
+			tpl, err := parseMailTemplate(sources, layout, locale, kind)
+			if err != nil {
+				return nil, err
+			}
+			if tpl == nil {
+				continue
+			}
+
+			if t.locales[locale] == nil {
+				t.locales[locale] = make(map[string]*mailTemplate)
+			}
+			t.locales[locale][kind] = tpl
+		}
+	}
+
+	for _, kind := range messageKinds {
+		if t.locales[defaultLocale][kind] == nil {
+			return nil, fmt.Errorf("missing %s template for default locale %q", kind, defaultLocale)
+		}
+	}
+
+	return t, nil
+}
+
+// Render executes the templates of the given kind in the best matching
+// locale: the exact locale, then its base language, then the default one.
+func (t *MailTemplates) Render(kind string, data TemplateData) (*Email, error) {
+	locale := t.resolveLocale(data.Locale, kind)
+	tpl := t.locales[locale][kind]
+	if tpl == nil {
+		return nil, fmt.Errorf("unknown email template %q", kind)
+	}
+	data.Locale = locale
+
+	var subject, text, html bytes.Buffer
+	if err := tpl.text.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return nil, err
+	}
+	if err := tpl.text.ExecuteTemplate(&text, "body", data); err != nil {
+		return nil, err
+	}
+	if err := tpl.html.ExecuteTemplate(&html, "layout", data); err != nil {
+		return nil, err
+	}
+
+	return &Email{
+		Subject: strings.TrimSpace(subject.String()),
+		Text:    strings.TrimSpace(text.String()) + "\n",
+		HTML:    html.String(),
+	}, nil
+}
+
+func (t *MailTemplates) resolveLocale(locale, kind string) string {
+	locale = strings.ReplaceAll(locale, "_", "-")
+	candidates := []string{locale}
+	if base, _, found := strings.Cut(locale, "-"); found {
+		candidates = append(candidates, base)
+	}
+
+	for _, candidate := range candidates {
+		for available, kinds := range t.locales {
+			if strings.EqualFold(available, candidate) && kinds[kind] != nil {
+				return available
+			}
+		}
+	}
+	return t.defaultLocale
+}
+
+func parseMailTemplate(sources []fs.FS, layout, locale, kind string) (*mailTemplate, error) {
+	textSource, err := readTemplateFile(sources, locale+"/"+kind+".txt")
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	htmlSource, err := readTemplateFile(sources, locale+"/"+kind+".html")
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("template %s/%s.txt has no matching %s.html", locale, kind, kind)
+	} else if err != nil {
+		return nil, err
+	}
+
+	text, err := texttemplate.New(kind).Option("missingkey=error").Parse(textSource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s/%s.txt: %w", locale, kind, err)
+	}
+
+	html, err := htmltemplate.New(kind).Option("missingkey=error").Parse(layout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse layout.html: %w", err)
+	}
+	if _, err := html.Parse(htmlSource); err != nil {
+		return nil, fmt.Errorf("failed to parse %s/%s.html: %w", locale, kind, err)
+	}
+
+	return &mailTemplate{text: text, html: html}, nil
+}
+
+// readTemplateFile returns the file from the first source that has it
+func readTemplateFile(sources []fs.FS, name string) (string, error) {
+	for _, source := range sources {
+		data, err := fs.ReadFile(source, name)
+		if err == nil {
+			return string(data), nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return "", err
+		}
+	}
+	return "", fmt.Errorf("template %s: %w", name, fs.ErrNotExist)
+}
+
+func listLocales(sources []fs.FS) []string {
+	seen := make(map[string]bool)
+	var locales []string
+
+	for _, source := range sources {
+		entries, err := fs.ReadDir(source, ".")
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			if entry.IsDir() && !seen[entry.Name()] {
+				seen[entry.Name()] = true
+				locales = append(locales, entry.Name())
+			}
+		}
+	}
+	return locales
+}
@@ -0,0 +1,93 @@
+package notifier
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMailTemplates_RenderAllKinds(t *testing.T) {
+	templates, err := NewMailTemplates("", "en")
+	if err != nil {
+		t.Fatalf("failed to load templates: %v", err)
+	}
+
+	for _, locale := range []string{"en", "it"} {
+		for _, kind := range messageKinds {
+			email, err := templates.Render(kind, TemplateData{
+				Name:   "Jane <Doe>",
+				Locale: locale,
+				Code:   "abc123",
+				Link:   "http://localhost:5173/confirm?code=abc123",
+				AppURL: "http://localhost:5173",
+			})
+			if err != nil {
+				t.Fatalf("%s/%s: unexpected error: %v", locale, kind, err)
+			}
+			if email.Subject == "" {
+				t.Errorf("%s/%s: expected a subject", locale, kind)
+			}
+			if !strings.Contains(email.Text, "http://localhost:5173/confirm?code=abc123") {
+				t.Errorf("%s/%s: expected link in text body, got %q", locale, kind, email.Text)
+			}
+			if !strings.Contains(email.HTML, "http://localhost:5173/confirm?code=abc123") {
+				t.Errorf("%s/%s: expected link in html body", locale, kind)
+			}
+			if strings.Contains(email.HTML, "Jane <Doe>") {
+				t.Errorf("%s/%s: expected name to be escaped in html body", locale, kind)
+			}
+		}
+	}
+}
+
+func TestMailTemplates_LocaleFallback(t *testing.T) {
+	templates, err := NewMailTemplates("", "en")
+	if err != nil {
+		t.Fatalf("failed to load templates: %v", err)
+	}
+
+	tests := map[string]string{
+		"it":    "it",
+		"it-IT": "it",
+		"it_CH": "it",
+		"de":    "en",
+		"":      "en",
+	}
+
+	for requested, expected := range tests {
+		if got := templates.resolveLocale(requested, MessageInvite); got != expected {
+			t.Errorf("locale %q: expected %q, got %q", requested, expected, got)
+		}
+	}
+}
+
+func TestMailTemplates_OverrideDir(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "en"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	override := `{{define "subject"}}Custom subject{{end}}{{define "body"}}Custom {{.Code}}{{end}}`
+	if err := os.WriteFile(filepath.Join(dir, "en", "invite.txt"), []byte(override), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	templates, err := NewMailTemplates(dir, "en")
+	if err != nil {
+		t.Fatalf("failed to load templates: %v", err)
+	}
+
+	email, err := templates.Render(MessageInvite, TemplateData{Code: "xyz"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if email.Subject != "Custom subject" {
+		t.Errorf("expected overridden subject, got %q", email.Subject)
+	}
+	if email.Text != "Custom xyz\n" {
+		t.Errorf("expected overridden body, got %q", email.Text)
+	}
+	if !strings.Contains(email.HTML, "xyz") {
+		t.Errorf("expected embedded html template to still be used")
+	}
+}
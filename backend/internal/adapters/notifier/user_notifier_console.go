@@ -0,0 +1,51 @@
+package notifier
+
+import (
+	"context"
+	"log"
+	"main/internal/core/domain"
+)
+
+// UserNotifierConsole logs the rendered emails instead of sending them, it's
+// meant for local development where no mail server is running
+type UserNotifierConsole struct {
+	logger   *log.Logger
+	renderer mailRenderer
+}
+
+func NewUserNotifierConsole(logger *log.Logger, templates *MailTemplates, frontendURL string) *UserNotifierConsole {
+	return &UserNotifierConsole{
+		logger:   logger,
+		renderer: newMailRenderer(templates, frontendURL),
+	}
+}
+
+func (c *UserNotifierConsole) NotifyRegistrationIntent(ctx context.Context, to domain.Recipient, code string) error {
+	return c.send(MessageRegistrationIntent, domain.NotificationPayload{Recipient: to, Code: code})
+}
+
+func (c *UserNotifierConsole) NotifyRegistrationSuccess(ctx context.Context, to domain.Recipient, userID string) error {
+	return c.send(MessageRegistrationSuccess, domain.NotificationPayload{Recipient: to, UserID: userID})
+}
+
+func (c *UserNotifierConsole) NotifyInvite(ctx context.Context, to domain.Recipient, token string) error {
+	return c.send(MessageInvite, domain.NotificationPayload{Recipient: to, Code: token})
+}
+
+func (c *UserNotifierConsole) NotifyOrganizationInvite(ctx context.Context, to domain.Recipient, organization, token string) error {
+	return c.send(MessageOrganizationInvite, domain.NotificationPayload{Recipient: to, Code: token, Organization: organization})
+}
+
+func (c *UserNotifierConsole) NotifyEmergencyAccessRequest(ctx context.Context, to domain.Recipient, request domain.EmergencyAccessRequest) error {
+	return c.send(MessageEmergencyAccessRequest, domain.NotificationPayload{Recipient: to, Code: request.AccessID, EmergencyAccess: &request})
+}
+
+func (c *UserNotifierConsole) send(kind string, payload domain.NotificationPayload) error {
+	email, err := c.renderer.render(kind, payload)
+	if err != nil {
+		return err
+	}
+
+	c.logger.Printf("email %s to %s\nSubject: %s\n\n%s", kind, payload.Recipient.Email, email.Subject, email.Text)
+	return nil
+}
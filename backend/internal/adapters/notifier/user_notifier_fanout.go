@@ -0,0 +1,60 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"main/internal/core/domain"
+	"main/internal/core/ports"
+)
+
+// UserNotifierFanout sends every notification to all of its notifiers. All of
+// them are tried even when one fails and the errors are joined. An outbox
+// retry then resends to every notifier, the delivery id they carry (Message-ID,
+// file name, webhook id) lets a duplicate be recognized.
+type UserNotifierFanout struct {
+	notifiers []ports.UserNotifier
+}
+
+func NewUserNotifierFanout(notifiers ...ports.UserNotifier) *UserNotifierFanout {
+	return &UserNotifierFanout{notifiers: notifiers}
+}
+
+func (f *UserNotifierFanout) NotifyRegistrationIntent(ctx context.Context, to domain.Recipient, code string) error {
+	return f.each(func(n ports.UserNotifier) error {
+		return n.NotifyRegistrationIntent(ctx, to, code)
+	})
+}
+
+func (f *UserNotifierFanout) NotifyRegistrationSuccess(ctx context.Context, to domain.Recipient, userID string) error {
+	return f.each(func(n ports.UserNotifier) error {
+		return n.NotifyRegistrationSuccess(ctx, to, userID)
+	})
+}
+
+func (f *UserNotifierFanout) NotifyInvite(ctx context.Context, to domain.Recipient, token string) error {
+	return f.each(func(n ports.UserNotifier) error {
+		return n.NotifyInvite(ctx, to, token)
+	})
+}
+
+func (f *UserNotifierFanout) NotifyOrganizationInvite(ctx context.Context, to domain.Recipient, organization, token string) error {
+	return f.each(func(n ports.UserNotifier) error {
+		return n.NotifyOrganizationInvite(ctx, to, organization, token)
+	})
+}
+
+func (f *UserNotifierFanout) NotifyEmergencyAccessRequest(ctx context.Context, to domain.Recipient, request domain.EmergencyAccessRequest) error {
+	return f.each(func(n ports.UserNotifier) error {
+		return n.NotifyEmergencyAccessRequest(ctx, to, request)
+	})
+}
+
+func (f *UserNotifierFanout) each(notify func(n ports.UserNotifier) error) error {
+	var errs []error
+	for _, n := range f.notifiers {
+		if err := notify(n); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
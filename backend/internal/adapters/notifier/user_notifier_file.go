@@ -0,0 +1,95 @@
+package notifier
+
+import (
+	"context"
+	"main/internal/core/domain"
+	"main/internal/smtp"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// UserNotifierFile writes every email as an .eml file in a directory, so it
+// can be opened with a mail client or read by integration tests
+type UserNotifierFile struct {
+	dir      string
+	smtp     *smtp.SMTPClient
+	renderer mailRenderer
+}
+
+// NewUserNotifierFile creates the directory if needed. The SMTP client is only
+// used to build the message, nothing is sent.
+func NewUserNotifierFile(dir string, smtp *smtp.SMTPClient, templates *MailTemplates, frontendURL string) (*UserNotifierFile, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, err
+	}
+
+	return &UserNotifierFile{
+		dir:      dir,
+		smtp:     smtp,
+		renderer: newMailRenderer(templates, frontendURL),
+	}, nil
+}
+
+func (c *UserNotifierFile) NotifyRegistrationIntent(ctx context.Context, to domain.Recipient, code string) error {
+	return c.send(ctx, MessageRegistrationIntent, domain.NotificationPayload{Recipient: to, Code: code})
+}
+
+func (c *UserNotifierFile) NotifyRegistrationSuccess(ctx context.Context, to domain.Recipient, userID string) error {
+	return c.send(ctx, MessageRegistrationSuccess, domain.NotificationPayload{Recipient: to, UserID: userID})
+}
+
+func (c *UserNotifierFile) NotifyInvite(ctx context.Context, to domain.Recipient, token string) error {
+	return c.send(ctx, MessageInvite, domain.NotificationPayload{Recipient: to, Code: token})
+}
+
+func (c *UserNotifierFile) NotifyOrganizationInvite(ctx context.Context, to domain.Recipient, organization, token string) error {
+	return c.send(ctx, MessageOrganizationInvite, domain.NotificationPayload{Recipient: to, Code: token, Organization: organization})
+}
+
+func (c *UserNotifierFile) NotifyEmergencyAccessRequest(ctx context.Context, to domain.Recipient, request domain.EmergencyAccessRequest) error {
+	return c.send(ctx, MessageEmergencyAccessRequest, domain.NotificationPayload{Recipient: to, Code: request.AccessID, EmergencyAccess: &request})
+}
+
+func (c *UserNotifierFile) send(ctx context.Context, kind string, payload domain.NotificationPayload) error {
+	email, err := c.renderer.render(kind, payload)
+	if err != nil {
+		return err
+	}
+
+	deliveryID := domain.DeliveryID(ctx)
+	message, err := c.smtp.BuildMultipartEmail(deliveryID, payload.Recipient.Email, email.Subject, email.Text, email.HTML)
+	if err != nil {
+		return err
+	}
+
+	// A retried outbox delivery overwrites its own file instead of adding a copy
+	name := deliveryID
+	if name == "" {
+		name = time.Now().UTC().Format("20060102T150405") + "-" + uuid.NewString()
+	}
+
+	return writeFileAtomic(filepath.Join(c.dir, name+"-"+kind+".eml"), message)
+}
+
+// writeFileAtomic writes to a temporary file and renames it, so a reader
+// watching the directory never sees a partial message
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
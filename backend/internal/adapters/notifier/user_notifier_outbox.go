@@ -0,0 +1,51 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"main/internal/core/domain"
+	"main/internal/core/ports"
+	"main/internal/utils"
+	"strconv"
+)
+
+// UserNotifierOutbox doesn't send anything, it stores the notification in the
+// outbox, in the caller's transaction when there is one. The outbox worker
+// delivers it later through the configured notifier.
+type UserNotifierOutbox struct {
+	outbox ports.OutboxRepository
+}
+
+func NewUserNotifierOutbox(outbox ports.OutboxRepository) *UserNotifierOutbox {
+	return &UserNotifierOutbox{outbox: outbox}
+}
+
+func (n *UserNotifierOutbox) NotifyRegistrationIntent(ctx context.Context, to domain.Recipient, code string) error {
+	return n.enqueue(ctx, domain.NotificationRegistrationIntent, utils.HashToken(code), domain.NotificationPayload{Recipient: to, Code: code})
+}
+
+func (n *UserNotifierOutbox) NotifyRegistrationSuccess(ctx context.Context, to domain.Recipient, userID string) error {
+	return n.enqueue(ctx, domain.NotificationRegistrationSuccess, userID, domain.NotificationPayload{Recipient: to, UserID: userID})
+}
+
+func (n *UserNotifierOutbox) NotifyInvite(ctx context.Context, to domain.Recipient, token string) error {
+	return n.enqueue(ctx, domain.NotificationInvite, utils.HashToken(token), domain.NotificationPayload{Recipient: to, Code: token})
+}
+
+func (n *UserNotifierOutbox) NotifyOrganizationInvite(ctx context.Context, to domain.Recipient, organization, token string) error {
+	return n.enqueue(ctx, domain.NotificationOrganizationInvite, utils.HashToken(token), domain.NotificationPayload{Recipient: to, Code: token, Organization: organization})
+}
+
+func (n *UserNotifierOutbox) NotifyEmergencyAccessRequest(ctx context.Context, to domain.Recipient, request domain.EmergencyAccessRequest) error {
+	key := request.AccessID + ":" + strconv.FormatInt(request.RequestedAt.Unix(), 10)
+	return n.enqueue(ctx, domain.NotificationEmergencyAccess, key, domain.NotificationPayload{Recipient: to, Code: request.AccessID, EmergencyAccess: &request})
+}
+
+func (n *UserNotifierOutbox) enqueue(ctx context.Context, kind domain.NotificationKind, key string, payload domain.NotificationPayload) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	return n.outbox.EnqueueMessage(ctx, string(kind)+":"+key, kind, data)
+}
@@ -1,23 +1,48 @@
 package notifier
 
 import (
+	"context"
+	"main/internal/core/domain"
 	"main/internal/smtp"
 )
 
 type UserNotifierSMTP struct {
-	smtp *smtp.SMTPClient
+	smtp     *smtp.SMTPClient
+	renderer mailRenderer
 }
 
-func NewUserNotifierSMTP(smtp *smtp.SMTPClient) *UserNotifierSMTP {
+func NewUserNotifierSMTP(smtp *smtp.SMTPClient, templates *MailTemplates, frontendURL string) *UserNotifierSMTP {
 	return &UserNotifierSMTP{
-		smtp: smtp,
+		smtp:     smtp,
+		renderer: newMailRenderer(templates, frontendURL),
 	}
 }
 
-func (c *UserNotifierSMTP) NotifyRegistrationIntent(to, code string) error {
-	return c.smtp.SendEmail(to, "Registration", code)
+func (c *UserNotifierSMTP) NotifyRegistrationIntent(ctx context.Context, to domain.Recipient, code string) error {
+	return c.send(ctx, MessageRegistrationIntent, domain.NotificationPayload{Recipient: to, Code: code})
 }
 
-func (c *UserNotifierSMTP) NotifyRegistrationSuccess(to string) error {
-	return c.smtp.SendEmail(to, "Registration Success", "Successfully registered!")
+func (c *UserNotifierSMTP) NotifyRegistrationSuccess(ctx context.Context, to domain.Recipient, userID string) error {
+	return c.send(ctx, MessageRegistrationSuccess, domain.NotificationPayload{Recipient: to, UserID: userID})
+}
+
+func (c *UserNotifierSMTP) NotifyInvite(ctx context.Context, to domain.Recipient, token string) error {
+	return c.send(ctx, MessageInvite, domain.NotificationPayload{Recipient: to, Code: token})
+}
+
+func (c *UserNotifierSMTP) NotifyOrganizationInvite(ctx context.Context, to domain.Recipient, organization, token string) error {
+	return c.send(ctx, MessageOrganizationInvite, domain.NotificationPayload{Recipient: to, Code: token, Organization: organization})
+}
+
+func (c *UserNotifierSMTP) NotifyEmergencyAccessRequest(ctx context.Context, to domain.Recipient, request domain.EmergencyAccessRequest) error {
+	return c.send(ctx, MessageEmergencyAccessRequest, domain.NotificationPayload{Recipient: to, Code: request.AccessID, EmergencyAccess: &request})
+}
+
+func (c *UserNotifierSMTP) send(ctx context.Context, kind string, payload domain.NotificationPayload) error {
+	email, err := c.renderer.render(kind, payload)
+	if err != nil {
+		return err
+	}
+
+	return c.smtp.SendMultipartEmail(domain.DeliveryID(ctx), payload.Recipient.Email, email.Subject, email.Text, email.HTML)
 }
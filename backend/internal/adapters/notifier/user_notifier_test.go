@@ -0,0 +1,160 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"main/internal/config"
+	"main/internal/core/domain"
+	"main/internal/core/ports"
+	"main/internal/smtp"
+	"main/internal/utils"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var testRecipient = domain.Recipient{Email: "jane@example.com", Name: "Jane", Locale: "en"}
+
+func TestUserNotifierFile_WritesEml(t *testing.T) {
+	templates, err := NewMailTemplates("", "en")
+	if err != nil {
+		t.Fatalf("failed to load templates: %v", err)
+	}
+
+	dir := filepath.Join(t.TempDir(), "mail")
+	client := smtp.NewSMTPclient(config.SMTPConfig{From: "noreply@example.com"})
+	n, err := NewUserNotifierFile(dir, client, templates, "http://localhost:5173")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx := domain.WithDeliveryID(context.Background(), "delivery-1")
+	for range 2 {
+		if err := n.NotifyRegistrationIntent(ctx, testRecipient, "abc123"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Name() != "delivery-1-registration_intent.eml" {
+		t.Fatalf("expected a single eml for the delivery, got %v", entries)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, entries[0].Name()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), "To: jane@example.com") || !strings.Contains(string(data), "abc123") {
+		t.Errorf("expected recipient and code in eml, got %q", data)
+	}
+}
+
+func TestUserNotifierWebhook_SignsPayload(t *testing.T) {
+	var received WebhookNotification
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		timestamp, _ := strconv.ParseInt(r.Header.Get(WebhookTimestampHeader), 10, 64)
+		if r.Header.Get(WebhookSignatureHeader) != "sha256="+utils.SignPayload("secret", timestamp, body) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		json.Unmarshal(body, &received)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	n := NewUserNotifierWebhook(server.URL, "secret", "http://localhost:5173")
+	if err := n.NotifyInvite(context.Background(), testRecipient, "token"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if received.Event != MessageInvite || received.Code != "token" || received.Recipient.Email != testRecipient.Email {
+		t.Errorf("unexpected payload %+v", received)
+	}
+	if received.Link != "http://localhost:5173/register?invite=token" {
+		t.Errorf("unexpected link %q", received.Link)
+	}
+
+	bad := NewUserNotifierWebhook(server.URL, "wrong", "http://localhost:5173")
+	if err := bad.NotifyInvite(context.Background(), testRecipient, "token"); err == nil {
+		t.Error("expected an error when the receiver rejects the signature")
+	}
+}
+
+type recordingNotifier struct {
+	err   error
+	codes []string
+}
+
+func (n *recordingNotifier) NotifyRegistrationIntent(ctx context.Context, to domain.Recipient, code string) error {
+	n.codes = append(n.codes, code)
+	return n.err
+}
+
+func (n *recordingNotifier) NotifyRegistrationSuccess(ctx context.Context, to domain.Recipient, userID string) error {
+	return n.err
+}
+
+func (n *recordingNotifier) NotifyInvite(ctx context.Context, to domain.Recipient, token string) error {
+	n.codes = append(n.codes, token)
+	return n.err
+}
+
+func (n *recordingNotifier) NotifyOrganizationInvite(ctx context.Context, to domain.Recipient, organization, token string) error {
+	n.codes = append(n.codes, token)
+	return n.err
+}
+
+func (n *recordingNotifier) NotifyEmergencyAccessRequest(ctx context.Context, to domain.Recipient, request domain.EmergencyAccessRequest) error {
+	n.codes = append(n.codes, request.AccessID)
+	return n.err
+}
+
+func TestUserNotifierFanout_NotifiesAll(t *testing.T) {
+	failing := &recordingNotifier{err: errors.New("down")}
+	working := &recordingNotifier{}
+
+	var n ports.UserNotifier = NewUserNotifierFanout(failing, working)
+	err := n.NotifyRegistrationIntent(context.Background(), testRecipient, "abc123")
+	if err == nil || !strings.Contains(err.Error(), "down") {
+		t.Errorf("expected the failing notifier error, got %v", err)
+	}
+	if len(failing.codes) != 1 || len(working.codes) != 1 {
+		t.Errorf("expected both notifiers to be called, got %v and %v", failing.codes, working.codes)
+	}
+}
+
+// keyRecordingOutbox records the idempotency keys of the enqueued messages
+type keyRecordingOutbox struct {
+	ports.OutboxRepository
+	keys []string
+}
+
+func (o *keyRecordingOutbox) EnqueueMessage(ctx context.Context, idempotencyKey string, kind domain.NotificationKind, payload []byte) error {
+	o.keys = append(o.keys, idempotencyKey)
+	return nil
+}
+
+func TestUserNotifierOutbox_RegistrationSuccessKeyedByUser(t *testing.T) {
+	outbox := &keyRecordingOutbox{}
+	n := NewUserNotifierOutbox(outbox)
+
+	// The account of the same address was deleted and created again
+	for _, userID := range []string{"user-1", "user-2"} {
+		if err := n.NotifyRegistrationSuccess(context.Background(), testRecipient, userID); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if len(outbox.keys) != 2 || outbox.keys[0] == outbox.keys[1] {
+		t.Errorf("expected a key per registration, got %v", outbox.keys)
+	}
+}
@@ -0,0 +1,94 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"main/internal/core/domain"
+	"net/http"
+	"time"
+)
+
+// UserNotifierWebhook POSTs every notification as JSON to a URL, signed with
+// an HMAC of the body so the receiver can check it came from us
+type UserNotifierWebhook struct {
+	client      *http.Client
+	url         string
+	secret      string
+	frontendURL string
+}
+
+type webhookRecipient struct {
+	Email  string `json:"email"`
+	Name   string `json:"name"`
+	Locale string `json:"locale"`
+}
+
+type WebhookNotification struct {
+	ID           string           `json:"id,omitempty"`
+	Event        string           `json:"event"`
+	Recipient    webhookRecipient `json:"recipient"`
+	Code         string           `json:"code,omitempty"`
+	Organization string           `json:"organization,omitempty"`
+	// EmergencyAccess is set for the emergency access requests
+	EmergencyAccess *webhookEmergencyAccess `json:"emergencyAccess,omitempty"`
+	Link            string                  `json:"link"`
+	CreatedAt       int64                   `json:"createdAt"`
+}
+
+type webhookEmergencyAccess struct {
+	Contact  string `json:"contact"`
+	Vault    string `json:"vault"`
+	WaitDays int    `json:"waitDays"`
+}
+
+func NewUserNotifierWebhook(url, secret, frontendURL string) *UserNotifierWebhook {
+	return &UserNotifierWebhook{
+		client:      &http.Client{Timeout: 10 * time.Second},
+		url:         url,
+		secret:      secret,
+		frontendURL: frontendURL,
+	}
+}
+
+func (c *UserNotifierWebhook) NotifyRegistrationIntent(ctx context.Context, to domain.Recipient, code string) error {
+	return c.send(ctx, MessageRegistrationIntent, domain.NotificationPayload{Recipient: to, Code: code})
+}
+
+func (c *UserNotifierWebhook) NotifyRegistrationSuccess(ctx context.Context, to domain.Recipient, userID string) error {
+	return c.send(ctx, MessageRegistrationSuccess, domain.NotificationPayload{Recipient: to, UserID: userID})
+}
+
+func (c *UserNotifierWebhook) NotifyInvite(ctx context.Context, to domain.Recipient, token string) error {
+	return c.send(ctx, MessageInvite, domain.NotificationPayload{Recipient: to, Code: token})
+}
+
+func (c *UserNotifierWebhook) NotifyOrganizationInvite(ctx context.Context, to domain.Recipient, organization, token string) error {
+	return c.send(ctx, MessageOrganizationInvite, domain.NotificationPayload{Recipient: to, Code: token, Organization: organization})
+}
+
+func (c *UserNotifierWebhook) NotifyEmergencyAccessRequest(ctx context.Context, to domain.Recipient, request domain.EmergencyAccessRequest) error {
+	return c.send(ctx, MessageEmergencyAccessRequest, domain.NotificationPayload{Recipient: to, Code: request.AccessID, EmergencyAccess: &request})
+}
+
+func (c *UserNotifierWebhook) send(ctx context.Context, kind string, payload domain.NotificationPayload) error {
+	notification := WebhookNotification{
+		ID:           domain.DeliveryID(ctx),
+		Event:        kind,
+		Recipient:    webhookRecipient{Email: payload.Recipient.Email, Name: payload.Recipient.Name, Locale: payload.Recipient.Locale},
+		Code:         payload.Code,
+		Organization: payload.Organization,
+		Link:         notificationLink(c.frontendURL, kind, payload.Code),
+		CreatedAt:    time.Now().Unix(),
+	}
+	if request := payload.EmergencyAccess; request != nil {
+		notification.EmergencyAccess = &webhookEmergencyAccess{Contact: request.Contact, Vault: request.Vault, WaitDays: request.WaitDays}
+	}
+
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+
+	_, err = postSigned(ctx, c.client, c.url, c.secret, body, nil)
+	return err
+}
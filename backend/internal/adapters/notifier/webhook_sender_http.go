@@ -0,0 +1,30 @@
+package notifier
+
+import (
+	"context"
+	"main/internal/core/domain"
+	"net/http"
+	"time"
+)
+
+const (
+	WebhookIDHeader    = "X-Webhook-ID"
+	WebhookEventHeader = "X-Webhook-Event"
+)
+
+// WebhookSenderHTTP delivers the event webhooks users subscribe to. The
+// delivery id header is stable across retries so receivers can deduplicate.
+type WebhookSenderHTTP struct {
+	client *http.Client
+}
+
+func NewWebhookSenderHTTP(timeout time.Duration, allowPrivate bool) *WebhookSenderHTTP {
+	return &WebhookSenderHTTP{client: newWebhookClient(timeout, allowPrivate)}
+}
+
+func (s *WebhookSenderHTTP) Send(ctx context.Context, delivery domain.WebhookDelivery) (int, error) {
+	return postSigned(ctx, s.client, delivery.URL, delivery.Secret, delivery.Payload, map[string]string{
+		WebhookIDHeader:    delivery.PublicID.String(),
+		WebhookEventHeader: string(delivery.EventType),
+	})
+}
@@ -0,0 +1,41 @@
+package notifier
+
+import (
+	"context"
+	"main/internal/core/domain"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestWebhookSenderHTTP_Headers(t *testing.T) {
+	delivery := domain.WebhookDelivery{
+		PublicID:  uuid.New(),
+		Secret:    "secret",
+		EventType: domain.SecurityEventLogin,
+		Payload:   []byte(`{"type":"login"}`),
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(WebhookIDHeader) != delivery.PublicID.String() || r.Header.Get(WebhookEventHeader) != "login" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+	delivery.URL = server.URL
+
+	status, err := NewWebhookSenderHTTP(time.Second, true).Send(context.Background(), delivery)
+	if err != nil || status != http.StatusAccepted {
+		t.Fatalf("expected 202, got %d (%v)", status, err)
+	}
+
+	// The test server listens on loopback, which user webhooks can't reach
+	if _, err := NewWebhookSenderHTTP(time.Second, false).Send(context.Background(), delivery); err == nil {
+		t.Error("expected a loopback address to be rejected")
+	}
+}
@@ -0,0 +1,219 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"main/internal/core/domain"
+	db "main/internal/db/sqlc"
+	"main/internal/utils"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type EmergencyAccessRepositoryPg struct {
+	queries *db.Queries
+}
+
+func NewEmergencyAccessRepositoryPg(dbConn *sql.DB) *EmergencyAccessRepositoryPg {
+	return &EmergencyAccessRepositoryPg{
+		queries: db.New(dbConn),
+	}
+}
+
+func (r *EmergencyAccessRepositoryPg) CreateAccess(ctx context.Context, access domain.EmergencyAccess) (*domain.EmergencyAccess, error) {
+	vaultUUID, err := uuid.Parse(access.VaultID)
+	if err != nil {
+		return nil, err
+	}
+	grantorID, err := utils.Int32FromString(access.GrantorID)
+	if err != nil {
+		return nil, err
+	}
+	granteeID, err := utils.Int32FromString(access.GranteeID)
+	if err != nil {
+		return nil, err
+	}
+
+	queries := queriesFromContext(ctx, r.queries)
+	accessUUID, err := queries.CreateEmergencyAccess(ctx, db.CreateEmergencyAccessParams{
+		GranteeID:      granteeID,
+		Mode:           string(access.Mode),
+		WaitSeconds:    int32(access.WaitPeriod / time.Second),
+		WrappedKey:     access.WrappedKey,
+		KeyVersion:     int32(access.KeyVersion),
+		UserKeyVersion: int32(access.UserKeyVersion),
+		VaultID:        vaultUUID,
+		GrantorID:      grantorID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := queries.GetEmergencyAccess(ctx, accessUUID)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainEmergencyAccess(db.ListEmergencyAccessByGrantorRow(row)), nil
+}
+
+func (r *EmergencyAccessRepositoryPg) GetAccess(ctx context.Context, accessID string) (*domain.EmergencyAccess, error) {
+	accessUUID, err := uuid.Parse(accessID)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := queriesFromContext(ctx, r.queries).GetEmergencyAccess(ctx, accessUUID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainEmergencyAccess(db.ListEmergencyAccessByGrantorRow(row)), nil
+}
+
+func (r *EmergencyAccessRepositoryPg) GetGrantedAccess(ctx context.Context, vaultID, granteeID string) (*domain.EmergencyAccess, error) {
+	vaultUUID, err := uuid.Parse(vaultID)
+	if err != nil {
+		return nil, err
+	}
+	id, err := utils.Int32FromString(granteeID)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := queriesFromContext(ctx, r.queries).GetGrantedEmergencyAccess(ctx, db.GetGrantedEmergencyAccessParams{
+		PublicID:  vaultUUID,
+		GranteeID: id,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainEmergencyAccess(db.ListEmergencyAccessByGrantorRow(row)), nil
+}
+
+func (r *EmergencyAccessRepositoryPg) ListByGrantor(ctx context.Context, grantorID string) ([]domain.EmergencyAccess, error) {
+	id, err := utils.Int32FromString(grantorID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := queriesFromContext(ctx, r.queries).ListEmergencyAccessByGrantor(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	accesses := make([]domain.EmergencyAccess, 0, len(rows))
+	for _, row := range rows {
+		accesses = append(accesses, *toDomainEmergencyAccess(row))
+	}
+	return accesses, nil
+}
+
+func (r *EmergencyAccessRepositoryPg) ListByGrantee(ctx context.Context, granteeID string) ([]domain.EmergencyAccess, error) {
+	id, err := utils.Int32FromString(granteeID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := queriesFromContext(ctx, r.queries).ListEmergencyAccessByGrantee(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	accesses := make([]domain.EmergencyAccess, 0, len(rows))
+	for _, row := range rows {
+		accesses = append(accesses, *toDomainEmergencyAccess(db.ListEmergencyAccessByGrantorRow(row)))
+	}
+	return accesses, nil
+}
+
+func (r *EmergencyAccessRepositoryPg) UpdateStatus(ctx context.Context, accessID string, current, status domain.EmergencyAccessStatus) (bool, error) {
+	accessUUID, err := uuid.Parse(accessID)
+	if err != nil {
+		return false, err
+	}
+
+	updated, err := queriesFromContext(ctx, r.queries).UpdateEmergencyAccessStatus(ctx, db.UpdateEmergencyAccessStatusParams{
+		Status:        string(status),
+		PublicID:      accessUUID,
+		CurrentStatus: string(current),
+	})
+	return updated > 0, err
+}
+
+func (r *EmergencyAccessRepositoryPg) RequestAccess(ctx context.Context, accessID string, requestedAt time.Time) (bool, error) {
+	accessUUID, err := uuid.Parse(accessID)
+	if err != nil {
+		return false, err
+	}
+
+	updated, err := queriesFromContext(ctx, r.queries).RequestEmergencyAccess(ctx, db.RequestEmergencyAccessParams{
+		PublicID:    accessUUID,
+		RequestedAt: sql.NullTime{Time: requestedAt, Valid: true},
+	})
+	return updated > 0, err
+}
+
+func (r *EmergencyAccessRepositoryPg) SetKey(ctx context.Context, accessID string, key domain.VaultMemberKey, keyVersion int) (bool, error) {
+	accessUUID, err := uuid.Parse(accessID)
+	if err != nil {
+		return false, err
+	}
+
+	updated, err := queriesFromContext(ctx, r.queries).SetEmergencyAccessKey(ctx, db.SetEmergencyAccessKeyParams{
+		PublicID:       accessUUID,
+		WrappedKey:     key.WrappedKey,
+		KeyVersion:     int32(keyVersion),
+		UserKeyVersion: int32(key.UserKeyVersion),
+	})
+	return updated > 0, err
+}
+
+func (r *EmergencyAccessRepositoryPg) DeleteAccess(ctx context.Context, accessID string) (bool, error) {
+	accessUUID, err := uuid.Parse(accessID)
+	if err != nil {
+		return false, err
+	}
+
+	deleted, err := queriesFromContext(ctx, r.queries).DeleteEmergencyAccess(ctx, accessUUID)
+	return deleted > 0, err
+}
+
+func (r *EmergencyAccessRepositoryPg) GrantExpiredRequests(ctx context.Context) (int, error) {
+	granted, err := queriesFromContext(ctx, r.queries).GrantExpiredEmergencyAccess(ctx)
+	return int(granted), err
+}
+
+func toDomainEmergencyAccess(a db.ListEmergencyAccessByGrantorRow) *domain.EmergencyAccess {
+	return &domain.EmergencyAccess{
+		ID:             a.PublicID.String(),
+		GrantorID:      strconv.FormatInt(int64(a.GrantorID), 10),
+		GrantorEmail:   a.GrantorEmail,
+		GrantorName:    a.GrantorName,
+		GranteeID:      strconv.FormatInt(int64(a.GranteeID), 10),
+		GranteeEmail:   a.GranteeEmail,
+		GranteeName:    a.GranteeName,
+		VaultID:        a.VaultID.String(),
+		VaultName:      a.VaultName,
+		Mode:           domain.EmergencyAccessMode(a.Mode),
+		Status:         domain.EmergencyAccessStatus(a.Status),
+		WaitPeriod:     time.Duration(a.WaitSeconds) * time.Second,
+		WrappedKey:     a.WrappedKey,
+		KeyVersion:     int(a.KeyVersion),
+		UserKeyVersion: int(a.UserKeyVersion),
+		RequestedAt:    a.RequestedAt.Time,
+		CreatedAt:      a.CreatedAt,
+	}
+}
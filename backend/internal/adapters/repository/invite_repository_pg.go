@@ -0,0 +1,163 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"main/internal/core/domain"
+	db "main/internal/db/sqlc"
+	"main/internal/utils"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const INVITE_EXPIRATION = 7 * 24 * time.Hour
+
+type InviteRepositoryPg struct {
+	queries *db.Queries
+}
+
+func NewInviteRepositoryPg(dbConn *sql.DB) *InviteRepositoryPg {
+	return &InviteRepositoryPg{
+		queries: db.New(dbConn),
+	}
+}
+
+func (r *InviteRepositoryPg) CreateInvite(ctx context.Context, createdBy, email string) (*domain.InviteToken, error) {
+	creatorID, err := utils.Int32FromString(createdBy)
+	if err != nil {
+		return nil, err
+	}
+
+	token, tokenHash, err := GenerateTokenForSession()
+	if err != nil {
+		return nil, err
+	}
+
+	dbInvite, err := queriesFromContext(ctx, r.queries).CreateInvite(ctx, db.CreateInviteParams{
+		TokenHash: tokenHash,
+		Email:     sql.NullString{String: email, Valid: email != ""},
+		CreatedBy: creatorID,
+		ExpiresAt: time.Now().Add(INVITE_EXPIRATION),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.InviteToken{
+		Invite: *toDomainInvite(dbInvite),
+		Token:  token,
+	}, nil
+}
+
+func (r *InviteRepositoryPg) GetInviteByToken(ctx context.Context, token string) (*domain.Invite, error) {
+	dbInvite, err := queriesFromContext(ctx, r.queries).GetInviteByTokenHash(ctx, utils.HashToken(token))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return toDomainInvite(dbInvite), nil
+}
+
+func (r *InviteRepositoryPg) GetInviteByPublicID(ctx context.Context, id string) (*domain.Invite, error) {
+	inviteUUID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	dbInvite, err := queriesFromContext(ctx, r.queries).GetInviteByPublicID(ctx, inviteUUID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return toDomainInvite(dbInvite), nil
+}
+
+func (r *InviteRepositoryPg) GetInvites(ctx context.Context) ([]domain.Invite, error) {
+	dbInvites, err := queriesFromContext(ctx, r.queries).GetInvites(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainInvites(dbInvites), nil
+}
+
+func (r *InviteRepositoryPg) GetInvitesByCreator(ctx context.Context, createdBy string) ([]domain.Invite, error) {
+	creatorID, err := utils.Int32FromString(createdBy)
+	if err != nil {
+		return nil, err
+	}
+
+	dbInvites, err := queriesFromContext(ctx, r.queries).GetInvitesByCreator(ctx, creatorID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainInvites(dbInvites), nil
+}
+
+// UseInvite atomically marks the invite as used, it returns nil if the invite
+// was already used, revoked or expired.
+func (r *InviteRepositoryPg) UseInvite(ctx context.Context, id, email string) (*domain.Invite, error) {
+	inviteUUID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	dbInvite, err := queriesFromContext(ctx, r.queries).UseInvite(ctx, db.UseInviteParams{
+		PublicID:    inviteUUID,
+		UsedByEmail: sql.NullString{String: email, Valid: true},
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return toDomainInvite(dbInvite), nil
+}
+
+func (r *InviteRepositoryPg) RevokeInvite(ctx context.Context, id string) (bool, error) {
+	inviteUUID, err := uuid.Parse(id)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := queriesFromContext(ctx, r.queries).RevokeInvite(ctx, inviteUUID)
+	if err != nil {
+		return false, err
+	}
+
+	return rows > 0, nil
+}
+
+func toDomainInvites(dbInvites []db.Invite) []domain.Invite {
+	invites := make([]domain.Invite, 0, len(dbInvites))
+	for _, i := range dbInvites {
+		invites = append(invites, *toDomainInvite(i))
+	}
+	return invites
+}
+
+func toDomainInvite(i db.Invite) *domain.Invite {
+	return &domain.Invite{
+		ID:          strconv.FormatInt(int64(i.ID), 10),
+		PublicID:    i.PublicID,
+		Email:       i.Email.String,
+		CreatedBy:   strconv.FormatInt(int64(i.CreatedBy), 10),
+		CreatedAt:   i.CreatedAt,
+		ExpiresAt:   i.ExpiresAt,
+		UsedAt:      i.UsedAt.Time,
+		UsedByEmail: i.UsedByEmail.String,
+		RevokedAt:   i.RevokedAt.Time,
+	}
+}
@@ -0,0 +1,566 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"main/internal/core/domain"
+	db "main/internal/db/sqlc"
+	"main/internal/utils"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type OrganizationRepositoryPg struct {
+	queries *db.Queries
+}
+
+func NewOrganizationRepositoryPg(dbConn *sql.DB) *OrganizationRepositoryPg {
+	return &OrganizationRepositoryPg{
+		queries: db.New(dbConn),
+	}
+}
+
+func (r *OrganizationRepositoryPg) CreateOrganization(ctx context.Context, name string) (*domain.Organization, error) {
+	org, err := queriesFromContext(ctx, r.queries).CreateOrganization(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainOrganization(org), nil
+}
+
+func (r *OrganizationRepositoryPg) GetOrganization(ctx context.Context, organizationID string) (*domain.Organization, error) {
+	orgUUID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	org, err := queriesFromContext(ctx, r.queries).GetOrganization(ctx, orgUUID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDomainOrganization(org), nil
+}
+
+func (r *OrganizationRepositoryPg) ListOrganizations(ctx context.Context, userID string) ([]domain.Organization, error) {
+	id, err := utils.Int32FromString(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := queriesFromContext(ctx, r.queries).ListOrganizationsByUserID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	orgs := make([]domain.Organization, 0, len(rows))
+	for _, row := range rows {
+		orgs = append(orgs, domain.Organization{
+			ID:        row.PublicID.String(),
+			Name:      row.Name,
+			CreatedAt: row.CreatedAt,
+			Membership: &domain.OrganizationMember{
+				ID:             row.MemberID.String(),
+				OrganizationID: row.PublicID.String(),
+				UserID:         userID,
+				Role:           domain.OrganizationRole(row.Role),
+			},
+		})
+	}
+	return orgs, nil
+}
+
+func (r *OrganizationRepositoryPg) RenameOrganization(ctx context.Context, organizationID, name string) (bool, error) {
+	orgUUID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return false, err
+	}
+
+	updated, err := queriesFromContext(ctx, r.queries).UpdateOrganizationName(ctx, db.UpdateOrganizationNameParams{
+		PublicID: orgUUID,
+		Name:     name,
+	})
+	return updated > 0, err
+}
+
+func (r *OrganizationRepositoryPg) DeleteOrganization(ctx context.Context, organizationID string) (bool, error) {
+	orgUUID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return false, err
+	}
+
+	deleted, err := queriesFromContext(ctx, r.queries).DeleteOrganization(ctx, orgUUID)
+	return deleted > 0, err
+}
+
+func (r *OrganizationRepositoryPg) CreateMember(ctx context.Context, organizationID, userID string, role domain.OrganizationRole) (*domain.OrganizationMember, error) {
+	orgUUID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return nil, err
+	}
+	id, err := utils.Int32FromString(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	queries := queriesFromContext(ctx, r.queries)
+	memberUUID, err := queries.CreateOrganizationMember(ctx, db.CreateOrganizationMemberParams{
+		UserID:         id,
+		Role:           string(role),
+		OrganizationID: orgUUID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := queries.GetOrganizationMemberByPublicID(ctx, memberUUID)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainOrganizationMember(db.ListOrganizationMembersRow(row)), nil
+}
+
+func (r *OrganizationRepositoryPg) GetMember(ctx context.Context, organizationID, userID string) (*domain.OrganizationMember, error) {
+	orgUUID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return nil, err
+	}
+	id, err := utils.Int32FromString(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := queriesFromContext(ctx, r.queries).GetOrganizationMember(ctx, db.GetOrganizationMemberParams{
+		PublicID: orgUUID,
+		UserID:   id,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDomainOrganizationMember(db.ListOrganizationMembersRow(row)), nil
+}
+
+func (r *OrganizationRepositoryPg) GetMemberByID(ctx context.Context, memberID string) (*domain.OrganizationMember, error) {
+	memberUUID, err := uuid.Parse(memberID)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := queriesFromContext(ctx, r.queries).GetOrganizationMemberByPublicID(ctx, memberUUID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDomainOrganizationMember(db.ListOrganizationMembersRow(row)), nil
+}
+
+func (r *OrganizationRepositoryPg) ListMembers(ctx context.Context, organizationID string) ([]domain.OrganizationMember, error) {
+	orgUUID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := queriesFromContext(ctx, r.queries).ListOrganizationMembers(ctx, orgUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]domain.OrganizationMember, 0, len(rows))
+	for _, row := range rows {
+		members = append(members, *toDomainOrganizationMember(row))
+	}
+	return members, nil
+}
+
+func (r *OrganizationRepositoryPg) UpdateMemberRole(ctx context.Context, memberID string, role domain.OrganizationRole) (bool, error) {
+	memberUUID, err := uuid.Parse(memberID)
+	if err != nil {
+		return false, err
+	}
+
+	updated, err := queriesFromContext(ctx, r.queries).UpdateOrganizationMemberRole(ctx, db.UpdateOrganizationMemberRoleParams{
+		PublicID: memberUUID,
+		Role:     string(role),
+	})
+	return updated > 0, err
+}
+
+func (r *OrganizationRepositoryPg) DeleteMember(ctx context.Context, memberID string) (bool, error) {
+	memberUUID, err := uuid.Parse(memberID)
+	if err != nil {
+		return false, err
+	}
+
+	deleted, err := queriesFromContext(ctx, r.queries).DeleteOrganizationMember(ctx, memberUUID)
+	return deleted > 0, err
+}
+
+func (r *OrganizationRepositoryPg) CountOwners(ctx context.Context, organizationID string) (int, error) {
+	orgUUID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := queriesFromContext(ctx, r.queries).CountOrganizationOwners(ctx, orgUUID)
+	return int(count), err
+}
+
+// LeaveVaults flags the vaults before deleting the memberships it looks at
+func (r *OrganizationRepositoryPg) LeaveVaults(ctx context.Context, organizationID, userID string) error {
+	orgUUID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return err
+	}
+	id, err := utils.Int32FromString(userID)
+	if err != nil {
+		return err
+	}
+
+	queries := queriesFromContext(ctx, r.queries)
+	err = queries.RequireOrganizationVaultKeyRotation(ctx, db.RequireOrganizationVaultKeyRotationParams{
+		OrganizationID: orgUUID,
+		UserID:         id,
+	})
+	if err != nil {
+		return err
+	}
+	return queries.DeleteOrganizationVaultMembers(ctx, db.DeleteOrganizationVaultMembersParams{
+		OrganizationID: orgUUID,
+		UserID:         id,
+	})
+}
+
+func (r *OrganizationRepositoryPg) CreateInvite(ctx context.Context, invite domain.OrganizationInvite) (*domain.OrganizationInviteToken, error) {
+	orgUUID, err := uuid.Parse(invite.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	var invitedBy sql.NullInt32
+	if invite.InvitedBy != "" {
+		id, err := utils.Int32FromString(invite.InvitedBy)
+		if err != nil {
+			return nil, err
+		}
+		invitedBy = sql.NullInt32{Int32: id, Valid: true}
+	}
+
+	token, tokenHash, err := GenerateTokenForSession()
+	if err != nil {
+		return nil, err
+	}
+
+	invite.ExpiresAt = time.Now().Add(INVITE_EXPIRATION)
+	row, err := queriesFromContext(ctx, r.queries).CreateOrganizationInvite(ctx, db.CreateOrganizationInviteParams{
+		Email:          invite.Email,
+		Role:           string(invite.Role),
+		TokenHash:      tokenHash,
+		InvitedBy:      invitedBy,
+		ExpiresAt:      invite.ExpiresAt,
+		OrganizationID: orgUUID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	invite.ID = row.PublicID.String()
+	invite.CreatedAt = row.CreatedAt
+	return &domain.OrganizationInviteToken{
+		OrganizationInvite: invite,
+		Token:              token,
+	}, nil
+}
+
+func (r *OrganizationRepositoryPg) GetInviteByToken(ctx context.Context, token string) (*domain.OrganizationInvite, error) {
+	row, err := queriesFromContext(ctx, r.queries).GetOrganizationInviteByTokenHash(ctx, utils.HashToken(token))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDomainOrganizationInvite(db.ListOrganizationInvitesRow(row)), nil
+}
+
+func (r *OrganizationRepositoryPg) ListInvites(ctx context.Context, organizationID string) ([]domain.OrganizationInvite, error) {
+	orgUUID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := queriesFromContext(ctx, r.queries).ListOrganizationInvites(ctx, orgUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	invites := make([]domain.OrganizationInvite, 0, len(rows))
+	for _, row := range rows {
+		invites = append(invites, *toDomainOrganizationInvite(row))
+	}
+	return invites, nil
+}
+
+func (r *OrganizationRepositoryPg) DeleteInvite(ctx context.Context, organizationID, inviteID string) (bool, error) {
+	orgUUID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return false, err
+	}
+	inviteUUID, err := uuid.Parse(inviteID)
+	if err != nil {
+		return false, err
+	}
+
+	deleted, err := queriesFromContext(ctx, r.queries).DeleteOrganizationInvite(ctx, db.DeleteOrganizationInviteParams{
+		OrganizationID: orgUUID,
+		InviteID:       inviteUUID,
+	})
+	return deleted > 0, err
+}
+
+func (r *OrganizationRepositoryPg) CreateGroup(ctx context.Context, organizationID, name string) (*domain.OrganizationGroup, error) {
+	orgUUID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := queriesFromContext(ctx, r.queries).CreateOrganizationGroup(ctx, db.CreateOrganizationGroupParams{
+		Name:           name,
+		OrganizationID: orgUUID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.OrganizationGroup{
+		ID:             row.PublicID.String(),
+		OrganizationID: organizationID,
+		Name:           name,
+		MemberIDs:      []string{},
+		CreatedAt:      row.CreatedAt,
+	}, nil
+}
+
+func (r *OrganizationRepositoryPg) GetGroup(ctx context.Context, groupID string) (*domain.OrganizationGroup, error) {
+	groupUUID, err := uuid.Parse(groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := queriesFromContext(ctx, r.queries).GetOrganizationGroup(ctx, groupUUID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDomainOrganizationGroup(db.ListOrganizationGroupsRow(row)), nil
+}
+
+func (r *OrganizationRepositoryPg) ListGroups(ctx context.Context, organizationID string) ([]domain.OrganizationGroup, error) {
+	orgUUID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	queries := queriesFromContext(ctx, r.queries)
+	rows, err := queries.ListOrganizationGroups(ctx, orgUUID)
+	if err != nil {
+		return nil, err
+	}
+	memberRows, err := queries.ListOrganizationGroupMembers(ctx, orgUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	membersByGroup := make(map[uuid.UUID][]string)
+	for _, row := range memberRows {
+		membersByGroup[row.GroupID] = append(membersByGroup[row.GroupID], row.MemberID.String())
+	}
+
+	groups := make([]domain.OrganizationGroup, 0, len(rows))
+	for _, row := range rows {
+		group := toDomainOrganizationGroup(row)
+		if members, ok := membersByGroup[row.PublicID]; ok {
+			group.MemberIDs = members
+		}
+		groups = append(groups, *group)
+	}
+	return groups, nil
+}
+
+func (r *OrganizationRepositoryPg) RenameGroup(ctx context.Context, groupID, name string) (bool, error) {
+	groupUUID, err := uuid.Parse(groupID)
+	if err != nil {
+		return false, err
+	}
+
+	updated, err := queriesFromContext(ctx, r.queries).UpdateOrganizationGroupName(ctx, db.UpdateOrganizationGroupNameParams{
+		Name:     name,
+		PublicID: groupUUID,
+	})
+	return updated > 0, err
+}
+
+func (r *OrganizationRepositoryPg) DeleteGroup(ctx context.Context, groupID string) (bool, error) {
+	groupUUID, err := uuid.Parse(groupID)
+	if err != nil {
+		return false, err
+	}
+
+	deleted, err := queriesFromContext(ctx, r.queries).DeleteOrganizationGroup(ctx, groupUUID)
+	return deleted > 0, err
+}
+
+// SetGroupMembers is meant to run in a transaction, so a member of another
+// organization rolls the whole change back
+func (r *OrganizationRepositoryPg) SetGroupMembers(ctx context.Context, groupID string, memberIDs []string) (bool, error) {
+	groupUUID, err := uuid.Parse(groupID)
+	if err != nil {
+		return false, err
+	}
+
+	queries := queriesFromContext(ctx, r.queries)
+	if err := queries.ClearOrganizationGroupMembers(ctx, groupUUID); err != nil {
+		return false, err
+	}
+	for _, memberID := range memberIDs {
+		memberUUID, err := uuid.Parse(memberID)
+		if err != nil {
+			return false, nil
+		}
+		added, err := queries.AddOrganizationGroupMember(ctx, db.AddOrganizationGroupMemberParams{
+			GroupID:  groupUUID,
+			MemberID: memberUUID,
+		})
+		if err != nil {
+			return false, err
+		}
+		if added == 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (r *OrganizationRepositoryPg) GetPolicies(ctx context.Context, organizationID string) (*domain.OrganizationPolicies, error) {
+	orgUUID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := queriesFromContext(ctx, r.queries).GetOrganizationPolicies(ctx, orgUUID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &domain.OrganizationPolicies{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDomainOrganizationPolicies(db.ListPoliciesByUserIDRow(row)), nil
+}
+
+func (r *OrganizationRepositoryPg) SetPolicies(ctx context.Context, organizationID string, policies domain.OrganizationPolicies) error {
+	orgUUID, err := uuid.Parse(organizationID)
+	if err != nil {
+		return err
+	}
+
+	domains := policies.AllowedEmailDomains
+	if domains == nil {
+		domains = []string{}
+	}
+	return queriesFromContext(ctx, r.queries).UpsertOrganizationPolicies(ctx, db.UpsertOrganizationPoliciesParams{
+		Require2fa:                policies.Require2FA,
+		MinKdfIterations:          int32(policies.MinKDFIterations),
+		AllowedEmailDomains:       domains,
+		SessionMaxLifetimeSeconds: int32(policies.SessionMaxLifetime / time.Second),
+		OrganizationID:            orgUUID,
+	})
+}
+
+func (r *OrganizationRepositoryPg) GetPoliciesByUserID(ctx context.Context, userID string) ([]domain.OrganizationPolicies, error) {
+	id, err := utils.Int32FromString(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := queriesFromContext(ctx, r.queries).ListPoliciesByUserID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	policies := make([]domain.OrganizationPolicies, 0, len(rows))
+	for _, row := range rows {
+		policies = append(policies, *toDomainOrganizationPolicies(row))
+	}
+	return policies, nil
+}
+
+func toDomainOrganization(o db.Organization) *domain.Organization {
+	return &domain.Organization{
+		ID:        o.PublicID.String(),
+		Name:      o.Name,
+		CreatedAt: o.CreatedAt,
+	}
+}
+
+func toDomainOrganizationMember(m db.ListOrganizationMembersRow) *domain.OrganizationMember {
+	return &domain.OrganizationMember{
+		ID:             m.PublicID.String(),
+		OrganizationID: m.OrganizationID.String(),
+		UserID:         strconv.FormatInt(int64(m.UserID), 10),
+		UserPublicID:   m.UserPublicID,
+		Email:          m.Email,
+		Name:           m.Name,
+		Role:           domain.OrganizationRole(m.Role),
+		CreatedAt:      m.CreatedAt,
+	}
+}
+
+func toDomainOrganizationInvite(i db.ListOrganizationInvitesRow) *domain.OrganizationInvite {
+	return &domain.OrganizationInvite{
+		ID:               i.PublicID.String(),
+		OrganizationID:   i.OrganizationID.String(),
+		OrganizationName: i.OrganizationName,
+		Email:            i.Email,
+		Role:             domain.OrganizationRole(i.Role),
+		CreatedAt:        i.CreatedAt,
+		ExpiresAt:        i.ExpiresAt,
+	}
+}
+
+func toDomainOrganizationGroup(g db.ListOrganizationGroupsRow) *domain.OrganizationGroup {
+	return &domain.OrganizationGroup{
+		ID:             g.PublicID.String(),
+		OrganizationID: g.OrganizationID.String(),
+		Name:           g.Name,
+		MemberIDs:      []string{},
+		CreatedAt:      g.CreatedAt,
+	}
+}
+
+func toDomainOrganizationPolicies(p db.ListPoliciesByUserIDRow) *domain.OrganizationPolicies {
+	return &domain.OrganizationPolicies{
+		Require2FA:          p.Require2fa,
+		MinKDFIterations:    int(p.MinKdfIterations),
+		AllowedEmailDomains: p.AllowedEmailDomains,
+		SessionMaxLifetime:  time.Duration(p.SessionMaxLifetimeSeconds) * time.Second,
+	}
+}
@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"main/internal/core/domain"
+	db "main/internal/db/sqlc"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type OutboxRepositoryPg struct {
+	queries *db.Queries
+}
+
+func NewOutboxRepositoryPg(dbConn *sql.DB) *OutboxRepositoryPg {
+	return &OutboxRepositoryPg{
+		queries: db.New(dbConn),
+	}
+}
+
+// EnqueueMessage stores a message to be delivered. Enqueuing the same
+// idempotency key twice is a no-op.
+func (r *OutboxRepositoryPg) EnqueueMessage(ctx context.Context, idempotencyKey string, kind domain.NotificationKind, payload []byte) error {
+	return queriesFromContext(ctx, r.queries).EnqueueOutboxMessage(ctx, db.EnqueueOutboxMessageParams{
+		IdempotencyKey: idempotencyKey,
+		Kind:           string(kind),
+		Payload:        payload,
+	})
+}
+
+// ClaimMessages leases due messages to the caller, other workers skip them
+// until the lease expires.
+func (r *OutboxRepositoryPg) ClaimMessages(ctx context.Context, batchSize int, lease time.Duration) ([]domain.OutboxMessage, error) {
+	dbMessages, err := queriesFromContext(ctx, r.queries).ClaimOutboxMessages(ctx, db.ClaimOutboxMessagesParams{
+		LockedUntil: sql.NullTime{Time: time.Now().Add(lease), Valid: true},
+		BatchSize:   int32(batchSize),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainOutboxMessages(dbMessages), nil
+}
+
+func (r *OutboxRepositoryPg) MarkMessageSent(ctx context.Context, id string) error {
+	iid, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	return queriesFromContext(ctx, r.queries).MarkOutboxMessageSent(ctx, iid)
+}
+
+func (r *OutboxRepositoryPg) MarkMessageFailed(ctx context.Context, id string, status domain.OutboxStatus, lastError string, nextAttemptAt time.Time) error {
+	iid, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	return queriesFromContext(ctx, r.queries).MarkOutboxMessageFailed(ctx, db.MarkOutboxMessageFailedParams{
+		ID:            iid,
+		Status:        string(status),
+		LastError:     sql.NullString{String: lastError, Valid: lastError != ""},
+		NextAttemptAt: nextAttemptAt,
+	})
+}
+
+func (r *OutboxRepositoryPg) GetMessagesByStatus(ctx context.Context, status domain.OutboxStatus, limit int) ([]domain.OutboxMessage, error) {
+	dbMessages, err := queriesFromContext(ctx, r.queries).GetOutboxMessagesByStatus(ctx, db.GetOutboxMessagesByStatusParams{
+		Status: string(status),
+		Limit:  int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainOutboxMessages(dbMessages), nil
+}
+
+func (r *OutboxRepositoryPg) RequeueMessage(ctx context.Context, publicID string) (bool, error) {
+	messageUUID, err := uuid.Parse(publicID)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := queriesFromContext(ctx, r.queries).RequeueOutboxMessage(ctx, messageUUID)
+	if err != nil {
+		return false, err
+	}
+
+	return rows > 0, nil
+}
+
+func toDomainOutboxMessages(dbMessages []db.EmailOutbox) []domain.OutboxMessage {
+	messages := make([]domain.OutboxMessage, 0, len(dbMessages))
+	for _, m := range dbMessages {
+		messages = append(messages, domain.OutboxMessage{
+			ID:             strconv.FormatInt(m.ID, 10),
+			PublicID:       m.PublicID,
+			IdempotencyKey: m.IdempotencyKey,
+			Kind:           domain.NotificationKind(m.Kind),
+			Payload:        m.Payload,
+			Status:         domain.OutboxStatus(m.Status),
+			Attempts:       int(m.Attempts),
+			LastError:      m.LastError.String,
+			NextAttemptAt:  m.NextAttemptAt,
+			CreatedAt:      m.CreatedAt,
+			UpdatedAt:      m.UpdatedAt,
+			SentAt:         m.SentAt.Time,
+		})
+	}
+	return messages
+}
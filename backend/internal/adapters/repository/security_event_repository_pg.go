@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"main/internal/core/domain"
+	db "main/internal/db/sqlc"
+	"main/internal/utils"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SecurityEventRepositoryPg struct {
+	queries *db.Queries
+}
+
+func NewSecurityEventRepositoryPg(dbConn *sql.DB) *SecurityEventRepositoryPg {
+	return &SecurityEventRepositoryPg{
+		queries: db.New(dbConn),
+	}
+}
+
+func (r *SecurityEventRepositoryPg) CreateEvent(ctx context.Context, event domain.SecurityEvent) error {
+	userID, err := nullInt32FromString(event.UserID)
+	if err != nil {
+		return err
+	}
+	actorID, err := nullInt32FromString(event.ActorID)
+	if err != nil {
+		return err
+	}
+
+	metadata := event.Metadata
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+	metadataJSON, err := json.Marshal(metadata)
+	if err != nil {
+		return err
+	}
+
+	return queriesFromContext(ctx, r.queries).CreateSecurityEvent(ctx, db.CreateSecurityEventParams{
+		UserID:    userID,
+		ActorID:   actorID,
+		Type:      string(event.Type),
+		Ip:        event.IP,
+		UserAgent: event.UserAgent,
+		DeviceID:  event.DeviceID,
+		Metadata:  metadataJSON,
+	})
+}
+
+func (r *SecurityEventRepositoryPg) GetEventsByUser(ctx context.Context, userID string, filter domain.SecurityEventFilter) ([]domain.SecurityEvent, error) {
+	id, err := utils.Int32FromString(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var after uuid.NullUUID
+	if filter.After != "" {
+		afterUUID, err := uuid.Parse(filter.After)
+		if err != nil {
+			return nil, err
+		}
+		after = uuid.NullUUID{UUID: afterUUID, Valid: true}
+	}
+
+	var types []string
+	for _, t := range filter.Types {
+		types = append(types, string(t))
+	}
+
+	dbEvents, err := queriesFromContext(ctx, r.queries).GetSecurityEventsByUser(ctx, db.GetSecurityEventsByUserParams{
+		UserID:   sql.NullInt32{Int32: id, Valid: true},
+		Types:    types,
+		Since:    nullTime(filter.Since),
+		Until:    nullTime(filter.Until),
+		After:    after,
+		PageSize: int32(filter.Limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]domain.SecurityEvent, 0, len(dbEvents))
+	for _, e := range dbEvents {
+		event, err := toDomainSecurityEvent(e)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, *event)
+	}
+	return events, nil
+}
+
+func toDomainSecurityEvent(e db.SecurityEvent) (*domain.SecurityEvent, error) {
+	var metadata map[string]string
+	if err := json.Unmarshal(e.Metadata, &metadata); err != nil {
+		return nil, err
+	}
+
+	event := &domain.SecurityEvent{
+		ID:        strconv.FormatInt(e.ID, 10),
+		PublicID:  e.PublicID,
+		Type:      domain.SecurityEventType(e.Type),
+		IP:        e.Ip,
+		UserAgent: e.UserAgent,
+		DeviceID:  e.DeviceID,
+		Metadata:  metadata,
+		CreatedAt: e.CreatedAt,
+	}
+	if e.UserID.Valid {
+		event.UserID = strconv.FormatInt(int64(e.UserID.Int32), 10)
+	}
+	if e.ActorID.Valid {
+		event.ActorID = strconv.FormatInt(int64(e.ActorID.Int32), 10)
+	}
+	return event, nil
+}
+
+// nullInt32FromString maps an empty id to NULL
+func nullInt32FromString(s string) (sql.NullInt32, error) {
+	if s == "" {
+		return sql.NullInt32{}, nil
+	}
+	i, err := utils.Int32FromString(s)
+	if err != nil {
+		return sql.NullInt32{}, err
+	}
+	return sql.NullInt32{Int32: i, Valid: true}, nil
+}
+
+func nullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
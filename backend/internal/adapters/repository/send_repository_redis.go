@@ -0,0 +1,234 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"main/internal/core/domain"
+	"main/internal/utils"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// SendRepositoryRedis keeps a send as a hash expiring with the send. The
+// sends of a user are indexed in a sorted set scored by expiry, the entries
+// of the sends deleted on their last view are dropped when listing.
+type SendRepositoryRedis struct {
+	rdb *redis.Client
+}
+
+func NewSendRepositoryRedis(rdb *redis.Client) *SendRepositoryRedis {
+	return &SendRepositoryRedis{rdb: rdb}
+}
+
+func sendKey(id string) string {
+	return fmt.Sprintf("send:%s", id)
+}
+
+func sendsKey(userID string) string {
+	return fmt.Sprintf("sends:%s", userID)
+}
+
+// sendFields are the fields of a send but its payload
+var sendFields = []string{"user_id", "password_hash", "max_views", "views_left", "expires_at", "created_at"}
+
+// createSendScript drops the expired sends from the index before counting
+var createSendScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', now)
+if redis.call('ZCARD', KEYS[1]) >= tonumber(ARGV[2]) then
+	return 0
+end
+redis.call('HSET', KEYS[2], 'user_id', ARGV[3], 'payload', ARGV[4], 'password_hash', ARGV[5],
+	'max_views', ARGV[6], 'views_left', ARGV[6], 'expires_at', ARGV[7], 'created_at', ARGV[1])
+redis.call('PEXPIRE', KEYS[2], ARGV[8])
+redis.call('ZADD', KEYS[1], ARGV[7], ARGV[9])
+if redis.call('PTTL', KEYS[1]) < tonumber(ARGV[8]) then
+	redis.call('PEXPIRE', KEYS[1], ARGV[8])
+end
+return 1
+`)
+
+// viewSendScript counts the view and returns the fields of the send as of
+// before its deletion on the last view, nil when it doesn't exist
+var viewSendScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return false
+end
+local left = redis.call('HINCRBY', KEYS[1], 'views_left', -1)
+local fields = redis.call('HGETALL', KEYS[1])
+if left <= 0 then
+	redis.call('DEL', KEYS[1])
+end
+return fields
+`)
+
+// deleteSendScript only deletes the sends of the user
+var deleteSendScript = redis.NewScript(`
+redis.call('ZREM', KEYS[1], ARGV[2])
+if redis.call('HGET', KEYS[2], 'user_id') ~= ARGV[1] then
+	return 0
+end
+return redis.call('DEL', KEYS[2])
+`)
+
+func (r *SendRepositoryRedis) CreateSend(ctx context.Context, send domain.Send, maxSends int) (*domain.Send, error) {
+	id, err := utils.GenerateRandomString(16)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	send.ID = id
+	send.ViewsLeft = send.MaxViews
+	send.CreatedAt = time.Unix(now.Unix(), 0)
+	ttl := time.Until(send.ExpiresAt)
+
+	created, err := createSendScript.Run(ctx, r.rdb,
+		[]string{sendsKey(send.UserID), sendKey(id)},
+		now.Unix(), maxSends, send.UserID, send.Payload, send.PasswordHash, send.MaxViews, send.ExpiresAt.Unix(), ttl.Milliseconds(), id,
+	).Int()
+	if err != nil {
+		return nil, err
+	}
+	if created == 0 {
+		return nil, nil
+	}
+	return &send, nil
+}
+
+func (r *SendRepositoryRedis) GetSend(ctx context.Context, id string) (*domain.Send, error) {
+	values, err := r.rdb.HMGet(ctx, sendKey(id), sendFields...).Result()
+	if err != nil {
+		return nil, err
+	}
+	return toDomainSend(id, sendFieldMap(values))
+}
+
+func (r *SendRepositoryRedis) ViewSend(ctx context.Context, id string) (*domain.Send, error) {
+	values, err := viewSendScript.Run(ctx, r.rdb, []string{sendKey(id)}).StringSlice()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]string, len(values)/2)
+	for i := 0; i+1 < len(values); i += 2 {
+		fields[values[i]] = values[i+1]
+	}
+	send, err := toDomainSend(id, fields)
+	if err != nil || send == nil {
+		return nil, err
+	}
+	send.Payload = []byte(fields["payload"])
+
+	// A send can't be viewed after its expiry, even if the key outlived it
+	// for a moment
+	if !send.ExpiresAt.After(time.Now()) {
+		return nil, nil
+	}
+	// Best effort, the listing drops the entries of deleted sends anyway
+	if send.ViewsLeft <= 0 {
+		r.rdb.ZRem(ctx, sendsKey(send.UserID), id)
+	}
+	return send, nil
+}
+
+func (r *SendRepositoryRedis) ListSends(ctx context.Context, userID string) ([]domain.Send, error) {
+	key := sendsKey(userID)
+	if err := r.rdb.ZRemRangeByScore(ctx, key, "-inf", strconv.FormatInt(time.Now().Unix(), 10)).Err(); err != nil {
+		return nil, err
+	}
+	ids, err := r.rdb.ZRange(ctx, key, 0, -1).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return []domain.Send{}, nil
+	}
+
+	pipe := r.rdb.Pipeline()
+	results := make([]*redis.SliceCmd, len(ids))
+	for i, id := range ids {
+		results[i] = pipe.HMGet(ctx, sendKey(id), sendFields...)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	sends := make([]domain.Send, 0, len(ids))
+	var gone []interface{}
+	for i, id := range ids {
+		send, err := toDomainSend(id, sendFieldMap(results[i].Val()))
+		if err != nil {
+			return nil, err
+		}
+		if send == nil {
+			gone = append(gone, id)
+			continue
+		}
+		sends = append(sends, *send)
+	}
+	if len(gone) > 0 {
+		if err := r.rdb.ZRem(ctx, key, gone...).Err(); err != nil {
+			return nil, err
+		}
+	}
+	return sends, nil
+}
+
+func (r *SendRepositoryRedis) DeleteSend(ctx context.Context, userID, id string) (bool, error) {
+	deleted, err := deleteSendScript.Run(ctx, r.rdb, []string{sendsKey(userID), sendKey(id)}, userID, id).Int()
+	if err != nil {
+		return false, fmt.Errorf("failed to delete send: %w", err)
+	}
+	return deleted > 0, nil
+}
+
+// sendFieldMap pairs the values of HMGET with sendFields, leaving out the
+// missing ones
+func sendFieldMap(values []interface{}) map[string]string {
+	fields := make(map[string]string, len(values))
+	for i, value := range values {
+		if s, ok := value.(string); ok {
+			fields[sendFields[i]] = s
+		}
+	}
+	return fields
+}
+
+func toDomainSend(id string, fields map[string]string) (*domain.Send, error) {
+	if fields["user_id"] == "" {
+		return nil, nil
+	}
+
+	maxViews, err := strconv.Atoi(fields["max_views"])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse send: %w", err)
+	}
+	viewsLeft, err := strconv.Atoi(fields["views_left"])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse send: %w", err)
+	}
+	expiresAt, err := strconv.ParseInt(fields["expires_at"], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse send: %w", err)
+	}
+	createdAt, err := strconv.ParseInt(fields["created_at"], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse send: %w", err)
+	}
+
+	return &domain.Send{
+		ID:           id,
+		UserID:       fields["user_id"],
+		PasswordHash: fields["password_hash"],
+		MaxViews:     maxViews,
+		ViewsLeft:    viewsLeft,
+		ExpiresAt:    time.Unix(expiresAt, 0),
+		CreatedAt:    time.Unix(createdAt, 0),
+	}, nil
+}
@@ -120,7 +120,7 @@ func (r *SessionRepositoryInMemory) NewAccessToken(ctx context.Context, userID,
 	}, nil
 }
 
-func (r *SessionRepositoryInMemory) NewRefreshToken(ctx context.Context, userID, deviceID string) (*domain.RefreshSessionLight, error) {
+func (r *SessionRepositoryInMemory) NewRefreshToken(ctx context.Context, userID, deviceID string, authenticatedAt time.Time) (*domain.RefreshSessionLight, error) {
 	r.revokeOldRefreshToken(ctx, userID, deviceID)
 
 	token, tokenHash, err := GenerateTokenForSession()
@@ -129,12 +129,13 @@ func (r *SessionRepositoryInMemory) NewRefreshToken(ctx context.Context, userID,
 	}
 
 	session := domain.RefreshSession{
-		ID:        uuid.NewString(),
-		UserID:    userID,
-		TokenHash: tokenHash,
-		DeviceID:  deviceID,
-		CreatedAt: time.Now(),
-		ExpiresAt: time.Now().Add(REFRESH_TOKEN_EXPIRATION),
+		ID:              uuid.NewString(),
+		UserID:          userID,
+		TokenHash:       tokenHash,
+		DeviceID:        deviceID,
+		CreatedAt:       time.Now(),
+		ExpiresAt:       time.Now().Add(REFRESH_TOKEN_EXPIRATION),
+		AuthenticatedAt: authenticatedAt,
 	}
 
 	key := deviceKey(userID, deviceID)
@@ -161,7 +161,7 @@ func (r *SessionRepositoryRedis) NewAccessToken(ctx context.Context, userID, dev
 	}, nil
 }
 
-func (r *SessionRepositoryRedis) NewRefreshToken(ctx context.Context, userID, deviceID string) (*domain.RefreshSessionLight, error) {
+func (r *SessionRepositoryRedis) NewRefreshToken(ctx context.Context, userID, deviceID string, authenticatedAt time.Time) (*domain.RefreshSessionLight, error) {
 	if err := r.revokeOldRefreshToken(ctx, userID, deviceID); err != nil {
 		return nil, err
 	}
@@ -172,12 +172,13 @@ func (r *SessionRepositoryRedis) NewRefreshToken(ctx context.Context, userID, de
 	}
 
 	session := domain.RefreshSession{
-		ID:        uuid.NewString(),
-		UserID:    userID,
-		TokenHash: tokenHash,
-		DeviceID:  deviceID,
-		CreatedAt: time.Now(),
-		ExpiresAt: time.Now().Add(REFRESH_TOKEN_EXPIRATION),
+		ID:              uuid.NewString(),
+		UserID:          userID,
+		TokenHash:       tokenHash,
+		DeviceID:        deviceID,
+		CreatedAt:       time.Now(),
+		ExpiresAt:       time.Now().Add(REFRESH_TOKEN_EXPIRATION),
+		AuthenticatedAt: authenticatedAt,
 	}
 
 	if err := r.addRefreshSession(ctx, session); err != nil {
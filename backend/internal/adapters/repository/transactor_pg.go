@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	db "main/internal/db/sqlc"
+)
+
+type txContextKey struct{}
+
+// txState is the transaction in the context and what to do once it ends
+type txState struct {
+	tx         *sql.Tx
+	onCommit   []func(ctx context.Context)
+	onRollback []func(ctx context.Context)
+}
+
+type TransactorPg struct {
+	db *sql.DB
+}
+
+func NewTransactorPg(dbConn *sql.DB) *TransactorPg {
+	return &TransactorPg{db: dbConn}
+}
+
+func (t *TransactorPg) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	// Nested calls join the outer transaction
+	if _, ok := ctx.Value(txContextKey{}).(*txState); ok {
+		return fn(ctx)
+	}
+
+	tx, err := t.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	state := &txState{tx: tx}
+	// The hooks run after the request may have been cancelled
+	hookCtx := context.WithoutCancel(ctx)
+
+	if err := fn(context.WithValue(ctx, txContextKey{}, state)); err != nil {
+		tx.Rollback()
+		runHooks(hookCtx, state.onRollback)
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		runHooks(hookCtx, state.onRollback)
+		return err
+	}
+	runHooks(hookCtx, state.onCommit)
+	return nil
+}
+
+func runHooks(ctx context.Context, hooks []func(ctx context.Context)) {
+	for _, hook := range hooks {
+		hook(ctx)
+	}
+}
+
+// queriesFromContext binds the queries to the transaction in ctx, if any
+func queriesFromContext(ctx context.Context, queries *db.Queries) *db.Queries {
+	if state, ok := ctx.Value(txContextKey{}).(*txState); ok {
+		return queries.WithTx(state.tx)
+	}
+	return queries
+}
+
+// afterCommit runs fn once the transaction in ctx is committed, right away
+// without one
+func afterCommit(ctx context.Context, fn func(ctx context.Context)) {
+	if state, ok := ctx.Value(txContextKey{}).(*txState); ok {
+		state.onCommit = append(state.onCommit, fn)
+		return
+	}
+	fn(ctx)
+}
+
+// afterRollback runs fn if the transaction in ctx is rolled back, never
+// without one
+func afterRollback(ctx context.Context, fn func(ctx context.Context)) {
+	if state, ok := ctx.Value(txContextKey{}).(*txState); ok {
+		state.onRollback = append(state.onRollback, fn)
+	}
+}
@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"main/internal/core/domain"
+	db "main/internal/db/sqlc"
+	"main/internal/utils"
+	"strconv"
+
+	"github.com/google/uuid"
+)
+
+type UserKeysRepositoryPg struct {
+	queries *db.Queries
+}
+
+func NewUserKeysRepositoryPg(dbConn *sql.DB) *UserKeysRepositoryPg {
+	return &UserKeysRepositoryPg{
+		queries: db.New(dbConn),
+	}
+}
+
+func (r *UserKeysRepositoryPg) CreateKey(ctx context.Context, key domain.UserKey) (*domain.UserKey, error) {
+	userID, err := utils.Int32FromString(key.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := queriesFromContext(ctx, r.queries).CreateUserKey(ctx, db.CreateUserKeyParams{
+		UserID:              userID,
+		Version:             int32(key.Version),
+		Algorithm:           string(key.Algorithm),
+		PublicKey:           key.PublicKey,
+		EncryptedPrivateKey: key.EncryptedPrivateKey,
+		Fingerprint:         key.Fingerprint,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainUserKey(row), nil
+}
+
+func (r *UserKeysRepositoryPg) GetCurrentKey(ctx context.Context, userID string) (*domain.UserKey, error) {
+	id, err := utils.Int32FromString(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := queriesFromContext(ctx, r.queries).GetCurrentUserKey(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainUserKey(row), nil
+}
+
+func (r *UserKeysRepositoryPg) GetKey(ctx context.Context, userID string, version int) (*domain.UserKey, error) {
+	id, err := utils.Int32FromString(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := queriesFromContext(ctx, r.queries).GetUserKey(ctx, db.GetUserKeyParams{
+		UserID:  id,
+		Version: int32(version),
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainUserKey(row), nil
+}
+
+func (r *UserKeysRepositoryPg) GetKeysByUserID(ctx context.Context, userID string) ([]domain.UserKey, error) {
+	id, err := utils.Int32FromString(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := queriesFromContext(ctx, r.queries).GetUserKeysByUserID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]domain.UserKey, 0, len(rows))
+	for _, row := range rows {
+		keys = append(keys, *toDomainUserKey(row))
+	}
+	return keys, nil
+}
+
+func (r *UserKeysRepositoryPg) GetPublicKeyByEmail(ctx context.Context, email string) (*domain.PublicKey, error) {
+	row, err := queriesFromContext(ctx, r.queries).GetCurrentPublicKeyByEmail(ctx, email)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainPublicKey(row), nil
+}
+
+func (r *UserKeysRepositoryPg) GetPublicKeyByPublicID(ctx context.Context, publicID string) (*domain.PublicKey, error) {
+	userUUID, err := uuid.Parse(publicID)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := queriesFromContext(ctx, r.queries).GetCurrentPublicKeyByPublicID(ctx, userUUID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainPublicKey(db.GetCurrentPublicKeyByEmailRow(row)), nil
+}
+
+func toDomainUserKey(row db.UserKey) *domain.UserKey {
+	return &domain.UserKey{
+		UserID:              strconv.Itoa(int(row.UserID)),
+		Version:             int(row.Version),
+		Algorithm:           domain.UserKeyAlgorithm(row.Algorithm),
+		PublicKey:           row.PublicKey,
+		EncryptedPrivateKey: row.EncryptedPrivateKey,
+		Fingerprint:         row.Fingerprint,
+		CreatedAt:           row.CreatedAt,
+	}
+}
+
+func toDomainPublicKey(row db.GetCurrentPublicKeyByEmailRow) *domain.PublicKey {
+	return &domain.PublicKey{
+		UserID:      row.PublicID,
+		Version:     int(row.Version),
+		Algorithm:   domain.UserKeyAlgorithm(row.Algorithm),
+		PublicKey:   row.PublicKey,
+		Fingerprint: row.Fingerprint,
+		CreatedAt:   row.CreatedAt,
+	}
+}
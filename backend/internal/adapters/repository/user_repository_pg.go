@@ -23,7 +23,7 @@ func NewUserRepositoryPg(dbConn *sql.DB) *UserRepositoryPg {
 }
 
 func (r *UserRepositoryPg) GetUsers(ctx context.Context) ([]domain.User, error) {
-	dbUsers, err := r.queries.GetUsers(ctx)
+	dbUsers, err := queriesFromContext(ctx, r.queries).GetUsers(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -36,7 +36,7 @@ func (r *UserRepositoryPg) GetUsers(ctx context.Context) ([]domain.User, error)
 }
 
 func (r *UserRepositoryPg) GetUserByEmail(ctx context.Context, email string) (*domain.User, error) {
-	dbUser, err := r.queries.GetUserByEmail(ctx, email)
+	dbUser, err := queriesFromContext(ctx, r.queries).GetUserByEmail(ctx, email)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
@@ -55,7 +55,7 @@ func (r *UserRepositoryPg) GetUserByPublicID(ctx context.Context, id string) (*d
 		return nil, err
 	}
 
-	dbUser, err := r.queries.GetUserByPublicID(ctx, userUUID)
+	dbUser, err := queriesFromContext(ctx, r.queries).GetUserByPublicID(ctx, userUUID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
@@ -73,7 +73,7 @@ func (r *UserRepositoryPg) GetUserByID(ctx context.Context, id string) (*domain.
 		return nil, err
 	}
 
-	dbUser, err := r.queries.GetUserByID(ctx, iid)
+	dbUser, err := queriesFromContext(ctx, r.queries).GetUserByID(ctx, iid)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
@@ -85,11 +85,25 @@ func (r *UserRepositoryPg) GetUserByID(ctx context.Context, id string) (*domain.
 	return u, nil
 }
 
-func (r *UserRepositoryPg) CreateUser(ctx context.Context, name, email, passwordHash string) (*domain.User, error) {
-	dbUser, err := r.queries.CreateUser(ctx, db.CreateUserParams{
+func (r *UserRepositoryPg) UpdatePasswordHash(ctx context.Context, id, passwordHash string) error {
+	iid, err := utils.Int32FromString(id)
+	if err != nil {
+		return err
+	}
+
+	_, err = queriesFromContext(ctx, r.queries).UpdateUserPasswordHash(ctx, db.UpdateUserPasswordHashParams{
+		ID:           iid,
+		PasswordHash: passwordHash,
+	})
+	return err
+}
+
+func (r *UserRepositoryPg) CreateUser(ctx context.Context, name, email, passwordHash, locale string) (*domain.User, error) {
+	dbUser, err := queriesFromContext(ctx, r.queries).CreateUser(ctx, db.CreateUserParams{
 		Name:         name,
 		Email:        email,
 		PasswordHash: passwordHash,
+		Locale:       locale,
 	})
 	if err != nil {
 		return nil, err
@@ -105,5 +119,6 @@ func toDomainUser(u db.User) *domain.User {
 		Email:        u.Email,
 		CreatedAt:    u.CreatedAt,
 		PasswordHash: u.PasswordHash,
+		Locale:       u.Locale,
 	}
 }
@@ -0,0 +1,191 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"main/internal/core/ports"
+	db "main/internal/db/sqlc"
+
+	"github.com/google/uuid"
+)
+
+// VaultBlobMigratorPg moves the contents still stored in the vaults and
+// vault_versions rows to the blob store. With encryption enabled it also
+// encrypts the contents stored before it was, wherever they are.
+type VaultBlobMigratorPg struct {
+	queries *db.Queries
+	blobs   *vaultBlobs
+	keys    *VaultKeyStorePg
+}
+
+func NewVaultBlobMigratorPg(dbConn *sql.DB, blobStore ports.BlobStore, keys *VaultKeyStorePg) *VaultBlobMigratorPg {
+	queries := db.New(dbConn)
+	return &VaultBlobMigratorPg{
+		queries: queries,
+		blobs:   &vaultBlobs{store: blobStore, queries: queries},
+		keys:    keys,
+	}
+}
+
+// storedContent is a row to migrate, and where the content of the other
+// table at the same revision is when it can be shared
+type storedContent struct {
+	userID    int32
+	vaultID   uuid.UUID
+	revision  int64
+	sha256    string
+	blobKey   sql.NullString
+	inline    []byte
+	encrypted bool
+	sibling   func() (db.GetVaultBlobAtRevisionRow, error)
+}
+
+// Migrate migrates up to limit versions and limit vaults and returns how
+// many rows it looked at, zero once there is nothing left. Versions go first
+// so the vault of the same revision can share their blob. A row written
+// meanwhile is skipped, the writer already stored it the new way.
+func (m *VaultBlobMigratorPg) Migrate(ctx context.Context, limit int) (int, error) {
+	encrypt := m.keys.Enabled()
+
+	versions, err := m.queries.GetVaultVersionsToMigrate(ctx, db.GetVaultVersionsToMigrateParams{
+		Encrypt:   encrypt,
+		BatchSize: int32(limit),
+	})
+	if err != nil {
+		return 0, err
+	}
+	for _, v := range versions {
+		row, err := m.queries.GetVaultVersion(ctx, db.GetVaultVersionParams{PublicID: v.VaultID, Revision: v.Revision})
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		content := storedContent{
+			userID:    row.UserID,
+			vaultID:   v.VaultID,
+			revision:  row.Revision,
+			sha256:    row.Sha256,
+			blobKey:   row.BlobKey,
+			inline:    row.Vault,
+			encrypted: row.Encrypted,
+			sibling: func() (db.GetVaultBlobAtRevisionRow, error) {
+				return m.queries.GetVaultBlobAtRevision(ctx, db.GetVaultBlobAtRevisionParams{PublicID: v.VaultID, Revision: row.Revision})
+			},
+		}
+		err = m.migrate(ctx, content, encrypt, func(key sql.NullString, encrypted bool) (int64, error) {
+			return m.queries.MigrateVaultVersion(ctx, db.MigrateVaultVersionParams{
+				BlobKey:      key,
+				Encrypted:    encrypted,
+				VaultID:      v.VaultID,
+				Revision:     row.Revision,
+				OldBlobKey:   row.BlobKey,
+				OldEncrypted: row.Encrypted,
+			})
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to migrate version %d of vault %s: %w", row.Revision, v.VaultID, err)
+		}
+	}
+
+	vaults, err := m.queries.GetVaultsToMigrate(ctx, db.GetVaultsToMigrateParams{
+		Encrypt:   encrypt,
+		BatchSize: int32(limit),
+	})
+	if err != nil {
+		return 0, err
+	}
+	for _, vaultID := range vaults {
+		row, err := m.queries.GetVaultContent(ctx, vaultID)
+		if errors.Is(err, sql.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		content := storedContent{
+			userID:    row.UserID,
+			vaultID:   row.PublicID,
+			revision:  row.Revision,
+			sha256:    row.Sha256,
+			blobKey:   row.BlobKey,
+			inline:    row.Vault,
+			encrypted: row.Encrypted,
+			sibling: func() (db.GetVaultBlobAtRevisionRow, error) {
+				version, err := m.queries.GetVaultVersionBlobAtRevision(ctx, db.GetVaultVersionBlobAtRevisionParams{PublicID: row.PublicID, Revision: row.Revision})
+				return db.GetVaultBlobAtRevisionRow(version), err
+			},
+		}
+		err = m.migrate(ctx, content, encrypt, func(key sql.NullString, encrypted bool) (int64, error) {
+			return m.queries.MigrateVault(ctx, db.MigrateVaultParams{
+				BlobKey:      key,
+				Encrypted:    encrypted,
+				VaultID:      row.PublicID,
+				Revision:     row.Revision,
+				OldBlobKey:   row.BlobKey,
+				OldEncrypted: row.Encrypted,
+			})
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to migrate vault %s: %w", row.PublicID, err)
+		}
+	}
+
+	return len(versions) + len(vaults), nil
+}
+
+// migrate stores the content in the blob store, encrypted if encrypt is
+// set, and swaps the row to it with update
+func (m *VaultBlobMigratorPg) migrate(
+	ctx context.Context,
+	content storedContent,
+	encrypt bool,
+	update func(key sql.NullString, encrypted bool) (int64, error),
+) error {
+	encrypted := content.encrypted || encrypt
+
+	sibling, err := content.sibling()
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+	key, put := sibling.BlobKey, false
+
+	shared := err == nil && key.Valid && sibling.Sha256 == content.sha256 && sibling.Encrypted == encrypted
+	if !shared {
+		stored, err := m.blobs.read(ctx, content.blobKey, content.inline)
+		if err != nil {
+			return err
+		}
+		var reader io.Reader = bytes.NewReader(stored)
+		size := int64(len(stored))
+		if !content.encrypted && encrypt {
+			if reader, size, _, err = m.keys.sealer(ctx, content.userID, reader, size); err != nil {
+				return err
+			}
+		}
+
+		blobKey, err := m.blobs.put(ctx, content.userID, content.vaultID.String(), content.revision, reader, size)
+		if err != nil {
+			return err
+		}
+		key, put = sql.NullString{String: blobKey, Valid: true}, true
+	}
+
+	updated, err := update(key, encrypted)
+	if err != nil || updated == 0 {
+		if put {
+			m.blobs.discard(ctx, key.String)
+		}
+		return err
+	}
+
+	m.blobs.release(ctx, content.blobKey)
+	return nil
+}
@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"fmt"
+	"io"
+	"log"
+	"main/internal/core/ports"
+	db "main/internal/db/sqlc"
+	"strings"
+)
+
+// vaultBlobs keeps the contents of the vault repositories in the blob store,
+// the rows only hold the key. A blob is shared by the current vault and the
+// version of the same revision, so it is only deleted once neither refers
+// to it anymore.
+type vaultBlobs struct {
+	store   ports.BlobStore
+	queries *db.Queries
+}
+
+// put streams size bytes of content, -1 when unknown, under a new key. The
+// blob is deleted again if the transaction in ctx is rolled back.
+func (b *vaultBlobs) put(ctx context.Context, userID int32, vaultID string, revision int64, content io.Reader, size int64) (string, error) {
+	key := fmt.Sprintf("%d/%s/%d-%s", userID, vaultID, revision, strings.ToLower(rand.Text()))
+	if err := b.store.Put(ctx, key, content, size); err != nil {
+		return "", err
+	}
+
+	afterRollback(ctx, func(ctx context.Context) {
+		b.discard(ctx, key)
+	})
+	return key, nil
+}
+
+// discard deletes a blob no row refers to
+func (b *vaultBlobs) discard(ctx context.Context, key string) {
+	if err := b.store.Delete(ctx, key); err != nil {
+		log.Printf("failed to delete vault blob %s: %v", key, err)
+	}
+}
+
+// release deletes the blob once the transaction in ctx is committed, unless
+// another row still refers to it
+func (b *vaultBlobs) release(ctx context.Context, key sql.NullString) {
+	if !key.Valid {
+		return
+	}
+
+	afterCommit(ctx, func(ctx context.Context) {
+		referenced, err := b.queries.IsVaultBlobReferenced(ctx, key.String)
+		if err != nil {
+			log.Printf("failed to check the references to vault blob %s: %v", key.String, err)
+			return
+		}
+		if !referenced {
+			b.discard(ctx, key.String)
+		}
+	})
+}
+
+// read returns the stored content, from the blob store or from the row when
+// it wasn't moved yet
+func (b *vaultBlobs) read(ctx context.Context, key sql.NullString, inline []byte) ([]byte, error) {
+	if !key.Valid {
+		return inline, nil
+	}
+
+	blob, err := b.store.Get(ctx, key.String)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read vault blob %s: %w", key.String, err)
+	}
+	defer blob.Close()
+	return io.ReadAll(blob)
+}
+
+// open streams the blob, which is only requested from the store on the
+// first read
+func (b *vaultBlobs) open(ctx context.Context, key string) *lazyBlob {
+	return &lazyBlob{ctx: ctx, store: b.store, key: key}
+}
+
+type lazyBlob struct {
+	ctx    context.Context
+	store  ports.BlobStore
+	key    string
+	blob   io.ReadCloser
+	offset int64
+}
+
+func (l *lazyBlob) Read(p []byte) (int, error) {
+	if l.blob == nil {
+		blob, err := l.store.Get(l.ctx, l.key)
+		if err != nil {
+			return 0, fmt.Errorf("failed to read vault blob %s: %w", l.key, err)
+		}
+		l.blob = blob
+	}
+
+	n, err := l.blob.Read(p)
+	l.offset += int64(n)
+	return n, err
+}
+
+func (l *lazyBlob) Close() error {
+	if l.blob == nil {
+		return nil
+	}
+	return l.blob.Close()
+}
+
+// fetch reads the blob in order for envelope.NewReader, which never seeks
+func (l *lazyBlob) fetch(offset, length int64) ([]byte, error) {
+	if offset != l.offset {
+		return nil, fmt.Errorf("vault blob %s read at %d, expected %d", l.key, offset, l.offset)
+	}
+
+	chunk := make([]byte, length)
+	n, err := io.ReadFull(l, chunk)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		err = nil
+	}
+	return chunk[:n], err
+}
+
+// countingReader counts the bytes read through it
+type countingReader struct {
+	reader io.Reader
+	n      int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.reader.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// readCloser closes closer once reader is done with it
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
@@ -0,0 +1,129 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"main/internal/core/domain"
+	db "main/internal/db/sqlc"
+	"main/internal/utils"
+
+	"github.com/google/uuid"
+)
+
+type VaultItemRepositoryPg struct {
+	queries *db.Queries
+}
+
+func NewVaultItemRepositoryPg(dbConn *sql.DB) *VaultItemRepositoryPg {
+	return &VaultItemRepositoryPg{
+		queries: db.New(dbConn),
+	}
+}
+
+func (r *VaultItemRepositoryPg) ApplyChange(ctx context.Context, userID string, change domain.VaultItemChange) (*domain.VaultItem, error) {
+	id, err := utils.Int32FromString(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	queries := queriesFromContext(ctx, r.queries)
+
+	seq, err := queries.NextVaultItemSeq(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Tombstones drop the content
+	data := change.Data
+	if change.Deleted || data == nil {
+		data = []byte{}
+	}
+
+	var item db.VaultItem
+	if change.BaseRevision == 0 {
+		item, err = queries.CreateVaultItem(ctx, db.CreateVaultItemParams{
+			UserID:    id,
+			ItemID:    change.ID,
+			Data:      data,
+			Deleted:   change.Deleted,
+			ChangeSeq: seq,
+		})
+	} else {
+		item, err = queries.UpdateVaultItemIfRevision(ctx, db.UpdateVaultItemIfRevisionParams{
+			UserID:           id,
+			ItemID:           change.ID,
+			Data:             data,
+			Deleted:          change.Deleted,
+			ChangeSeq:        seq,
+			ExpectedRevision: change.BaseRevision,
+		})
+	}
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return toDomainVaultItem(item), nil
+}
+
+func (r *VaultItemRepositoryPg) GetItem(ctx context.Context, userID, itemID string) (*domain.VaultItem, error) {
+	id, err := utils.Int32FromString(userID)
+	if err != nil {
+		return nil, err
+	}
+	itemUUID, err := uuid.Parse(itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := queriesFromContext(ctx, r.queries).GetVaultItem(ctx, db.GetVaultItemParams{
+		UserID: id,
+		ItemID: itemUUID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return toDomainVaultItem(item), nil
+}
+
+func (r *VaultItemRepositoryPg) GetItemsChangedSince(ctx context.Context, userID string, cursor int64, limit int) ([]domain.VaultItem, error) {
+	id, err := utils.Int32FromString(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := queriesFromContext(ctx, r.queries).GetVaultItemsChangedSince(ctx, db.GetVaultItemsChangedSinceParams{
+		UserID:   id,
+		Cursor:   cursor,
+		PageSize: int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]domain.VaultItem, 0, len(rows))
+	for _, item := range rows {
+		items = append(items, *toDomainVaultItem(item))
+	}
+	return items, nil
+}
+
+func toDomainVaultItem(i db.VaultItem) *domain.VaultItem {
+	return &domain.VaultItem{
+		ID:        i.ItemID,
+		UserID:    i.UserID,
+		Revision:  i.Revision,
+		Data:      i.Data,
+		Deleted:   i.Deleted,
+		Seq:       i.ChangeSeq,
+		UpdatedAt: i.UpdatedAt,
+	}
+}
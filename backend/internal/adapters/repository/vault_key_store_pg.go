@@ -0,0 +1,214 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"main/internal/core/ports"
+	db "main/internal/db/sqlc"
+	"main/internal/envelope"
+)
+
+// VaultKeyStorePg encrypts the vaults stored by VaultRepositoryPg and
+// VaultVersionRepositoryPg with the data key of their user. Without a keyring
+// vaults are stored as they are, rows written before encryption was enabled
+// stay readable either way until VaultBlobMigratorPg encrypts them.
+type VaultKeyStorePg struct {
+	queries *db.Queries
+	keyring *envelope.Keyring
+}
+
+func NewVaultKeyStorePg(dbConn *sql.DB, keyring *envelope.Keyring) *VaultKeyStorePg {
+	return &VaultKeyStorePg{
+		queries: db.New(dbConn),
+		keyring: keyring,
+	}
+}
+
+func (s *VaultKeyStorePg) Enabled() bool {
+	return s != nil && s.keyring != nil
+}
+
+// sealer encrypts the vault while it is read if encryption is enabled,
+// creating the data key of the user on their first write. It returns the
+// size of what is to be stored for a vault of size bytes, -1 when size is.
+func (s *VaultKeyStorePg) sealer(ctx context.Context, userID int32, vault io.Reader, size int64) (io.Reader, int64, bool, error) {
+	if !s.Enabled() {
+		return vault, size, false, nil
+	}
+
+	key, err := s.dataKey(ctx, userID, true)
+	if err != nil {
+		return nil, 0, false, err
+	}
+	sealed, err := envelope.NewSealer(key, vaultAAD(userID), vault)
+	if err != nil {
+		return nil, 0, false, err
+	}
+	if size >= 0 {
+		size = envelope.SealedSize(size)
+	}
+	return sealed, size, true, nil
+}
+
+func (s *VaultKeyStorePg) open(ctx context.Context, userID int32, data []byte, encrypted bool) ([]byte, error) {
+	if !encrypted {
+		return data, nil
+	}
+	if !s.Enabled() {
+		return nil, errors.New("vault is encrypted but no key-encryption keys are configured")
+	}
+
+	key, err := s.dataKey(ctx, userID, false)
+	if err != nil {
+		return nil, err
+	}
+	vault, err := envelope.Open(key, data, vaultAAD(userID))
+	return vault, corrupted(err)
+}
+
+// reader returns the plaintext of a stored vault of the given plaintext size,
+// fetching it in chunks
+func (s *VaultKeyStorePg) reader(ctx context.Context, userID int32, size int64, encrypted bool, fetch envelope.FetchFunc) (io.Reader, error) {
+	if !encrypted {
+		return &chunkReader{fetch: fetch, size: size}, nil
+	}
+	if !s.Enabled() {
+		return nil, errors.New("vault is encrypted but no key-encryption keys are configured")
+	}
+
+	key, err := s.dataKey(ctx, userID, false)
+	if err != nil {
+		return nil, err
+	}
+	reader, err := envelope.NewReader(key, vaultAAD(userID), size, fetch)
+	if err != nil {
+		return nil, corrupted(err)
+	}
+	return &corruptionReader{reader}, nil
+}
+
+func (s *VaultKeyStorePg) dataKey(ctx context.Context, userID int32, create bool) ([]byte, error) {
+	queries := queriesFromContext(ctx, s.queries)
+
+	row, err := queries.GetVaultKey(ctx, userID)
+	if errors.Is(err, sql.ErrNoRows) && create {
+		_, wrapped, err := s.keyring.NewDataKey(vaultAAD(userID))
+		if err != nil {
+			return nil, err
+		}
+		err = queries.CreateVaultKey(ctx, db.CreateVaultKeyParams{
+			UserID:     userID,
+			KekID:      s.keyring.ActiveID(),
+			WrappedKey: wrapped,
+		})
+		if err != nil {
+			return nil, err
+		}
+		// Read it back, a concurrent writer may have created another one first
+		row, err = queries.GetVaultKey(ctx, userID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the vault key of user %d: %w", userID, err)
+	}
+
+	return s.keyring.Unwrap(row.KekID, row.WrappedKey, vaultAAD(userID))
+}
+
+// RewrapKeys wraps up to limit data keys not wrapped with the active KEK yet
+// with it and returns how many were rewrapped. The vaults are untouched, so
+// keys can be rotated while the server is running.
+func (s *VaultKeyStorePg) RewrapKeys(ctx context.Context, limit int) (int, error) {
+	if !s.Enabled() {
+		return 0, errors.New("no key-encryption keys are configured")
+	}
+
+	active := s.keyring.ActiveID()
+	rows, err := s.queries.GetVaultKeysNotWrappedWith(ctx, db.GetVaultKeysNotWrappedWithParams{
+		KekID: active,
+		Limit: int32(limit),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	rewrapped := 0
+	for _, row := range rows {
+		aad := vaultAAD(row.UserID)
+		key, err := s.keyring.Unwrap(row.KekID, row.WrappedKey, aad)
+		if err != nil {
+			return rewrapped, fmt.Errorf("failed to unwrap the vault key of user %d: %w", row.UserID, err)
+		}
+		wrapped, err := s.keyring.Wrap(active, key, aad)
+		if err != nil {
+			return rewrapped, err
+		}
+
+		n, err := s.queries.RewrapVaultKey(ctx, db.RewrapVaultKeyParams{
+			NewKekID:   active,
+			WrappedKey: wrapped,
+			UserID:     row.UserID,
+			OldKekID:   row.KekID,
+		})
+		if err != nil {
+			return rewrapped, err
+		}
+		rewrapped += int(n)
+	}
+	return rewrapped, nil
+}
+
+// vaultAAD binds a sealed vault and the data key to their user, so they can't
+// be swapped between users in the database
+func vaultAAD(userID int32) []byte {
+	return fmt.Appendf(nil, "vault:%d", userID)
+}
+
+// chunkReader reads a vault stored unencrypted in chunks of vaultChunkSize
+type chunkReader struct {
+	fetch  envelope.FetchFunc
+	size   int64
+	offset int64
+	chunk  []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	if len(r.chunk) == 0 {
+		if r.offset >= r.size {
+			return 0, io.EOF
+		}
+
+		chunk, err := r.fetch(r.offset, vaultChunkSize)
+		if err != nil {
+			return 0, err
+		}
+		if len(chunk) == 0 {
+			return 0, fmt.Errorf("%w: %w", ports.ErrVaultCorrupted, io.ErrUnexpectedEOF)
+		}
+		r.chunk = chunk
+		r.offset += int64(len(chunk))
+	}
+
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+	return n, nil
+}
+
+// corrupted tells a vault that fails authentication apart from storage errors
+func corrupted(err error) error {
+	if errors.Is(err, envelope.ErrCorrupted) {
+		return fmt.Errorf("%w: %w", ports.ErrVaultCorrupted, err)
+	}
+	return err
+}
+
+type corruptionReader struct {
+	reader io.Reader
+}
+
+func (r *corruptionReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	return n, corrupted(err)
+}
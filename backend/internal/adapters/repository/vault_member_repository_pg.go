@@ -0,0 +1,212 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"main/internal/core/domain"
+	db "main/internal/db/sqlc"
+	"main/internal/utils"
+	"strconv"
+
+	"github.com/google/uuid"
+)
+
+type VaultMemberRepositoryPg struct {
+	queries *db.Queries
+}
+
+func NewVaultMemberRepositoryPg(dbConn *sql.DB) *VaultMemberRepositoryPg {
+	return &VaultMemberRepositoryPg{
+		queries: db.New(dbConn),
+	}
+}
+
+func (r *VaultMemberRepositoryPg) GetMember(ctx context.Context, vaultID, userID string) (*domain.VaultMember, error) {
+	vaultUUID, err := uuid.Parse(vaultID)
+	if err != nil {
+		return nil, err
+	}
+	id, err := utils.Int32FromString(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := queriesFromContext(ctx, r.queries).GetVaultMember(ctx, db.GetVaultMemberParams{
+		PublicID: vaultUUID,
+		UserID:   id,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainVaultMember(db.ListVaultMembersRow(row)), nil
+}
+
+func (r *VaultMemberRepositoryPg) GetMemberByID(ctx context.Context, memberID string) (*domain.VaultMember, error) {
+	memberUUID, err := uuid.Parse(memberID)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := queriesFromContext(ctx, r.queries).GetVaultMemberByPublicID(ctx, memberUUID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainVaultMember(db.ListVaultMembersRow(row)), nil
+}
+
+func (r *VaultMemberRepositoryPg) ListMembers(ctx context.Context, vaultID string) ([]domain.VaultMember, error) {
+	vaultUUID, err := uuid.Parse(vaultID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := queriesFromContext(ctx, r.queries).ListVaultMembers(ctx, vaultUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]domain.VaultMember, 0, len(rows))
+	for _, row := range rows {
+		members = append(members, *toDomainVaultMember(row))
+	}
+	return members, nil
+}
+
+func (r *VaultMemberRepositoryPg) CreateMember(ctx context.Context, member domain.VaultMember) (*domain.VaultMember, error) {
+	vaultUUID, err := uuid.Parse(member.VaultID)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := utils.Int32FromString(member.UserID)
+	if err != nil {
+		return nil, err
+	}
+	var invitedBy sql.NullInt32
+	if member.InvitedBy != "" {
+		id, err := utils.Int32FromString(member.InvitedBy)
+		if err != nil {
+			return nil, err
+		}
+		invitedBy = sql.NullInt32{Int32: id, Valid: true}
+	}
+
+	queries := queriesFromContext(ctx, r.queries)
+	memberUUID, err := queries.CreateVaultMember(ctx, db.CreateVaultMemberParams{
+		UserID:         userID,
+		Role:           string(member.Role),
+		WrappedKey:     member.WrappedKey,
+		KeyVersion:     int32(member.KeyVersion),
+		UserKeyVersion: int32(member.UserKeyVersion),
+		InvitedBy:      invitedBy,
+		VaultID:        vaultUUID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := queries.GetVaultMemberByPublicID(ctx, memberUUID)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainVaultMember(db.ListVaultMembersRow(row)), nil
+}
+
+func (r *VaultMemberRepositoryPg) AcceptMember(ctx context.Context, memberID string) (bool, error) {
+	memberUUID, err := uuid.Parse(memberID)
+	if err != nil {
+		return false, err
+	}
+
+	updated, err := queriesFromContext(ctx, r.queries).AcceptVaultMember(ctx, memberUUID)
+	return updated > 0, err
+}
+
+func (r *VaultMemberRepositoryPg) UpdateMemberRole(ctx context.Context, memberID string, role domain.VaultRole) (bool, error) {
+	memberUUID, err := uuid.Parse(memberID)
+	if err != nil {
+		return false, err
+	}
+
+	updated, err := queriesFromContext(ctx, r.queries).UpdateVaultMemberRole(ctx, db.UpdateVaultMemberRoleParams{
+		PublicID: memberUUID,
+		Role:     string(role),
+	})
+	return updated > 0, err
+}
+
+func (r *VaultMemberRepositoryPg) DeleteMember(ctx context.Context, memberID string) (bool, error) {
+	memberUUID, err := uuid.Parse(memberID)
+	if err != nil {
+		return false, err
+	}
+
+	deleted, err := queriesFromContext(ctx, r.queries).DeleteVaultMember(ctx, memberUUID)
+	return deleted > 0, err
+}
+
+func (r *VaultMemberRepositoryPg) SetMemberKey(ctx context.Context, memberID string, key domain.VaultMemberKey, keyVersion int) (bool, error) {
+	memberUUID, err := uuid.Parse(memberID)
+	if err != nil {
+		return false, err
+	}
+
+	updated, err := queriesFromContext(ctx, r.queries).SetVaultMemberKey(ctx, db.SetVaultMemberKeyParams{
+		PublicID:       memberUUID,
+		WrappedKey:     key.WrappedKey,
+		KeyVersion:     int32(keyVersion),
+		UserKeyVersion: int32(key.UserKeyVersion),
+	})
+	return updated > 0, err
+}
+
+func (r *VaultMemberRepositoryPg) RotateVaultKey(ctx context.Context, vaultID string, keyVersion int) (bool, error) {
+	vaultUUID, err := uuid.Parse(vaultID)
+	if err != nil {
+		return false, err
+	}
+
+	updated, err := queriesFromContext(ctx, r.queries).RotateVaultKey(ctx, db.RotateVaultKeyParams{
+		KeyVersion: int32(keyVersion),
+		VaultID:    vaultUUID,
+	})
+	return updated > 0, err
+}
+
+func (r *VaultMemberRepositoryPg) RequireKeyRotation(ctx context.Context, vaultID string) error {
+	vaultUUID, err := uuid.Parse(vaultID)
+	if err != nil {
+		return err
+	}
+
+	return queriesFromContext(ctx, r.queries).RequireVaultKeyRotation(ctx, vaultUUID)
+}
+
+func toDomainVaultMember(m db.ListVaultMembersRow) *domain.VaultMember {
+	return &domain.VaultMember{
+		ID:             m.PublicID.String(),
+		VaultID:        m.VaultID.String(),
+		UserID:         strconv.FormatInt(int64(m.UserID), 10),
+		UserPublicID:   m.UserPublicID,
+		Email:          m.Email,
+		Name:           m.Name,
+		Role:           domain.VaultRole(m.Role),
+		Status:         domain.VaultMemberStatus(m.Status),
+		WrappedKey:     m.WrappedKey,
+		KeyVersion:     int(m.KeyVersion),
+		UserKeyVersion: int(m.UserKeyVersion),
+		CreatedAt:      m.CreatedAt,
+		AcceptedAt:     m.AcceptedAt.Time,
+	}
+}
@@ -2,49 +2,203 @@ package repository
 
 import (
 	"context"
+	"crypto/sha256"
 	"database/sql"
+	"encoding/hex"
 	"errors"
+	"fmt"
+	"io"
 	"main/internal/core/domain"
+	"main/internal/core/ports"
 	db "main/internal/db/sqlc"
 	"main/internal/utils"
-	"time"
+	"strconv"
+
+	"github.com/google/uuid"
 )
 
 type VaultRepositoryPg struct {
 	queries *db.Queries
+	blobs   *vaultBlobs
+	keys    *VaultKeyStorePg
 }
 
-func NewVaultRepositoryPg(dbConn *sql.DB) *VaultRepositoryPg {
+func NewVaultRepositoryPg(dbConn *sql.DB, blobStore ports.BlobStore, keys *VaultKeyStorePg) *VaultRepositoryPg {
+	queries := db.New(dbConn)
 	return &VaultRepositoryPg{
-		queries: db.New(dbConn),
+		queries: queries,
+		blobs:   &vaultBlobs{store: blobStore, queries: queries},
+		keys:    keys,
 	}
 }
 
-func (r *VaultRepositoryPg) GetVaultByUserID(ctx context.Context, userID string) (*domain.Vault, error) {
+func (r *VaultRepositoryPg) CreateVault(ctx context.Context, userID, organizationID, name string, vaultType domain.VaultType, isDefault bool) (*domain.Vault, error) {
 	id, err := utils.Int32FromString(userID)
 	if err != nil {
 		return nil, err
 	}
+	var orgUUID uuid.NullUUID
+	if organizationID != "" {
+		parsed, err := uuid.Parse(organizationID)
+		if err != nil {
+			return nil, err
+		}
+		orgUUID = uuid.NullUUID{UUID: parsed, Valid: true}
+	}
 
-	dbVault, err := r.queries.GetVaultByUserID(ctx, id)
+	row, err := queriesFromContext(ctx, r.queries).CreateVault(ctx, db.CreateVaultParams{
+		UserID:         id,
+		Name:           name,
+		Type:           string(vaultType),
+		IsDefault:      isDefault,
+		OrganizationID: orgUUID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return nil, nil
-		}
 		return nil, err
 	}
 
-	v := toDomainVault(dbVault)
-	return v, nil
+	return toDomainVaultInfo(db.GetVaultRow(row)), nil
+}
+
+func (r *VaultRepositoryPg) GetVault(ctx context.Context, vaultID string) (*domain.Vault, error) {
+	vaultUUID, err := uuid.Parse(vaultID)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := queriesFromContext(ctx, r.queries).GetVault(ctx, vaultUUID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainVaultInfo(row), nil
+}
+
+func (r *VaultRepositoryPg) GetDefaultVault(ctx context.Context, userID string) (*domain.Vault, error) {
+	id, err := utils.Int32FromString(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := queriesFromContext(ctx, r.queries).GetDefaultVault(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainVaultInfo(db.GetVaultRow(row)), nil
 }
 
-func (r *VaultRepositoryPg) GetVaultUpdatedAtByUserID(ctx context.Context, userID string) (*time.Time, error) {
+func (r *VaultRepositoryPg) ListVaults(ctx context.Context, userID string) ([]domain.Vault, error) {
 	id, err := utils.Int32FromString(userID)
 	if err != nil {
 		return nil, err
 	}
 
-	dbVault, err := r.queries.GetVaultUpdatedAtByUserID(ctx, id)
+	rows, err := queriesFromContext(ctx, r.queries).ListVaultsByMember(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	vaults := make([]domain.Vault, 0, len(rows))
+	for _, row := range rows {
+		vault := toDomainVaultInfo(db.GetVaultRow{
+			PublicID:         row.PublicID,
+			UserID:           row.UserID,
+			Name:             row.Name,
+			Type:             row.Type,
+			IsDefault:        row.IsDefault,
+			Revision:         row.Revision,
+			Size:             row.Size,
+			Sha256:           row.Sha256,
+			KeyVersion:       row.KeyVersion,
+			RotationRequired: row.RotationRequired,
+			OrganizationID:   row.OrganizationID,
+			CreatedAt:        row.CreatedAt,
+			UpdatedAt:        row.UpdatedAt,
+		})
+		vault.Membership = &domain.VaultMember{
+			ID:      row.MemberID.String(),
+			VaultID: vault.ID,
+			UserID:  userID,
+			Role:    domain.VaultRole(row.Role),
+			Status:  domain.VaultMemberStatus(row.Status),
+		}
+		vaults = append(vaults, *vault)
+	}
+	return vaults, nil
+}
+
+func (r *VaultRepositoryPg) UpdateVault(ctx context.Context, vaultID, name string, vaultType domain.VaultType) (*domain.Vault, error) {
+	vaultUUID, err := uuid.Parse(vaultID)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := queriesFromContext(ctx, r.queries).UpdateVault(ctx, db.UpdateVaultParams{
+		PublicID: vaultUUID,
+		Name:     name,
+		Type:     string(vaultType),
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainVaultInfo(db.GetVaultRow(row)), nil
+}
+
+// DeleteVault locks the vault first, so no write adds a blob between
+// listing the blobs and deleting the rows
+func (r *VaultRepositoryPg) DeleteVault(ctx context.Context, vaultID string) (bool, error) {
+	vaultUUID, err := uuid.Parse(vaultID)
+	if err != nil {
+		return false, err
+	}
+
+	queries := queriesFromContext(ctx, r.queries)
+
+	current, err := queries.GetVaultBlobKeyForUpdate(ctx, vaultUUID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	keys, err := queries.GetVaultVersionBlobKeys(ctx, vaultUUID)
+	if err != nil {
+		return false, err
+	}
+
+	deleted, err := queries.DeleteVault(ctx, vaultUUID)
+	if err != nil || deleted == 0 {
+		return false, err
+	}
+
+	r.blobs.release(ctx, current.BlobKey)
+	for _, key := range keys {
+		r.blobs.release(ctx, key)
+	}
+	return true, nil
+}
+
+func (r *VaultRepositoryPg) GetVaultContent(ctx context.Context, vaultID string) (*domain.Vault, error) {
+	vaultUUID, err := uuid.Parse(vaultID)
+	if err != nil {
+		return nil, err
+	}
+
+	dbVault, err := queriesFromContext(ctx, r.queries).GetVaultContent(ctx, vaultUUID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
@@ -52,38 +206,194 @@ func (r *VaultRepositoryPg) GetVaultUpdatedAtByUserID(ctx context.Context, userI
 		return nil, err
 	}
 
-	return &dbVault.Time, nil
+	return r.toDomainVault(ctx, dbVault)
 }
 
-func (r *VaultRepositoryPg) InsertVaultByUserID(
+// StageVaultContent streams the content to the blob store outside of any
+// transaction, so a slow upload holds neither a connection nor the row lock.
+// The blob is only referred to once InsertVaultContent swaps it in.
+func (r *VaultRepositoryPg) StageVaultContent(
 	ctx context.Context,
-	userID string,
-	vault []byte,
+	vaultID string,
+	content io.Reader,
+	size int64,
+	expectedRevision int64,
+) (*domain.StagedVaultContent, error) {
+
+	vaultUUID, err := uuid.Parse(vaultID)
+	if err != nil {
+		return nil, err
+	}
+
+	// A stale revision is refused before the content is read, it's checked
+	// again when swapping
+	current, err := r.queries.GetVault(ctx, vaultUUID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !domain.VaultRevisionMatches(current.Revision, expectedRevision) {
+		return nil, nil
+	}
+
+	// Size and digest are of the plaintext, which is what clients download
+	digest := sha256.New()
+	plaintext := &countingReader{reader: io.TeeReader(content, digest)}
+	stored, storedSize, encrypted, err := r.keys.sealer(ctx, current.UserID, plaintext, size)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := r.blobs.put(ctx, current.UserID, vaultID, current.Revision+1, stored, storedSize)
+	if err != nil {
+		return nil, err
+	}
+	if size >= 0 && plaintext.n != size {
+		r.blobs.discard(ctx, key)
+		return nil, fmt.Errorf("vault %s: read %d bytes, expected %d", vaultID, plaintext.n, size)
+	}
+
+	return &domain.StagedVaultContent{
+		Key:       key,
+		Size:      int(plaintext.n),
+		SHA256:    hex.EncodeToString(digest.Sum(nil)),
+		Encrypted: encrypted,
+	}, nil
+}
+
+// InsertVaultContent compares and swaps in a single statement, so of two
+// writers based on the same revision only the first one succeeds
+func (r *VaultRepositoryPg) InsertVaultContent(
+	ctx context.Context,
+	vaultID string,
+	staged *domain.StagedVaultContent,
+	expectedRevision int64,
 ) (*domain.Vault, error) {
 
-	id, err := utils.Int32FromString(userID)
+	vaultUUID, err := uuid.Parse(vaultID)
 	if err != nil {
 		return nil, err
 	}
 
-	newVault, err := r.queries.InsertVaultByUserID(ctx, db.InsertVaultByUserIDParams{
-		UserID: id,
-		Vault:  vault,
+	queries := queriesFromContext(ctx, r.queries)
+
+	// Locking the row keeps the previous blob from being migrated meanwhile
+	previous, err := queries.GetVaultBlobKeyForUpdate(ctx, vaultUUID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !domain.VaultRevisionMatches(previous.Revision, expectedRevision) {
+		return nil, nil
+	}
+
+	newVault, err := queries.UpdateVaultIfRevision(ctx, db.UpdateVaultIfRevisionParams{
+		PublicID:         vaultUUID,
+		BlobKey:          sql.NullString{String: staged.Key, Valid: true},
+		Size:             int32(staged.Size),
+		Sha256:           staged.SHA256,
+		Encrypted:        staged.Encrypted,
+		ExpectedRevision: previous.Revision,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The version of the previous revision usually still refers to it
+	r.blobs.release(ctx, previous.BlobKey)
+
+	return toDomainVault(newVault), nil
+}
+
+// DiscardVaultContent deletes staged content that wasn't swapped in
+func (r *VaultRepositoryPg) DiscardVaultContent(ctx context.Context, staged *domain.StagedVaultContent) {
+	r.blobs.discard(ctx, staged.Key)
+}
+
+func (r *VaultRepositoryPg) ListVaultIDs(ctx context.Context, after string, limit int) ([]string, error) {
+	var afterUUID uuid.UUID
+	if after != "" {
+		parsed, err := uuid.Parse(after)
+		if err != nil {
+			return nil, err
+		}
+		afterUUID = parsed
+	}
+
+	ids, err := queriesFromContext(ctx, r.queries).ListVaultIDs(ctx, db.ListVaultIDsParams{
+		PublicID: afterUUID,
+		Limit:    int32(limit),
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	vaultIDs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		vaultIDs = append(vaultIDs, id.String())
+	}
+	return vaultIDs, nil
+}
 
+// toDomainVault reads and decrypts the stored vault
+func (r *VaultRepositoryPg) toDomainVault(ctx context.Context, dbVault db.Vault) (*domain.Vault, error) {
+	stored, err := r.blobs.read(ctx, dbVault.BlobKey, dbVault.Vault)
+	if err != nil {
+		return nil, err
+	}
+	vault, err := r.keys.open(ctx, dbVault.UserID, stored, dbVault.Encrypted)
 	if err != nil {
 		return nil, err
 	}
 
-	v := toDomainVault(newVault)
+	v := toDomainVault(dbVault)
+	v.Vault = vault
 	return v, nil
 }
 
 func toDomainVault(v db.Vault) *domain.Vault {
 	return &domain.Vault{
-		UserID:    v.UserID,
-		Vault:     v.Vault,
-		CreatedAt: v.CreatedAt.Time,
-		UpdatedAt: v.UpdatedAt.Time,
+		ID:               v.PublicID.String(),
+		UserID:           strconv.FormatInt(int64(v.UserID), 10),
+		Name:             v.Name,
+		Type:             domain.VaultType(v.Type),
+		Default:          v.IsDefault,
+		Vault:            v.Vault,
+		Revision:         v.Revision,
+		Size:             int(v.Size),
+		SHA256:           v.Sha256,
+		KeyVersion:       int(v.KeyVersion),
+		RotationRequired: v.RotationRequired,
+		CreatedAt:        v.CreatedAt.Time,
+		UpdatedAt:        v.UpdatedAt.Time,
+	}
+}
+
+// toDomainVaultInfo maps the queries that leave the content out
+func toDomainVaultInfo(v db.GetVaultRow) *domain.Vault {
+	vault := &domain.Vault{
+		ID:               v.PublicID.String(),
+		UserID:           strconv.FormatInt(int64(v.UserID), 10),
+		Name:             v.Name,
+		Type:             domain.VaultType(v.Type),
+		Default:          v.IsDefault,
+		Revision:         v.Revision,
+		Size:             int(v.Size),
+		SHA256:           v.Sha256,
+		KeyVersion:       int(v.KeyVersion),
+		RotationRequired: v.RotationRequired,
+		CreatedAt:        v.CreatedAt.Time,
+		UpdatedAt:        v.UpdatedAt.Time,
+	}
+	if v.OrganizationID.Valid {
+		vault.OrganizationID = v.OrganizationID.UUID.String()
 	}
+	return vault
 }
@@ -0,0 +1,199 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"main/internal/core/domain"
+	"main/internal/utils"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// VaultUploadRepositoryRedis keeps an upload as a hash of its metadata and a
+// string the chunks are appended to, both expiring together. The uploads of
+// a user are indexed in a sorted set scored by expiry.
+type VaultUploadRepositoryRedis struct {
+	rdb *redis.Client
+}
+
+func NewVaultUploadRepositoryRedis(rdb *redis.Client) *VaultUploadRepositoryRedis {
+	return &VaultUploadRepositoryRedis{rdb: rdb}
+}
+
+func vaultUploadKey(userID, id string) string {
+	return fmt.Sprintf("vault_upload:%s:%s", userID, id)
+}
+
+func vaultUploadDataKey(userID, id string) string {
+	return fmt.Sprintf("vault_upload:%s:%s:data", userID, id)
+}
+
+func vaultUploadsKey(userID string) string {
+	return fmt.Sprintf("vault_uploads:%s", userID)
+}
+
+// createUploadScript drops the expired uploads from the index before
+// counting, so abandoned uploads don't hold the slots
+var createUploadScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', now)
+if redis.call('ZCARD', KEYS[1]) >= tonumber(ARGV[2]) then
+	return 0
+end
+redis.call('HSET', KEYS[2], 'size', ARGV[3], 'sha256', ARGV[4], 'expires_at', ARGV[5], 'vault_id', ARGV[8])
+redis.call('PEXPIRE', KEYS[2], ARGV[6])
+redis.call('ZADD', KEYS[1], ARGV[5], ARGV[7])
+redis.call('PEXPIRE', KEYS[1], ARGV[6])
+return 1
+`)
+
+// appendChunkScript returns the offset after the call and 1 if the chunk
+// was appended, or -1 when the upload doesn't exist
+var appendChunkScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return {-1, 0}
+end
+local offset = redis.call('STRLEN', KEYS[2])
+if offset ~= tonumber(ARGV[1]) then
+	return {offset, 0}
+end
+offset = redis.call('APPEND', KEYS[2], ARGV[2])
+redis.call('HSET', KEYS[1], 'expires_at', ARGV[3])
+redis.call('PEXPIRE', KEYS[1], ARGV[4])
+redis.call('PEXPIRE', KEYS[2], ARGV[4])
+redis.call('ZADD', KEYS[3], ARGV[3], ARGV[5])
+if redis.call('PTTL', KEYS[3]) < tonumber(ARGV[4]) then
+	redis.call('PEXPIRE', KEYS[3], ARGV[4])
+end
+return {offset, 1}
+`)
+
+func (r *VaultUploadRepositoryRedis) CreateUpload(ctx context.Context, upload domain.VaultUpload, ttl time.Duration, maxUploads int) (*domain.VaultUpload, error) {
+	id, err := utils.GenerateRandomString(16)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	upload.ID = id
+	upload.Offset = 0
+	upload.ExpiresAt = now.Add(ttl)
+
+	created, err := createUploadScript.Run(ctx, r.rdb,
+		[]string{vaultUploadsKey(upload.UserID), vaultUploadKey(upload.UserID, id)},
+		now.Unix(), maxUploads, upload.Size, upload.SHA256, upload.ExpiresAt.Unix(), ttl.Milliseconds(), id, upload.VaultID,
+	).Int()
+	if err != nil {
+		return nil, err
+	}
+	if created == 0 {
+		return nil, nil
+	}
+	return &upload, nil
+}
+
+func (r *VaultUploadRepositoryRedis) GetUpload(ctx context.Context, userID, id string) (*domain.VaultUpload, error) {
+	pipe := r.rdb.Pipeline()
+	fields := pipe.HGetAll(ctx, vaultUploadKey(userID, id))
+	offset := pipe.StrLen(ctx, vaultUploadDataKey(userID, id))
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	return toDomainVaultUpload(userID, id, fields.Val(), offset.Val())
+}
+
+func (r *VaultUploadRepositoryRedis) AppendChunk(ctx context.Context, userID, id string, offset int64, chunk []byte, ttl time.Duration) (*domain.VaultUpload, bool, error) {
+	expiresAt := time.Now().Add(ttl)
+	result, err := appendChunkScript.Run(ctx, r.rdb,
+		[]string{vaultUploadKey(userID, id), vaultUploadDataKey(userID, id), vaultUploadsKey(userID)},
+		offset, chunk, expiresAt.Unix(), ttl.Milliseconds(), id,
+	).Int64Slice()
+	if err != nil {
+		return nil, false, err
+	}
+	if result[0] < 0 {
+		return nil, false, nil
+	}
+
+	upload, err := r.GetUpload(ctx, userID, id)
+	if err != nil || upload == nil {
+		return nil, false, err
+	}
+	// The offset as of the script, a concurrent chunk may have followed
+	upload.Offset = result[0]
+	return upload, result[1] == 1, nil
+}
+
+// vaultUploadReadSize is how much of an upload is fetched per GETRANGE
+const vaultUploadReadSize = 1 << 20
+
+func (r *VaultUploadRepositoryRedis) OpenContent(ctx context.Context, userID, id string) io.Reader {
+	return &vaultUploadReader{ctx: ctx, rdb: r.rdb, key: vaultUploadDataKey(userID, id)}
+}
+
+// vaultUploadReader reads the data of an upload a range at a time rather
+// than loading it whole
+type vaultUploadReader struct {
+	ctx    context.Context
+	rdb    *redis.Client
+	key    string
+	offset int64
+	buf    []byte
+}
+
+func (r *vaultUploadReader) Read(p []byte) (int, error) {
+	if len(r.buf) == 0 {
+		chunk, err := r.rdb.GetRange(r.ctx, r.key, r.offset, r.offset+vaultUploadReadSize-1).Bytes()
+		if err != nil && err != redis.Nil {
+			return 0, fmt.Errorf("failed to read vault upload: %w", err)
+		}
+		if len(chunk) == 0 {
+			return 0, io.EOF
+		}
+		r.offset += int64(len(chunk))
+		r.buf = chunk
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+func (r *VaultUploadRepositoryRedis) DeleteUpload(ctx context.Context, userID, id string) (bool, error) {
+	pipe := r.rdb.TxPipeline()
+	deleted := pipe.Del(ctx, vaultUploadKey(userID, id), vaultUploadDataKey(userID, id))
+	pipe.ZRem(ctx, vaultUploadsKey(userID), id)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return false, fmt.Errorf("failed to delete vault upload: %w", err)
+	}
+	return deleted.Val() > 0, nil
+}
+
+func toDomainVaultUpload(userID, id string, fields map[string]string, offset int64) (*domain.VaultUpload, error) {
+	if len(fields) == 0 {
+		return nil, nil
+	}
+
+	size, err := strconv.ParseInt(fields["size"], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse vault upload: %w", err)
+	}
+	expiresAt, err := strconv.ParseInt(fields["expires_at"], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse vault upload: %w", err)
+	}
+
+	return &domain.VaultUpload{
+		ID:        id,
+		UserID:    userID,
+		VaultID:   fields["vault_id"],
+		Size:      size,
+		SHA256:    fields["sha256"],
+		Offset:    offset,
+		ExpiresAt: time.Unix(expiresAt, 0),
+	}, nil
+}
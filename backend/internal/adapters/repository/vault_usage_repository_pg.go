@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"main/internal/core/domain"
+	db "main/internal/db/sqlc"
+	"main/internal/utils"
+)
+
+type VaultUsageRepositoryPg struct {
+	queries *db.Queries
+}
+
+func NewVaultUsageRepositoryPg(dbConn *sql.DB) *VaultUsageRepositoryPg {
+	return &VaultUsageRepositoryPg{
+		queries: db.New(dbConn),
+	}
+}
+
+// GetUsageByUserID sees the uncommitted writes when called in a transaction,
+// so a write can be checked against the quota before it commits
+func (r *VaultUsageRepositoryPg) GetUsageByUserID(ctx context.Context, userID string) (*domain.VaultUsage, error) {
+	id, err := utils.Int32FromString(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := queriesFromContext(ctx, r.queries).GetVaultUsageByUserID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.VaultUsage{
+		VaultBytes:   row.VaultBytes,
+		HistoryBytes: row.HistoryBytes,
+		ItemBytes:    row.ItemBytes,
+	}, nil
+}
@@ -0,0 +1,212 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"main/internal/core/domain"
+	"main/internal/core/ports"
+	db "main/internal/db/sqlc"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type VaultVersionRepositoryPg struct {
+	queries *db.Queries
+	blobs   *vaultBlobs
+	keys    *VaultKeyStorePg
+}
+
+func NewVaultVersionRepositoryPg(dbConn *sql.DB, blobStore ports.BlobStore, keys *VaultKeyStorePg) *VaultVersionRepositoryPg {
+	queries := db.New(dbConn)
+	return &VaultVersionRepositoryPg{
+		queries: queries,
+		blobs:   &vaultBlobs{store: blobStore, queries: queries},
+		keys:    keys,
+	}
+}
+
+// CreateVersion records the current content of the vault as a version,
+// sharing its blob. The vault has to be at the revision and content of the
+// version, as it is when written by the vault service.
+func (r *VaultVersionRepositoryPg) CreateVersion(ctx context.Context, version domain.VaultVersion) error {
+	vaultUUID, err := uuid.Parse(version.VaultID)
+	if err != nil {
+		return err
+	}
+
+	queries := queriesFromContext(ctx, r.queries)
+
+	current, err := queries.GetVaultBlobAtRevision(ctx, db.GetVaultBlobAtRevisionParams{
+		PublicID: vaultUUID,
+		Revision: version.Revision,
+	})
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+	if err != nil || !current.BlobKey.Valid || current.Sha256 != version.SHA256 {
+		return fmt.Errorf("vault %s has no content at revision %d to version", version.VaultID, version.Revision)
+	}
+
+	return queries.CreateVaultVersion(ctx, db.CreateVaultVersionParams{
+		VaultID:   vaultUUID,
+		Revision:  version.Revision,
+		BlobKey:   current.BlobKey,
+		Size:      int32(version.Size),
+		Sha256:    version.SHA256,
+		DeviceID:  version.DeviceID,
+		Encrypted: current.Encrypted,
+	})
+}
+
+func (r *VaultVersionRepositoryPg) GetVersionsByVaultID(ctx context.Context, vaultID string) ([]domain.VaultVersion, error) {
+	vaultUUID, err := uuid.Parse(vaultID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := queriesFromContext(ctx, r.queries).GetVaultVersionsByVaultID(ctx, vaultUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]domain.VaultVersion, 0, len(rows))
+	for _, v := range rows {
+		versions = append(versions, domain.VaultVersion{
+			VaultID:   vaultID,
+			UserID:    strconv.FormatInt(int64(v.UserID), 10),
+			Revision:  v.Revision,
+			Size:      int(v.Size),
+			SHA256:    v.Sha256,
+			DeviceID:  v.DeviceID,
+			CreatedAt: v.CreatedAt,
+		})
+	}
+	return versions, nil
+}
+
+func (r *VaultVersionRepositoryPg) GetVersion(ctx context.Context, vaultID string, revision int64) (*domain.VaultVersion, error) {
+	vaultUUID, err := uuid.Parse(vaultID)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := queriesFromContext(ctx, r.queries).GetVaultVersion(ctx, db.GetVaultVersionParams{
+		PublicID: vaultUUID,
+		Revision: revision,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	stored, err := r.blobs.read(ctx, v.BlobKey, v.Vault)
+	if err != nil {
+		return nil, err
+	}
+	vault, err := r.keys.open(ctx, v.UserID, stored, v.Encrypted)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.VaultVersion{
+		VaultID:   vaultID,
+		UserID:    strconv.FormatInt(int64(v.UserID), 10),
+		Revision:  v.Revision,
+		Vault:     vault,
+		Size:      int(v.Size),
+		SHA256:    v.Sha256,
+		DeviceID:  v.DeviceID,
+		CreatedAt: v.CreatedAt,
+	}, nil
+}
+
+// OpenVersion streams the blob, or reads the content in chunks of about
+// vaultChunkSize when it is still in the row. Versions never change so the
+// chunks belong together even if the vault is written meanwhile.
+func (r *VaultVersionRepositoryPg) OpenVersion(ctx context.Context, vaultID string, revision int64) (*domain.VaultVersion, io.ReadCloser, error) {
+	vaultUUID, err := uuid.Parse(vaultID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	queries := queriesFromContext(ctx, r.queries)
+	v, err := queries.GetVaultVersionInfo(ctx, db.GetVaultVersionInfoParams{
+		PublicID: vaultUUID,
+		Revision: revision,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+
+	version := &domain.VaultVersion{
+		VaultID:   vaultID,
+		UserID:    strconv.FormatInt(int64(v.UserID), 10),
+		Revision:  v.Revision,
+		Size:      int(v.Size),
+		SHA256:    v.Sha256,
+		DeviceID:  v.DeviceID,
+		CreatedAt: v.CreatedAt,
+	}
+	if v.BlobKey.Valid {
+		blob := r.blobs.open(ctx, v.BlobKey.String)
+		if !v.Encrypted {
+			return version, blob, nil
+		}
+		reader, err := r.keys.reader(ctx, v.UserID, int64(v.Size), true, blob.fetch)
+		if err != nil {
+			return nil, nil, err
+		}
+		return version, readCloser{reader, blob}, nil
+	}
+
+	fetch := func(offset, length int64) ([]byte, error) {
+		chunk, err := queries.GetVaultVersionChunk(ctx, db.GetVaultVersionChunkParams{
+			Start:    int32(offset) + 1,
+			Length:   int32(length),
+			VaultID:  vaultUUID,
+			Revision: revision,
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("vault version %d was pruned while being read", revision)
+		}
+		return chunk, err
+	}
+	reader, err := r.keys.reader(ctx, v.UserID, int64(v.Size), v.Encrypted, fetch)
+	if err != nil {
+		return nil, nil, err
+	}
+	return version, io.NopCloser(reader), nil
+}
+
+func (r *VaultVersionRepositoryPg) PruneVersions(ctx context.Context, vaultID string, keep int, before time.Time) (int64, error) {
+	vaultUUID, err := uuid.Parse(vaultID)
+	if err != nil {
+		return 0, err
+	}
+
+	keys, err := queriesFromContext(ctx, r.queries).PruneVaultVersions(ctx, db.PruneVaultVersionsParams{
+		VaultID: vaultUUID,
+		Keep:    int64(keep),
+		Before:  nullTime(before),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, key := range keys {
+		r.blobs.release(ctx, key)
+	}
+	return int64(len(keys)), nil
+}
+
+const vaultChunkSize = 1 << 20
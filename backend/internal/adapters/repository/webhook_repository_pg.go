@@ -0,0 +1,229 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"main/internal/core/domain"
+	db "main/internal/db/sqlc"
+	"main/internal/utils"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WebhookRepositoryPg struct {
+	queries *db.Queries
+}
+
+func NewWebhookRepositoryPg(dbConn *sql.DB) *WebhookRepositoryPg {
+	return &WebhookRepositoryPg{
+		queries: db.New(dbConn),
+	}
+}
+
+func (r *WebhookRepositoryPg) CreateSubscription(ctx context.Context, subscription domain.WebhookSubscription) (*domain.WebhookSubscription, error) {
+	userID, err := utils.Int32FromString(subscription.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	events := []string{}
+	for _, e := range subscription.Events {
+		events = append(events, string(e))
+	}
+
+	dbSubscription, err := queriesFromContext(ctx, r.queries).CreateWebhookSubscription(ctx, db.CreateWebhookSubscriptionParams{
+		UserID: userID,
+		Scope:  string(subscription.Scope),
+		Url:    subscription.URL,
+		Secret: subscription.Secret,
+		Events: events,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainWebhookSubscription(dbSubscription), nil
+}
+
+func (r *WebhookRepositoryPg) GetSubscriptionsByUser(ctx context.Context, userID string) ([]domain.WebhookSubscription, error) {
+	id, err := utils.Int32FromString(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	dbSubscriptions, err := queriesFromContext(ctx, r.queries).GetWebhookSubscriptionsByUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptions := make([]domain.WebhookSubscription, 0, len(dbSubscriptions))
+	for _, s := range dbSubscriptions {
+		subscriptions = append(subscriptions, *toDomainWebhookSubscription(s))
+	}
+	return subscriptions, nil
+}
+
+func (r *WebhookRepositoryPg) GetSubscriptionByPublicID(ctx context.Context, id string) (*domain.WebhookSubscription, error) {
+	subscriptionUUID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	dbSubscription, err := queriesFromContext(ctx, r.queries).GetWebhookSubscriptionByPublicID(ctx, subscriptionUUID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainWebhookSubscription(dbSubscription), nil
+}
+
+func (r *WebhookRepositoryPg) DeleteSubscription(ctx context.Context, id string) (bool, error) {
+	subscriptionUUID, err := uuid.Parse(id)
+	if err != nil {
+		return false, err
+	}
+
+	rows, err := queriesFromContext(ctx, r.queries).DeleteWebhookSubscription(ctx, subscriptionUUID)
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+// EnqueueDeliveries queues the event for the user's subscriptions and the
+// instance-wide ones that want its type, it returns how many were queued.
+func (r *WebhookRepositoryPg) EnqueueDeliveries(ctx context.Context, userID string, eventType domain.SecurityEventType, payload []byte) (int64, error) {
+	id, err := nullInt32FromString(userID)
+	if err != nil {
+		return 0, err
+	}
+
+	return queriesFromContext(ctx, r.queries).EnqueueWebhookDeliveries(ctx, db.EnqueueWebhookDeliveriesParams{
+		EventType: string(eventType),
+		Payload:   payload,
+		UserID:    id,
+	})
+}
+
+// ClaimDeliveries leases due deliveries to the caller, other workers skip them
+// until the lease expires.
+func (r *WebhookRepositoryPg) ClaimDeliveries(ctx context.Context, batchSize int, lease time.Duration) ([]domain.WebhookDelivery, error) {
+	rows, err := queriesFromContext(ctx, r.queries).ClaimWebhookDeliveries(ctx, db.ClaimWebhookDeliveriesParams{
+		LockedUntil: sql.NullTime{Time: time.Now().Add(lease), Valid: true},
+		BatchSize:   int32(batchSize),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]domain.WebhookDelivery, 0, len(rows))
+	for _, d := range rows {
+		deliveries = append(deliveries, domain.WebhookDelivery{
+			ID:             strconv.FormatInt(d.ID, 10),
+			PublicID:       d.PublicID,
+			SubscriptionID: strconv.FormatInt(int64(d.SubscriptionID), 10),
+			URL:            d.Url,
+			Secret:         d.Secret,
+			EventType:      domain.SecurityEventType(d.EventType),
+			Payload:        d.Payload,
+			Status:         domain.WebhookDeliveryStatus(d.Status),
+			Attempts:       int(d.Attempts),
+			ResponseStatus: int(d.ResponseStatus.Int32),
+			LastError:      d.LastError.String,
+			NextAttemptAt:  d.NextAttemptAt,
+			CreatedAt:      d.CreatedAt,
+			DeliveredAt:    d.DeliveredAt.Time,
+		})
+	}
+	return deliveries, nil
+}
+
+func (r *WebhookRepositoryPg) MarkDeliveryDelivered(ctx context.Context, id string, responseStatus int) error {
+	iid, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	return queriesFromContext(ctx, r.queries).MarkWebhookDeliveryDelivered(ctx, db.MarkWebhookDeliveryDeliveredParams{
+		ID:             iid,
+		ResponseStatus: nullResponseStatus(responseStatus),
+	})
+}
+
+func (r *WebhookRepositoryPg) MarkDeliveryFailed(ctx context.Context, id string, status domain.WebhookDeliveryStatus, responseStatus int, lastError string, nextAttemptAt time.Time) error {
+	iid, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	return queriesFromContext(ctx, r.queries).MarkWebhookDeliveryFailed(ctx, db.MarkWebhookDeliveryFailedParams{
+		ID:             iid,
+		Status:         string(status),
+		ResponseStatus: nullResponseStatus(responseStatus),
+		LastError:      sql.NullString{String: lastError, Valid: lastError != ""},
+		NextAttemptAt:  nextAttemptAt,
+	})
+}
+
+func (r *WebhookRepositoryPg) GetDeliveriesBySubscription(ctx context.Context, subscriptionID string, limit int) ([]domain.WebhookDelivery, error) {
+	id, err := utils.Int32FromString(subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	dbDeliveries, err := queriesFromContext(ctx, r.queries).GetWebhookDeliveriesBySubscription(ctx, db.GetWebhookDeliveriesBySubscriptionParams{
+		SubscriptionID: id,
+		Limit:          int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]domain.WebhookDelivery, 0, len(dbDeliveries))
+	for _, d := range dbDeliveries {
+		deliveries = append(deliveries, domain.WebhookDelivery{
+			ID:             strconv.FormatInt(d.ID, 10),
+			PublicID:       d.PublicID,
+			SubscriptionID: strconv.FormatInt(int64(d.SubscriptionID), 10),
+			EventType:      domain.SecurityEventType(d.EventType),
+			Payload:        d.Payload,
+			Status:         domain.WebhookDeliveryStatus(d.Status),
+			Attempts:       int(d.Attempts),
+			ResponseStatus: int(d.ResponseStatus.Int32),
+			LastError:      d.LastError.String,
+			NextAttemptAt:  d.NextAttemptAt,
+			CreatedAt:      d.CreatedAt,
+			DeliveredAt:    d.DeliveredAt.Time,
+		})
+	}
+	return deliveries, nil
+}
+
+func toDomainWebhookSubscription(s db.WebhookSubscription) *domain.WebhookSubscription {
+	events := make([]domain.SecurityEventType, 0, len(s.Events))
+	for _, e := range s.Events {
+		events = append(events, domain.SecurityEventType(e))
+	}
+
+	return &domain.WebhookSubscription{
+		ID:        strconv.FormatInt(int64(s.ID), 10),
+		PublicID:  s.PublicID,
+		UserID:    strconv.FormatInt(int64(s.UserID), 10),
+		Scope:     domain.WebhookScope(s.Scope),
+		URL:       s.Url,
+		Secret:    s.Secret,
+		Events:    events,
+		CreatedAt: s.CreatedAt,
+	}
+}
+
+// nullResponseStatus maps "no response" (0) to NULL
+func nullResponseStatus(status int) sql.NullInt32 {
+	return sql.NullInt32{Int32: int32(status), Valid: status != 0}
+}
@@ -2,9 +2,14 @@ package bootstrap
 
 import (
 	"database/sql"
+	"log"
+	"main/internal/adapters/blobstore"
+	"main/internal/adapters/eventbus"
 	"main/internal/adapters/notifier"
 	"main/internal/adapters/repository"
+	"main/internal/config"
 	"main/internal/core/ports"
+	"main/internal/envelope"
 	"main/internal/smtp"
 
 	"github.com/go-redis/redis/v8"
@@ -12,18 +17,138 @@ import (
 
 type Adapters struct {
 	ports.UserRepository
+	ports.UserKeysRepository
 	ports.SessionRepository
 	ports.VaultRepository
+	ports.VaultVersionRepository
+	ports.VaultMemberRepository
+	ports.VaultItemRepository
+	ports.VaultUsageRepository
+	ports.VaultUploadRepository
+	ports.VaultEventBus
 	ports.UserIntentRepository
 	ports.UserNotifier
+	ports.InviteRepository
+	ports.OutboxRepository
+	ports.Transactor
+	ports.SecurityEventRepository
+	ports.WebhookRepository
+	ports.WebhookSender
+	ports.OrganizationRepository
+	ports.EmergencyAccessRepository
+	ports.SendRepository
+
+	// DeliveryNotifier is used by the outbox worker, UserNotifier only enqueues
+	DeliveryNotifier ports.UserNotifier
 }
 
-func NewAdapters(db *sql.DB, rdb *redis.Client, smtp *smtp.SMTPClient) *Adapters {
+func NewAdapters(db *sql.DB, rdb *redis.Client, smtp *smtp.SMTPClient, cfg *config.Config) *Adapters {
+	mailTemplates, err := notifier.NewMailTemplates(cfg.Mail.TemplatesDir, cfg.Mail.DefaultLocale)
+	if err != nil {
+		log.Fatalf("failed to load mail templates: %v", err)
+	}
+
+	outboxRepository := repository.NewOutboxRepositoryPg(db)
+	vaultKeys := NewVaultKeyStore(db, cfg)
+	vaultBlobs := NewVaultBlobStore(cfg)
+
 	return &Adapters{
-		UserRepository:       repository.NewUserRepositoryPg(db),
-		SessionRepository:    repository.NewSessionRepositoryRedis(rdb),
-		VaultRepository:      repository.NewVaultRepositoryPg(db),
-		UserIntentRepository: repository.NewUserIntentRepositoryRedis(rdb),
-		UserNotifier:         notifier.NewUserNotifierSMTP(smtp),
+		UserRepository:            repository.NewUserRepositoryPg(db),
+		UserKeysRepository:        repository.NewUserKeysRepositoryPg(db),
+		SessionRepository:         repository.NewSessionRepositoryRedis(rdb),
+		VaultRepository:           repository.NewVaultRepositoryPg(db, vaultBlobs, vaultKeys),
+		VaultVersionRepository:    repository.NewVaultVersionRepositoryPg(db, vaultBlobs, vaultKeys),
+		VaultMemberRepository:     repository.NewVaultMemberRepositoryPg(db),
+		VaultItemRepository:       repository.NewVaultItemRepositoryPg(db),
+		VaultUsageRepository:      repository.NewVaultUsageRepositoryPg(db),
+		VaultUploadRepository:     repository.NewVaultUploadRepositoryRedis(rdb),
+		VaultEventBus:             newVaultEventBus(db, rdb, cfg),
+		UserIntentRepository:      repository.NewUserIntentRepositoryRedis(rdb),
+		UserNotifier:              notifier.NewUserNotifierOutbox(outboxRepository),
+		InviteRepository:          repository.NewInviteRepositoryPg(db),
+		OutboxRepository:          outboxRepository,
+		Transactor:                repository.NewTransactorPg(db),
+		SecurityEventRepository:   repository.NewSecurityEventRepositoryPg(db),
+		WebhookRepository:         repository.NewWebhookRepositoryPg(db),
+		WebhookSender:             notifier.NewWebhookSenderHTTP(cfg.Webhook.Timeout, cfg.Webhook.AllowPrivate),
+		OrganizationRepository:    repository.NewOrganizationRepositoryPg(db),
+		EmergencyAccessRepository: repository.NewEmergencyAccessRepositoryPg(db),
+		SendRepository:            repository.NewSendRepositoryRedis(rdb),
+		DeliveryNotifier:          newDeliveryNotifier(smtp, mailTemplates, cfg),
+	}
+}
+
+// NewVaultKeyStore loads the key-encryption keys of the config, vaults are
+// stored unencrypted when there are none
+func NewVaultKeyStore(db *sql.DB, cfg *config.Config) *repository.VaultKeyStorePg {
+	entries := cfg.Vault.KEKs
+	if cfg.Vault.KEKFile != "" {
+		fileEntries, err := envelope.ReadKeyFile(cfg.Vault.KEKFile)
+		if err != nil {
+			log.Fatalf("failed to read key-encryption keys: %v", err)
+		}
+		entries = append(entries, fileEntries...)
+	}
+	if len(entries) == 0 {
+		log.Printf("no key-encryption keys configured, vaults are stored unencrypted")
+		return repository.NewVaultKeyStorePg(db, nil)
+	}
+
+	keyring, err := envelope.NewKeyring(entries, cfg.Vault.KEKActive)
+	if err != nil {
+		log.Fatalf("failed to load key-encryption keys: %v", err)
+	}
+	return repository.NewVaultKeyStorePg(db, keyring)
+}
+
+// NewVaultBlobStore builds the blob store selected in the config
+func NewVaultBlobStore(cfg *config.Config) ports.BlobStore {
+	if cfg.Vault.BlobBackend == "s3" {
+		s3 := cfg.Vault.S3
+		store, err := blobstore.NewBlobStoreS3(s3.Endpoint, s3.Bucket, s3.Region, s3.AccessKey, s3.SecretKey, s3.PathStyle)
+		if err != nil {
+			log.Fatalf("failed to create the S3 blob store: %v", err)
+		}
+		return store
+	}
+
+	store, err := blobstore.NewBlobStoreFS(cfg.Vault.BlobDir)
+	if err != nil {
+		log.Fatalf("failed to create the vault blob directory: %v", err)
+	}
+	return store
+}
+
+func newVaultEventBus(db *sql.DB, rdb *redis.Client, cfg *config.Config) ports.VaultEventBus {
+	if cfg.Vault.EventsBackend == "postgres" {
+		return eventbus.NewVaultEventBusPg(db, cfg.DB.ConnString(), cfg.Vault.EventsReplaySize, cfg.Vault.EventsRetention)
+	}
+	return eventbus.NewVaultEventBusRedis(rdb, cfg.Vault.EventsReplaySize, cfg.Vault.EventsRetention)
+}
+
+// newDeliveryNotifier builds the notifiers selected in the config, fanning
+// out when there is more than one
+func newDeliveryNotifier(smtp *smtp.SMTPClient, mailTemplates *notifier.MailTemplates, cfg *config.Config) ports.UserNotifier {
+	var notifiers []ports.UserNotifier
+	for _, backend := range cfg.Notifier.Backends {
+		switch backend {
+		case "smtp":
+			notifiers = append(notifiers, notifier.NewUserNotifierSMTP(smtp, mailTemplates, cfg.AppFrontendUrl))
+		case "console":
+			notifiers = append(notifiers, notifier.NewUserNotifierConsole(log.Default(), mailTemplates, cfg.AppFrontendUrl))
+		case "file":
+			fileNotifier, err := notifier.NewUserNotifierFile(cfg.Notifier.FileDir, smtp, mailTemplates, cfg.AppFrontendUrl)
+			if err != nil {
+				log.Fatalf("failed to create file notifier: %v", err)
+			}
+			notifiers = append(notifiers, fileNotifier)
+		case "webhook":
+			notifiers = append(notifiers, notifier.NewUserNotifierWebhook(cfg.Notifier.WebhookURL, cfg.Notifier.WebhookSecret, cfg.AppFrontendUrl))
+		}
+	}
+
+	if len(notifiers) == 1 {
+		return notifiers[0]
 	}
+	return notifier.NewUserNotifierFanout(notifiers...)
 }
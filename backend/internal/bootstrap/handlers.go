@@ -2,18 +2,58 @@ package bootstrap
 
 import (
 	"main/internal/adapters/handler"
+	"main/internal/config"
+	"net/url"
 )
 
 type Handlers struct {
 	*handler.UserHandler
+	*handler.UserKeyHandler
 	*handler.AuthHandler
 	*handler.VaultHandler
+	*handler.VaultMemberHandler
+	*handler.VaultItemHandler
+	*handler.VaultUploadHandler
+	*handler.VaultEventHandler
+	*handler.InviteHandler
+	*handler.AdminHandler
+	*handler.SecurityEventHandler
+	*handler.WebhookHandler
+	*handler.OrganizationHandler
+	*handler.EmergencyAccessHandler
+	*handler.SendHandler
+
+	// VaultSocket is served next to the OpenAPI handler, the upgrade needs the request
+	VaultSocket *handler.VaultSocketHandler
 }
 
-func NewHandlers(s *Services) *Handlers {
+func NewHandlers(s *Services, cfg *config.Config) *Handlers {
 	return &Handlers{
-		UserHandler:  handler.NewUserHandler(s.UserService),
-		AuthHandler:  handler.NewAuthHandler(s.UserService, s.AuthService),
-		VaultHandler: handler.NewVaultHandler(s.VaultService),
+		UserHandler:            handler.NewUserHandler(s.UserService),
+		UserKeyHandler:         handler.NewUserKeyHandler(s.UserKeyService),
+		AuthHandler:            handler.NewAuthHandler(s.UserService, s.AuthService),
+		VaultHandler:           handler.NewVaultHandler(s.VaultService),
+		VaultMemberHandler:     handler.NewVaultMemberHandler(s.VaultMemberService),
+		VaultItemHandler:       handler.NewVaultItemHandler(s.VaultItemService),
+		VaultUploadHandler:     handler.NewVaultUploadHandler(s.VaultUploadService, s.VaultService),
+		VaultEventHandler:      handler.NewVaultEventHandler(s.VaultEventService),
+		InviteHandler:          handler.NewInviteHandler(s.InviteService, s.AuthService),
+		AdminHandler:           handler.NewAdminHandler(s.OutboxService, s.VaultIntegrityService),
+		SecurityEventHandler:   handler.NewSecurityEventHandler(s.SecurityEventService),
+		WebhookHandler:         handler.NewWebhookHandler(s.WebhookService, s.AuthService),
+		OrganizationHandler:    handler.NewOrganizationHandler(s.OrganizationService, s.VaultService),
+		EmergencyAccessHandler: handler.NewEmergencyAccessHandler(s.EmergencyAccessService),
+		SendHandler:            handler.NewSendHandler(s.SendService),
+		VaultSocket:            handler.NewVaultSocketHandler(s.VaultEventService, s.VaultService, socketOriginPatterns(cfg)),
+	}
+}
+
+// socketOriginPatterns lets the frontend open the vault WebSocket, native
+// clients send no Origin and are always accepted
+func socketOriginPatterns(cfg *config.Config) []string {
+	frontendURL, err := url.Parse(cfg.AppFrontendUrl)
+	if err != nil || frontendURL.Host == "" {
+		return nil
 	}
+	return []string{frontendURL.Host}
 }
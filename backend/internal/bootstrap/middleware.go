@@ -11,6 +11,6 @@ type Middlewares struct {
 
 func NewMiddlewares(s *Services, cfg *config.Config) *Middlewares {
 	return &Middlewares{
-		Middleware: middleware.NewMiddleware(s.AuthService, cfg),
+		Middleware: middleware.NewMiddleware(s.AuthService, s.VaultService, s.OrganizationService, cfg),
 	}
 }
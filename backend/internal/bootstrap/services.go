@@ -1,19 +1,102 @@
 package bootstrap
 
 import (
+	"main/internal/config"
+	"main/internal/core/domain"
 	"main/internal/core/services"
+	"time"
 )
 
 type Services struct {
 	*services.UserService
+	*services.UserKeyService
 	*services.AuthService
 	*services.VaultService
+	*services.VaultMemberService
+	*services.VaultItemService
+	*services.VaultUploadService
+	*services.VaultIntegrityService
+	*services.VaultEventService
+	*services.InviteService
+	*services.OutboxService
+	*services.SecurityEventService
+	*services.WebhookService
+	*services.OrganizationService
+	*services.EmergencyAccessService
+	*services.SendService
 }
 
-func NewServices(r *Adapters) *Services {
+func NewServices(r *Adapters, cfg *config.Config) *Services {
+	registrationPolicy := domain.RegistrationPolicy{
+		Mode:           domain.RegistrationMode(cfg.Registration.Mode),
+		AllowedDomains: cfg.Registration.AllowedDomains,
+		AdminEmails:    cfg.AdminEmails,
+	}
+
+	outboxConfig := services.OutboxConfig{
+		PollInterval: cfg.Outbox.PollInterval,
+		BatchSize:    cfg.Outbox.BatchSize,
+		MaxAttempts:  cfg.Outbox.MaxAttempts,
+		BackoffBase:  cfg.Outbox.BackoffBase,
+		BackoffMax:   cfg.Outbox.BackoffMax,
+		Lease:        time.Minute,
+	}
+
+	webhookConfig := services.WebhookConfig{
+		PollInterval: cfg.Webhook.PollInterval,
+		BatchSize:    cfg.Webhook.BatchSize,
+		MaxAttempts:  cfg.Webhook.MaxAttempts,
+		BackoffBase:  cfg.Webhook.BackoffBase,
+		BackoffMax:   cfg.Webhook.BackoffMax,
+		Lease:        time.Minute,
+	}
+
+	vaultConfig := services.VaultConfig{
+		VersionsKeep:   cfg.Vault.VersionsKeep,
+		VersionsMaxAge: cfg.Vault.VersionsMaxAge,
+		PollMaxWait:    cfg.Vault.PollMaxWait,
+		PollMaxWaiters: cfg.Vault.PollMaxWaiters,
+	}
+
+	emergencyAccessConfig := services.EmergencyAccessConfig{
+		PollInterval: cfg.Emergency.PollInterval,
+	}
+
+	sendConfig := services.SendConfig{
+		MaxSize:  cfg.Send.MaxSize,
+		MaxTTL:   cfg.Send.MaxTTL,
+		MaxViews: cfg.Send.MaxViews,
+		MaxSends: cfg.Send.MaxSends,
+	}
+
+	vaultUploadConfig := services.VaultUploadConfig{
+		TTL:        cfg.Vault.UploadTTL,
+		MaxUploads: cfg.Vault.UploadMaxSessions,
+	}
+
+	eventRecorder := services.NewEventRecorder(r.SecurityEventRepository, r.WebhookRepository, r.UserRepository)
+	vaultEvents := services.NewVaultEventService(r.VaultEventBus, cfg.Vault.EventsHeartbeat)
+	vaultQuota := services.NewVaultQuota(r.VaultUsageRepository, cfg.Vault.MaxSize, int64(cfg.Vault.Quota))
+
+	userService := services.NewUserService(r.UserRepository, r.UserIntentRepository, r.UserNotifier, r.SessionRepository, r.InviteRepository, r.Transactor, eventRecorder, registrationPolicy)
+	vaultService := services.NewVaultService(r.VaultRepository, r.VaultVersionRepository, r.VaultMemberRepository, r.EmergencyAccessRepository, r.Transactor, eventRecorder, vaultEvents, vaultQuota, vaultConfig)
+
 	return &Services{
-		UserService:  services.NewUserService(r.UserRepository, r.UserIntentRepository, r.UserNotifier, r.SessionRepository),
-		AuthService:  services.NewAuthService(r.UserRepository, r.SessionRepository),
-		VaultService: services.NewVaultService(r.VaultRepository),
+		UserService:            userService,
+		UserKeyService:         services.NewUserKeyService(r.UserKeysRepository),
+		AuthService:            services.NewAuthService(r.UserRepository, r.SessionRepository, r.OrganizationRepository, eventRecorder, vaultEvents, cfg.AdminEmails),
+		VaultService:           vaultService,
+		VaultMemberService:     services.NewVaultMemberService(r.VaultMemberRepository, r.UserRepository, r.UserKeysRepository, r.OrganizationRepository, r.Transactor),
+		VaultItemService:       services.NewVaultItemService(r.VaultItemRepository, r.Transactor, eventRecorder, vaultEvents, vaultQuota),
+		VaultUploadService:     services.NewVaultUploadService(r.VaultUploadRepository, vaultService, vaultQuota, vaultUploadConfig),
+		VaultIntegrityService:  services.NewVaultIntegrityService(r.VaultRepository, vaultService),
+		VaultEventService:      vaultEvents,
+		InviteService:          services.NewInviteService(r.InviteRepository, r.UserRepository, r.UserNotifier, r.Transactor),
+		OutboxService:          services.NewOutboxService(r.OutboxRepository, r.DeliveryNotifier, outboxConfig),
+		SecurityEventService:   services.NewSecurityEventService(r.SecurityEventRepository),
+		WebhookService:         services.NewWebhookService(r.WebhookRepository, r.WebhookSender, webhookConfig),
+		OrganizationService:    services.NewOrganizationService(r.OrganizationRepository, r.UserRepository, userService, r.UserNotifier, r.Transactor),
+		EmergencyAccessService: services.NewEmergencyAccessService(r.EmergencyAccessRepository, r.VaultRepository, r.VaultMemberRepository, r.UserRepository, r.UserKeysRepository, r.UserNotifier, r.Transactor, emergencyAccessConfig),
+		SendService:            services.NewSendService(r.SendRepository, sendConfig),
 	}
 }
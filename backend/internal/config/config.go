@@ -4,6 +4,10 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 )
 
 type DBConfig struct {
@@ -25,6 +29,115 @@ type SMTPConfig struct {
 	From     string
 	User     string
 	Password string
+	// TLSMode is one of none, opportunistic, starttls or tls
+	TLSMode     string
+	IdleTimeout time.Duration
+	DKIM        DKIMConfig
+}
+
+// DKIMConfig enables DKIM signing when KeyFile is set
+type DKIMConfig struct {
+	Domain   string
+	Selector string
+	KeyFile  string
+}
+
+type MailConfig struct {
+	TemplatesDir  string
+	DefaultLocale string
+}
+
+type NotifierConfig struct {
+	Backends      []string
+	FileDir       string
+	WebhookURL    string
+	WebhookSecret string
+}
+
+type OutboxConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+	MaxAttempts  int
+	BackoffBase  time.Duration
+	BackoffMax   time.Duration
+}
+
+type WebhookConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+	MaxAttempts  int
+	BackoffBase  time.Duration
+	BackoffMax   time.Duration
+	Timeout      time.Duration
+	// AllowPrivate lets webhooks target loopback and private addresses
+	AllowPrivate bool
+}
+
+type EmergencyAccessConfig struct {
+	// PollInterval is how often requests past their waiting period are
+	// granted
+	PollInterval time.Duration
+}
+
+// SendConfig bounds the sends, MaxSize is in bytes and MaxSends counts the
+// active sends of a user
+type SendConfig struct {
+	MaxSize  int
+	MaxTTL   time.Duration
+	MaxViews int
+	MaxSends int
+}
+
+type VaultConfig struct {
+	// VersionsKeep is how many past versions of a vault are kept
+	VersionsKeep int
+	// VersionsMaxAge prunes older versions, zero keeps them regardless of age
+	VersionsMaxAge time.Duration
+	// EventsBackend is redis or postgres
+	EventsBackend   string
+	EventsHeartbeat time.Duration
+	// EventsReplaySize and EventsRetention bound what a reconnecting client
+	// can catch up on with Last-Event-ID
+	EventsReplaySize int
+	EventsRetention  time.Duration
+	// PollMaxWait caps the wait of a long poll, PollMaxWaiters the long
+	// polls a user can hold open at once on an instance
+	PollMaxWait    time.Duration
+	PollMaxWaiters int
+	// MaxSize is the largest vault upload and Quota the storage of a user,
+	// history and items included, both in bytes
+	MaxSize int
+	Quota   int
+	// UploadTTL expires a resumable upload after its last chunk,
+	// UploadMaxSessions bounds the uploads a user has in progress
+	UploadTTL         time.Duration
+	UploadMaxSessions int
+	// KEKs are the key-encryption keys as "<id>:<base64 key>", read from
+	// KEKFile too, one per line. Vaults are encrypted at rest when set, new
+	// data keys are wrapped with KEKActive.
+	KEKs      []string
+	KEKFile   string
+	KEKActive string
+	// BlobBackend is filesystem, storing the contents under BlobDir, or s3
+	BlobBackend string
+	BlobDir     string
+	S3          S3Config
+}
+
+// S3Config is an S3 compatible object storage, PathStyle addresses the
+// bucket in the path for the stores without bucket subdomains like MinIO
+type S3Config struct {
+	Endpoint  string
+	Bucket    string
+	Region    string
+	AccessKey string
+	SecretKey string
+	PathStyle bool
+}
+
+type RegistrationConfig struct {
+	Mode           string
+	AllowedDomains []string
 }
 
 func (db DBConfig) ConnString() string {
@@ -38,12 +151,21 @@ type Config struct {
 	DB             DBConfig
 	Redis          RedisConfig
 	SMTP           SMTPConfig
+	Mail           MailConfig
+	Notifier       NotifierConfig
+	Outbox         OutboxConfig
+	Webhook        WebhookConfig
+	Emergency      EmergencyAccessConfig
+	Send           SendConfig
+	Vault          VaultConfig
+	Registration   RegistrationConfig
 	AppPort        string
 	AppFrontendUrl string
+	AdminEmails    []string
 }
 
 func Load() Config {
-	return Config{
+	cfg := Config{
 		DB: DBConfig{
 			Host:     mustGetEnv("POSTGRES_HOST"),
 			Port:     mustGetEnv("POSTGRES_PORT"),
@@ -56,15 +178,138 @@ func Load() Config {
 			Port: mustGetEnv("REDIS_PORT"),
 		},
 		SMTP: SMTPConfig{
-			Host:     mustGetEnv("SMTP_HOST"),
-			Port:     mustGetEnv("SMTP_PORT"),
-			User:     getEnv("SMTP_USER", ""),
-			Password: getEnv("SMTP_PASSWORD", ""),
-			From:     mustGetEnv("SMTP_FROM"),
+			Host:        getEnv("SMTP_HOST", ""),
+			Port:        getEnv("SMTP_PORT", ""),
+			User:        getEnv("SMTP_USER", ""),
+			Password:    getEnv("SMTP_PASSWORD", ""),
+			From:        mustGetEnv("SMTP_FROM"),
+			TLSMode:     mustBeOneOf("SMTP_TLS_MODE", getEnv("SMTP_TLS_MODE", "opportunistic"), "none", "opportunistic", "starttls", "tls"),
+			IdleTimeout: getEnvDuration("SMTP_IDLE_TIMEOUT", 30*time.Second),
+			DKIM: DKIMConfig{
+				Domain:   getEnv("SMTP_DKIM_DOMAIN", ""),
+				Selector: getEnv("SMTP_DKIM_SELECTOR", ""),
+				KeyFile:  getEnv("SMTP_DKIM_KEY_FILE", ""),
+			},
+		},
+		Mail: MailConfig{
+			TemplatesDir:  getEnv("MAIL_TEMPLATES_DIR", ""),
+			DefaultLocale: getEnv("MAIL_DEFAULT_LOCALE", "en"),
+		},
+		Notifier: NotifierConfig{
+			Backends:      getEnvList("NOTIFIERS"),
+			FileDir:       getEnv("NOTIFIER_FILE_DIR", "./mail"),
+			WebhookURL:    getEnv("NOTIFIER_WEBHOOK_URL", ""),
+			WebhookSecret: getEnv("NOTIFIER_WEBHOOK_SECRET", ""),
+		},
+		Outbox: OutboxConfig{
+			PollInterval: getEnvDuration("OUTBOX_POLL_INTERVAL", 5*time.Second),
+			BatchSize:    getEnvInt("OUTBOX_BATCH_SIZE", 20),
+			MaxAttempts:  getEnvInt("OUTBOX_MAX_ATTEMPTS", 10),
+			BackoffBase:  getEnvDuration("OUTBOX_BACKOFF_BASE", 30*time.Second),
+			BackoffMax:   getEnvDuration("OUTBOX_BACKOFF_MAX", time.Hour),
+		},
+		Webhook: WebhookConfig{
+			PollInterval: getEnvDuration("WEBHOOK_POLL_INTERVAL", 5*time.Second),
+			BatchSize:    getEnvInt("WEBHOOK_BATCH_SIZE", 20),
+			MaxAttempts:  getEnvInt("WEBHOOK_MAX_ATTEMPTS", 8),
+			BackoffBase:  getEnvDuration("WEBHOOK_BACKOFF_BASE", 30*time.Second),
+			BackoffMax:   getEnvDuration("WEBHOOK_BACKOFF_MAX", 6*time.Hour),
+			Timeout:      getEnvDuration("WEBHOOK_TIMEOUT", 10*time.Second),
+			AllowPrivate: getEnvBool("WEBHOOK_ALLOW_PRIVATE", false),
+		},
+		Emergency: EmergencyAccessConfig{
+			PollInterval: getEnvDuration("EMERGENCY_ACCESS_POLL_INTERVAL", time.Minute),
+		},
+		Send: SendConfig{
+			MaxSize:  getEnvInt("SEND_MAX_SIZE", 64<<10),
+			MaxTTL:   getEnvDuration("SEND_MAX_TTL", 30*24*time.Hour),
+			MaxViews: getEnvInt("SEND_MAX_VIEWS", 100),
+			MaxSends: getEnvInt("SEND_MAX_ACTIVE", 50),
+		},
+		Vault: VaultConfig{
+			VersionsKeep:      getEnvInt("VAULT_VERSIONS_KEEP", 20),
+			VersionsMaxAge:    getEnvDuration("VAULT_VERSIONS_MAX_AGE", 90*24*time.Hour),
+			EventsBackend:     mustBeOneOf("VAULT_EVENTS_BACKEND", getEnv("VAULT_EVENTS_BACKEND", "redis"), "redis", "postgres"),
+			EventsHeartbeat:   getEnvDuration("VAULT_EVENTS_HEARTBEAT", 25*time.Second),
+			EventsReplaySize:  getEnvInt("VAULT_EVENTS_REPLAY_SIZE", 100),
+			EventsRetention:   getEnvDuration("VAULT_EVENTS_RETENTION", time.Hour),
+			PollMaxWait:       getEnvDuration("VAULT_POLL_MAX_WAIT", time.Minute),
+			PollMaxWaiters:    getEnvInt("VAULT_POLL_MAX_WAITERS", 5),
+			MaxSize:           getEnvInt("VAULT_MAX_SIZE", 10<<20),
+			Quota:             getEnvInt("VAULT_QUOTA", 100<<20),
+			UploadTTL:         getEnvDuration("VAULT_UPLOAD_TTL", 24*time.Hour),
+			UploadMaxSessions: getEnvInt("VAULT_UPLOAD_MAX_SESSIONS", 3),
+			KEKs:              getEnvList("VAULT_KEKS"),
+			KEKFile:           getEnv("VAULT_KEK_FILE", ""),
+			KEKActive:         getEnv("VAULT_KEK_ACTIVE", ""),
+			BlobBackend:       mustBeOneOf("VAULT_BLOB_BACKEND", getEnv("VAULT_BLOB_BACKEND", "filesystem"), "filesystem", "s3"),
+			BlobDir:           getEnv("VAULT_BLOB_DIR", "data/vaults"),
+			S3: S3Config{
+				Region:    getEnv("VAULT_S3_REGION", "us-east-1"),
+				AccessKey: getEnv("VAULT_S3_ACCESS_KEY", ""),
+				SecretKey: getEnv("VAULT_S3_SECRET_KEY", ""),
+				PathStyle: getEnvBool("VAULT_S3_PATH_STYLE", false),
+			},
+		},
+		Registration: RegistrationConfig{
+			Mode:           mustBeOneOf("REGISTRATION_MODE", getEnv("REGISTRATION_MODE", "open"), "open", "invite-only", "domain-allowlist"),
+			AllowedDomains: getEnvList("REGISTRATION_ALLOWED_DOMAINS"),
 		},
 		AppPort:        getEnv("APP_PORT", "8080"),
 		AppFrontendUrl: mustGetEnv("APP_FRONTEND_URL"),
+		AdminEmails:    getEnvList("ADMIN_EMAILS"),
+	}
+
+	if cfg.Vault.VersionsKeep < 1 {
+		log.Fatalf("environment variable VAULT_VERSIONS_KEEP must be at least 1")
 	}
+
+	if cfg.Vault.PollMaxWaiters < 1 {
+		log.Fatalf("environment variable VAULT_POLL_MAX_WAITERS must be at least 1")
+	}
+
+	if cfg.Vault.MaxSize < 1 || cfg.Vault.Quota < cfg.Vault.MaxSize {
+		log.Fatalf("environment variable VAULT_QUOTA must be at least VAULT_MAX_SIZE, which must be positive")
+	}
+
+	if cfg.Vault.UploadTTL <= 0 || cfg.Vault.UploadMaxSessions < 1 {
+		log.Fatalf("environment variables VAULT_UPLOAD_TTL and VAULT_UPLOAD_MAX_SESSIONS must be positive")
+	}
+
+	if (len(cfg.Vault.KEKs) > 0 || cfg.Vault.KEKFile != "") && cfg.Vault.KEKActive == "" {
+		log.Fatalf("environment variable VAULT_KEK_ACTIVE is required when VAULT_KEKS or VAULT_KEK_FILE is set")
+	}
+
+	if cfg.Registration.Mode == "domain-allowlist" && len(cfg.Registration.AllowedDomains) == 0 {
+		log.Fatalf("environment variable REGISTRATION_ALLOWED_DOMAINS is required in domain-allowlist mode")
+	}
+
+	if cfg.SMTP.DKIM.KeyFile != "" && cfg.SMTP.DKIM.Selector == "" {
+		log.Fatalf("environment variable SMTP_DKIM_SELECTOR is required when SMTP_DKIM_KEY_FILE is set")
+	}
+
+	if len(cfg.Notifier.Backends) == 0 {
+		cfg.Notifier.Backends = []string{"smtp"}
+	}
+	for _, backend := range cfg.Notifier.Backends {
+		mustBeOneOf("NOTIFIERS", backend, "smtp", "console", "file", "webhook")
+	}
+	if slices.Contains(cfg.Notifier.Backends, "smtp") {
+		cfg.SMTP.Host = mustGetEnv("SMTP_HOST")
+		cfg.SMTP.Port = mustGetEnv("SMTP_PORT")
+	}
+	if cfg.Vault.BlobBackend == "s3" {
+		cfg.Vault.S3.Endpoint = mustGetEnv("VAULT_S3_ENDPOINT")
+		cfg.Vault.S3.Bucket = mustGetEnv("VAULT_S3_BUCKET")
+	}
+	if slices.Contains(cfg.Notifier.Backends, "webhook") {
+		cfg.Notifier.WebhookURL = mustGetEnv("NOTIFIER_WEBHOOK_URL")
+		// The notifications carry registration and reset links, the receiver
+		// must be able to tell them from forged ones
+		cfg.Notifier.WebhookSecret = mustGetEnv("NOTIFIER_WEBHOOK_SECRET")
+	}
+
+	return cfg
 }
 
 func mustGetEnv(key string) string {
@@ -81,3 +326,60 @@ func getEnv(key, fallback string) string {
 	}
 	return fallback
 }
+
+func getEnvInt(key string, fallback int) int {
+	val := os.Getenv(key)
+	if val == "" {
+		return fallback
+	}
+
+	i, err := strconv.Atoi(val)
+	if err != nil {
+		log.Fatalf("environment variable %s must be an integer: %v", key, err)
+	}
+	return i
+}
+
+func getEnvBool(key string, fallback bool) bool {
+	val := os.Getenv(key)
+	if val == "" {
+		return fallback
+	}
+
+	b, err := strconv.ParseBool(val)
+	if err != nil {
+		log.Fatalf("environment variable %s must be a boolean: %v", key, err)
+	}
+	return b
+}
+
+func getEnvDuration(key string, fallback time.Duration) time.Duration {
+	val := os.Getenv(key)
+	if val == "" {
+		return fallback
+	}
+
+	d, err := time.ParseDuration(val)
+	if err != nil {
+		log.Fatalf("environment variable %s must be a duration: %v", key, err)
+	}
+	return d
+}
+
+// getEnvList splits a comma separated variable, ignoring empty entries
+func getEnvList(key string) []string {
+	var list []string
+	for _, item := range strings.Split(os.Getenv(key), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+func mustBeOneOf(key, val string, allowed ...string) string {
+	if !slices.Contains(allowed, val) {
+		log.Fatalf("environment variable %s must be one of %s, got %q", key, strings.Join(allowed, ", "), val)
+	}
+	return val
+}
@@ -5,17 +5,24 @@ import (
 	"testing"
 )
 
-func setDBEnvVars(t *testing.T) {
+// setRequiredEnvVars sets every variable Load requires
+func setRequiredEnvVars(t *testing.T) {
 	t.Helper()
-	t.Setenv("DB_HOST", "localhost")
-	t.Setenv("DB_PORT", "5432")
-	t.Setenv("DB_USER", "testuser")
-	t.Setenv("DB_PASSWORD", "testpass")
-	t.Setenv("DB_NAME", "testdb")
+	t.Setenv("POSTGRES_HOST", "localhost")
+	t.Setenv("POSTGRES_PORT", "5432")
+	t.Setenv("POSTGRES_USER", "testuser")
+	t.Setenv("POSTGRES_PASSWORD", "testpass")
+	t.Setenv("POSTGRES_DB", "testdb")
+	t.Setenv("REDIS_HOST", "localhost")
+	t.Setenv("REDIS_PORT", "6379")
+	t.Setenv("SMTP_HOST", "localhost")
+	t.Setenv("SMTP_PORT", "1025")
+	t.Setenv("SMTP_FROM", "noreply@example.com")
+	t.Setenv("APP_FRONTEND_URL", "http://localhost:5173")
 }
 
 func TestLoad_AllEnvVarsSet(t *testing.T) {
-	setDBEnvVars(t)
+	setRequiredEnvVars(t)
 	t.Setenv("APP_PORT", "9090")
 
 	cfg := Load()
@@ -41,7 +48,7 @@ func TestLoad_AllEnvVarsSet(t *testing.T) {
 }
 
 func TestLoad_AppPortDefault(t *testing.T) {
-	setDBEnvVars(t)
+	setRequiredEnvVars(t)
 	os.Unsetenv("APP_PORT")
 
 	cfg := Load()
@@ -51,6 +58,95 @@ func TestLoad_AppPortDefault(t *testing.T) {
 	}
 }
 
+func TestLoad_RegistrationDefaults(t *testing.T) {
+	setRequiredEnvVars(t)
+	os.Unsetenv("REGISTRATION_MODE")
+	os.Unsetenv("ADMIN_EMAILS")
+
+	cfg := Load()
+
+	if cfg.Registration.Mode != "open" {
+		t.Errorf("expected default Registration.Mode 'open', got %q", cfg.Registration.Mode)
+	}
+	if len(cfg.AdminEmails) != 0 {
+		t.Errorf("expected no AdminEmails, got %v", cfg.AdminEmails)
+	}
+}
+
+func TestLoad_RegistrationDomainAllowlist(t *testing.T) {
+	setRequiredEnvVars(t)
+	t.Setenv("REGISTRATION_MODE", "domain-allowlist")
+	t.Setenv("REGISTRATION_ALLOWED_DOMAINS", "example.com, corp.example.org ,")
+	t.Setenv("ADMIN_EMAILS", "admin@example.com")
+
+	cfg := Load()
+
+	if cfg.Registration.Mode != "domain-allowlist" {
+		t.Errorf("expected Registration.Mode 'domain-allowlist', got %q", cfg.Registration.Mode)
+	}
+	expected := []string{"example.com", "corp.example.org"}
+	if len(cfg.Registration.AllowedDomains) != len(expected) {
+		t.Fatalf("expected AllowedDomains %v, got %v", expected, cfg.Registration.AllowedDomains)
+	}
+	for i := range expected {
+		if cfg.Registration.AllowedDomains[i] != expected[i] {
+			t.Errorf("expected AllowedDomains[%d] %q, got %q", i, expected[i], cfg.Registration.AllowedDomains[i])
+		}
+	}
+	if len(cfg.AdminEmails) != 1 || cfg.AdminEmails[0] != "admin@example.com" {
+		t.Errorf("expected AdminEmails [admin@example.com], got %v", cfg.AdminEmails)
+	}
+}
+
+func TestLoad_NotifierWithoutSMTP(t *testing.T) {
+	setRequiredEnvVars(t)
+	t.Setenv("SMTP_HOST", "")
+	t.Setenv("SMTP_PORT", "")
+	t.Setenv("NOTIFIERS", "console,file")
+
+	cfg := Load()
+
+	expected := []string{"console", "file"}
+	if len(cfg.Notifier.Backends) != len(expected) {
+		t.Fatalf("expected Notifier.Backends %v, got %v", expected, cfg.Notifier.Backends)
+	}
+	for i := range expected {
+		if cfg.Notifier.Backends[i] != expected[i] {
+			t.Errorf("expected Notifier.Backends[%d] %q, got %q", i, expected[i], cfg.Notifier.Backends[i])
+		}
+	}
+	if cfg.Notifier.FileDir != "./mail" {
+		t.Errorf("expected default Notifier.FileDir './mail', got %q", cfg.Notifier.FileDir)
+	}
+}
+
+func TestLoad_NotifierDefault(t *testing.T) {
+	setRequiredEnvVars(t)
+	os.Unsetenv("NOTIFIERS")
+
+	cfg := Load()
+
+	if len(cfg.Notifier.Backends) != 1 || cfg.Notifier.Backends[0] != "smtp" {
+		t.Errorf("expected default Notifier.Backends [smtp], got %v", cfg.Notifier.Backends)
+	}
+}
+
+func TestLoad_NotifierWebhook(t *testing.T) {
+	setRequiredEnvVars(t)
+	t.Setenv("NOTIFIERS", "webhook")
+	t.Setenv("NOTIFIER_WEBHOOK_URL", "https://hooks.example.com/notify")
+	t.Setenv("NOTIFIER_WEBHOOK_SECRET", "secret")
+
+	cfg := Load()
+
+	if cfg.Notifier.WebhookURL != "https://hooks.example.com/notify" {
+		t.Errorf("expected Notifier.WebhookURL to be loaded, got %q", cfg.Notifier.WebhookURL)
+	}
+	if cfg.Notifier.WebhookSecret != "secret" {
+		t.Errorf("expected Notifier.WebhookSecret 'secret', got %q", cfg.Notifier.WebhookSecret)
+	}
+}
+
 func TestConnString(t *testing.T) {
 	dbCfg := DBConfig{
 		Host:     "myhost",
@@ -94,3 +190,12 @@ func TestGetEnv_EmptyUseFallback(t *testing.T) {
 		t.Errorf("expected 'fallback' for empty env var, got %q", val)
 	}
 }
+
+func TestGetEnvList_Empty(t *testing.T) {
+	os.Unsetenv("TEST_GET_ENV_LIST")
+
+	val := getEnvList("TEST_GET_ENV_LIST")
+	if len(val) != 0 {
+		t.Errorf("expected empty list, got %v", val)
+	}
+}
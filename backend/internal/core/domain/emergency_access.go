@@ -0,0 +1,105 @@
+package domain
+
+import "time"
+
+// EmergencyAccessMode is what the grantee can do with the vault once the
+// access is granted
+type EmergencyAccessMode string
+
+const (
+	// EmergencyAccessView reads the vault
+	EmergencyAccessView EmergencyAccessMode = "view"
+	// EmergencyAccessTakeover manages the vault like a member with the manage
+	// role, the grantor stays its owner
+	EmergencyAccessTakeover EmergencyAccessMode = "takeover"
+)
+
+func (m EmergencyAccessMode) Valid() bool {
+	return m == EmergencyAccessView || m == EmergencyAccessTakeover
+}
+
+// VaultRole is the role the grantee acts with on the vault
+func (m EmergencyAccessMode) VaultRole() VaultRole {
+	if m == EmergencyAccessTakeover {
+		return VaultRoleManage
+	}
+	return VaultRoleRead
+}
+
+type EmergencyAccessStatus string
+
+const (
+	// EmergencyAccessInvited until the grantee accepts to be a trusted contact
+	EmergencyAccessInvited EmergencyAccessStatus = "invited"
+	// EmergencyAccessAccepted grantees can request the access
+	EmergencyAccessAccepted EmergencyAccessStatus = "accepted"
+	// EmergencyAccessRequested is granted when the grantor approves, or
+	// after the waiting period unless they reject it
+	EmergencyAccessRequested EmergencyAccessStatus = "requested"
+	EmergencyAccessGranted   EmergencyAccessStatus = "granted"
+)
+
+// EmergencyAccess lets a trusted contact, the grantee, into a vault of the
+// grantor when the grantor doesn't answer. WrappedKey is the vault key
+// wrapped with version UserKeyVersion of the public key of the grantee, it
+// is only handed out once the access is granted.
+type EmergencyAccess struct {
+	// ID is the public ID of the emergency access
+	ID           string
+	GrantorID    string
+	GrantorEmail string
+	GrantorName  string
+	GranteeID    string
+	GranteeEmail string
+	GranteeName  string
+	VaultID      string
+	VaultName    string
+	Mode         EmergencyAccessMode
+	Status       EmergencyAccessStatus
+	// WaitPeriod is how long a request waits for the grantor
+	WaitPeriod time.Duration
+	WrappedKey []byte
+	// KeyVersion is the version of the vault key WrappedKey holds, a
+	// rotation of the vault key leaves it behind until the grantor wraps
+	// the new key
+	KeyVersion     int
+	UserKeyVersion int
+	RequestedAt    time.Time
+	CreatedAt      time.Time
+}
+
+// GrantsAt is when a pending request is granted, zero when there is none
+func (a *EmergencyAccess) GrantsAt() time.Time {
+	if a.Status != EmergencyAccessRequested {
+		return time.Time{}
+	}
+	return a.RequestedAt.Add(a.WaitPeriod)
+}
+
+// VaultMember is the membership the grantee acts with on the vault
+func (a *EmergencyAccess) VaultMember() *VaultMember {
+	return &VaultMember{
+		ID:             a.ID,
+		VaultID:        a.VaultID,
+		UserID:         a.GranteeID,
+		Email:          a.GranteeEmail,
+		Name:           a.GranteeName,
+		Role:           a.Mode.VaultRole(),
+		Status:         VaultMemberAccepted,
+		WrappedKey:     a.WrappedKey,
+		KeyVersion:     a.KeyVersion,
+		UserKeyVersion: a.UserKeyVersion,
+		CreatedAt:      a.CreatedAt,
+		AcceptedAt:     a.RequestedAt,
+	}
+}
+
+// EmergencyAccessRequest is what the grantor is told when a grantee requests
+// the access
+type EmergencyAccessRequest struct {
+	AccessID    string
+	Contact     string
+	Vault       string
+	RequestedAt time.Time
+	WaitDays    int
+}
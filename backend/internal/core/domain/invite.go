@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Invite struct {
+	ID          string
+	PublicID    uuid.UUID
+	Email       string
+	CreatedBy   string
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+	UsedAt      time.Time
+	UsedByEmail string
+	RevokedAt   time.Time
+}
+
+// InviteToken is only available right after the invite is created,
+// the repository keeps the hash of the token.
+type InviteToken struct {
+	Invite
+	Token string
+}
+
+func (i *Invite) IsUsable() bool {
+	return i.UsedAt.IsZero() && i.RevokedAt.IsZero() && time.Now().Before(i.ExpiresAt)
+}
@@ -0,0 +1,139 @@
+package domain
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OrganizationRole is what a member can do in an organization, every role
+// includes the ones before it
+type OrganizationRole string
+
+const (
+	OrganizationRoleMember OrganizationRole = "member"
+	OrganizationRoleAdmin  OrganizationRole = "admin"
+	OrganizationRoleOwner  OrganizationRole = "owner"
+)
+
+var organizationRoleRanks = map[OrganizationRole]int{
+	OrganizationRoleMember: 1,
+	OrganizationRoleAdmin:  2,
+	OrganizationRoleOwner:  3,
+}
+
+func (r OrganizationRole) Valid() bool {
+	return organizationRoleRanks[r] > 0
+}
+
+// Allows tells whether the role includes required
+func (r OrganizationRole) Allows(required OrganizationRole) bool {
+	return r.Valid() && organizationRoleRanks[r] >= organizationRoleRanks[required]
+}
+
+type Organization struct {
+	// ID is the public ID of the organization
+	ID        string
+	Name      string
+	CreatedAt time.Time
+	// Membership is the one of the user the organizations were listed for
+	Membership *OrganizationMember
+}
+
+type OrganizationMember struct {
+	// ID is the public ID of the membership
+	ID             string
+	OrganizationID string
+	UserID         string
+	UserPublicID   uuid.UUID
+	Email          string
+	Name           string
+	Role           OrganizationRole
+	CreatedAt      time.Time
+}
+
+type OrganizationInvite struct {
+	// ID is the public ID of the invite
+	ID               string
+	OrganizationID   string
+	OrganizationName string
+	Email            string
+	Role             OrganizationRole
+	InvitedBy        string
+	CreatedAt        time.Time
+	ExpiresAt        time.Time
+}
+
+// OrganizationInviteToken is only available right after the invite is
+// created, the repository keeps the hash of the token.
+type OrganizationInviteToken struct {
+	OrganizationInvite
+	Token string
+}
+
+func (i *OrganizationInvite) IsUsable() bool {
+	return i != nil && time.Now().Before(i.ExpiresAt)
+}
+
+type OrganizationGroup struct {
+	// ID is the public ID of the group
+	ID             string
+	OrganizationID string
+	Name           string
+	// MemberIDs are the public IDs of the memberships in the group
+	MemberIDs []string
+	CreatedAt time.Time
+}
+
+// OrganizationPolicies are the rules an organization sets for its members.
+// The zero value sets none.
+type OrganizationPolicies struct {
+	Require2FA bool
+	// MinKDFIterations is the minimum work factor of the password hash,
+	// 0 for the server default
+	MinKDFIterations int
+	// AllowedEmailDomains restricts the emails of the members to these
+	// domains and their subdomains, any domain when empty
+	AllowedEmailDomains []string
+	// SessionMaxLifetime is how long a login lasts across refreshes, 0 for
+	// no limit
+	SessionMaxLifetime time.Duration
+}
+
+// AllowsEmail reports whether the email is in one of the allowed domains
+func (p OrganizationPolicies) AllowsEmail(email string) bool {
+	if len(p.AllowedEmailDomains) == 0 {
+		return true
+	}
+	return RegistrationPolicy{AllowedDomains: p.AllowedEmailDomains}.IsDomainAllowed(email)
+}
+
+// MergeOrganizationPolicies returns the strictest of the policies, the ones
+// a member of all those organizations has to follow. The email domains
+// aren't merged, an email must be allowed by each organization on its own.
+func MergeOrganizationPolicies(policies []OrganizationPolicies) OrganizationPolicies {
+	var merged OrganizationPolicies
+	for _, p := range policies {
+		merged.Require2FA = merged.Require2FA || p.Require2FA
+		merged.MinKDFIterations = max(merged.MinKDFIterations, p.MinKDFIterations)
+		if p.SessionMaxLifetime > 0 && (merged.SessionMaxLifetime == 0 || p.SessionMaxLifetime < merged.SessionMaxLifetime) {
+			merged.SessionMaxLifetime = p.SessionMaxLifetime
+		}
+	}
+	return merged
+}
+
+// NormalizeEmailDomains lowercases the domains and drops a leading @, blanks
+// and duplicates
+func NormalizeEmailDomains(domains []string) []string {
+	normalized := make([]string, 0, len(domains))
+	for _, d := range domains {
+		d = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(d), "@"))
+		if d != "" && !slices.Contains(normalized, d) {
+			normalized = append(normalized, d)
+		}
+	}
+	return normalized
+}
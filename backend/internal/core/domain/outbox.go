@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type NotificationKind string
+
+const (
+	NotificationRegistrationIntent  NotificationKind = "registration_intent"
+	NotificationRegistrationSuccess NotificationKind = "registration_success"
+	NotificationInvite              NotificationKind = "invite"
+	NotificationOrganizationInvite  NotificationKind = "organization_invite"
+	NotificationEmergencyAccess     NotificationKind = "emergency_access_request"
+)
+
+// NotificationPayload is what the outbox stores to replay a notifier call
+type NotificationPayload struct {
+	Recipient Recipient
+	Code      string
+	// UserID is the public ID of the user a registration succeeded for
+	UserID          string                  `json:",omitempty"`
+	Organization    string                  `json:",omitempty"`
+	EmergencyAccess *EmergencyAccessRequest `json:",omitempty"`
+}
+
+type OutboxStatus string
+
+const (
+	OutboxStatusPending OutboxStatus = "pending"
+	OutboxStatusSent    OutboxStatus = "sent"
+	OutboxStatusDead    OutboxStatus = "dead"
+)
+
+type OutboxMessage struct {
+	ID             string
+	PublicID       uuid.UUID
+	IdempotencyKey string
+	Kind           NotificationKind
+	Payload        []byte
+	Status         OutboxStatus
+	Attempts       int
+	LastError      string
+	NextAttemptAt  time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	SentAt         time.Time
+}
+
+// RecipientEmail returns the recipient stored in the payload, sent messages
+// have their payload cleared.
+func (m *OutboxMessage) RecipientEmail() string {
+	var payload NotificationPayload
+	if err := json.Unmarshal(m.Payload, &payload); err != nil {
+		return ""
+	}
+	return payload.Recipient.Email
+}
+
+type deliveryIDContextKey struct{}
+
+// WithDeliveryID attaches a stable id to a notification delivery, so retries
+// of the same message can be recognized by the receiving side.
+func WithDeliveryID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, deliveryIDContextKey{}, id)
+}
+
+func DeliveryID(ctx context.Context) string {
+	id, _ := ctx.Value(deliveryIDContextKey{}).(string)
+	return id
+}
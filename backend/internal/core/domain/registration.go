@@ -0,0 +1,47 @@
+package domain
+
+import (
+	"strings"
+)
+
+type RegistrationMode string
+
+const (
+	RegistrationModeOpen            RegistrationMode = "open"
+	RegistrationModeInviteOnly      RegistrationMode = "invite-only"
+	RegistrationModeDomainAllowlist RegistrationMode = "domain-allowlist"
+)
+
+type RegistrationPolicy struct {
+	Mode           RegistrationMode
+	AllowedDomains []string
+	// AdminEmails can always register, so an invite-only instance can be bootstrapped
+	AdminEmails []string
+}
+
+func (p RegistrationPolicy) IsAdminEmail(email string) bool {
+	for _, admin := range p.AdminEmails {
+		if strings.EqualFold(admin, email) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsDomainAllowed reports whether the email belongs to one of the allowed
+// domains or to one of their subdomains.
+func (p RegistrationPolicy) IsDomainAllowed(email string) bool {
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return false
+	}
+	emailDomain := strings.ToLower(email[at+1:])
+
+	for _, allowed := range p.AllowedDomains {
+		allowed = strings.ToLower(strings.TrimPrefix(allowed, "@"))
+		if emailDomain == allowed || strings.HasSuffix(emailDomain, "."+allowed) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SecurityEventType string
+
+const (
+	SecurityEventLogin        SecurityEventType = "login"
+	SecurityEventLoginFailed  SecurityEventType = "login_failed"
+	SecurityEventTokenRefresh SecurityEventType = "token_refresh"
+	SecurityEventLogout       SecurityEventType = "logout"
+	SecurityEventRegistration SecurityEventType = "registration"
+	SecurityEventVaultWrite   SecurityEventType = "vault_write"
+)
+
+// SecurityEvent is an entry of the append-only audit log. UserID is the
+// account the event belongs to, ActorID who performed it, both can be empty.
+type SecurityEvent struct {
+	ID        string
+	PublicID  uuid.UUID
+	UserID    string
+	ActorID   string
+	Type      SecurityEventType
+	IP        string
+	UserAgent string
+	DeviceID  string
+	Metadata  map[string]string
+	CreatedAt time.Time
+}
+
+// SecurityEventFilter selects a page of a user's events, newest first. Zero
+// values don't filter, After is the public id of the last event of the
+// previous page.
+type SecurityEventFilter struct {
+	Types []SecurityEventType
+	Since time.Time
+	Until time.Time
+	After string
+	Limit int
+}
+
+// ClientInfo describes who is on the other side of the request
+type ClientInfo struct {
+	IP        string
+	UserAgent string
+	DeviceID  string
+}
+
+type clientInfoContextKey struct{}
+
+func WithClientInfo(ctx context.Context, info ClientInfo) context.Context {
+	return context.WithValue(ctx, clientInfoContextKey{}, info)
+}
+
+func ClientInfoFromContext(ctx context.Context) ClientInfo {
+	info, _ := ctx.Value(clientInfoContextKey{}).(ClientInfo)
+	return info
+}
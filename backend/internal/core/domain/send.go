@@ -0,0 +1,24 @@
+package domain
+
+import "time"
+
+// Send is a secret shared through a link with people without an account.
+// The payload is encrypted by the client, the key stays in the fragment of
+// the link and never reaches the server. A send is deleted on its last view
+// or when it expires.
+type Send struct {
+	ID     string
+	UserID string
+	// Payload is left out of the listings
+	Payload []byte
+	// PasswordHash is "" when the send doesn't need a password
+	PasswordHash string
+	MaxViews     int
+	ViewsLeft    int
+	ExpiresAt    time.Time
+	CreatedAt    time.Time
+}
+
+func (s *Send) HasPassword() bool {
+	return s.PasswordHash != ""
+}
@@ -22,6 +22,18 @@ type RefreshSession struct {
 	ExpiresAt time.Time
 	RevokedAt time.Time
 	DeviceID  string
+	// AuthenticatedAt is the time of the login the session was refreshed
+	// from, it's carried over when the token is rotated
+	AuthenticatedAt time.Time
+}
+
+// LoginTime is AuthenticatedAt, or CreatedAt for the sessions stored before
+// it was recorded
+func (s *RefreshSession) LoginTime() time.Time {
+	if s.AuthenticatedAt.IsZero() {
+		return s.CreatedAt
+	}
+	return s.AuthenticatedAt
 }
 
 type AccessSessionLight struct {
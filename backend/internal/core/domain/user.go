@@ -12,5 +12,17 @@ type User struct {
 	Name         string
 	Email        string
 	PasswordHash string
+	Locale       string
 	CreatedAt    time.Time
 }
+
+// Recipient is who a notification is addressed to
+type Recipient struct {
+	Email  string
+	Name   string
+	Locale string
+}
+
+func (u *User) Recipient() Recipient {
+	return Recipient{Email: u.Email, Name: u.Name, Locale: u.Locale}
+}
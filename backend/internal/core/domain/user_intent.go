@@ -4,9 +4,15 @@ type RegistrationIntentUser struct {
 	Name         string
 	PasswordHash string
 	Email        string
+	Locale       string
 	Code         string
+	InviteID     string
 }
 
 type RegistrationIntentToken struct {
 	Code string
 }
+
+func (u *RegistrationIntentUser) Recipient() Recipient {
+	return Recipient{Email: u.Email, Name: u.Name, Locale: u.Locale}
+}
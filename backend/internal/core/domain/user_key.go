@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type UserKeyAlgorithm string
+
+const (
+	// UserKeyX25519 keys are the raw 32 bytes of the public key
+	UserKeyX25519 UserKeyAlgorithm = "x25519"
+	// UserKeyECDHP256 and UserKeyRSAOAEP256 keys are DER encoded SPKI, as
+	// exported by Web Crypto
+	UserKeyECDHP256   UserKeyAlgorithm = "ecdh-p256"
+	UserKeyRSAOAEP256 UserKeyAlgorithm = "rsa-oaep-256"
+)
+
+// UserKey is a version of the keypair a user shares with. The private key is
+// encrypted by the client with a key derived from the master password, the
+// server only stores it for the other devices of the user.
+type UserKey struct {
+	UserID              string
+	Version             int
+	Algorithm           UserKeyAlgorithm
+	PublicKey           []byte
+	EncryptedPrivateKey []byte
+	Fingerprint         string
+	CreatedAt           time.Time
+}
+
+// PublicKey is the directory entry of a user, what others encrypt for them with
+type PublicKey struct {
+	UserID      uuid.UUID
+	Version     int
+	Algorithm   UserKeyAlgorithm
+	PublicKey   []byte
+	Fingerprint string
+	CreatedAt   time.Time
+}
+
+// KeyFingerprint is the hex encoded SHA-256 of a public key, users compare
+// it out of band to make sure the server handed out the right key
+func KeyFingerprint(publicKey []byte) string {
+	digest := sha256.Sum256(publicKey)
+	return hex.EncodeToString(digest[:])
+}
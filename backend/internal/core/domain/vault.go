@@ -1,12 +1,172 @@
 package domain
 
 import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"strconv"
+	"strings"
 	"time"
 )
 
+type VaultType string
+
+const (
+	VaultTypePersonal VaultType = "personal"
+	VaultTypeWork     VaultType = "work"
+	VaultTypeOther    VaultType = "other"
+)
+
+// DefaultVaultName is the name of the vault created for the /user/vault endpoints
+const DefaultVaultName = "Personal"
+
 type Vault struct {
-	UserID    int32
-	Vault     []byte
-	CreatedAt time.Time
+	// ID is the public ID of the vault
+	ID     string
+	UserID string
+	Name   string
+	Type   VaultType
+	// Default is the vault the /user/vault endpoints refer to
+	Default bool
+	Vault   []byte
+	// Revision starts at 1 and grows by one on every write, it is 0 until
+	// the first write
+	Revision int64
+	// Size of Vault in bytes, also set when the content isn't loaded
+	Size int
+	// SHA256 is the hex encoded digest of Vault
+	SHA256 string
+	// KeyVersion counts the rotations of the key of a shared vault, 0 for
+	// vaults that aren't shared
+	KeyVersion int
+	// RotationRequired is set when a member who had the key left
+	RotationRequired bool
+	// OrganizationID is the public ID of the organization the vault is
+	// shared in, "" for the vaults of a user
+	OrganizationID string
+	// Membership is the one of the user the vaults were listed for
+	Membership *VaultMember
+	CreatedAt  time.Time
+	// UpdatedAt is the time of the last write of the content
 	UpdatedAt time.Time
 }
+
+// HasContent is false for a vault that was created but never written
+func (v *Vault) HasContent() bool {
+	return v != nil && v.Revision > 0
+}
+
+func (v *Vault) OwnedBy(userID string) bool {
+	return v != nil && v.UserID == userID
+}
+
+// VaultETag formats the strong entity tag of a vault, "0" when there is no
+// vault or it has no content yet. It starts with the revision, which
+// If-Match is compared on, followed by a digest of the content hash and
+// the update time.
+func VaultETag(vault *Vault) string {
+	if !vault.HasContent() {
+		return `"0"`
+	}
+
+	digest := sha256.Sum256([]byte(vault.SHA256 + "@" + strconv.FormatInt(vault.UpdatedAt.UnixNano(), 10)))
+	return `"` + strconv.FormatInt(vault.Revision, 10) + "-" + hex.EncodeToString(digest[:8]) + `"`
+}
+
+// AnyVaultRevision is the revision If-Match: * expects, it matches any
+// current content but not a vault that was never written (RFC 9110)
+const AnyVaultRevision int64 = -1
+
+// VaultRevisionMatches tells whether a vault at revision is at the expected
+// one, which may be AnyVaultRevision
+func VaultRevisionMatches(revision, expected int64) bool {
+	if expected == AnyVaultRevision {
+		return revision > 0
+	}
+	return revision == expected
+}
+
+// ParseVaultETag returns the revision of an entity tag made by VaultETag, a
+// bare revision like "3" is also accepted, and AnyVaultRevision for "*".
+// Weak tags never match a revision.
+func ParseVaultETag(etag string) (int64, bool) {
+	etag = strings.TrimSpace(etag)
+	if etag == "*" {
+		return AnyVaultRevision, true
+	}
+	if len(etag) < 2 || etag[0] != '"' || etag[len(etag)-1] != '"' {
+		return 0, false
+	}
+	value, _, _ := strings.Cut(etag[1:len(etag)-1], "-")
+	revision, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || revision < 0 {
+		return 0, false
+	}
+	return revision, true
+}
+
+// VaultContentDigest formats a hex encoded SHA-256 digest as the value of a
+// Content-Digest header (RFC 9530), "" when it isn't one
+func VaultContentDigest(sha256Hex string) string {
+	digest, err := hex.DecodeString(sha256Hex)
+	if err != nil || len(digest) != sha256.Size {
+		return ""
+	}
+	return "sha-256=:" + base64.StdEncoding.EncodeToString(digest) + ":"
+}
+
+// ParseContentDigest returns the hex encoded SHA-256 digest of a
+// Content-Digest header. Other algorithms may be listed too but are ignored,
+// false means there is no valid sha-256 one.
+func ParseContentDigest(header string) (string, bool) {
+	for member := range strings.SplitSeq(header, ",") {
+		alg, value, ok := strings.Cut(strings.TrimSpace(member), "=")
+		if !ok || strings.ToLower(alg) != "sha-256" {
+			continue
+		}
+		if len(value) < 2 || value[0] != ':' || value[len(value)-1] != ':' {
+			return "", false
+		}
+		digest, err := base64.StdEncoding.DecodeString(value[1 : len(value)-1])
+		if err != nil || len(digest) != sha256.Size {
+			return "", false
+		}
+		return hex.EncodeToString(digest), true
+	}
+	return "", false
+}
+
+// VaultContent is the content of a vault being written, read once
+type VaultContent struct {
+	Reader io.Reader
+	// Size is -1 when it isn't known up front
+	Size int64
+	// SHA256 is the hex encoded digest the content has to match, "" when the
+	// writer didn't send one
+	SHA256 string
+}
+
+// StagedVaultContent is written content that no vault refers to yet
+type StagedVaultContent struct {
+	// Key locates the content in storage
+	Key  string
+	Size int
+	// SHA256 is the hex encoded digest of the plaintext
+	SHA256    string
+	Encrypted bool
+}
+
+// VaultVersion is a past or current revision of a user's vault
+type VaultVersion struct {
+	VaultID  string
+	UserID   string
+	Revision int64
+	// Vault is only loaded when downloading a single version
+	Vault []byte
+	Size  int
+	// SHA256 is the hex encoded digest of Vault
+	SHA256    string
+	DeviceID  string
+	CreatedAt time.Time
+}
@@ -0,0 +1,28 @@
+package domain
+
+import "time"
+
+type VaultEventType string
+
+const (
+	// VaultEventUpdated is published on every write of the vault or its items
+	VaultEventUpdated VaultEventType = "vault.updated"
+	// VaultEventSessionRevoked tells the clients of a device to lock
+	VaultEventSessionRevoked VaultEventType = "session.revoked"
+)
+
+// VaultEvent is pushed to the connected clients of a user. The ID is given
+// by the event bus and grows with every event of the user.
+type VaultEvent struct {
+	ID     int64          `json:"id"`
+	UserID string         `json:"userId"`
+	Type   VaultEventType `json:"type"`
+	// VaultID is the vault written, its content is at Revision
+	VaultID string `json:"vaultId,omitempty"`
+	// Revision of the vault blob after a write
+	Revision int64 `json:"revision,omitempty"`
+	// Cursor of the item sync after a write
+	Cursor    int64     `json:"cursor,omitempty"`
+	DeviceID  string    `json:"deviceId,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+}
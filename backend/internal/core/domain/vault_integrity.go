@@ -0,0 +1,36 @@
+package domain
+
+// VaultIntegrityStatus is the outcome of verifying a vault that didn't match
+// its digest
+type VaultIntegrityStatus string
+
+const (
+	// VaultCorrupted vaults were left as they are
+	VaultCorrupted VaultIntegrityStatus = "corrupted"
+	// VaultRestored vaults were rolled back to their newest intact version
+	VaultRestored VaultIntegrityStatus = "restored"
+	// VaultUnreadable vaults couldn't be read, which may not be corruption
+	VaultUnreadable VaultIntegrityStatus = "unreadable"
+)
+
+// VaultIntegrityProblem is a vault whose stored content doesn't hash to the
+// digest it was written with
+type VaultIntegrityProblem struct {
+	VaultID  string
+	UserID   string
+	Revision int64
+	Status   VaultIntegrityStatus
+	Error    string
+	// RestoredFrom is the version the vault was rolled back to, written as
+	// RestoredRevision
+	RestoredFrom     int64
+	RestoredRevision int64
+}
+
+// VaultIntegrityReport is the result of verifying a batch of vaults
+type VaultIntegrityReport struct {
+	Checked  int
+	Problems []VaultIntegrityProblem
+	// Next is the cursor of the next batch, "" once all vaults were verified
+	Next string
+}
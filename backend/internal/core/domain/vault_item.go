@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// VaultItem is an opaque encrypted entry of a vault, the server never sees
+// its content. Deleted items keep their id and revision as tombstones.
+type VaultItem struct {
+	ID       uuid.UUID
+	UserID   int32
+	Revision int64
+	Data     []byte
+	Deleted  bool
+	// Seq orders the changes of a user, sync cursors are sequence numbers
+	Seq       int64
+	UpdatedAt time.Time
+}
+
+// VaultItemChange is an upsert or delete sent by a client. BaseRevision is
+// the revision the change was made on, 0 for a new item.
+type VaultItemChange struct {
+	ID           uuid.UUID
+	BaseRevision int64
+	Data         []byte
+	Deleted      bool
+}
+
+// VaultItemResult tells whether a change was applied, on a conflict Current
+// is the item the client has to merge with
+type VaultItemResult struct {
+	ID       uuid.UUID
+	Applied  bool
+	Revision int64
+	Current  *VaultItem
+}
+
+type VaultSyncResult struct {
+	Results []VaultItemResult
+	// Items changed after the requested cursor, in order
+	Items   []VaultItem
+	Cursor  int64
+	HasMore bool
+}
@@ -0,0 +1,75 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// VaultRole is what a member can do with a vault, every role includes the
+// ones before it
+type VaultRole string
+
+const (
+	VaultRoleRead   VaultRole = "read"
+	VaultRoleWrite  VaultRole = "write"
+	VaultRoleManage VaultRole = "manage"
+)
+
+var vaultRoleRanks = map[VaultRole]int{
+	VaultRoleRead:   1,
+	VaultRoleWrite:  2,
+	VaultRoleManage: 3,
+}
+
+func (r VaultRole) Valid() bool {
+	return vaultRoleRanks[r] > 0
+}
+
+// Allows tells whether the role includes required
+func (r VaultRole) Allows(required VaultRole) bool {
+	return r.Valid() && vaultRoleRanks[r] >= vaultRoleRanks[required]
+}
+
+type VaultMemberStatus string
+
+const (
+	VaultMemberInvited  VaultMemberStatus = "invited"
+	VaultMemberAccepted VaultMemberStatus = "accepted"
+)
+
+// VaultMember gives a user access to a vault. The owner of a vault is a
+// member with the manage role. WrappedKey is the vault key wrapped with
+// version UserKeyVersion of the public key of the member, nil until the
+// vault is shared.
+type VaultMember struct {
+	// ID is the public ID of the membership
+	ID           string
+	VaultID      string
+	UserID       string
+	UserPublicID uuid.UUID
+	Email        string
+	Name         string
+	Role         VaultRole
+	Status       VaultMemberStatus
+	WrappedKey   []byte
+	// KeyVersion is the version of the vault key WrappedKey holds
+	KeyVersion     int
+	UserKeyVersion int
+	// InvitedBy is only set when inviting
+	InvitedBy  string
+	CreatedAt  time.Time
+	AcceptedAt time.Time
+}
+
+// Accepted is false for a nil member and for pending invitations
+func (m *VaultMember) Accepted() bool {
+	return m != nil && m.Status == VaultMemberAccepted
+}
+
+// VaultMemberKey is the vault key wrapped for a member by a rotation
+type VaultMemberKey struct {
+	MemberID       string
+	WrappedKey     []byte
+	UserKeyVersion int
+}
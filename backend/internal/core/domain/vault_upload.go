@@ -0,0 +1,18 @@
+package domain
+
+import "time"
+
+// VaultUpload is a resumable vault upload, the vault is received in chunks
+// and stored once complete
+type VaultUpload struct {
+	ID     string
+	UserID string
+	// VaultID is the vault the upload is stored to, "" for the default one
+	VaultID string
+	// Size and SHA256 (hex) are announced by the client for the whole vault
+	Size   int64
+	SHA256 string
+	// Offset is the number of bytes received so far
+	Offset    int64
+	ExpiresAt time.Time
+}
@@ -0,0 +1,15 @@
+package domain
+
+// VaultUsage is the storage taken by a user, in bytes
+type VaultUsage struct {
+	// VaultBytes is the size of the current vault
+	VaultBytes int64
+	// HistoryBytes is the size of the kept versions, the current one excluded
+	HistoryBytes int64
+	// ItemBytes is the size of the synced items, tombstones included
+	ItemBytes int64
+}
+
+func (u VaultUsage) Total() int64 {
+	return u.VaultBytes + u.HistoryBytes + u.ItemBytes
+}
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WebhookScope string
+
+const (
+	// WebhookScopeUser receives the events of the subscription owner
+	WebhookScopeUser WebhookScope = "user"
+	// WebhookScopeInstance receives the events of every user, admins only
+	WebhookScopeInstance WebhookScope = "instance"
+)
+
+type WebhookSubscription struct {
+	ID       string
+	PublicID uuid.UUID
+	UserID   string
+	Scope    WebhookScope
+	URL      string
+	Secret   string
+	// Events the subscription wants, empty for all of them
+	Events    []SecurityEventType
+	CreatedAt time.Time
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryDead      WebhookDeliveryStatus = "dead"
+)
+
+// WebhookDelivery is one attempt chain of sending an event to a subscription.
+// URL and Secret are only loaded for the worker.
+type WebhookDelivery struct {
+	ID             string
+	PublicID       uuid.UUID
+	SubscriptionID string
+	URL            string
+	Secret         string
+	EventType      SecurityEventType
+	Payload        []byte
+	Status         WebhookDeliveryStatus
+	Attempts       int
+	ResponseStatus int
+	LastError      string
+	NextAttemptAt  time.Time
+	CreatedAt      time.Time
+	DeliveredAt    time.Time
+}
+
+// WebhookEvent is the JSON body POSTed to the subscribers
+type WebhookEvent struct {
+	ID        string            `json:"id"`
+	Type      SecurityEventType `json:"type"`
+	CreatedAt int64             `json:"createdAt"`
+	User      *WebhookEventUser `json:"user,omitempty"`
+	IP        string            `json:"ip,omitempty"`
+	DeviceID  string            `json:"deviceId,omitempty"`
+	Metadata  map[string]string `json:"metadata,omitempty"`
+}
+
+type WebhookEventUser struct {
+	ID    string `json:"id"`
+	Email string `json:"email"`
+}
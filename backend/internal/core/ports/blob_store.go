@@ -0,0 +1,21 @@
+package ports
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+var ErrBlobNotFound = errors.New("Blob not found")
+
+// BlobStore keeps the vault contents out of the database. A blob is written
+// once under a new key and never modified, only deleted.
+type BlobStore interface {
+	// Put stores size bytes of content under key, a reader never sees a
+	// partially written blob. size is -1 when it isn't known up front.
+	Put(ctx context.Context, key string, content io.Reader, size int64) error
+	// Get returns ErrBlobNotFound when there is no blob under key
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete succeeds when there is no blob under key
+	Delete(ctx context.Context, key string) error
+}
@@ -0,0 +1,31 @@
+package ports
+
+import (
+	"context"
+	"main/internal/core/domain"
+	"time"
+)
+
+type EmergencyAccessRepository interface {
+	// CreateAccess returns nil when the vault isn't the grantor's or the
+	// grantee has an emergency access to it already
+	CreateAccess(ctx context.Context, access domain.EmergencyAccess) (*domain.EmergencyAccess, error)
+	// GetAccess returns nil when there is no such emergency access
+	GetAccess(ctx context.Context, accessID string) (*domain.EmergencyAccess, error)
+	// GetGrantedAccess returns the granted access of the grantee to the
+	// vault, nil when there is none
+	GetGrantedAccess(ctx context.Context, vaultID, granteeID string) (*domain.EmergencyAccess, error)
+	// ListByGrantor and ListByGrantee return the oldest first
+	ListByGrantor(ctx context.Context, grantorID string) ([]domain.EmergencyAccess, error)
+	ListByGrantee(ctx context.Context, granteeID string) ([]domain.EmergencyAccess, error)
+	// UpdateStatus returns false when the status isn't current anymore
+	UpdateStatus(ctx context.Context, accessID string, current, status domain.EmergencyAccessStatus) (bool, error)
+	// RequestAccess returns false when the access isn't accepted
+	RequestAccess(ctx context.Context, accessID string, requestedAt time.Time) (bool, error)
+	// SetKey replaces the wrapped vault key of the grantee
+	SetKey(ctx context.Context, accessID string, key domain.VaultMemberKey, keyVersion int) (bool, error)
+	DeleteAccess(ctx context.Context, accessID string) (bool, error)
+	// GrantExpiredRequests grants the requests whose waiting period is over
+	// and returns how many
+	GrantExpiredRequests(ctx context.Context) (int, error)
+}
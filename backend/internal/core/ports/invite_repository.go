@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+	"main/internal/core/domain"
+)
+
+type InviteRepository interface {
+	CreateInvite(ctx context.Context, createdBy, email string) (*domain.InviteToken, error)
+	GetInviteByToken(ctx context.Context, token string) (*domain.Invite, error)
+	GetInviteByPublicID(ctx context.Context, id string) (*domain.Invite, error)
+	GetInvites(ctx context.Context) ([]domain.Invite, error)
+	GetInvitesByCreator(ctx context.Context, createdBy string) ([]domain.Invite, error)
+	UseInvite(ctx context.Context, id, email string) (*domain.Invite, error)
+	RevokeInvite(ctx context.Context, id string) (bool, error)
+}
@@ -0,0 +1,62 @@
+package ports
+
+import (
+	"context"
+	"main/internal/core/domain"
+)
+
+type OrganizationRepository interface {
+	CreateOrganization(ctx context.Context, name string) (*domain.Organization, error)
+	// GetOrganization returns nil when there is no such organization
+	GetOrganization(ctx context.Context, organizationID string) (*domain.Organization, error)
+	// ListOrganizations returns the organizations of the user with their
+	// membership
+	ListOrganizations(ctx context.Context, userID string) ([]domain.Organization, error)
+	RenameOrganization(ctx context.Context, organizationID, name string) (bool, error)
+	// DeleteOrganization deletes the members, invites, groups and policies,
+	// the vaults of the organization are kept by their owners
+	DeleteOrganization(ctx context.Context, organizationID string) (bool, error)
+
+	// CreateMember returns nil when the user is already a member
+	CreateMember(ctx context.Context, organizationID, userID string, role domain.OrganizationRole) (*domain.OrganizationMember, error)
+	// GetMember returns the membership of the user, nil when there is none
+	GetMember(ctx context.Context, organizationID, userID string) (*domain.OrganizationMember, error)
+	// GetMemberByID returns nil when there is no such membership
+	GetMemberByID(ctx context.Context, memberID string) (*domain.OrganizationMember, error)
+	ListMembers(ctx context.Context, organizationID string) ([]domain.OrganizationMember, error)
+	UpdateMemberRole(ctx context.Context, memberID string, role domain.OrganizationRole) (bool, error)
+	DeleteMember(ctx context.Context, memberID string) (bool, error)
+	CountOwners(ctx context.Context, organizationID string) (int, error)
+	// LeaveVaults removes the user from the vaults of the organization they
+	// don't own. The shared ones they had the key of need a new key.
+	LeaveVaults(ctx context.Context, organizationID, userID string) error
+
+	// CreateInvite returns nil when there is no such organization
+	CreateInvite(ctx context.Context, invite domain.OrganizationInvite) (*domain.OrganizationInviteToken, error)
+	// GetInviteByToken returns nil when there is no such invite
+	GetInviteByToken(ctx context.Context, token string) (*domain.OrganizationInvite, error)
+	// ListInvites returns the invites that haven't expired, the newest first
+	ListInvites(ctx context.Context, organizationID string) ([]domain.OrganizationInvite, error)
+	DeleteInvite(ctx context.Context, organizationID, inviteID string) (bool, error)
+
+	// CreateGroup returns nil when the organization has a group with that name
+	CreateGroup(ctx context.Context, organizationID, name string) (*domain.OrganizationGroup, error)
+	// GetGroup returns the group without its members, nil when there is none
+	GetGroup(ctx context.Context, groupID string) (*domain.OrganizationGroup, error)
+	// ListGroups returns the groups with their members, by name
+	ListGroups(ctx context.Context, organizationID string) ([]domain.OrganizationGroup, error)
+	// RenameGroup returns false when there is no such group or the name is
+	// taken
+	RenameGroup(ctx context.Context, groupID, name string) (bool, error)
+	DeleteGroup(ctx context.Context, groupID string) (bool, error)
+	// SetGroupMembers replaces the members of the group. It returns false
+	// when one of them isn't a member of the organization of the group.
+	SetGroupMembers(ctx context.Context, groupID string, memberIDs []string) (bool, error)
+
+	// GetPolicies returns the zero value when the organization sets none
+	GetPolicies(ctx context.Context, organizationID string) (*domain.OrganizationPolicies, error)
+	SetPolicies(ctx context.Context, organizationID string, policies domain.OrganizationPolicies) error
+	// GetPoliciesByUserID returns the policies of every organization of the
+	// user
+	GetPoliciesByUserID(ctx context.Context, userID string) ([]domain.OrganizationPolicies, error)
+}
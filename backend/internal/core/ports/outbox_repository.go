@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+	"main/internal/core/domain"
+	"time"
+)
+
+type OutboxRepository interface {
+	EnqueueMessage(ctx context.Context, idempotencyKey string, kind domain.NotificationKind, payload []byte) error
+	ClaimMessages(ctx context.Context, batchSize int, lease time.Duration) ([]domain.OutboxMessage, error)
+	MarkMessageSent(ctx context.Context, id string) error
+	MarkMessageFailed(ctx context.Context, id string, status domain.OutboxStatus, lastError string, nextAttemptAt time.Time) error
+	GetMessagesByStatus(ctx context.Context, status domain.OutboxStatus, limit int) ([]domain.OutboxMessage, error)
+	RequeueMessage(ctx context.Context, publicID string) (bool, error)
+}
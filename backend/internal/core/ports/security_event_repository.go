@@ -0,0 +1,11 @@
+package ports
+
+import (
+	"context"
+	"main/internal/core/domain"
+)
+
+type SecurityEventRepository interface {
+	CreateEvent(ctx context.Context, event domain.SecurityEvent) error
+	GetEventsByUser(ctx context.Context, userID string, filter domain.SecurityEventFilter) ([]domain.SecurityEvent, error)
+}
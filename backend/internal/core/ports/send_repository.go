@@ -0,0 +1,25 @@
+package ports
+
+import (
+	"context"
+	"main/internal/core/domain"
+)
+
+// SendRepository keeps the sends until their last view or their expiry
+type SendRepository interface {
+	// CreateSend assigns the ID, it returns nil when the user already has
+	// maxSends active sends
+	CreateSend(ctx context.Context, send domain.Send, maxSends int) (*domain.Send, error)
+	// GetSend returns the send without its payload and without counting a
+	// view, nil when it doesn't exist or expired
+	GetSend(ctx context.Context, id string) (*domain.Send, error)
+	// ViewSend counts a view and returns the send with its payload, the
+	// send is deleted on its last view. nil when it doesn't exist, expired
+	// or its views are used up.
+	ViewSend(ctx context.Context, id string) (*domain.Send, error)
+	// ListSends returns the active sends of the user without their
+	// payloads, the ones expiring first first
+	ListSends(ctx context.Context, userID string) ([]domain.Send, error)
+	// DeleteSend returns false when the user has no such send
+	DeleteSend(ctx context.Context, userID, id string) (bool, error)
+}
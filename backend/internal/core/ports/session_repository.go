@@ -3,6 +3,7 @@ package ports
 import (
 	"context"
 	"main/internal/core/domain"
+	"time"
 )
 
 type SessionRepository interface {
@@ -11,10 +12,13 @@ type SessionRepository interface {
 		userID,
 		deviceID string,
 	) (*domain.AccessSessionLight, error)
+	// NewRefreshToken replaces the refresh token of the device,
+	// authenticatedAt is the time of the login it continues
 	NewRefreshToken(
 		ctx context.Context,
 		userID,
 		deviceID string,
+		authenticatedAt time.Time,
 	) (*domain.RefreshSessionLight, error)
 
 	GetAccessSessionByToken(ctx context.Context, token string) (*domain.AccessSession, error)
@@ -0,0 +1,9 @@
+package ports
+
+import "context"
+
+// Transactor runs fn in a single database transaction. Repositories called
+// with the ctx passed to fn take part in the transaction.
+type Transactor interface {
+	WithinTx(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,22 @@
+package ports
+
+import (
+	"context"
+	"main/internal/core/domain"
+)
+
+type UserKeysRepository interface {
+	// CreateKey stores the key if its version follows the current one, 1 for
+	// the first key, and returns nil otherwise
+	CreateKey(ctx context.Context, key domain.UserKey) (*domain.UserKey, error)
+	// GetCurrentKey returns nil when the user has no key yet
+	GetCurrentKey(ctx context.Context, userID string) (*domain.UserKey, error)
+	// GetKey returns nil when the version doesn't exist
+	GetKey(ctx context.Context, userID string, version int) (*domain.UserKey, error)
+	// GetKeysByUserID returns every version, newest first
+	GetKeysByUserID(ctx context.Context, userID string) ([]domain.UserKey, error)
+	// GetPublicKeyByEmail and GetPublicKeyByPublicID return the current
+	// public key of the user, nil when the user or their key doesn't exist
+	GetPublicKeyByEmail(ctx context.Context, email string) (*domain.PublicKey, error)
+	GetPublicKeyByPublicID(ctx context.Context, publicID string) (*domain.PublicKey, error)
+}
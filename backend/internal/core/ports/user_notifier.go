@@ -1,6 +1,19 @@
 package ports
 
+import (
+	"context"
+	"main/internal/core/domain"
+)
+
 type UserNotifier interface {
-	NotifyRegistrationIntent(to, code string) error
-	NotifyRegistrationSuccess(to string) error
+	NotifyRegistrationIntent(ctx context.Context, to domain.Recipient, code string) error
+	// NotifyRegistrationSuccess welcomes the user with the given public ID
+	NotifyRegistrationSuccess(ctx context.Context, to domain.Recipient, userID string) error
+	NotifyInvite(ctx context.Context, to domain.Recipient, token string) error
+	// NotifyOrganizationInvite sends the token of an invite to join the
+	// organization with the given name
+	NotifyOrganizationInvite(ctx context.Context, to domain.Recipient, organization, token string) error
+	// NotifyEmergencyAccessRequest tells the grantor a trusted contact
+	// requested emergency access to their vault
+	NotifyEmergencyAccessRequest(ctx context.Context, to domain.Recipient, request domain.EmergencyAccessRequest) error
 }
@@ -10,5 +10,6 @@ type UserRepository interface {
 	GetUserByEmail(ctx context.Context, email string) (*domain.User, error)
 	GetUserByPublicID(ctx context.Context, id string) (*domain.User, error)
 	GetUserByID(ctx context.Context, id string) (*domain.User, error)
-	CreateUser(ctx context.Context, name, email, passwordHash string) (*domain.User, error)
+	CreateUser(ctx context.Context, name, email, passwordHash, locale string) (*domain.User, error)
+	UpdatePasswordHash(ctx context.Context, id, passwordHash string) error
 }
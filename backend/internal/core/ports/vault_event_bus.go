@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+	"main/internal/core/domain"
+)
+
+// VaultEventBus fans vault events out to the subscribers of every replica
+// and keeps the recent ones so a reconnecting client can catch up
+type VaultEventBus interface {
+	// Publish assigns the event ID and delivers the event
+	Publish(ctx context.Context, event domain.VaultEvent) error
+	// Subscribe delivers the events of the user published from now on, the
+	// channel is closed once ctx is done
+	Subscribe(ctx context.Context, userID string) (<-chan domain.VaultEvent, error)
+	// EventsSince returns the kept events with an ID greater than lastID, oldest first
+	EventsSince(ctx context.Context, userID string, lastID int64) ([]domain.VaultEvent, error)
+}
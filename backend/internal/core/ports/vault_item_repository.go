@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+	"main/internal/core/domain"
+)
+
+type VaultItemRepository interface {
+	// ApplyChange writes the change if the item is still at its base
+	// revision and returns nil otherwise. Call it in a transaction, the
+	// sequence bump serializes the writers of a user.
+	ApplyChange(ctx context.Context, userID string, change domain.VaultItemChange) (*domain.VaultItem, error)
+	// GetItem returns nil when the item doesn't exist
+	GetItem(ctx context.Context, userID, itemID string) (*domain.VaultItem, error)
+	GetItemsChangedSince(ctx context.Context, userID string, cursor int64, limit int) ([]domain.VaultItem, error)
+}
@@ -0,0 +1,32 @@
+package ports
+
+import (
+	"context"
+	"main/internal/core/domain"
+)
+
+type VaultMemberRepository interface {
+	// GetMember returns the membership of the user, nil when there is none
+	GetMember(ctx context.Context, vaultID, userID string) (*domain.VaultMember, error)
+	// GetMemberByID returns nil when there is no such membership
+	GetMemberByID(ctx context.Context, memberID string) (*domain.VaultMember, error)
+	// ListMembers returns the members and invitations of the vault, the
+	// owner first
+	ListMembers(ctx context.Context, vaultID string) ([]domain.VaultMember, error)
+	// CreateMember invites the user, it returns nil when the user is already
+	// a member or invited, or when the key of the vault isn't at the
+	// KeyVersion of the member or has to be rotated
+	CreateMember(ctx context.Context, member domain.VaultMember) (*domain.VaultMember, error)
+	// AcceptMember returns false when the membership isn't a pending invite
+	AcceptMember(ctx context.Context, memberID string) (bool, error)
+	UpdateMemberRole(ctx context.Context, memberID string, role domain.VaultRole) (bool, error)
+	DeleteMember(ctx context.Context, memberID string) (bool, error)
+	// SetMemberKey replaces the wrapped vault key of the member
+	SetMemberKey(ctx context.Context, memberID string, key domain.VaultMemberKey, keyVersion int) (bool, error)
+	// RotateVaultKey bumps the key version of the vault to keyVersion and
+	// clears the rotation requirement. It returns false when the current
+	// version isn't keyVersion-1.
+	RotateVaultKey(ctx context.Context, vaultID string, keyVersion int) (bool, error)
+	// RequireKeyRotation flags a shared vault as needing a new key
+	RequireKeyRotation(ctx context.Context, vaultID string) error
+}
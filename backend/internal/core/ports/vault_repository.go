@@ -2,12 +2,47 @@ package ports
 
 import (
 	"context"
+	"errors"
+	"io"
 	"main/internal/core/domain"
-	"time"
 )
 
+// ErrVaultCorrupted is returned while reading a stored vault that fails to
+// decrypt or is shorter than it should be
+var ErrVaultCorrupted = errors.New("Stored vault is corrupted")
+
 type VaultRepository interface {
-	GetVaultByUserID(ctx context.Context, userID string) (*domain.Vault, error)
-	GetVaultUpdatedAtByUserID(ctx context.Context, userID string) (*time.Time, error)
-	InsertVaultByUserID(ctx context.Context, userID string, vault []byte) (*domain.Vault, error)
+	// CreateVault creates a vault without content, in the organization when
+	// organizationID isn't "". It returns nil when isDefault is set and the
+	// user already has a default vault.
+	CreateVault(ctx context.Context, userID, organizationID, name string, vaultType domain.VaultType, isDefault bool) (*domain.Vault, error)
+	// GetVault loads the vault without its content, nil when it doesn't exist
+	GetVault(ctx context.Context, vaultID string) (*domain.Vault, error)
+	// GetDefaultVault is GetVault for the default vault of the user
+	GetDefaultVault(ctx context.Context, userID string) (*domain.Vault, error)
+	// ListVaults returns the vaults the user is a member of or invited to
+	// without their content, with the membership of the user, their default
+	// vault first
+	ListVaults(ctx context.Context, userID string) ([]domain.Vault, error)
+	// UpdateVault renames the vault, nil when it doesn't exist
+	UpdateVault(ctx context.Context, vaultID, name string, vaultType domain.VaultType) (*domain.Vault, error)
+	// DeleteVault deletes the vault and its versions, it returns false when
+	// there is no such vault or it's a default one
+	DeleteVault(ctx context.Context, vaultID string) (bool, error)
+	// GetVaultContent loads the vault with its content
+	GetVaultContent(ctx context.Context, vaultID string) (*domain.Vault, error)
+	// StageVaultContent stores the content, read to its end, without
+	// touching the vault. size is -1 when it isn't known. It returns nil
+	// when the vault doesn't exist or isn't at expectedRevision anymore.
+	StageVaultContent(ctx context.Context, vaultID string, content io.Reader, size int64, expectedRevision int64) (*domain.StagedVaultContent, error)
+	// InsertVaultContent swaps in the staged content only if the current
+	// revision of the vault is expectedRevision, 0 meaning it has no content
+	// yet and domain.AnyVaultRevision any content. It returns nil when the revision didn't match or the vault doesn't
+	// exist.
+	InsertVaultContent(ctx context.Context, vaultID string, staged *domain.StagedVaultContent, expectedRevision int64) (*domain.Vault, error)
+	// DiscardVaultContent deletes staged content no vault refers to
+	DiscardVaultContent(ctx context.Context, staged *domain.StagedVaultContent)
+	// ListVaultIDs returns up to limit IDs of vaults with content, in order,
+	// starting after the ID after, "" to start from the first one
+	ListVaultIDs(ctx context.Context, after string, limit int) ([]string, error)
 }
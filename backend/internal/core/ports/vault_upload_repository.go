@@ -0,0 +1,26 @@
+package ports
+
+import (
+	"context"
+	"io"
+	"main/internal/core/domain"
+	"time"
+)
+
+// VaultUploadRepository keeps the resumable uploads in progress, an upload
+// expires ttl after its last chunk
+type VaultUploadRepository interface {
+	// CreateUpload assigns the ID, it returns nil when the user already has
+	// maxUploads uploads in progress
+	CreateUpload(ctx context.Context, upload domain.VaultUpload, ttl time.Duration, maxUploads int) (*domain.VaultUpload, error)
+	// GetUpload returns nil when the upload doesn't exist or expired
+	GetUpload(ctx context.Context, userID, id string) (*domain.VaultUpload, error)
+	// AppendChunk appends the chunk only if offset is the current offset,
+	// and returns the upload after the append, or as it is when the offset
+	// didn't match. nil when the upload doesn't exist.
+	AppendChunk(ctx context.Context, userID, id string, offset int64, chunk []byte, ttl time.Duration) (upload *domain.VaultUpload, appended bool, err error)
+	// OpenContent streams the bytes received so far
+	OpenContent(ctx context.Context, userID, id string) io.Reader
+	// DeleteUpload returns false when the upload doesn't exist
+	DeleteUpload(ctx context.Context, userID, id string) (bool, error)
+}
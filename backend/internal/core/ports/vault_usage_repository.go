@@ -0,0 +1,10 @@
+package ports
+
+import (
+	"context"
+	"main/internal/core/domain"
+)
+
+type VaultUsageRepository interface {
+	GetUsageByUserID(ctx context.Context, userID string) (*domain.VaultUsage, error)
+}
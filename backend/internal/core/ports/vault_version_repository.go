@@ -0,0 +1,22 @@
+package ports
+
+import (
+	"context"
+	"io"
+	"main/internal/core/domain"
+	"time"
+)
+
+type VaultVersionRepository interface {
+	CreateVersion(ctx context.Context, version domain.VaultVersion) error
+	// GetVersionsByVaultID lists the versions newest first, without their content
+	GetVersionsByVaultID(ctx context.Context, vaultID string) ([]domain.VaultVersion, error)
+	// GetVersion returns nil when the version doesn't exist
+	GetVersion(ctx context.Context, vaultID string, revision int64) (*domain.VaultVersion, error)
+	// OpenVersion returns the version without its content and a reader
+	// streaming the content, to be closed, nil when the version doesn't exist
+	OpenVersion(ctx context.Context, vaultID string, revision int64) (*domain.VaultVersion, io.ReadCloser, error)
+	// PruneVersions keeps the newest keep versions, minus those created before
+	// before when it's not zero. The newest version is never pruned.
+	PruneVersions(ctx context.Context, vaultID string, keep int, before time.Time) (int64, error)
+}
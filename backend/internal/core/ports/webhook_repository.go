@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+	"main/internal/core/domain"
+	"time"
+)
+
+type WebhookRepository interface {
+	CreateSubscription(ctx context.Context, subscription domain.WebhookSubscription) (*domain.WebhookSubscription, error)
+	GetSubscriptionsByUser(ctx context.Context, userID string) ([]domain.WebhookSubscription, error)
+	GetSubscriptionByPublicID(ctx context.Context, id string) (*domain.WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, id string) (bool, error)
+	EnqueueDeliveries(ctx context.Context, userID string, eventType domain.SecurityEventType, payload []byte) (int64, error)
+	ClaimDeliveries(ctx context.Context, batchSize int, lease time.Duration) ([]domain.WebhookDelivery, error)
+	MarkDeliveryDelivered(ctx context.Context, id string, responseStatus int) error
+	MarkDeliveryFailed(ctx context.Context, id string, status domain.WebhookDeliveryStatus, responseStatus int, lastError string, nextAttemptAt time.Time) error
+	GetDeliveriesBySubscription(ctx context.Context, subscriptionID string, limit int) ([]domain.WebhookDelivery, error)
+}
@@ -0,0 +1,11 @@
+package ports
+
+import (
+	"context"
+	"main/internal/core/domain"
+)
+
+type WebhookSender interface {
+	// Send POSTs the delivery payload and returns the response status, if any
+	Send(ctx context.Context, delivery domain.WebhookDelivery) (int, error)
+}
@@ -2,19 +2,36 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
 	"main/internal/core/domain"
 	"main/internal/core/ports"
 	"main/internal/utils"
+	"strings"
+	"time"
 )
 
+var ErrSessionLifetimeExceeded = errors.New("Session reached the maximum lifetime, log in again")
+
 type AuthService struct {
-	userRepository    ports.UserRepository
-	sessionRepository ports.SessionRepository
+	userRepository         ports.UserRepository
+	sessionRepository      ports.SessionRepository
+	organizationRepository ports.OrganizationRepository
+	eventRecorder          *EventRecorder
+	vaultEvents            *VaultEventService
+	adminEmails            []string
 }
 
-func NewAuthService(userRepo ports.UserRepository, sessionRepo ports.SessionRepository) *AuthService {
-	return &AuthService{userRepository: userRepo, sessionRepository: sessionRepo}
+func NewAuthService(userRepo ports.UserRepository, sessionRepo ports.SessionRepository, organizationRepo ports.OrganizationRepository, eventRecorder *EventRecorder, vaultEvents *VaultEventService, adminEmails []string) *AuthService {
+	return &AuthService{
+		userRepository:         userRepo,
+		sessionRepository:      sessionRepo,
+		organizationRepository: organizationRepo,
+		eventRecorder:          eventRecorder,
+		vaultEvents:            vaultEvents,
+		adminEmails:            adminEmails,
+	}
 }
 
 func (s *AuthService) CreateToken(ctx context.Context, email, password, deviceID string) (*domain.User, *domain.AccessSessionLight, *domain.RefreshSessionLight, error) {
@@ -23,25 +40,49 @@ func (s *AuthService) CreateToken(ctx context.Context, email, password, deviceID
 		return nil, nil, nil, err
 	}
 	if user == nil {
+		s.eventRecorder.Record(ctx, domain.SecurityEvent{
+			Type:     domain.SecurityEventLoginFailed,
+			DeviceID: deviceID,
+			Metadata: map[string]string{"email": email, "reason": "unknown_email"},
+		})
 		return nil, nil, nil, fmt.Errorf("Invalid email or password")
 	}
 
 	err = utils.CheckPassword(user.PasswordHash, password)
 	if err != nil {
+		s.eventRecorder.Record(ctx, domain.SecurityEvent{
+			UserID:   user.ID,
+			Type:     domain.SecurityEventLoginFailed,
+			DeviceID: deviceID,
+			Metadata: map[string]string{"reason": "wrong_password"},
+		})
 		return nil, nil, nil, fmt.Errorf("Invalid email or password")
 	}
 
 	userID := user.ID
+	policies, err := s.userPolicies(ctx, userID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	s.upgradePasswordHash(ctx, user, password, policies.MinKDFIterations)
+
 	accessSession, err := s.sessionRepository.NewAccessToken(ctx, userID, deviceID)
 	if err != nil {
 		return nil, nil, nil, err
 	}
 
-	refreshSession, err := s.sessionRepository.NewRefreshToken(ctx, userID, deviceID)
+	refreshSession, err := s.sessionRepository.NewRefreshToken(ctx, userID, deviceID, time.Now())
 	if err != nil {
 		return nil, nil, nil, err
 	}
 
+	s.eventRecorder.Record(ctx, domain.SecurityEvent{
+		UserID:   userID,
+		ActorID:  userID,
+		Type:     domain.SecurityEventLogin,
+		DeviceID: deviceID,
+	})
+
 	return user, accessSession, refreshSession, nil
 }
 
@@ -52,17 +93,37 @@ func (s *AuthService) RefreshToken(ctx context.Context, refreshToken string) (*d
 		return nil, nil, err
 	}
 
-	// 2 Generate new access token and rotate refresh token
+	// 2 The organizations of the user may cap how long a login lasts
+	policies, err := s.userPolicies(ctx, refreshSession.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+	loginTime := refreshSession.LoginTime()
+	if policies.SessionMaxLifetime > 0 && time.Since(loginTime) > policies.SessionMaxLifetime {
+		if err := s.sessionRepository.DeleteRefreshSession(ctx, refreshSession.UserID, refreshSession.DeviceID); err != nil {
+			return nil, nil, err
+		}
+		return nil, nil, ErrSessionLifetimeExceeded
+	}
+
+	// 3 Generate new access token and rotate refresh token
 	accessSession, err := s.sessionRepository.NewAccessToken(ctx, refreshSession.UserID, refreshSession.DeviceID)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	newRefreshSession, err := s.sessionRepository.NewRefreshToken(ctx, refreshSession.UserID, refreshSession.DeviceID)
+	newRefreshSession, err := s.sessionRepository.NewRefreshToken(ctx, refreshSession.UserID, refreshSession.DeviceID, loginTime)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	s.eventRecorder.Record(ctx, domain.SecurityEvent{
+		UserID:   refreshSession.UserID,
+		ActorID:  refreshSession.UserID,
+		Type:     domain.SecurityEventTokenRefresh,
+		DeviceID: refreshSession.DeviceID,
+	})
+
 	return accessSession, newRefreshSession, nil
 }
 
@@ -95,5 +156,65 @@ func (s *AuthService) Logout(ctx context.Context, userID, deviceID string) error
 	if err != nil {
 		return err
 	}
+
+	s.eventRecorder.Record(ctx, domain.SecurityEvent{
+		UserID:   userID,
+		ActorID:  userID,
+		Type:     domain.SecurityEventLogout,
+		DeviceID: deviceID,
+	})
+	s.vaultEvents.Publish(ctx, domain.VaultEvent{
+		UserID:   userID,
+		Type:     domain.VaultEventSessionRevoked,
+		DeviceID: deviceID,
+	})
 	return nil
 }
+
+// IsAdmin reports whether the user is one of the configured instance admins.
+func (s *AuthService) IsAdmin(ctx context.Context, userID string) (bool, error) {
+	user, err := s.userRepository.GetUserByID(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+	if user == nil {
+		return false, nil
+	}
+
+	for _, admin := range s.adminEmails {
+		if strings.EqualFold(admin, user.Email) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// userPolicies merges the policies of the organizations of the user
+func (s *AuthService) userPolicies(ctx context.Context, userID string) (domain.OrganizationPolicies, error) {
+	policies, err := s.organizationRepository.GetPoliciesByUserID(ctx, userID)
+	if err != nil {
+		return domain.OrganizationPolicies{}, err
+	}
+	return domain.MergeOrganizationPolicies(policies), nil
+}
+
+// upgradePasswordHash rehashes the password when an organization asks for
+// more iterations than the stored hash has. The password is only known at
+// login, so that's the earliest it can be done. A failure keeps the old hash
+// until the next login.
+func (s *AuthService) upgradePasswordHash(ctx context.Context, user *domain.User, password string, minIterations int) {
+	if minIterations <= utils.PasswordIterations(user.PasswordHash) {
+		return
+	}
+
+	hash, err := utils.NewPasswordWithIterations(password, minIterations)
+	if err != nil {
+		log.Printf("failed to rehash password: %v", err)
+		return
+	}
+	if err := s.userRepository.UpdatePasswordHash(ctx, user.ID, hash); err != nil {
+		log.Printf("failed to store rehashed password: %v", err)
+		return
+	}
+	user.PasswordHash = hash
+}
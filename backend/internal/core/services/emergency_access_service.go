@@ -0,0 +1,367 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log"
+	"main/internal/core/domain"
+	"main/internal/core/ports"
+	"strings"
+	"time"
+)
+
+var (
+	ErrEmergencyAccessNotFound     = errors.New("Emergency access not found")
+	ErrEmergencyAccessExists       = errors.New("The contact has emergency access to this vault already")
+	ErrEmergencyAccessSelf         = errors.New("You can't be your own trusted contact")
+	ErrEmergencyAccessStatus       = errors.New("The emergency access doesn't allow this in its current state")
+	ErrInvalidEmergencyAccessMode  = errors.New("Mode must be view or takeover")
+	ErrInvalidEmergencyAccessWait  = errors.New("Waiting period must be between 1 and 90 days")
+	ErrEmergencyAccessVaultNotOwns = errors.New("Emergency access can only be given to your own vaults")
+)
+
+const (
+	MinEmergencyAccessWait = 24 * time.Hour
+	MaxEmergencyAccessWait = 90 * 24 * time.Hour
+)
+
+type EmergencyAccessConfig struct {
+	// PollInterval is how often the requests past their waiting period are
+	// granted
+	PollInterval time.Duration
+}
+
+// EmergencyAccessService lets users give trusted contacts access to their
+// vaults in an emergency. The contact requests the access, which is granted
+// when the grantor approves it or after the waiting period unless they
+// reject it. The vault key is wrapped for the contact when the access is set
+// up, so it doesn't depend on the grantor being around.
+type EmergencyAccessService struct {
+	accessRepo   ports.EmergencyAccessRepository
+	vaultRepo    ports.VaultRepository
+	memberRepo   ports.VaultMemberRepository
+	userRepo     ports.UserRepository
+	userKeysRepo ports.UserKeysRepository
+	userNotifier ports.UserNotifier
+	transactor   ports.Transactor
+	config       EmergencyAccessConfig
+}
+
+func NewEmergencyAccessService(
+	accessRepo ports.EmergencyAccessRepository,
+	vaultRepo ports.VaultRepository,
+	memberRepo ports.VaultMemberRepository,
+	userRepo ports.UserRepository,
+	userKeysRepo ports.UserKeysRepository,
+	userNotifier ports.UserNotifier,
+	transactor ports.Transactor,
+	config EmergencyAccessConfig,
+) *EmergencyAccessService {
+	return &EmergencyAccessService{
+		accessRepo:   accessRepo,
+		vaultRepo:    vaultRepo,
+		memberRepo:   memberRepo,
+		userRepo:     userRepo,
+		userKeysRepo: userKeysRepo,
+		userNotifier: userNotifier,
+		transactor:   transactor,
+		config:       config,
+	}
+}
+
+// Invite makes the user with the email of access a trusted contact for a
+// vault of the grantor. The wrapped key must be of the current key of the
+// vault, wrapped with the current public key of the contact.
+func (s *EmergencyAccessService) Invite(ctx context.Context, grantorID string, access domain.EmergencyAccess) (*domain.EmergencyAccess, error) {
+	if !access.Mode.Valid() {
+		return nil, ErrInvalidEmergencyAccessMode
+	}
+	if access.WaitPeriod < MinEmergencyAccessWait || access.WaitPeriod > MaxEmergencyAccessWait {
+		return nil, ErrInvalidEmergencyAccessWait
+	}
+	if err := validateWrappedKey(access.WrappedKey); err != nil {
+		return nil, err
+	}
+
+	vault, err := s.vaultRepo.GetVault(ctx, access.VaultID)
+	if err != nil {
+		return nil, err
+	}
+	if vault == nil {
+		return nil, ErrVaultNotFound
+	}
+	if vault.UserID != grantorID {
+		return nil, ErrEmergencyAccessVaultNotOwns
+	}
+	if vault.KeyVersion == 0 {
+		return nil, ErrVaultNotShared
+	}
+	if vault.RotationRequired {
+		return nil, ErrVaultKeyRotationRequired
+	}
+	if access.KeyVersion != vault.KeyVersion {
+		return nil, ErrVaultKeyVersionMismatch
+	}
+
+	grantee, err := s.userRepo.GetUserByEmail(ctx, strings.TrimSpace(access.GranteeEmail))
+	if err != nil {
+		return nil, err
+	}
+	if grantee == nil {
+		return nil, ErrUserKeyNotFound
+	}
+	if grantee.ID == grantorID {
+		return nil, ErrEmergencyAccessSelf
+	}
+	if err := checkUserKeyVersion(ctx, s.userKeysRepo, grantee.ID, access.UserKeyVersion); err != nil {
+		return nil, err
+	}
+
+	access.GrantorID = grantorID
+	access.GranteeID = grantee.ID
+	created, err := s.accessRepo.CreateAccess(ctx, access)
+	if err != nil {
+		return nil, err
+	}
+	if created == nil {
+		return nil, ErrEmergencyAccessExists
+	}
+	return created, nil
+}
+
+// ListGranted returns the emergency accesses the user gave to others
+func (s *EmergencyAccessService) ListGranted(ctx context.Context, grantorID string) ([]domain.EmergencyAccess, error) {
+	return s.accessRepo.ListByGrantor(ctx, grantorID)
+}
+
+// ListTrusted returns the emergency accesses others gave to the user
+func (s *EmergencyAccessService) ListTrusted(ctx context.Context, granteeID string) ([]domain.EmergencyAccess, error) {
+	return s.accessRepo.ListByGrantee(ctx, granteeID)
+}
+
+// Accept makes the grantee a trusted contact, who can request the access
+// from now on
+func (s *EmergencyAccessService) Accept(ctx context.Context, granteeID, accessID string) (*domain.EmergencyAccess, error) {
+	access, err := s.granteeAccess(ctx, granteeID, accessID)
+	if err != nil {
+		return nil, err
+	}
+	return s.updateStatus(ctx, access, domain.EmergencyAccessInvited, domain.EmergencyAccessAccepted)
+}
+
+// Request starts the waiting period and emails the grantor, who can
+// approve or reject the request meanwhile
+func (s *EmergencyAccessService) Request(ctx context.Context, granteeID, accessID string) (*domain.EmergencyAccess, error) {
+	access, err := s.granteeAccess(ctx, granteeID, accessID)
+	if err != nil {
+		return nil, err
+	}
+	if access.Status != domain.EmergencyAccessAccepted {
+		return nil, ErrEmergencyAccessStatus
+	}
+
+	grantor, err := s.userRepo.GetUserByID(ctx, access.GrantorID)
+	if err != nil {
+		return nil, err
+	}
+	if grantor == nil {
+		return nil, ErrEmergencyAccessNotFound
+	}
+
+	requestedAt := time.Now()
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		requested, err := s.accessRepo.RequestAccess(ctx, access.ID, requestedAt)
+		if err != nil {
+			return err
+		}
+		if !requested {
+			return ErrEmergencyAccessStatus
+		}
+
+		return s.userNotifier.NotifyEmergencyAccessRequest(ctx, grantor.Recipient(), domain.EmergencyAccessRequest{
+			AccessID:    access.ID,
+			Contact:     emergencyContact(access),
+			Vault:       access.VaultName,
+			RequestedAt: requestedAt,
+			WaitDays:    int(access.WaitPeriod / (24 * time.Hour)),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	access.Status = domain.EmergencyAccessRequested
+	access.RequestedAt = requestedAt
+	return access, nil
+}
+
+// Approve grants a pending request without waiting
+func (s *EmergencyAccessService) Approve(ctx context.Context, grantorID, accessID string) (*domain.EmergencyAccess, error) {
+	access, err := s.grantorAccess(ctx, grantorID, accessID)
+	if err != nil {
+		return nil, err
+	}
+	return s.updateStatus(ctx, access, domain.EmergencyAccessRequested, domain.EmergencyAccessGranted)
+}
+
+// Reject turns down a pending request, or ends a granted access. The
+// contact stays trusted and can request the access again. A contact who
+// was granted the access may have kept the key, so the vault then needs a
+// new one.
+func (s *EmergencyAccessService) Reject(ctx context.Context, grantorID, accessID string) (*domain.EmergencyAccess, error) {
+	access, err := s.grantorAccess(ctx, grantorID, accessID)
+	if err != nil {
+		return nil, err
+	}
+	if access.Status != domain.EmergencyAccessRequested && access.Status != domain.EmergencyAccessGranted {
+		return nil, ErrEmergencyAccessStatus
+	}
+
+	var rejected *domain.EmergencyAccess
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		wasGranted := access.Status == domain.EmergencyAccessGranted
+		var err error
+		rejected, err = s.updateStatus(ctx, access, access.Status, domain.EmergencyAccessAccepted)
+		if err != nil || !wasGranted {
+			return err
+		}
+		return s.memberRepo.RequireKeyRotation(ctx, access.VaultID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rejected, nil
+}
+
+// Revoke deletes the emergency access, the grantor and the grantee can both
+// end it
+func (s *EmergencyAccessService) Revoke(ctx context.Context, userID, accessID string) error {
+	access, err := s.accessRepo.GetAccess(ctx, accessID)
+	if err != nil {
+		return err
+	}
+	if access == nil || (access.GrantorID != userID && access.GranteeID != userID) {
+		return ErrEmergencyAccessNotFound
+	}
+
+	return s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		deleted, err := s.accessRepo.DeleteAccess(ctx, access.ID)
+		if err != nil {
+			return err
+		}
+		if !deleted {
+			return ErrEmergencyAccessNotFound
+		}
+		if access.Status != domain.EmergencyAccessGranted {
+			return nil
+		}
+		return s.memberRepo.RequireKeyRotation(ctx, access.VaultID)
+	})
+}
+
+// UpdateKey wraps the vault key for the grantee again, after the key of the
+// vault or of the grantee was rotated
+func (s *EmergencyAccessService) UpdateKey(ctx context.Context, grantorID, accessID string, keyVersion int, key domain.VaultMemberKey) (*domain.EmergencyAccess, error) {
+	if err := validateWrappedKey(key.WrappedKey); err != nil {
+		return nil, err
+	}
+	access, err := s.grantorAccess(ctx, grantorID, accessID)
+	if err != nil {
+		return nil, err
+	}
+
+	vault, err := s.vaultRepo.GetVault(ctx, access.VaultID)
+	if err != nil {
+		return nil, err
+	}
+	if vault == nil {
+		return nil, ErrVaultNotFound
+	}
+	if keyVersion != vault.KeyVersion {
+		return nil, ErrVaultKeyVersionMismatch
+	}
+	if err := checkUserKeyVersion(ctx, s.userKeysRepo, access.GranteeID, key.UserKeyVersion); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.accessRepo.SetKey(ctx, access.ID, key, keyVersion)
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return nil, ErrEmergencyAccessNotFound
+	}
+	access.WrappedKey = key.WrappedKey
+	access.KeyVersion = keyVersion
+	access.UserKeyVersion = key.UserKeyVersion
+	return access, nil
+}
+
+// Run grants the requests past their waiting period until ctx is cancelled
+func (s *EmergencyAccessService) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		granted, err := s.accessRepo.GrantExpiredRequests(ctx)
+		if err != nil {
+			log.Printf("emergency access: failed to grant expired requests: %v", err)
+		} else if granted > 0 {
+			log.Printf("emergency access: granted %d requests past their waiting period", granted)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// grantorAccess and granteeAccess hide the emergency accesses of others as
+// not found
+func (s *EmergencyAccessService) grantorAccess(ctx context.Context, grantorID, accessID string) (*domain.EmergencyAccess, error) {
+	access, err := s.accessRepo.GetAccess(ctx, accessID)
+	if err != nil {
+		return nil, err
+	}
+	if access == nil || access.GrantorID != grantorID {
+		return nil, ErrEmergencyAccessNotFound
+	}
+	return access, nil
+}
+
+func (s *EmergencyAccessService) granteeAccess(ctx context.Context, granteeID, accessID string) (*domain.EmergencyAccess, error) {
+	access, err := s.accessRepo.GetAccess(ctx, accessID)
+	if err != nil {
+		return nil, err
+	}
+	if access == nil || access.GranteeID != granteeID {
+		return nil, ErrEmergencyAccessNotFound
+	}
+	return access, nil
+}
+
+func (s *EmergencyAccessService) updateStatus(ctx context.Context, access *domain.EmergencyAccess, current, status domain.EmergencyAccessStatus) (*domain.EmergencyAccess, error) {
+	if access.Status != current {
+		return nil, ErrEmergencyAccessStatus
+	}
+
+	updated, err := s.accessRepo.UpdateStatus(ctx, access.ID, current, status)
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return nil, ErrEmergencyAccessStatus
+	}
+	access.Status = status
+	return access, nil
+}
+
+// emergencyContact names the grantee in the email to the grantor
+func emergencyContact(access *domain.EmergencyAccess) string {
+	if access.GranteeName == "" {
+		return access.GranteeEmail
+	}
+	return access.GranteeName + " (" + access.GranteeEmail + ")"
+}
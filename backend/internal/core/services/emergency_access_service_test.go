@@ -0,0 +1,149 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"main/internal/core/domain"
+	"main/internal/core/ports"
+	"testing"
+	"time"
+)
+
+type fakeEmergencyAccessRepository struct {
+	ports.EmergencyAccessRepository
+	accesses []*domain.EmergencyAccess
+}
+
+func (r *fakeEmergencyAccessRepository) GetAccess(ctx context.Context, accessID string) (*domain.EmergencyAccess, error) {
+	for _, a := range r.accesses {
+		if a.ID == accessID {
+			access := *a
+			return &access, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeEmergencyAccessRepository) GetGrantedAccess(ctx context.Context, vaultID, granteeID string) (*domain.EmergencyAccess, error) {
+	for _, a := range r.accesses {
+		if a.VaultID == vaultID && a.GranteeID == granteeID && a.Status == domain.EmergencyAccessGranted {
+			access := *a
+			return &access, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeEmergencyAccessRepository) UpdateStatus(ctx context.Context, accessID string, current, status domain.EmergencyAccessStatus) (bool, error) {
+	for _, a := range r.accesses {
+		if a.ID == accessID && a.Status == current {
+			a.Status = status
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *fakeEmergencyAccessRepository) RequestAccess(ctx context.Context, accessID string, requestedAt time.Time) (bool, error) {
+	for _, a := range r.accesses {
+		if a.ID == accessID && a.Status == domain.EmergencyAccessAccepted {
+			a.Status, a.RequestedAt = domain.EmergencyAccessRequested, requestedAt
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *fakeEmergencyAccessRepository) GrantExpiredRequests(ctx context.Context) (int, error) {
+	granted := 0
+	for _, a := range r.accesses {
+		if a.Status == domain.EmergencyAccessRequested && !a.GrantsAt().After(time.Now()) {
+			a.Status = domain.EmergencyAccessGranted
+			granted++
+		}
+	}
+	return granted, nil
+}
+
+func TestEmergencyAccessService_Lifecycle(t *testing.T) {
+	vaults := &fakeVaultRepository{vaults: []*domain.Vault{{ID: "v1", UserID: "1", Name: "Personal", KeyVersion: 1}}}
+	members := &fakeVaultMemberRepository{vaults: vaults, members: []*domain.VaultMember{
+		{ID: "m1", VaultID: "v1", UserID: "1", Role: domain.VaultRoleManage, Status: domain.VaultMemberAccepted, KeyVersion: 1, UserKeyVersion: 1},
+	}}
+	accesses := &fakeEmergencyAccessRepository{accesses: []*domain.EmergencyAccess{
+		{ID: "e1", GrantorID: "1", GranteeID: "2", GranteeEmail: "bob@example.com", VaultID: "v1", Mode: domain.EmergencyAccessView, Status: domain.EmergencyAccessInvited, WaitPeriod: 7 * 24 * time.Hour},
+	}}
+	notifier := &fakeUserNotifier{}
+	users := &fakeUserRepository{user: &domain.User{ID: "1", Email: "alice@example.com"}}
+	s := NewEmergencyAccessService(accesses, vaults, members, users, &fakeUserKeysRepository{}, notifier, fakeTransactor{}, EmergencyAccessConfig{})
+	vaultService := newTestVaultService(vaults, &fakeVaultVersionRepository{})
+	vaultService.memberRepo = members
+	vaultService.emergencyAccessRepo = accesses
+	ctx := context.Background()
+
+	if _, err := s.Request(ctx, "2", "e1"); !errors.Is(err, ErrEmergencyAccessStatus) {
+		t.Errorf("expected a request before accepting to be refused, got %v", err)
+	}
+	if _, err := s.Accept(ctx, "3", "e1"); !errors.Is(err, ErrEmergencyAccessNotFound) {
+		t.Errorf("expected another user to see no emergency access, got %v", err)
+	}
+	if _, err := s.Accept(ctx, "2", "e1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := s.Request(ctx, "2", "e1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(notifier.deliveryIDs) != 1 {
+		t.Errorf("expected the grantor to be notified of the request, got %d notifications", len(notifier.deliveryIDs))
+	}
+
+	// The vault stays closed until the waiting period is over
+	if _, err := vaultService.AuthorizeVault(ctx, "2", "v1", domain.VaultRoleRead); !errors.Is(err, ErrVaultNotFound) {
+		t.Errorf("expected the vault to stay hidden during the waiting period, got %v", err)
+	}
+	accesses.accesses[0].RequestedAt = time.Now().Add(-8 * 24 * time.Hour)
+	if granted, _ := accesses.GrantExpiredRequests(ctx); granted != 1 {
+		t.Fatalf("expected the request to be granted after the waiting period, got %d", granted)
+	}
+
+	vault, err := vaultService.AuthorizeVault(ctx, "2", "v1", domain.VaultRoleRead)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if vault.Membership.Role != domain.VaultRoleRead {
+		t.Errorf("expected the view mode to read the vault, got %s", vault.Membership.Role)
+	}
+	if _, err := vaultService.AuthorizeVault(ctx, "2", "v1", domain.VaultRoleWrite); !errors.Is(err, ErrVaultForbidden) {
+		t.Errorf("expected the view mode not to write the vault, got %v", err)
+	}
+
+	// Rejecting a granted access closes the vault and needs a new key
+	if _, err := s.Reject(ctx, "2", "e1"); !errors.Is(err, ErrEmergencyAccessNotFound) {
+		t.Errorf("expected only the grantor to reject, got %v", err)
+	}
+	if _, err := s.Reject(ctx, "1", "e1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := vaultService.AuthorizeVault(ctx, "2", "v1", domain.VaultRoleRead); !errors.Is(err, ErrVaultNotFound) {
+		t.Errorf("expected the vault to be hidden again, got %v", err)
+	}
+	if !vaults.vaults[0].RotationRequired {
+		t.Error("expected the vault to require a key rotation")
+	}
+}
+
+func TestEmergencyAccessService_InviteValidation(t *testing.T) {
+	s := NewEmergencyAccessService(&fakeEmergencyAccessRepository{}, &fakeVaultRepository{}, nil, &fakeUserRepository{}, &fakeUserKeysRepository{}, nil, fakeTransactor{}, EmergencyAccessConfig{})
+	ctx := context.Background()
+
+	invalid := map[error]domain.EmergencyAccess{
+		ErrInvalidEmergencyAccessMode: {Mode: "admin", WaitPeriod: 24 * time.Hour, WrappedKey: []byte("k")},
+		ErrInvalidEmergencyAccessWait: {Mode: domain.EmergencyAccessView, WaitPeriod: time.Hour, WrappedKey: []byte("k")},
+		ErrInvalidWrappedKey:          {Mode: domain.EmergencyAccessTakeover, WaitPeriod: 90 * 24 * time.Hour},
+	}
+	for want, access := range invalid {
+		if _, err := s.Invite(ctx, "1", access); !errors.Is(err, want) {
+			t.Errorf("expected %v, got %v", want, err)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"main/internal/core/domain"
+	"main/internal/core/ports"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventRecorder appends security events to the audit log and queues a webhook
+// delivery for every subscription interested in them
+type EventRecorder struct {
+	securityEventRepository ports.SecurityEventRepository
+	webhookRepository       ports.WebhookRepository
+	userRepository          ports.UserRepository
+}
+
+func NewEventRecorder(securityEventRepo ports.SecurityEventRepository, webhookRepo ports.WebhookRepository, userRepo ports.UserRepository) *EventRecorder {
+	return &EventRecorder{
+		securityEventRepository: securityEventRepo,
+		webhookRepository:       webhookRepo,
+		userRepository:          userRepo,
+	}
+}
+
+// Record stores the event with the client info of the request. The recorded
+// action already happened, so failures are logged instead of returned.
+func (r *EventRecorder) Record(ctx context.Context, event domain.SecurityEvent) {
+	client := domain.ClientInfoFromContext(ctx)
+	event.IP = client.IP
+	event.UserAgent = client.UserAgent
+	if event.DeviceID == "" {
+		event.DeviceID = client.DeviceID
+	}
+
+	if err := r.securityEventRepository.CreateEvent(ctx, event); err != nil {
+		log.Printf("failed to record security event %s: %v", event.Type, err)
+	}
+
+	if err := r.enqueueWebhooks(ctx, event); err != nil {
+		log.Printf("failed to queue webhooks for security event %s: %v", event.Type, err)
+	}
+}
+
+func (r *EventRecorder) enqueueWebhooks(ctx context.Context, event domain.SecurityEvent) error {
+	webhookEvent := domain.WebhookEvent{
+		ID:        uuid.NewString(),
+		Type:      event.Type,
+		CreatedAt: time.Now().Unix(),
+		IP:        event.IP,
+		DeviceID:  event.DeviceID,
+		Metadata:  event.Metadata,
+	}
+
+	// Receivers only ever see public ids
+	if event.UserID != "" {
+		user, err := r.userRepository.GetUserByID(ctx, event.UserID)
+		if err != nil {
+			return err
+		}
+		if user != nil {
+			webhookEvent.User = &domain.WebhookEventUser{ID: user.PublicID.String(), Email: user.Email}
+		}
+	}
+
+	payload, err := json.Marshal(webhookEvent)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.webhookRepository.EnqueueDeliveries(ctx, event.UserID, event.Type, payload)
+	return err
+}
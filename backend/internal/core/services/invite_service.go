@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"main/internal/core/domain"
+	"main/internal/core/ports"
+)
+
+var (
+	ErrInviteNotFound  = errors.New("Invite not found")
+	ErrInviteForbidden = errors.New("Invite belongs to another user")
+)
+
+type InviteService struct {
+	inviteRepository ports.InviteRepository
+	userRepository   ports.UserRepository
+	userNotifier     ports.UserNotifier
+	transactor       ports.Transactor
+}
+
+func NewInviteService(
+	inviteRepo ports.InviteRepository,
+	userRepo ports.UserRepository,
+	userNotifier ports.UserNotifier,
+	transactor ports.Transactor,
+) *InviteService {
+	return &InviteService{inviteRepository: inviteRepo, userRepository: userRepo, userNotifier: userNotifier, transactor: transactor}
+}
+
+// CreateInvite mints a single-use invite token. When an email is given the
+// invite can only be redeemed by that address and is sent to it.
+func (s *InviteService) CreateInvite(ctx context.Context, createdBy, email string) (*domain.InviteToken, error) {
+	if email != "" {
+		existingUser, err := s.userRepository.GetUserByEmail(ctx, email)
+		if err != nil {
+			return nil, err
+		}
+		if existingUser != nil {
+			return nil, fmt.Errorf("User already exists")
+		}
+	}
+
+	var invite *domain.InviteToken
+	err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		invite, err = s.inviteRepository.CreateInvite(ctx, createdBy, email)
+		if err != nil {
+			return err
+		}
+		if email == "" {
+			return nil
+		}
+
+		// The invitee has no account yet, so the email uses the inviter's locale
+		inviter, err := s.userRepository.GetUserByID(ctx, createdBy)
+		if err != nil {
+			return err
+		}
+
+		recipient := domain.Recipient{Email: email}
+		if inviter != nil {
+			recipient.Locale = inviter.Locale
+		}
+
+		return s.userNotifier.NotifyInvite(ctx, recipient, invite.Token)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return invite, nil
+}
+
+// ListInvites returns the invites created by the user, or every invite when all is set.
+func (s *InviteService) ListInvites(ctx context.Context, userID string, all bool) ([]domain.Invite, error) {
+	if all {
+		return s.inviteRepository.GetInvites(ctx)
+	}
+	return s.inviteRepository.GetInvitesByCreator(ctx, userID)
+}
+
+// RevokeInvite revokes an unused invite. Only its creator or an admin can revoke it.
+func (s *InviteService) RevokeInvite(ctx context.Context, userID, inviteID string, isAdmin bool) error {
+	invite, err := s.inviteRepository.GetInviteByPublicID(ctx, inviteID)
+	if err != nil {
+		return err
+	}
+	if invite == nil {
+		return ErrInviteNotFound
+	}
+	if invite.CreatedBy != userID && !isAdmin {
+		return ErrInviteForbidden
+	}
+
+	revoked, err := s.inviteRepository.RevokeInvite(ctx, inviteID)
+	if err != nil {
+		return err
+	}
+	if !revoked {
+		return fmt.Errorf("Invite was already used or revoked")
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"main/internal/core/domain"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestInviteService_CreateInviteRejectsExistingUser(t *testing.T) {
+	users := &fakeUserRepository{user: &domain.User{ID: "2", Email: "jane@example.com"}}
+	notifier := &fakeUserNotifier{}
+	s := NewInviteService(&fakeInviteRepository{}, users, notifier, fakeTransactor{})
+
+	if _, err := s.CreateInvite(context.Background(), "1", "JANE@example.com"); err == nil {
+		t.Fatal("expected an invite for an existing user to be rejected")
+	}
+	if len(notifier.deliveryIDs) != 0 {
+		t.Errorf("expected no invite email, got %d", len(notifier.deliveryIDs))
+	}
+
+	if _, err := s.CreateInvite(context.Background(), "1", "john@example.com"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(notifier.deliveryIDs) != 1 {
+		t.Errorf("expected the invite email to be sent, got %d", len(notifier.deliveryIDs))
+	}
+}
+
+func TestInviteService_RevokeInvite(t *testing.T) {
+	invite := &domain.Invite{ID: "1", PublicID: uuid.New(), CreatedBy: "1", ExpiresAt: time.Now().Add(time.Hour)}
+	invites := &fakeInviteRepository{invites: map[string]*domain.Invite{"token": invite}}
+	s := NewInviteService(invites, &fakeUserRepository{}, &fakeUserNotifier{}, fakeTransactor{})
+
+	if err := s.RevokeInvite(context.Background(), "2", invite.PublicID.String(), false); !errors.Is(err, ErrInviteForbidden) {
+		t.Fatalf("expected ErrInviteForbidden for another user, got %v", err)
+	}
+	if err := s.RevokeInvite(context.Background(), "1", uuid.NewString(), false); !errors.Is(err, ErrInviteNotFound) {
+		t.Fatalf("expected ErrInviteNotFound, got %v", err)
+	}
+	if err := s.RevokeInvite(context.Background(), "2", invite.PublicID.String(), true); err != nil {
+		t.Fatalf("expected an admin to revoke the invite, got %v", err)
+	}
+	if len(invites.revoked) != 1 || invites.revoked[0] != invite.PublicID.String() {
+		t.Errorf("expected the invite to be revoked, got %v", invites.revoked)
+	}
+}
@@ -0,0 +1,543 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"main/internal/core/domain"
+	"main/internal/core/ports"
+	"slices"
+	"strings"
+	"time"
+)
+
+var (
+	ErrOrganizationNotFound            = errors.New("Organization not found")
+	ErrOrganizationForbidden           = errors.New("Your role doesn't allow this in the organization")
+	ErrInvalidOrganizationName         = errors.New("Organization name can't be empty")
+	ErrInvalidOrganizationRole         = errors.New("Role must be owner, admin or member")
+	ErrOrganizationMemberNotFound      = errors.New("Member not found")
+	ErrOrganizationMemberExists        = errors.New("User is already a member of the organization")
+	ErrLastOrganizationOwner           = errors.New("An organization needs at least one owner")
+	ErrOrganizationInviteNotFound      = errors.New("Invite not found or expired")
+	ErrOrganizationInviteEmailMismatch = errors.New("Invite was issued for a different email")
+	ErrOrganizationGroupNotFound       = errors.New("Group not found")
+	ErrOrganizationGroupExists         = errors.New("The organization has a group with that name")
+	ErrInvalidOrganizationGroupName    = errors.New("Group name can't be empty")
+	ErrInvalidOrganizationGroupMembers = errors.New("Every member of a group must be a member of the organization")
+	ErrTwoFactorUnavailable            = errors.New("Two-factor authentication isn't available on this server yet")
+	ErrInvalidKDFIterations            = errors.New("Minimum KDF iterations must be between 0 and 16384")
+	ErrInvalidSessionMaxLifetime       = errors.New("Session max lifetime must be 0 or at least one hour")
+)
+
+const (
+	// MaxKDFIterations keeps a login under a second or so, the password
+	// hash is computed on the server
+	MaxKDFIterations = 1 << 14
+	// MinSessionMaxLifetime is well above the lifetime of an access token
+	MinSessionMaxLifetime = time.Hour
+)
+
+// OrganizationService manages organizations, their members, groups and
+// policies. The roles of the caller are checked by the middleware with
+// Authorize, the checks here are the ones that depend on the member acted on.
+type OrganizationService struct {
+	organizationRepo ports.OrganizationRepository
+	userRepo         ports.UserRepository
+	userService      *UserService
+	userNotifier     ports.UserNotifier
+	transactor       ports.Transactor
+}
+
+func NewOrganizationService(
+	organizationRepo ports.OrganizationRepository,
+	userRepo ports.UserRepository,
+	userService *UserService,
+	userNotifier ports.UserNotifier,
+	transactor ports.Transactor,
+) *OrganizationService {
+	return &OrganizationService{
+		organizationRepo: organizationRepo,
+		userRepo:         userRepo,
+		userService:      userService,
+		userNotifier:     userNotifier,
+		transactor:       transactor,
+	}
+}
+
+// Create creates an organization with the user as its owner
+func (s *OrganizationService) Create(ctx context.Context, userID, name string) (*domain.Organization, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, ErrInvalidOrganizationName
+	}
+
+	var org *domain.Organization
+	err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		org, err = s.organizationRepo.CreateOrganization(ctx, name)
+		if err != nil {
+			return err
+		}
+		org.Membership, err = s.organizationRepo.CreateMember(ctx, org.ID, userID, domain.OrganizationRoleOwner)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return org, nil
+}
+
+// List returns the organizations of the user with their membership
+func (s *OrganizationService) List(ctx context.Context, userID string) ([]domain.Organization, error) {
+	return s.organizationRepo.ListOrganizations(ctx, userID)
+}
+
+// Authorize returns the organization with the membership of the user. The
+// organizations the user isn't a member of are hidden as not found, a role
+// below role fails with ErrOrganizationForbidden.
+func (s *OrganizationService) Authorize(ctx context.Context, userID, organizationID string, role domain.OrganizationRole) (*domain.Organization, error) {
+	org, err := s.organizationRepo.GetOrganization(ctx, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	if org == nil {
+		return nil, ErrOrganizationNotFound
+	}
+
+	member, err := s.organizationRepo.GetMember(ctx, organizationID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if member == nil {
+		return nil, ErrOrganizationNotFound
+	}
+	if !member.Role.Allows(role) {
+		return nil, ErrOrganizationForbidden
+	}
+
+	org.Membership = member
+	return org, nil
+}
+
+func (s *OrganizationService) Rename(ctx context.Context, org *domain.Organization, name string) (*domain.Organization, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, ErrInvalidOrganizationName
+	}
+
+	renamed, err := s.organizationRepo.RenameOrganization(ctx, org.ID, name)
+	if err != nil {
+		return nil, err
+	}
+	if !renamed {
+		return nil, ErrOrganizationNotFound
+	}
+	org.Name = name
+	return org, nil
+}
+
+// Delete deletes the organization. Its vaults are kept by their owners and
+// stay shared with the members they were shared with.
+func (s *OrganizationService) Delete(ctx context.Context, organizationID string) error {
+	deleted, err := s.organizationRepo.DeleteOrganization(ctx, organizationID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrOrganizationNotFound
+	}
+	return nil
+}
+
+func (s *OrganizationService) ListMembers(ctx context.Context, organizationID string) ([]domain.OrganizationMember, error) {
+	return s.organizationRepo.ListMembers(ctx, organizationID)
+}
+
+// UpdateMemberRole changes the role of a member. Only owners can make or
+// unmake owners, and the last owner can't step down.
+func (s *OrganizationService) UpdateMemberRole(ctx context.Context, org *domain.Organization, memberID string, role domain.OrganizationRole) (*domain.OrganizationMember, error) {
+	if !role.Valid() {
+		return nil, ErrInvalidOrganizationRole
+	}
+
+	member, err := s.organizationMember(ctx, org, memberID)
+	if err != nil {
+		return nil, err
+	}
+	if (role == domain.OrganizationRoleOwner || member.Role == domain.OrganizationRoleOwner) &&
+		!org.Membership.Role.Allows(domain.OrganizationRoleOwner) {
+		return nil, ErrOrganizationForbidden
+	}
+
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if member.Role == domain.OrganizationRoleOwner && role != domain.OrganizationRoleOwner {
+			if err := s.checkOtherOwner(ctx, org.ID); err != nil {
+				return err
+			}
+		}
+
+		updated, err := s.organizationRepo.UpdateMemberRole(ctx, member.ID, role)
+		if err != nil {
+			return err
+		}
+		if !updated {
+			return ErrOrganizationMemberNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	member.Role = role
+	return member, nil
+}
+
+// RemoveMember removes a member from the organization and from its vaults.
+// Admins can remove anyone but the owners, the other members only
+// themselves. The shared vaults the member had the key of need a new one.
+func (s *OrganizationService) RemoveMember(ctx context.Context, org *domain.Organization, memberID string) error {
+	member, err := s.organizationMember(ctx, org, memberID)
+	if err != nil {
+		return err
+	}
+	actor := org.Membership
+	if actor.ID != member.ID {
+		required := domain.OrganizationRoleAdmin
+		if member.Role == domain.OrganizationRoleOwner {
+			required = domain.OrganizationRoleOwner
+		}
+		if !actor.Role.Allows(required) {
+			return ErrOrganizationForbidden
+		}
+	}
+
+	return s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		if member.Role == domain.OrganizationRoleOwner {
+			if err := s.checkOtherOwner(ctx, org.ID); err != nil {
+				return err
+			}
+		}
+
+		deleted, err := s.organizationRepo.DeleteMember(ctx, member.ID)
+		if err != nil {
+			return err
+		}
+		if !deleted {
+			return ErrOrganizationMemberNotFound
+		}
+		return s.organizationRepo.LeaveVaults(ctx, org.ID, member.UserID)
+	})
+}
+
+// Invite emails an invite to join the organization with the role. The
+// invitee needs an account with that email to accept it.
+func (s *OrganizationService) Invite(ctx context.Context, org *domain.Organization, email string, role domain.OrganizationRole) (*domain.OrganizationInviteToken, error) {
+	if !role.Valid() {
+		return nil, ErrInvalidOrganizationRole
+	}
+	if role == domain.OrganizationRoleOwner && !org.Membership.Role.Allows(domain.OrganizationRoleOwner) {
+		return nil, ErrOrganizationForbidden
+	}
+
+	email = strings.TrimSpace(email)
+	policies, err := s.organizationRepo.GetPolicies(ctx, org.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !policies.AllowsEmail(email) {
+		return nil, ErrEmailDomainNotAllowed
+	}
+
+	recipient := domain.Recipient{Email: email}
+	invitee, err := s.userRepo.GetUserByEmail(ctx, email)
+	if err != nil {
+		return nil, err
+	}
+	if invitee != nil {
+		member, err := s.organizationRepo.GetMember(ctx, org.ID, invitee.ID)
+		if err != nil {
+			return nil, err
+		}
+		if member != nil {
+			return nil, ErrOrganizationMemberExists
+		}
+		recipient = invitee.Recipient()
+	} else {
+		// Like instance invites, the email uses the inviter's locale
+		inviter, err := s.userRepo.GetUserByID(ctx, org.Membership.UserID)
+		if err != nil {
+			return nil, err
+		}
+		if inviter != nil {
+			recipient.Locale = inviter.Locale
+		}
+	}
+
+	var invite *domain.OrganizationInviteToken
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		invite, err = s.organizationRepo.CreateInvite(ctx, domain.OrganizationInvite{
+			OrganizationID:   org.ID,
+			OrganizationName: org.Name,
+			Email:            email,
+			Role:             role,
+			InvitedBy:        org.Membership.UserID,
+		})
+		if err != nil {
+			return err
+		}
+		if invite == nil {
+			return ErrOrganizationNotFound
+		}
+		return s.userNotifier.NotifyOrganizationInvite(ctx, recipient, org.Name, invite.Token)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return invite, nil
+}
+
+// ListInvites returns the invites that haven't expired
+func (s *OrganizationService) ListInvites(ctx context.Context, organizationID string) ([]domain.OrganizationInvite, error) {
+	return s.organizationRepo.ListInvites(ctx, organizationID)
+}
+
+func (s *OrganizationService) RevokeInvite(ctx context.Context, organizationID, inviteID string) error {
+	deleted, err := s.organizationRepo.DeleteInvite(ctx, organizationID, inviteID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrOrganizationInviteNotFound
+	}
+	return nil
+}
+
+// AcceptInvite makes the user a member of the organization of the invite.
+// The invite must be for the email of the user, which the policies of the
+// organization must allow.
+func (s *OrganizationService) AcceptInvite(ctx context.Context, userID, token string) (*domain.Organization, error) {
+	invite, err := s.organizationRepo.GetInviteByToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if !invite.IsUsable() {
+		return nil, ErrOrganizationInviteNotFound
+	}
+
+	user, err := s.userService.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(user.Email, invite.Email) {
+		return nil, ErrOrganizationInviteEmailMismatch
+	}
+
+	policies, err := s.organizationRepo.GetPolicies(ctx, invite.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.userService.CheckOrganizationPolicies(ctx, userID, *policies); err != nil {
+		return nil, err
+	}
+
+	var member *domain.OrganizationMember
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		// Deleting the invite first makes it single use
+		deleted, err := s.organizationRepo.DeleteInvite(ctx, invite.OrganizationID, invite.ID)
+		if err != nil {
+			return err
+		}
+		if !deleted {
+			return ErrOrganizationInviteNotFound
+		}
+
+		member, err = s.organizationRepo.CreateMember(ctx, invite.OrganizationID, userID, invite.Role)
+		if err != nil {
+			return err
+		}
+		if member == nil {
+			return ErrOrganizationMemberExists
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	org, err := s.organizationRepo.GetOrganization(ctx, invite.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	if org == nil {
+		return nil, ErrOrganizationNotFound
+	}
+	org.Membership = member
+	return org, nil
+}
+
+func (s *OrganizationService) ListGroups(ctx context.Context, organizationID string) ([]domain.OrganizationGroup, error) {
+	return s.organizationRepo.ListGroups(ctx, organizationID)
+}
+
+func (s *OrganizationService) CreateGroup(ctx context.Context, organizationID, name string, memberIDs []string) (*domain.OrganizationGroup, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, ErrInvalidOrganizationGroupName
+	}
+
+	var group *domain.OrganizationGroup
+	err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		group, err = s.organizationRepo.CreateGroup(ctx, organizationID, name)
+		if err != nil {
+			return err
+		}
+		if group == nil {
+			return ErrOrganizationGroupExists
+		}
+		return s.setGroupMembers(ctx, group, memberIDs)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+// UpdateGroup renames the group and replaces its members
+func (s *OrganizationService) UpdateGroup(ctx context.Context, organizationID, groupID, name string, memberIDs []string) (*domain.OrganizationGroup, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, ErrInvalidOrganizationGroupName
+	}
+
+	group, err := s.organizationGroup(ctx, organizationID, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		renamed, err := s.organizationRepo.RenameGroup(ctx, group.ID, name)
+		if err != nil {
+			return err
+		}
+		if !renamed {
+			return ErrOrganizationGroupExists
+		}
+		group.Name = name
+		return s.setGroupMembers(ctx, group, memberIDs)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+func (s *OrganizationService) DeleteGroup(ctx context.Context, organizationID, groupID string) error {
+	group, err := s.organizationGroup(ctx, organizationID, groupID)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := s.organizationRepo.DeleteGroup(ctx, group.ID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrOrganizationGroupNotFound
+	}
+	return nil
+}
+
+func (s *OrganizationService) GetPolicies(ctx context.Context, organizationID string) (*domain.OrganizationPolicies, error) {
+	return s.organizationRepo.GetPolicies(ctx, organizationID)
+}
+
+// UpdatePolicies replaces the policies of the organization. They apply to
+// the next login of the members, the allowed email domains to the members
+// that join from now on.
+func (s *OrganizationService) UpdatePolicies(ctx context.Context, organizationID string, policies domain.OrganizationPolicies) (*domain.OrganizationPolicies, error) {
+	// Requiring what no member can set up would lock everyone out
+	if policies.Require2FA {
+		return nil, ErrTwoFactorUnavailable
+	}
+	if policies.MinKDFIterations < 0 || policies.MinKDFIterations > MaxKDFIterations {
+		return nil, ErrInvalidKDFIterations
+	}
+	if policies.SessionMaxLifetime < 0 || (policies.SessionMaxLifetime > 0 && policies.SessionMaxLifetime < MinSessionMaxLifetime) {
+		return nil, ErrInvalidSessionMaxLifetime
+	}
+	policies.AllowedEmailDomains = domain.NormalizeEmailDomains(policies.AllowedEmailDomains)
+
+	if err := s.organizationRepo.SetPolicies(ctx, organizationID, policies); err != nil {
+		return nil, err
+	}
+	return &policies, nil
+}
+
+// UserPolicies returns the strictest of the policies of the organizations of
+// the user
+func (s *OrganizationService) UserPolicies(ctx context.Context, userID string) (domain.OrganizationPolicies, error) {
+	policies, err := s.organizationRepo.GetPoliciesByUserID(ctx, userID)
+	if err != nil {
+		return domain.OrganizationPolicies{}, err
+	}
+	return domain.MergeOrganizationPolicies(policies), nil
+}
+
+// organizationMember hides the members of other organizations as not found
+func (s *OrganizationService) organizationMember(ctx context.Context, org *domain.Organization, memberID string) (*domain.OrganizationMember, error) {
+	member, err := s.organizationRepo.GetMemberByID(ctx, memberID)
+	if err != nil {
+		return nil, err
+	}
+	if member == nil || member.OrganizationID != org.ID {
+		return nil, ErrOrganizationMemberNotFound
+	}
+	return member, nil
+}
+
+// organizationGroup hides the groups of other organizations as not found
+func (s *OrganizationService) organizationGroup(ctx context.Context, organizationID, groupID string) (*domain.OrganizationGroup, error) {
+	group, err := s.organizationRepo.GetGroup(ctx, groupID)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil || group.OrganizationID != organizationID {
+		return nil, ErrOrganizationGroupNotFound
+	}
+	return group, nil
+}
+
+func (s *OrganizationService) setGroupMembers(ctx context.Context, group *domain.OrganizationGroup, memberIDs []string) error {
+	unique := make([]string, 0, len(memberIDs))
+	for _, id := range memberIDs {
+		if !slices.Contains(unique, id) {
+			unique = append(unique, id)
+		}
+	}
+	memberIDs = unique
+
+	set, err := s.organizationRepo.SetGroupMembers(ctx, group.ID, memberIDs)
+	if err != nil {
+		return err
+	}
+	if !set {
+		return ErrInvalidOrganizationGroupMembers
+	}
+	group.MemberIDs = memberIDs
+	return nil
+}
+
+// checkOtherOwner fails when the organization has a single owner, which is
+// about to be demoted or removed
+func (s *OrganizationService) checkOtherOwner(ctx context.Context, organizationID string) error {
+	owners, err := s.organizationRepo.CountOwners(ctx, organizationID)
+	if err != nil {
+		return err
+	}
+	if owners < 2 {
+		return ErrLastOrganizationOwner
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"main/internal/core/domain"
+	"main/internal/core/ports"
+	"testing"
+	"time"
+)
+
+// fakeOrganizationRepository keeps the members of a single organization
+type fakeOrganizationRepository struct {
+	ports.OrganizationRepository
+	members  []*domain.OrganizationMember
+	left     []string
+	policies domain.OrganizationPolicies
+}
+
+func (r *fakeOrganizationRepository) GetOrganization(ctx context.Context, organizationID string) (*domain.Organization, error) {
+	return &domain.Organization{ID: organizationID, Name: "Acme"}, nil
+}
+
+func (r *fakeOrganizationRepository) GetMember(ctx context.Context, organizationID, userID string) (*domain.OrganizationMember, error) {
+	for _, m := range r.members {
+		if m.OrganizationID == organizationID && m.UserID == userID {
+			member := *m
+			return &member, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeOrganizationRepository) GetMemberByID(ctx context.Context, memberID string) (*domain.OrganizationMember, error) {
+	for _, m := range r.members {
+		if m.ID == memberID {
+			member := *m
+			return &member, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeOrganizationRepository) UpdateMemberRole(ctx context.Context, memberID string, role domain.OrganizationRole) (bool, error) {
+	for _, m := range r.members {
+		if m.ID == memberID {
+			m.Role = role
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *fakeOrganizationRepository) DeleteMember(ctx context.Context, memberID string) (bool, error) {
+	for i, m := range r.members {
+		if m.ID == memberID {
+			r.members = append(r.members[:i], r.members[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *fakeOrganizationRepository) CountOwners(ctx context.Context, organizationID string) (int, error) {
+	owners := 0
+	for _, m := range r.members {
+		if m.OrganizationID == organizationID && m.Role == domain.OrganizationRoleOwner {
+			owners++
+		}
+	}
+	return owners, nil
+}
+
+func (r *fakeOrganizationRepository) LeaveVaults(ctx context.Context, organizationID, userID string) error {
+	r.left = append(r.left, userID)
+	return nil
+}
+
+func (r *fakeOrganizationRepository) SetPolicies(ctx context.Context, organizationID string, policies domain.OrganizationPolicies) error {
+	r.policies = policies
+	return nil
+}
+
+func TestOrganizationService_Roles(t *testing.T) {
+	repo := &fakeOrganizationRepository{members: []*domain.OrganizationMember{
+		{ID: "m1", OrganizationID: "o1", UserID: "1", Role: domain.OrganizationRoleOwner},
+		{ID: "m2", OrganizationID: "o1", UserID: "2", Role: domain.OrganizationRoleAdmin},
+		{ID: "m3", OrganizationID: "o1", UserID: "3", Role: domain.OrganizationRoleMember},
+	}}
+	s := NewOrganizationService(repo, &fakeUserRepository{}, nil, nil, fakeTransactor{})
+	ctx := context.Background()
+
+	if _, err := s.Authorize(ctx, "4", "o1", domain.OrganizationRoleMember); !errors.Is(err, ErrOrganizationNotFound) {
+		t.Errorf("expected a non-member to see no organization, got %v", err)
+	}
+	if _, err := s.Authorize(ctx, "3", "o1", domain.OrganizationRoleAdmin); !errors.Is(err, ErrOrganizationForbidden) {
+		t.Errorf("expected a member to be refused administering, got %v", err)
+	}
+	owner, _ := s.Authorize(ctx, "1", "o1", domain.OrganizationRoleOwner)
+	admin, _ := s.Authorize(ctx, "2", "o1", domain.OrganizationRoleAdmin)
+	member, _ := s.Authorize(ctx, "3", "o1", domain.OrganizationRoleMember)
+
+	if _, err := s.UpdateMemberRole(ctx, admin, "m3", domain.OrganizationRoleOwner); !errors.Is(err, ErrOrganizationForbidden) {
+		t.Errorf("expected an admin to be refused making owners, got %v", err)
+	}
+	if err := s.RemoveMember(ctx, admin, "m1"); !errors.Is(err, ErrOrganizationForbidden) {
+		t.Errorf("expected an admin to be refused removing an owner, got %v", err)
+	}
+	if err := s.RemoveMember(ctx, member, "m2"); !errors.Is(err, ErrOrganizationForbidden) {
+		t.Errorf("expected a member to be refused removing others, got %v", err)
+	}
+	if _, err := s.UpdateMemberRole(ctx, owner, "m1", domain.OrganizationRoleAdmin); !errors.Is(err, ErrLastOrganizationOwner) {
+		t.Errorf("expected the last owner to stay, got %v", err)
+	}
+
+	// With a second owner the first one can step down
+	if _, err := s.UpdateMemberRole(ctx, owner, "m2", domain.OrganizationRoleOwner); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := s.UpdateMemberRole(ctx, owner, "m1", domain.OrganizationRoleAdmin); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Leaving the organization leaves its vaults too
+	if err := s.RemoveMember(ctx, member, "m3"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(repo.members) != 2 || len(repo.left) != 1 || repo.left[0] != "3" {
+		t.Errorf("expected the member to leave the organization and its vaults, got %v", repo.left)
+	}
+}
+
+func TestOrganizationService_UpdatePolicies(t *testing.T) {
+	repo := &fakeOrganizationRepository{}
+	s := NewOrganizationService(repo, &fakeUserRepository{}, nil, nil, fakeTransactor{})
+	ctx := context.Background()
+
+	invalid := []domain.OrganizationPolicies{
+		{Require2FA: true},
+		{MinKDFIterations: MaxKDFIterations + 1},
+		{SessionMaxLifetime: time.Minute},
+	}
+	for _, policies := range invalid {
+		if _, err := s.UpdatePolicies(ctx, "o1", policies); err == nil {
+			t.Errorf("expected %+v to be refused", policies)
+		}
+	}
+
+	policies, err := s.UpdatePolicies(ctx, "o1", domain.OrganizationPolicies{
+		MinKDFIterations:    4096,
+		AllowedEmailDomains: []string{" @Example.com", "example.com", ""},
+		SessionMaxLifetime:  8 * time.Hour,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(policies.AllowedEmailDomains) != 1 || policies.AllowedEmailDomains[0] != "example.com" {
+		t.Errorf("expected the domains to be normalized, got %v", policies.AllowedEmailDomains)
+	}
+	if !repo.policies.AllowsEmail("alice@mail.example.com") || repo.policies.AllowsEmail("alice@example.org") {
+		t.Errorf("expected only example.com and its subdomains to be allowed, got %+v", repo.policies)
+	}
+
+	merged := domain.MergeOrganizationPolicies([]domain.OrganizationPolicies{*policies, {MinKDFIterations: 1024, SessionMaxLifetime: 2 * time.Hour}, {}})
+	if merged.MinKDFIterations != 4096 || merged.SessionMaxLifetime != 2*time.Hour {
+		t.Errorf("expected the strictest policies, got %+v", merged)
+	}
+}
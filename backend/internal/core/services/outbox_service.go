@@ -0,0 +1,153 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"expvar"
+	"fmt"
+	"log"
+	"main/internal/core/domain"
+	"main/internal/core/ports"
+	"main/internal/utils"
+	"time"
+)
+
+var ErrOutboxMessageNotFound = errors.New("Outbox message not found or not dead-lettered")
+
+// Exposed on /debug/vars
+var outboxMetrics = expvar.NewMap("email_outbox")
+
+type OutboxConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+	MaxAttempts  int
+	BackoffBase  time.Duration
+	BackoffMax   time.Duration
+	// Lease is how long a claimed message stays invisible to other workers
+	Lease time.Duration
+}
+
+type OutboxService struct {
+	outboxRepository ports.OutboxRepository
+	// delivery is the notifier that actually reaches the user
+	delivery ports.UserNotifier
+	config   OutboxConfig
+}
+
+func NewOutboxService(outboxRepo ports.OutboxRepository, delivery ports.UserNotifier, config OutboxConfig) *OutboxService {
+	return &OutboxService{outboxRepository: outboxRepo, delivery: delivery, config: config}
+}
+
+// Run delivers outbox messages until ctx is cancelled
+func (s *OutboxService) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			delivered, err := s.ProcessBatch(ctx)
+			if err != nil {
+				log.Printf("outbox: failed to process batch: %v", err)
+			}
+			// Keep draining while batches are full
+			if err != nil || delivered < s.config.BatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProcessBatch claims due messages and tries to deliver each of them once
+func (s *OutboxService) ProcessBatch(ctx context.Context) (int, error) {
+	messages, err := s.outboxRepository.ClaimMessages(ctx, s.config.BatchSize, s.config.Lease)
+	if err != nil {
+		return 0, err
+	}
+	outboxMetrics.Add("claimed", int64(len(messages)))
+
+	for _, message := range messages {
+		if err := s.deliver(ctx, message); err != nil {
+			s.handleFailure(ctx, message, err)
+			continue
+		}
+
+		if err := s.outboxRepository.MarkMessageSent(ctx, message.ID); err != nil {
+			log.Printf("outbox: failed to mark message %s as sent: %v", message.PublicID, err)
+			continue
+		}
+		outboxMetrics.Add("sent", 1)
+	}
+
+	return len(messages), nil
+}
+
+func (s *OutboxService) deliver(ctx context.Context, message domain.OutboxMessage) error {
+	var payload domain.NotificationPayload
+	if err := json.Unmarshal(message.Payload, &payload); err != nil {
+		return fmt.Errorf("invalid payload: %w", err)
+	}
+
+	// The message id is stable across retries, so the receiving side can deduplicate
+	ctx = domain.WithDeliveryID(ctx, message.PublicID.String())
+
+	switch message.Kind {
+	case domain.NotificationRegistrationIntent:
+		return s.delivery.NotifyRegistrationIntent(ctx, payload.Recipient, payload.Code)
+	case domain.NotificationRegistrationSuccess:
+		return s.delivery.NotifyRegistrationSuccess(ctx, payload.Recipient, payload.UserID)
+	case domain.NotificationInvite:
+		return s.delivery.NotifyInvite(ctx, payload.Recipient, payload.Code)
+	case domain.NotificationOrganizationInvite:
+		return s.delivery.NotifyOrganizationInvite(ctx, payload.Recipient, payload.Organization, payload.Code)
+	case domain.NotificationEmergencyAccess:
+		if payload.EmergencyAccess == nil {
+			return fmt.Errorf("emergency access request without its details")
+		}
+		return s.delivery.NotifyEmergencyAccessRequest(ctx, payload.Recipient, *payload.EmergencyAccess)
+	default:
+		return fmt.Errorf("unknown notification kind %q", message.Kind)
+	}
+}
+
+func (s *OutboxService) handleFailure(ctx context.Context, message domain.OutboxMessage, deliveryErr error) {
+	status := domain.OutboxStatusPending
+	nextAttemptAt := time.Now().Add(utils.ExponentialBackoff(message.Attempts, s.config.BackoffBase, s.config.BackoffMax))
+
+	if message.Attempts >= s.config.MaxAttempts {
+		status = domain.OutboxStatusDead
+		outboxMetrics.Add("dead_lettered", 1)
+		log.Printf("outbox: message %s dead-lettered after %d attempts: %v", message.PublicID, message.Attempts, deliveryErr)
+	} else {
+		outboxMetrics.Add("failed", 1)
+	}
+
+	err := s.outboxRepository.MarkMessageFailed(ctx, message.ID, status, deliveryErr.Error(), nextAttemptAt)
+	if err != nil {
+		log.Printf("outbox: failed to record failure of message %s: %v", message.PublicID, err)
+	}
+}
+
+func (s *OutboxService) ListMessages(ctx context.Context, status domain.OutboxStatus, limit int) ([]domain.OutboxMessage, error) {
+	return s.outboxRepository.GetMessagesByStatus(ctx, status, limit)
+}
+
+// RequeueMessage resets a dead-lettered message so the worker picks it up again
+func (s *OutboxService) RequeueMessage(ctx context.Context, publicID string) error {
+	requeued, err := s.outboxRepository.RequeueMessage(ctx, publicID)
+	if err != nil {
+		return err
+	}
+	if !requeued {
+		return ErrOutboxMessageNotFound
+	}
+
+	outboxMetrics.Add("requeued", 1)
+	return nil
+}
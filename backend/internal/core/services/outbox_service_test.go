@@ -0,0 +1,142 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"main/internal/core/domain"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type fakeOutboxRepository struct {
+	pending []domain.OutboxMessage
+	sent    []string
+	failed  map[string]domain.OutboxStatus
+}
+
+func (r *fakeOutboxRepository) EnqueueMessage(ctx context.Context, idempotencyKey string, kind domain.NotificationKind, payload []byte) error {
+	return nil
+}
+
+func (r *fakeOutboxRepository) ClaimMessages(ctx context.Context, batchSize int, lease time.Duration) ([]domain.OutboxMessage, error) {
+	claimed := r.pending
+	r.pending = nil
+	for i := range claimed {
+		claimed[i].Attempts++
+	}
+	return claimed, nil
+}
+
+func (r *fakeOutboxRepository) MarkMessageSent(ctx context.Context, id string) error {
+	r.sent = append(r.sent, id)
+	return nil
+}
+
+func (r *fakeOutboxRepository) MarkMessageFailed(ctx context.Context, id string, status domain.OutboxStatus, lastError string, nextAttemptAt time.Time) error {
+	r.failed[id] = status
+	return nil
+}
+
+func (r *fakeOutboxRepository) GetMessagesByStatus(ctx context.Context, status domain.OutboxStatus, limit int) ([]domain.OutboxMessage, error) {
+	return nil, nil
+}
+
+func (r *fakeOutboxRepository) RequeueMessage(ctx context.Context, publicID string) (bool, error) {
+	return false, nil
+}
+
+type fakeUserNotifier struct {
+	err         error
+	deliveryIDs []string
+}
+
+func (n *fakeUserNotifier) NotifyRegistrationIntent(ctx context.Context, to domain.Recipient, code string) error {
+	n.deliveryIDs = append(n.deliveryIDs, domain.DeliveryID(ctx))
+	return n.err
+}
+
+func (n *fakeUserNotifier) NotifyRegistrationSuccess(ctx context.Context, to domain.Recipient, userID string) error {
+	n.deliveryIDs = append(n.deliveryIDs, domain.DeliveryID(ctx))
+	return n.err
+}
+
+func (n *fakeUserNotifier) NotifyInvite(ctx context.Context, to domain.Recipient, token string) error {
+	n.deliveryIDs = append(n.deliveryIDs, domain.DeliveryID(ctx))
+	return n.err
+}
+
+func (n *fakeUserNotifier) NotifyOrganizationInvite(ctx context.Context, to domain.Recipient, organization, token string) error {
+	n.deliveryIDs = append(n.deliveryIDs, domain.DeliveryID(ctx))
+	return n.err
+}
+
+func (n *fakeUserNotifier) NotifyEmergencyAccessRequest(ctx context.Context, to domain.Recipient, request domain.EmergencyAccessRequest) error {
+	n.deliveryIDs = append(n.deliveryIDs, domain.DeliveryID(ctx))
+	return n.err
+}
+
+func newTestOutboxMessage(t *testing.T, id string, attempts int) domain.OutboxMessage {
+	t.Helper()
+	payload, err := json.Marshal(domain.NotificationPayload{Recipient: domain.Recipient{Email: "jane@example.com"}, Code: "code"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return domain.OutboxMessage{
+		ID:       id,
+		PublicID: uuid.New(),
+		Kind:     domain.NotificationRegistrationIntent,
+		Payload:  payload,
+		Attempts: attempts,
+	}
+}
+
+func testOutboxConfig() OutboxConfig {
+	return OutboxConfig{BatchSize: 10, MaxAttempts: 3, BackoffBase: time.Second, BackoffMax: time.Minute, Lease: time.Minute}
+}
+
+func TestOutboxService_ProcessBatchDelivers(t *testing.T) {
+	message := newTestOutboxMessage(t, "1", 0)
+	repo := &fakeOutboxRepository{pending: []domain.OutboxMessage{message}, failed: map[string]domain.OutboxStatus{}}
+	notifier := &fakeUserNotifier{}
+
+	s := NewOutboxService(repo, notifier, testOutboxConfig())
+	if _, err := s.ProcessBatch(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(repo.sent) != 1 || repo.sent[0] != "1" {
+		t.Errorf("expected message 1 to be marked sent, got %v", repo.sent)
+	}
+	if len(notifier.deliveryIDs) != 1 || notifier.deliveryIDs[0] != message.PublicID.String() {
+		t.Errorf("expected delivery id %s, got %v", message.PublicID, notifier.deliveryIDs)
+	}
+}
+
+func TestOutboxService_ProcessBatchRetriesThenDeadLetters(t *testing.T) {
+	repo := &fakeOutboxRepository{
+		pending: []domain.OutboxMessage{
+			newTestOutboxMessage(t, "1", 0),
+			newTestOutboxMessage(t, "2", 2),
+		},
+		failed: map[string]domain.OutboxStatus{},
+	}
+	notifier := &fakeUserNotifier{err: errors.New("smtp unavailable")}
+
+	s := NewOutboxService(repo, notifier, testOutboxConfig())
+	if _, err := s.ProcessBatch(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if repo.failed["1"] != domain.OutboxStatusPending {
+		t.Errorf("expected message 1 to be retried, got %q", repo.failed["1"])
+	}
+	if repo.failed["2"] != domain.OutboxStatusDead {
+		t.Errorf("expected message 2 to be dead-lettered, got %q", repo.failed["2"])
+	}
+	if len(repo.sent) != 0 {
+		t.Errorf("expected no sent messages, got %v", repo.sent)
+	}
+}
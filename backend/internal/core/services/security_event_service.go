@@ -0,0 +1,44 @@
+package services
+
+import (
+	"context"
+	"main/internal/core/domain"
+	"main/internal/core/ports"
+)
+
+const (
+	defaultSecurityEventPageSize = 50
+	maxSecurityEventPageSize     = 200
+)
+
+type SecurityEventService struct {
+	securityEventRepository ports.SecurityEventRepository
+}
+
+func NewSecurityEventService(securityEventRepo ports.SecurityEventRepository) *SecurityEventService {
+	return &SecurityEventService{securityEventRepository: securityEventRepo}
+}
+
+// ListEvents returns a page of the user's events, newest first, and the cursor
+// of the next page, empty on the last one.
+func (s *SecurityEventService) ListEvents(ctx context.Context, userID string, filter domain.SecurityEventFilter) ([]domain.SecurityEvent, string, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = defaultSecurityEventPageSize
+	}
+	filter.Limit = min(filter.Limit, maxSecurityEventPageSize)
+
+	// One more than the page tells whether there is a next one
+	pageSize := filter.Limit
+	filter.Limit++
+
+	events, err := s.securityEventRepository.GetEventsByUser(ctx, userID, filter)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(events) <= pageSize {
+		return events, "", nil
+	}
+	events = events[:pageSize]
+	return events, events[pageSize-1].PublicID.String(), nil
+}
@@ -0,0 +1,81 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"main/internal/core/domain"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+type fakeSecurityEventRepository struct {
+	events []domain.SecurityEvent
+	filter domain.SecurityEventFilter
+}
+
+func (r *fakeSecurityEventRepository) CreateEvent(ctx context.Context, event domain.SecurityEvent) error {
+	r.events = append(r.events, event)
+	return nil
+}
+
+func (r *fakeSecurityEventRepository) GetEventsByUser(ctx context.Context, userID string, filter domain.SecurityEventFilter) ([]domain.SecurityEvent, error) {
+	r.filter = filter
+	return r.events[:min(filter.Limit, len(r.events))], nil
+}
+
+func TestSecurityEventService_ListEventsPagination(t *testing.T) {
+	repo := &fakeSecurityEventRepository{}
+	for range 3 {
+		repo.events = append(repo.events, domain.SecurityEvent{PublicID: uuid.New()})
+	}
+	s := NewSecurityEventService(repo)
+
+	events, next, err := s.ListEvents(context.Background(), "1", domain.SecurityEventFilter{Limit: 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(events) != 2 || next != repo.events[1].PublicID.String() {
+		t.Errorf("expected 2 events and a cursor on the second, got %d events and cursor %q", len(events), next)
+	}
+	if repo.filter.Limit != 3 {
+		t.Errorf("expected one extra event to be requested, got limit %d", repo.filter.Limit)
+	}
+
+	events, next, err = s.ListEvents(context.Background(), "1", domain.SecurityEventFilter{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(events) != 3 || next != "" {
+		t.Errorf("expected the last page without a cursor, got %d events and cursor %q", len(events), next)
+	}
+}
+
+func TestEventRecorder_RecordUsesClientInfo(t *testing.T) {
+	repo := &fakeSecurityEventRepository{}
+	webhooks := &fakeWebhookRepository{}
+	users := &fakeUserRepository{user: &domain.User{ID: "1", PublicID: uuid.New(), Email: "jane@example.com"}}
+	recorder := NewEventRecorder(repo, webhooks, users)
+	ctx := domain.WithClientInfo(context.Background(), domain.ClientInfo{IP: "203.0.113.7", UserAgent: "test", DeviceID: "laptop"})
+
+	recorder.Record(ctx, domain.SecurityEvent{UserID: "1", Type: domain.SecurityEventLogin})
+
+	if len(repo.events) != 1 {
+		t.Fatalf("expected one event, got %d", len(repo.events))
+	}
+	e := repo.events[0]
+	if e.IP != "203.0.113.7" || e.UserAgent != "test" || e.DeviceID != "laptop" {
+		t.Errorf("expected the client info on the event, got %+v", e)
+	}
+
+	if len(webhooks.payloads) != 1 {
+		t.Fatalf("expected one webhook payload, got %d", len(webhooks.payloads))
+	}
+	var payload domain.WebhookEvent
+	if err := json.Unmarshal(webhooks.payloads[0], &payload); err != nil {
+		t.Fatalf("invalid payload: %v", err)
+	}
+	if payload.Type != domain.SecurityEventLogin || payload.User == nil || payload.User.ID != users.user.PublicID.String() {
+		t.Errorf("expected the public user id in the payload, got %+v", payload)
+	}
+}
@@ -0,0 +1,143 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"main/internal/core/domain"
+	"main/internal/core/ports"
+	"main/internal/utils"
+	"regexp"
+	"time"
+)
+
+var (
+	ErrSendNotFound         = errors.New("Send not found or expired")
+	ErrSendPasswordRequired = errors.New("Send needs a password")
+	ErrSendPasswordMismatch = errors.New("Wrong password")
+	ErrInvalidSendPayload   = errors.New("Payload is empty or too large")
+	ErrInvalidSendExpiry    = errors.New("Expiry is too short or too long")
+	ErrInvalidSendViews     = errors.New("Max views is out of range")
+	ErrTooManySends         = errors.New("Too many active sends")
+)
+
+// MinSendTTL keeps a send around long enough for the link to be passed on
+const MinSendTTL = time.Minute
+
+// sendIDPattern matches the IDs the repository assigns, other IDs are not
+// looked up
+var sendIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
+type SendConfig struct {
+	// MaxSize is the largest encrypted payload in bytes
+	MaxSize int
+	// MaxTTL caps the expiry of a send
+	MaxTTL time.Duration
+	// MaxViews caps the views of a send
+	MaxViews int
+	// MaxSends is how many active sends a user can have
+	MaxSends int
+}
+
+// SendService shares secrets through links with people without an
+// account. The server only sees the encrypted payload, the key is in the
+// fragment of the link.
+type SendService struct {
+	sendRepo ports.SendRepository
+	config   SendConfig
+}
+
+func NewSendService(sendRepo ports.SendRepository, config SendConfig) *SendService {
+	return &SendService{sendRepo: sendRepo, config: config}
+}
+
+// Create stores the payload for maxViews views until ttl elapses. password
+// is "" for the sends anyone with the link can view.
+func (s *SendService) Create(ctx context.Context, userID string, payload []byte, ttl time.Duration, maxViews int, password string) (*domain.Send, error) {
+	if len(payload) == 0 || len(payload) > s.config.MaxSize {
+		return nil, ErrInvalidSendPayload
+	}
+	if ttl < MinSendTTL || ttl > s.config.MaxTTL {
+		return nil, ErrInvalidSendExpiry
+	}
+	if maxViews < 1 || maxViews > s.config.MaxViews {
+		return nil, ErrInvalidSendViews
+	}
+
+	send := domain.Send{
+		UserID:    userID,
+		Payload:   payload,
+		MaxViews:  maxViews,
+		ExpiresAt: time.Now().Add(ttl),
+	}
+	if password != "" {
+		hash, err := utils.NewPassword(password)
+		if err != nil {
+			return nil, err
+		}
+		send.PasswordHash = hash
+	}
+
+	created, err := s.sendRepo.CreateSend(ctx, send, s.config.MaxSends)
+	if err != nil {
+		return nil, err
+	}
+	if created == nil {
+		return nil, ErrTooManySends
+	}
+	return created, nil
+}
+
+// View returns the payload and counts the view, the send is gone after its
+// last one. A missing or wrong password doesn't count as a view.
+func (s *SendService) View(ctx context.Context, id, password string) (*domain.Send, error) {
+	if !sendIDPattern.MatchString(id) {
+		return nil, ErrSendNotFound
+	}
+
+	send, err := s.sendRepo.GetSend(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if send == nil {
+		return nil, ErrSendNotFound
+	}
+	if send.HasPassword() {
+		if password == "" {
+			return nil, ErrSendPasswordRequired
+		}
+		if utils.CheckPassword(send.PasswordHash, password) != nil {
+			return nil, ErrSendPasswordMismatch
+		}
+	}
+
+	// The last view may have been taken meanwhile
+	viewed, err := s.sendRepo.ViewSend(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if viewed == nil {
+		return nil, ErrSendNotFound
+	}
+	return viewed, nil
+}
+
+// List returns the active sends of the user without their payloads
+func (s *SendService) List(ctx context.Context, userID string) ([]domain.Send, error) {
+	return s.sendRepo.ListSends(ctx, userID)
+}
+
+// Revoke deletes a send of the user before its last view or its expiry
+func (s *SendService) Revoke(ctx context.Context, userID, id string) error {
+	if !sendIDPattern.MatchString(id) {
+		return ErrSendNotFound
+	}
+
+	deleted, err := s.sendRepo.DeleteSend(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrSendNotFound
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"main/internal/core/domain"
+	"testing"
+	"time"
+)
+
+// fakeSendRepository keeps the sends in memory, without expiry
+type fakeSendRepository struct {
+	sends  map[string]*domain.Send
+	nextID int
+}
+
+func (r *fakeSendRepository) CreateSend(ctx context.Context, send domain.Send, maxSends int) (*domain.Send, error) {
+	if len(r.sends) >= maxSends {
+		return nil, nil
+	}
+	r.nextID++
+	send.ID = string(rune('a' + r.nextID))
+	send.ViewsLeft = send.MaxViews
+	r.sends[send.ID] = &send
+	return &send, nil
+}
+
+func (r *fakeSendRepository) GetSend(ctx context.Context, id string) (*domain.Send, error) {
+	send, ok := r.sends[id]
+	if !ok {
+		return nil, nil
+	}
+	copied := *send
+	copied.Payload = nil
+	return &copied, nil
+}
+
+func (r *fakeSendRepository) ViewSend(ctx context.Context, id string) (*domain.Send, error) {
+	send, ok := r.sends[id]
+	if !ok {
+		return nil, nil
+	}
+	send.ViewsLeft--
+	if send.ViewsLeft == 0 {
+		delete(r.sends, id)
+	}
+	copied := *send
+	return &copied, nil
+}
+
+func (r *fakeSendRepository) ListSends(ctx context.Context, userID string) ([]domain.Send, error) {
+	var sends []domain.Send
+	for _, send := range r.sends {
+		if send.UserID == userID {
+			sends = append(sends, *send)
+		}
+	}
+	return sends, nil
+}
+
+func (r *fakeSendRepository) DeleteSend(ctx context.Context, userID, id string) (bool, error) {
+	send, ok := r.sends[id]
+	if !ok || send.UserID != userID {
+		return false, nil
+	}
+	delete(r.sends, id)
+	return true, nil
+}
+
+func TestSendService_Views(t *testing.T) {
+	repo := &fakeSendRepository{sends: map[string]*domain.Send{}}
+	s := NewSendService(repo, SendConfig{MaxSize: 16, MaxTTL: time.Hour, MaxViews: 5, MaxSends: 2})
+	ctx := context.Background()
+
+	invalid := []struct {
+		payload  []byte
+		ttl      time.Duration
+		maxViews int
+		want     error
+	}{
+		{nil, time.Hour, 1, ErrInvalidSendPayload},
+		{make([]byte, 17), time.Hour, 1, ErrInvalidSendPayload},
+		{[]byte("secret"), time.Second, 1, ErrInvalidSendExpiry},
+		{[]byte("secret"), 2 * time.Hour, 1, ErrInvalidSendExpiry},
+		{[]byte("secret"), time.Hour, 0, ErrInvalidSendViews},
+		{[]byte("secret"), time.Hour, 6, ErrInvalidSendViews},
+	}
+	for _, c := range invalid {
+		if _, err := s.Create(ctx, "1", c.payload, c.ttl, c.maxViews, ""); !errors.Is(err, c.want) {
+			t.Errorf("expected %v, got %v", c.want, err)
+		}
+	}
+
+	send, err := s.Create(ctx, "1", []byte("secret"), time.Hour, 2, "hunter2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !send.HasPassword() || send.PasswordHash == "hunter2" {
+		t.Errorf("expected the password to be hashed, got %q", send.PasswordHash)
+	}
+
+	// A missing or wrong password doesn't use up a view
+	if _, err := s.View(ctx, send.ID, ""); !errors.Is(err, ErrSendPasswordRequired) {
+		t.Errorf("expected the password to be asked, got %v", err)
+	}
+	if _, err := s.View(ctx, send.ID, "hunter3"); !errors.Is(err, ErrSendPasswordMismatch) {
+		t.Errorf("expected a wrong password to be refused, got %v", err)
+	}
+
+	for viewsLeft := 1; viewsLeft >= 0; viewsLeft-- {
+		viewed, err := s.View(ctx, send.ID, "hunter2")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if string(viewed.Payload) != "secret" || viewed.ViewsLeft != viewsLeft {
+			t.Errorf("expected the payload with %d views left, got %q with %d", viewsLeft, viewed.Payload, viewed.ViewsLeft)
+		}
+	}
+	if _, err := s.View(ctx, send.ID, "hunter2"); !errors.Is(err, ErrSendNotFound) {
+		t.Errorf("expected the send to be gone after its last view, got %v", err)
+	}
+
+	// Only the owner revokes a send
+	other, _ := s.Create(ctx, "1", []byte("secret"), time.Hour, 1, "")
+	if err := s.Revoke(ctx, "2", other.ID); !errors.Is(err, ErrSendNotFound) {
+		t.Errorf("expected another user not to revoke the send, got %v", err)
+	}
+	if err := s.Revoke(ctx, "1", other.ID); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := s.View(ctx, other.ID, ""); !errors.Is(err, ErrSendNotFound) {
+		t.Errorf("expected a revoked send to be gone, got %v", err)
+	}
+}
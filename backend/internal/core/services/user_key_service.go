@@ -0,0 +1,128 @@
+package services
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"errors"
+	"main/internal/core/domain"
+	"main/internal/core/ports"
+)
+
+var (
+	ErrUserKeyNotFound        = errors.New("Key not found")
+	ErrUserKeyVersionMismatch = errors.New("Keys were rotated by another device")
+	ErrInvalidPublicKey       = errors.New("Public key doesn't match its algorithm")
+	ErrInvalidPrivateKey      = errors.New("Encrypted private key is empty or too large")
+)
+
+// maxEncryptedPrivateKeySize leaves room for an RSA-4096 key and its
+// encryption envelope
+const maxEncryptedPrivateKeySize = 16 << 10
+
+// UserKeyService keeps the key directory: the keypairs the clients generate
+// for sharing. Rotations add versions, the previous ones stay readable.
+type UserKeyService struct {
+	userKeysRepo ports.UserKeysRepository
+}
+
+func NewUserKeyService(userKeysRepo ports.UserKeysRepository) *UserKeyService {
+	return &UserKeyService{userKeysRepo: userKeysRepo}
+}
+
+// PublishKey stores the keypair as the next version, 1 on the first setup.
+// Another version than the one after the current key fails with
+// ErrUserKeyVersionMismatch, the client then has to fetch the current key
+// first.
+func (s *UserKeyService) PublishKey(ctx context.Context, userID string, key domain.UserKey) (*domain.UserKey, error) {
+	if err := validatePublicKey(key.Algorithm, key.PublicKey); err != nil {
+		return nil, err
+	}
+	if len(key.EncryptedPrivateKey) == 0 || len(key.EncryptedPrivateKey) > maxEncryptedPrivateKeySize {
+		return nil, ErrInvalidPrivateKey
+	}
+
+	key.UserID = userID
+	key.Fingerprint = domain.KeyFingerprint(key.PublicKey)
+	created, err := s.userKeysRepo.CreateKey(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if created == nil {
+		return nil, ErrUserKeyVersionMismatch
+	}
+	return created, nil
+}
+
+// ListKeys returns every version of the keypair of the user, newest first
+func (s *UserKeyService) ListKeys(ctx context.Context, userID string) ([]domain.UserKey, error) {
+	return s.userKeysRepo.GetKeysByUserID(ctx, userID)
+}
+
+// CurrentKey returns the newest keypair of the user, nil before the setup
+func (s *UserKeyService) CurrentKey(ctx context.Context, userID string) (*domain.UserKey, error) {
+	return s.userKeysRepo.GetCurrentKey(ctx, userID)
+}
+
+func (s *UserKeyService) GetKey(ctx context.Context, userID string, version int) (*domain.UserKey, error) {
+	key, err := s.userKeysRepo.GetKey(ctx, userID, version)
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return nil, ErrUserKeyNotFound
+	}
+	return key, nil
+}
+
+// LookupPublicKey returns the current public key of the user with the email
+// or public ID, whichever isn't empty
+func (s *UserKeyService) LookupPublicKey(ctx context.Context, email, publicID string) (*domain.PublicKey, error) {
+	var key *domain.PublicKey
+	var err error
+	if email != "" {
+		key, err = s.userKeysRepo.GetPublicKeyByEmail(ctx, email)
+	} else {
+		key, err = s.userKeysRepo.GetPublicKeyByPublicID(ctx, publicID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		return nil, ErrUserKeyNotFound
+	}
+	return key, nil
+}
+
+// validatePublicKey checks the key can be used by the other clients, a key
+// they fail to import would only show up when someone shares with its owner
+func validatePublicKey(algorithm domain.UserKeyAlgorithm, publicKey []byte) error {
+	switch algorithm {
+	case domain.UserKeyX25519:
+		if len(publicKey) != 32 {
+			return ErrInvalidPublicKey
+		}
+		return nil
+	case domain.UserKeyECDHP256, domain.UserKeyRSAOAEP256:
+	default:
+		return ErrInvalidPublicKey
+	}
+
+	parsed, err := x509.ParsePKIXPublicKey(publicKey)
+	if err != nil {
+		return ErrInvalidPublicKey
+	}
+	switch key := parsed.(type) {
+	case *ecdsa.PublicKey:
+		if algorithm == domain.UserKeyECDHP256 && key.Curve == elliptic.P256() {
+			return nil
+		}
+	case *rsa.PublicKey:
+		if algorithm == domain.UserKeyRSAOAEP256 && key.N.BitLen() >= 2048 {
+			return nil
+		}
+	}
+	return ErrInvalidPublicKey
+}
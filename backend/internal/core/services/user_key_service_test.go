@@ -0,0 +1,93 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"errors"
+	"main/internal/core/domain"
+	"testing"
+)
+
+// fakeUserKeysRepository keeps the keys of one user, newest first
+type fakeUserKeysRepository struct {
+	keys []domain.UserKey
+}
+
+func (r *fakeUserKeysRepository) CreateKey(ctx context.Context, key domain.UserKey) (*domain.UserKey, error) {
+	if key.Version != len(r.keys)+1 {
+		return nil, nil
+	}
+	r.keys = append([]domain.UserKey{key}, r.keys...)
+	return &key, nil
+}
+
+func (r *fakeUserKeysRepository) GetCurrentKey(ctx context.Context, userID string) (*domain.UserKey, error) {
+	if len(r.keys) == 0 {
+		return nil, nil
+	}
+	return &r.keys[0], nil
+}
+
+func (r *fakeUserKeysRepository) GetKey(ctx context.Context, userID string, version int) (*domain.UserKey, error) {
+	for _, k := range r.keys {
+		if k.Version == version {
+			return &k, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeUserKeysRepository) GetKeysByUserID(ctx context.Context, userID string) ([]domain.UserKey, error) {
+	return r.keys, nil
+}
+
+func (r *fakeUserKeysRepository) GetPublicKeyByEmail(ctx context.Context, email string) (*domain.PublicKey, error) {
+	return nil, nil
+}
+
+func (r *fakeUserKeysRepository) GetPublicKeyByPublicID(ctx context.Context, publicID string) (*domain.PublicKey, error) {
+	return nil, nil
+}
+
+func TestUserKeyService_PublishAndRotate(t *testing.T) {
+	repo := &fakeUserKeysRepository{}
+	s := NewUserKeyService(repo)
+	ctx := context.Background()
+
+	private, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spki, err := x509.MarshalPKIXPublicKey(&private.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key := domain.UserKey{Version: 1, Algorithm: domain.UserKeyRSAOAEP256, PublicKey: spki, EncryptedPrivateKey: []byte("sealed")}
+	if _, err := s.PublishKey(ctx, "1", key); !errors.Is(err, ErrInvalidPublicKey) {
+		t.Fatalf("expected a P-256 key to be refused as RSA, got %v", err)
+	}
+
+	key.Algorithm = domain.UserKeyECDHP256
+	created, err := s.PublishKey(ctx, "1", key)
+	if err != nil || created.Fingerprint != domain.KeyFingerprint(spki) {
+		t.Fatalf("expected the key with its fingerprint, got %+v (%v)", created, err)
+	}
+
+	// Two devices rotating from version 1, only the first one wins
+	rotated := domain.UserKey{Version: 2, Algorithm: domain.UserKeyX25519, PublicKey: bytes.Repeat([]byte{1}, 32), EncryptedPrivateKey: []byte("sealed")}
+	if _, err := s.PublishKey(ctx, "1", rotated); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := s.PublishKey(ctx, "1", rotated); !errors.Is(err, ErrUserKeyVersionMismatch) {
+		t.Errorf("expected ErrUserKeyVersionMismatch, got %v", err)
+	}
+
+	if old, err := s.GetKey(ctx, "1", 1); err != nil || old.Algorithm != domain.UserKeyECDHP256 {
+		t.Errorf("expected the first version to stay readable, got %+v (%v)", old, err)
+	}
+}
@@ -2,17 +2,26 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
 	"main/internal/core/domain"
 	"main/internal/core/ports"
 	"main/internal/utils"
+	"strings"
 )
 
+var ErrEmailDomainNotAllowed = errors.New("The organization doesn't allow the domain of your email")
+
 type UserService struct {
 	userRepository       ports.UserRepository
 	sessionRepository    ports.SessionRepository
 	userIntentRepository ports.UserIntentRepository
 	userNotifier         ports.UserNotifier
+	inviteRepository     ports.InviteRepository
+	transactor           ports.Transactor
+	eventRecorder        *EventRecorder
+	registrationPolicy   domain.RegistrationPolicy
 }
 
 func NewUserService(
@@ -20,8 +29,21 @@ func NewUserService(
 	userIntentRepo ports.UserIntentRepository,
 	userNotifier ports.UserNotifier,
 	sessionRepo ports.SessionRepository,
+	inviteRepo ports.InviteRepository,
+	transactor ports.Transactor,
+	eventRecorder *EventRecorder,
+	registrationPolicy domain.RegistrationPolicy,
 ) *UserService {
-	return &UserService{userRepository: userRepo, userIntentRepository: userIntentRepo, userNotifier: userNotifier, sessionRepository: sessionRepo}
+	return &UserService{
+		userRepository:       userRepo,
+		userIntentRepository: userIntentRepo,
+		userNotifier:         userNotifier,
+		sessionRepository:    sessionRepo,
+		inviteRepository:     inviteRepo,
+		transactor:           transactor,
+		eventRecorder:        eventRecorder,
+		registrationPolicy:   registrationPolicy,
+	}
 }
 
 func (s *UserService) GetUsers(ctx context.Context) ([]domain.User, error) {
@@ -52,7 +74,20 @@ func (s *UserService) GetUserByID(ctx context.Context, id string) (*domain.User,
 	return user, nil
 }
 
-func (s *UserService) CreateUser(ctx context.Context, name, email, password string) (*domain.RegistrationIntentToken, error) {
+// CheckOrganizationPolicies tells whether the user can be a member of an
+// organization with the policies
+func (s *UserService) CheckOrganizationPolicies(ctx context.Context, userID string, policies domain.OrganizationPolicies) error {
+	user, err := s.GetUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !policies.AllowsEmail(user.Email) {
+		return ErrEmailDomainNotAllowed
+	}
+	return nil
+}
+
+func (s *UserService) CreateUser(ctx context.Context, name, email, password, locale, inviteToken string) (*domain.RegistrationIntentToken, error) {
 	existingUser, err := s.userRepository.GetUserByEmail(ctx, email)
 	if err != nil {
 		return nil, err
@@ -61,6 +96,16 @@ func (s *UserService) CreateUser(ctx context.Context, name, email, password stri
 		return nil, fmt.Errorf("User already exists")
 	}
 
+	invite, err := s.checkRegistrationPolicy(ctx, email, inviteToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var inviteID string
+	if invite != nil {
+		inviteID = invite.PublicID.String()
+	}
+
 	hashedPassword, err := utils.NewPassword(password)
 	if err != nil {
 		return nil, err
@@ -70,15 +115,17 @@ func (s *UserService) CreateUser(ctx context.Context, name, email, password stri
 		Name:         name,
 		PasswordHash: hashedPassword,
 		Email:        email,
+		Locale:       locale,
+		InviteID:     inviteID,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	err = s.userNotifier.NotifyRegistrationIntent(email, registrationToken.Code)
+	recipient := domain.Recipient{Email: email, Name: name, Locale: locale}
+	err = s.userNotifier.NotifyRegistrationIntent(ctx, recipient, registrationToken.Code)
 	if err != nil {
-		err := s.userIntentRepository.DeleteRegistrationIntent(ctx, email)
-		if err != nil {
+		if err := s.userIntentRepository.DeleteRegistrationIntent(ctx, registrationToken.Code); err != nil {
 			return nil, err
 		}
 		return nil, err
@@ -93,20 +140,83 @@ func (s *UserService) ConfirmUser(ctx context.Context, code string) (*domain.Use
 		return nil, err
 	}
 
-	user, err := s.userRepository.CreateUser(ctx, registrationIntent.Name, registrationIntent.Email, registrationIntent.PasswordHash)
+	// The user, the invite consumption and the welcome email are committed together
+	var user *domain.User
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		// The invite is only consumed once the email is confirmed, so an abandoned
+		// registration doesn't burn it.
+		if registrationIntent.InviteID != "" {
+			invite, err := s.inviteRepository.UseInvite(ctx, registrationIntent.InviteID, registrationIntent.Email)
+			if err != nil {
+				return err
+			}
+			if invite == nil {
+				return fmt.Errorf("Invite is no longer valid")
+			}
+		}
+
+		var err error
+		user, err = s.userRepository.CreateUser(ctx, registrationIntent.Name, registrationIntent.Email, registrationIntent.PasswordHash, registrationIntent.Locale)
+		if err != nil {
+			return err
+		}
+
+		return s.userNotifier.NotifyRegistrationSuccess(ctx, user.Recipient(), user.PublicID.String())
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	err = s.userIntentRepository.DeleteRegistrationIntent(ctx, code)
-	if err != nil {
-		return nil, err
+	metadata := map[string]string{}
+	if registrationIntent.InviteID != "" {
+		metadata["inviteId"] = registrationIntent.InviteID
 	}
+	s.eventRecorder.Record(ctx, domain.SecurityEvent{
+		UserID:   user.ID,
+		ActorID:  user.ID,
+		Type:     domain.SecurityEventRegistration,
+		Metadata: metadata,
+	})
 
-	err = s.userNotifier.NotifyRegistrationSuccess(user.Email)
-	if err != nil {
-		return nil, err
+	// The user exists at this point, a leftover intent just expires on its own
+	if err := s.userIntentRepository.DeleteRegistrationIntent(ctx, code); err != nil {
+		log.Printf("failed to delete registration intent: %v", err)
 	}
 
 	return user, nil
 }
+
+// checkRegistrationPolicy enforces the configured registration mode. In
+// invite-only mode it returns the invite the registration will consume.
+func (s *UserService) checkRegistrationPolicy(ctx context.Context, email, inviteToken string) (*domain.Invite, error) {
+	if s.registrationPolicy.IsAdminEmail(email) {
+		return nil, nil
+	}
+
+	switch s.registrationPolicy.Mode {
+	case domain.RegistrationModeInviteOnly:
+		if inviteToken == "" {
+			return nil, fmt.Errorf("Registration requires an invite")
+		}
+
+		invite, err := s.inviteRepository.GetInviteByToken(ctx, inviteToken)
+		if err != nil {
+			return nil, err
+		}
+		if invite == nil || !invite.IsUsable() {
+			return nil, fmt.Errorf("Invalid or expired invite")
+		}
+		if invite.Email != "" && !strings.EqualFold(invite.Email, email) {
+			return nil, fmt.Errorf("Invite was issued for a different email")
+		}
+
+		return invite, nil
+	case domain.RegistrationModeDomainAllowlist:
+		if !s.registrationPolicy.IsDomainAllowed(email) {
+			return nil, fmt.Errorf("Registration is not allowed for this email domain")
+		}
+		return nil, nil
+	default:
+		return nil, nil
+	}
+}
@@ -0,0 +1,196 @@
+package services
+
+import (
+	"context"
+	"main/internal/core/domain"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// fakeInviteRepository keeps the invites in memory, keyed by their token
+type fakeInviteRepository struct {
+	invites map[string]*domain.Invite
+	revoked []string
+}
+
+func (r *fakeInviteRepository) CreateInvite(ctx context.Context, createdBy, email string) (*domain.InviteToken, error) {
+	invite := domain.Invite{
+		ID:        "1",
+		PublicID:  uuid.New(),
+		Email:     email,
+		CreatedBy: createdBy,
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+	return &domain.InviteToken{Invite: invite, Token: "token"}, nil
+}
+
+func (r *fakeInviteRepository) GetInviteByToken(ctx context.Context, token string) (*domain.Invite, error) {
+	return r.invites[token], nil
+}
+
+func (r *fakeInviteRepository) GetInviteByPublicID(ctx context.Context, id string) (*domain.Invite, error) {
+	for _, invite := range r.invites {
+		if invite.PublicID.String() == id {
+			return invite, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeInviteRepository) GetInvites(ctx context.Context) ([]domain.Invite, error) {
+	return nil, nil
+}
+
+func (r *fakeInviteRepository) GetInvitesByCreator(ctx context.Context, createdBy string) ([]domain.Invite, error) {
+	return nil, nil
+}
+
+func (r *fakeInviteRepository) UseInvite(ctx context.Context, id, email string) (*domain.Invite, error) {
+	return nil, nil
+}
+
+func (r *fakeInviteRepository) RevokeInvite(ctx context.Context, id string) (bool, error) {
+	r.revoked = append(r.revoked, id)
+	return true, nil
+}
+
+// fakeUserIntentRepository keeps the registration intents in memory
+type fakeUserIntentRepository struct {
+	intents map[string]domain.RegistrationIntentUser
+}
+
+func (r *fakeUserIntentRepository) CreateRegistrationIntent(ctx context.Context, user domain.RegistrationIntentUser) (*domain.RegistrationIntentToken, error) {
+	user.Code = "code"
+	r.intents[user.Code] = user
+	return &domain.RegistrationIntentToken{Code: user.Code}, nil
+}
+
+func (r *fakeUserIntentRepository) GetRegistrationIntent(ctx context.Context, code string) (*domain.RegistrationIntentUser, error) {
+	user, ok := r.intents[code]
+	if !ok {
+		return nil, nil
+	}
+	return &user, nil
+}
+
+func (r *fakeUserIntentRepository) DeleteRegistrationIntent(ctx context.Context, code string) error {
+	delete(r.intents, code)
+	return nil
+}
+
+func newTestUserService(invites *fakeInviteRepository, intents *fakeUserIntentRepository, policy domain.RegistrationPolicy) *UserService {
+	return NewUserService(&fakeUserRepository{}, intents, &fakeUserNotifier{}, nil, invites, fakeTransactor{}, nil, policy)
+}
+
+func TestUserService_CreateUserInviteOnly(t *testing.T) {
+	validInvite := &domain.Invite{ID: "1", PublicID: uuid.New(), ExpiresAt: time.Now().Add(time.Hour)}
+	usedInvite := &domain.Invite{ID: "2", PublicID: uuid.New(), ExpiresAt: time.Now().Add(time.Hour), UsedAt: time.Now()}
+	expiredInvite := &domain.Invite{ID: "3", PublicID: uuid.New(), ExpiresAt: time.Now().Add(-time.Minute)}
+	otherEmailInvite := &domain.Invite{ID: "4", PublicID: uuid.New(), Email: "john@example.com", ExpiresAt: time.Now().Add(time.Hour)}
+
+	tests := []struct {
+		name     string
+		token    string
+		wantErr  bool
+		inviteID string
+	}{
+		{name: "without token", token: "", wantErr: true},
+		{name: "unknown token", token: "unknown", wantErr: true},
+		{name: "used token", token: "used", wantErr: true},
+		{name: "expired token", token: "expired", wantErr: true},
+		{name: "token for another email", token: "other", wantErr: true},
+		{name: "valid token", token: "valid", inviteID: validInvite.PublicID.String()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			invites := &fakeInviteRepository{invites: map[string]*domain.Invite{
+				"valid":   validInvite,
+				"used":    usedInvite,
+				"expired": expiredInvite,
+				"other":   otherEmailInvite,
+			}}
+			intents := &fakeUserIntentRepository{intents: map[string]domain.RegistrationIntentUser{}}
+			s := newTestUserService(invites, intents, domain.RegistrationPolicy{Mode: domain.RegistrationModeInviteOnly})
+
+			_, err := s.CreateUser(context.Background(), "Jane", "jane@example.com", "password", "en", tt.token)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected the registration to be rejected")
+				}
+				if len(intents.intents) != 0 {
+					t.Errorf("expected no registration intent, got %d", len(intents.intents))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if intent := intents.intents["code"]; intent.InviteID != tt.inviteID {
+				t.Errorf("expected the intent to reference invite %q, got %q", tt.inviteID, intent.InviteID)
+			}
+		})
+	}
+}
+
+func TestUserService_CreateUserDomainAllowlist(t *testing.T) {
+	policy := domain.RegistrationPolicy{
+		Mode:           domain.RegistrationModeDomainAllowlist,
+		AllowedDomains: []string{"Example.com", "@corp.org"},
+	}
+
+	tests := []struct {
+		email   string
+		allowed bool
+	}{
+		{email: "jane@example.com", allowed: true},
+		{email: "jane@EXAMPLE.COM", allowed: true},
+		{email: "jane@mail.example.com", allowed: true},
+		{email: "jane@corp.org", allowed: true},
+		{email: "jane@notexample.com", allowed: false},
+		{email: "jane@example.com.evil.org", allowed: false},
+		{email: "jane@other.org", allowed: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.email, func(t *testing.T) {
+			intents := &fakeUserIntentRepository{intents: map[string]domain.RegistrationIntentUser{}}
+			s := newTestUserService(&fakeInviteRepository{}, intents, policy)
+
+			_, err := s.CreateUser(context.Background(), "Jane", tt.email, "password", "en", "")
+			if tt.allowed && err != nil {
+				t.Errorf("expected %s to be allowed, got %v", tt.email, err)
+			}
+			if !tt.allowed && err == nil {
+				t.Errorf("expected %s to be rejected", tt.email)
+			}
+		})
+	}
+}
+
+func TestUserService_CreateUserAdminBypass(t *testing.T) {
+	policies := []domain.RegistrationPolicy{
+		{Mode: domain.RegistrationModeInviteOnly, AdminEmails: []string{"Admin@Example.org"}},
+		{Mode: domain.RegistrationModeDomainAllowlist, AllowedDomains: []string{"example.com"}, AdminEmails: []string{"Admin@Example.org"}},
+	}
+
+	for _, policy := range policies {
+		t.Run(string(policy.Mode), func(t *testing.T) {
+			intents := &fakeUserIntentRepository{intents: map[string]domain.RegistrationIntentUser{}}
+			s := newTestUserService(&fakeInviteRepository{}, intents, policy)
+
+			if _, err := s.CreateUser(context.Background(), "Admin", "admin@example.org", "password", "en", ""); err != nil {
+				t.Fatalf("expected the admin email to bypass the policy, got %v", err)
+			}
+			if intent := intents.intents["code"]; intent.InviteID != "" {
+				t.Errorf("expected no invite on the admin registration, got %q", intent.InviteID)
+			}
+
+			if _, err := s.CreateUser(context.Background(), "Jane", "jane@example.org", "password", "en", ""); err == nil {
+				t.Error("expected a non-admin email to be rejected")
+			}
+		})
+	}
+}
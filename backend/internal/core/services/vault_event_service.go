@@ -0,0 +1,92 @@
+package services
+
+import (
+	"context"
+	"log"
+	"main/internal/core/domain"
+	"main/internal/core/ports"
+	"time"
+)
+
+// VaultEventService pushes vault changes to the connected clients of a user
+type VaultEventService struct {
+	bus       ports.VaultEventBus
+	heartbeat time.Duration
+}
+
+func NewVaultEventService(bus ports.VaultEventBus, heartbeat time.Duration) *VaultEventService {
+	return &VaultEventService{bus: bus, heartbeat: heartbeat}
+}
+
+// Heartbeat is how often an idle stream sends something to stay open
+func (s *VaultEventService) Heartbeat() time.Duration {
+	return s.heartbeat
+}
+
+// Publish stamps the event with the writing device. The change it reports
+// already happened, so failures are logged instead of returned.
+func (s *VaultEventService) Publish(ctx context.Context, event domain.VaultEvent) {
+	if event.DeviceID == "" {
+		event.DeviceID = domain.ClientInfoFromContext(ctx).DeviceID
+	}
+
+	if err := s.bus.Publish(ctx, event); err != nil {
+		log.Printf("failed to publish vault event %s: %v", event.Type, err)
+	}
+}
+
+// Subscribe returns the kept events after lastEventID, if not 0, followed by
+// the live ones. The channel is closed when ctx is done or the subscriber
+// falls too far behind, the client should then reconnect.
+func (s *VaultEventService) Subscribe(ctx context.Context, userID string, lastEventID int64) (<-chan domain.VaultEvent, error) {
+	// Subscribing first means nothing published during the replay is lost,
+	// the duplicates are skipped by ID
+	live, err := s.bus.Subscribe(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	var missed []domain.VaultEvent
+	if lastEventID > 0 {
+		if missed, err = s.bus.EventsSince(ctx, userID, lastEventID); err != nil {
+			return nil, err
+		}
+	}
+
+	out := make(chan domain.VaultEvent)
+	go func() {
+		defer close(out)
+
+		last := lastEventID
+		send := func(event domain.VaultEvent) bool {
+			if event.ID <= last {
+				return true
+			}
+			select {
+			case out <- event:
+				last = event.ID
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		for _, event := range missed {
+			if !send(event) {
+				return
+			}
+		}
+		for {
+			select {
+			case event, ok := <-live:
+				if !ok || !send(event) {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out, nil
+}
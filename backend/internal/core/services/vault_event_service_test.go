@@ -0,0 +1,80 @@
+package services
+
+import (
+	"context"
+	"main/internal/core/domain"
+	"testing"
+	"time"
+)
+
+// fakeVaultEventBus keeps every event and hands out a single live channel.
+// With echoLast the last kept event is also delivered live on subscribe, as
+// if it was published between the subscription and the replay.
+type fakeVaultEventBus struct {
+	events   []domain.VaultEvent
+	live     chan domain.VaultEvent
+	echoLast bool
+}
+
+func (b *fakeVaultEventBus) Publish(ctx context.Context, event domain.VaultEvent) error {
+	event.ID = int64(len(b.events) + 1)
+	b.events = append(b.events, event)
+	if b.live != nil {
+		b.live <- event
+	}
+	return nil
+}
+
+func (b *fakeVaultEventBus) Subscribe(ctx context.Context, userID string) (<-chan domain.VaultEvent, error) {
+	b.live = make(chan domain.VaultEvent, 16)
+	if b.echoLast && len(b.events) > 0 {
+		b.live <- b.events[len(b.events)-1]
+	}
+	return b.live, nil
+}
+
+func (b *fakeVaultEventBus) EventsSince(ctx context.Context, userID string, lastID int64) ([]domain.VaultEvent, error) {
+	var events []domain.VaultEvent
+	for _, e := range b.events {
+		if e.ID > lastID {
+			events = append(events, e)
+		}
+	}
+	return events, nil
+}
+
+func TestVaultEventService_SubscribeReplaysMissedEvents(t *testing.T) {
+	bus := &fakeVaultEventBus{echoLast: true}
+	s := NewVaultEventService(bus, time.Second)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for revision := range int64(3) {
+		s.Publish(ctx, domain.VaultEvent{UserID: "1", Type: domain.VaultEventUpdated, Revision: revision + 1})
+	}
+
+	events, err := s.Subscribe(ctx, "1", 1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	s.Publish(ctx, domain.VaultEvent{UserID: "1", Type: domain.VaultEventSessionRevoked, DeviceID: "phone"})
+
+	var got []int64
+	for range 3 {
+		select {
+		case e := <-events:
+			got = append(got, e.ID)
+		case <-time.After(time.Second):
+			t.Fatalf("timed out, got %v", got)
+		}
+	}
+	if got[0] != 2 || got[1] != 3 || got[2] != 4 {
+		t.Errorf("expected events 2, 3 and 4, got %v", got)
+	}
+
+	select {
+	case e := <-events:
+		t.Errorf("unexpected duplicate %+v", e)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
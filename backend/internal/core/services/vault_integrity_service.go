@@ -0,0 +1,163 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"main/internal/core/domain"
+	"main/internal/core/ports"
+)
+
+// VaultIntegrityService re-hashes the stored vaults to detect corruption of
+// the storage, which clients would otherwise only notice when they fail to
+// open their vault
+type VaultIntegrityService struct {
+	vaultRepo    ports.VaultRepository
+	vaultService *VaultService
+}
+
+func NewVaultIntegrityService(vaultRepo ports.VaultRepository, vaultService *VaultService) *VaultIntegrityService {
+	return &VaultIntegrityService{
+		vaultRepo:    vaultRepo,
+		vaultService: vaultService,
+	}
+}
+
+// Verify checks up to limit vaults after the cursor after, "" for the first
+// batch. With rollback a corrupted vault is restored from its
+// newest version that is still intact, as a new revision so clients pick it
+// up like any other write.
+func (s *VaultIntegrityService) Verify(ctx context.Context, after string, limit int, rollback bool) (*domain.VaultIntegrityReport, error) {
+	vaultIDs, err := s.vaultRepo.ListVaultIDs(ctx, after, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &domain.VaultIntegrityReport{Problems: []domain.VaultIntegrityProblem{}}
+	for _, vaultID := range vaultIDs {
+		problem, err := s.verifyVault(ctx, vaultID, rollback)
+		if err != nil {
+			return nil, err
+		}
+		report.Checked++
+		if problem != nil {
+			log.Printf("vault integrity: vault %s at revision %d is %s: %s", vaultID, problem.Revision, problem.Status, problem.Error)
+			report.Problems = append(report.Problems, *problem)
+		}
+	}
+	if len(vaultIDs) == limit {
+		report.Next = vaultIDs[len(vaultIDs)-1]
+	}
+
+	return report, nil
+}
+
+// verifyVault returns nil when the vault is intact or was deleted meanwhile
+func (s *VaultIntegrityService) verifyVault(ctx context.Context, vaultID string, rollback bool) (*domain.VaultIntegrityProblem, error) {
+	vault, content, err := s.vaultService.OpenVault(ctx, vaultID)
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	if err != nil {
+		return &domain.VaultIntegrityProblem{VaultID: vaultID, Status: domain.VaultUnreadable, Error: err.Error()}, nil
+	}
+	if vault == nil {
+		return nil, nil
+	}
+
+	problem := &domain.VaultIntegrityProblem{VaultID: vaultID, UserID: vault.UserID, Revision: vault.Revision, Status: domain.VaultCorrupted}
+	intact, err := verifyContent(content, vault.SHA256)
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	switch {
+	case err != nil:
+		// Storage being unavailable is no reason to roll back
+		problem.Status = domain.VaultUnreadable
+		problem.Error = err.Error()
+		return problem, nil
+	case intact:
+		return nil, nil
+	}
+
+	problem.Error = "content doesn't match its digest"
+	if !rollback {
+		return problem, nil
+	}
+
+	restored, from, err := s.restoreIntactVersion(ctx, vault)
+	if err != nil {
+		problem.Error = fmt.Sprintf("%s, rollback failed: %v", problem.Error, err)
+		return problem, nil
+	}
+	if restored == nil {
+		problem.Error += ", no intact version to roll back to"
+		return problem, nil
+	}
+
+	problem.Status = domain.VaultRestored
+	problem.RestoredFrom = from
+	problem.RestoredRevision = restored.Revision
+	return problem, nil
+}
+
+// restoreIntactVersion restores the newest version older than the corrupted
+// vault whose content matches its digest, nil when there is none. The
+// version of the current revision shares its content, so it's skipped.
+func (s *VaultIntegrityService) restoreIntactVersion(ctx context.Context, vault *domain.Vault) (*domain.Vault, int64, error) {
+	versions, err := s.vaultService.ListVersions(ctx, vault.ID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for _, v := range versions {
+		if v.Revision >= vault.Revision {
+			continue
+		}
+
+		_, content, err := s.vaultService.OpenVersion(ctx, vault.ID, v.Revision)
+		if errors.Is(err, ErrVaultVersionNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		intact, err := verifyContent(content, v.SHA256)
+		if err != nil {
+			return nil, 0, err
+		}
+		if !intact {
+			continue
+		}
+
+		// A client writing meanwhile fails the If-Match check, its write
+		// replaced the corrupted vault anyway
+		restored, err := s.vaultService.RestoreVersion(ctx, vault.UserID, vault.ID, v.Revision, vault.Revision)
+		if err != nil {
+			return nil, 0, err
+		}
+		return restored, v.Revision, nil
+	}
+
+	return nil, 0, nil
+}
+
+// verifyContent hashes and closes content. Content that fails to decrypt or
+// is missing is reported as not intact rather than as an error.
+func verifyContent(content io.ReadCloser, sha256Hex string) (bool, error) {
+	defer content.Close()
+
+	hash := sha256.New()
+	_, err := io.Copy(hash, content)
+	if errors.Is(err, ports.ErrVaultCorrupted) || errors.Is(err, ports.ErrBlobNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return hex.EncodeToString(hash.Sum(nil)) == sha256Hex, nil
+}
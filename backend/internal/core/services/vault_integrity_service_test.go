@@ -0,0 +1,49 @@
+package services
+
+import (
+	"context"
+	"main/internal/core/domain"
+	"testing"
+)
+
+func TestVaultIntegrityService_RollsBackCorruptedVault(t *testing.T) {
+	repo := &fakeVaultRepository{}
+	versions := &fakeVaultVersionRepository{}
+	vaultService := newTestVaultService(repo, versions)
+	s := NewVaultIntegrityService(repo, vaultService)
+	ctx := context.Background()
+
+	for i, content := range []string{"one", "two"} {
+		if _, err := vaultService.InsertDefaultVault(ctx, "1", testVaultContent([]byte(content)), int64(i)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	report, err := s.Verify(ctx, "", 10, true)
+	if err != nil || report.Checked != 1 || len(report.Problems) != 0 {
+		t.Fatalf("expected one intact vault, got %+v (%v)", report, err)
+	}
+
+	// The storage flips a byte of the current revision
+	versions.versions[0].Vault = []byte("twO")
+
+	report, err = s.Verify(ctx, "", 10, false)
+	if err != nil || len(report.Problems) != 1 || report.Problems[0].Status != domain.VaultCorrupted {
+		t.Fatalf("expected the vault to be reported as corrupted, got %+v (%v)", report, err)
+	}
+	if repo.vaults[0].Revision != 2 {
+		t.Fatalf("expected no rollback without asking for it, got revision %d", repo.vaults[0].Revision)
+	}
+
+	report, err = s.Verify(ctx, "", 10, true)
+	if err != nil || len(report.Problems) != 1 {
+		t.Fatalf("expected one problem, got %+v (%v)", report, err)
+	}
+	problem := report.Problems[0]
+	if problem.Status != domain.VaultRestored || problem.RestoredFrom != 1 || problem.RestoredRevision != 3 {
+		t.Errorf("expected revision 1 to be restored as revision 3, got %+v", problem)
+	}
+	if string(repo.vaults[0].Vault) != "one" {
+		t.Errorf("expected the vault of revision 1, got %q", repo.vaults[0].Vault)
+	}
+}
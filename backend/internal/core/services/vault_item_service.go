@@ -0,0 +1,157 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"main/internal/core/domain"
+	"main/internal/core/ports"
+	"strconv"
+
+	"github.com/google/uuid"
+)
+
+var ErrInvalidVaultSync = errors.New("Invalid sync request")
+
+const (
+	defaultSyncPageSize = 500
+	maxSyncPageSize     = 1000
+	// maxSyncChanges bounds the changes of a single request
+	maxSyncChanges = 500
+	// maxVaultItemSize is the largest encrypted item accepted
+	maxVaultItemSize = 64 << 10
+)
+
+// VaultItemService syncs vaults item by item, so a device only exchanges
+// what changed since its last sync instead of the whole blob
+type VaultItemService struct {
+	vaultItemRepo ports.VaultItemRepository
+	transactor    ports.Transactor
+	eventRecorder *EventRecorder
+	vaultEvents   *VaultEventService
+	quota         *VaultQuota
+}
+
+func NewVaultItemService(vaultItemRepo ports.VaultItemRepository, transactor ports.Transactor, eventRecorder *EventRecorder, vaultEvents *VaultEventService, quota *VaultQuota) *VaultItemService {
+	return &VaultItemService{
+		vaultItemRepo: vaultItemRepo,
+		transactor:    transactor,
+		eventRecorder: eventRecorder,
+		vaultEvents:   vaultEvents,
+		quota:         quota,
+	}
+}
+
+// Sync applies the changes of the client, then returns the items changed
+// after cursor, 0 for a full sync. A change based on a stale revision is
+// reported as a conflict with the current item and doesn't stop the others.
+// None of the changes are kept when they take the user over quota.
+func (s *VaultItemService) Sync(ctx context.Context, userID string, cursor int64, changes []domain.VaultItemChange, limit int) (*domain.VaultSyncResult, error) {
+	if err := validateVaultItemChanges(changes); err != nil {
+		return nil, err
+	}
+	if cursor < 0 {
+		return nil, fmt.Errorf("%w: negative cursor", ErrInvalidVaultSync)
+	}
+	if limit <= 0 {
+		limit = defaultSyncPageSize
+	}
+	limit = min(limit, maxSyncPageSize)
+
+	result := &domain.VaultSyncResult{Results: make([]domain.VaultItemResult, 0, len(changes))}
+	applied := 0
+	var lastSeq int64
+
+	if len(changes) > 0 {
+		err := s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+			result.Results = result.Results[:0]
+			applied = 0
+			lastSeq = 0
+			for _, change := range changes {
+				item, err := s.vaultItemRepo.ApplyChange(ctx, userID, change)
+				if err != nil {
+					return err
+				}
+				if item != nil {
+					applied++
+					lastSeq = max(lastSeq, item.Seq)
+					result.Results = append(result.Results, domain.VaultItemResult{ID: change.ID, Applied: true, Revision: item.Revision})
+					continue
+				}
+
+				current, err := s.vaultItemRepo.GetItem(ctx, userID, change.ID.String())
+				if err != nil {
+					return err
+				}
+				conflict := domain.VaultItemResult{ID: change.ID, Current: current}
+				if current != nil {
+					conflict.Revision = current.Revision
+				}
+				result.Results = append(result.Results, conflict)
+			}
+			if applied == 0 {
+				return nil
+			}
+			return s.quota.Check(ctx, userID)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	items, err := s.vaultItemRepo.GetItemsChangedSince(ctx, userID, cursor, limit+1)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) > limit {
+		items = items[:limit]
+		result.HasMore = true
+	}
+	result.Items = items
+	result.Cursor = cursor
+	if len(items) > 0 {
+		result.Cursor = items[len(items)-1].Seq
+	}
+
+	if applied > 0 {
+		s.eventRecorder.Record(ctx, domain.SecurityEvent{
+			UserID:   userID,
+			ActorID:  userID,
+			Type:     domain.SecurityEventVaultWrite,
+			Metadata: map[string]string{"items": strconv.Itoa(applied)},
+		})
+		s.vaultEvents.Publish(ctx, domain.VaultEvent{
+			UserID: userID,
+			Type:   domain.VaultEventUpdated,
+			Cursor: lastSeq,
+		})
+	}
+
+	return result, nil
+}
+
+func validateVaultItemChanges(changes []domain.VaultItemChange) error {
+	if len(changes) > maxSyncChanges {
+		return fmt.Errorf("%w: at most %d changes per request", ErrInvalidVaultSync, maxSyncChanges)
+	}
+
+	seen := make(map[uuid.UUID]bool, len(changes))
+	for _, c := range changes {
+		switch {
+		case c.ID == uuid.Nil:
+			return fmt.Errorf("%w: missing item id", ErrInvalidVaultSync)
+		case seen[c.ID]:
+			return fmt.Errorf("%w: item %s changed twice", ErrInvalidVaultSync, c.ID)
+		case c.BaseRevision < 0:
+			return fmt.Errorf("%w: negative base revision for item %s", ErrInvalidVaultSync, c.ID)
+		case c.Deleted && c.BaseRevision == 0:
+			return fmt.Errorf("%w: item %s is deleted before being created", ErrInvalidVaultSync, c.ID)
+		case !c.Deleted && len(c.Data) == 0:
+			return fmt.Errorf("%w: item %s has no data", ErrInvalidVaultSync, c.ID)
+		case len(c.Data) > maxVaultItemSize:
+			return fmt.Errorf("%w: item %s is larger than %d bytes", ErrInvalidVaultSync, c.ID, maxVaultItemSize)
+		}
+		seen[c.ID] = true
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"main/internal/core/domain"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// fakeVaultItemRepository mimics the per item compare-and-swap and the
+// change sequence of the SQL queries
+type fakeVaultItemRepository struct {
+	seq   int64
+	items map[uuid.UUID]domain.VaultItem
+}
+
+func (r *fakeVaultItemRepository) ApplyChange(ctx context.Context, userID string, change domain.VaultItemChange) (*domain.VaultItem, error) {
+	if r.items == nil {
+		r.items = map[uuid.UUID]domain.VaultItem{}
+	}
+	r.seq++
+
+	current, exists := r.items[change.ID]
+	if (!exists && change.BaseRevision != 0) || (exists && current.Revision != change.BaseRevision) {
+		return nil, nil
+	}
+
+	item := domain.VaultItem{ID: change.ID, Revision: change.BaseRevision + 1, Data: change.Data, Deleted: change.Deleted, Seq: r.seq}
+	r.items[change.ID] = item
+	return &item, nil
+}
+
+func (r *fakeVaultItemRepository) GetItem(ctx context.Context, userID, itemID string) (*domain.VaultItem, error) {
+	item, ok := r.items[uuid.MustParse(itemID)]
+	if !ok {
+		return nil, nil
+	}
+	return &item, nil
+}
+
+func (r *fakeVaultItemRepository) GetItemsChangedSince(ctx context.Context, userID string, cursor int64, limit int) ([]domain.VaultItem, error) {
+	var items []domain.VaultItem
+	for _, item := range r.items {
+		if item.Seq > cursor {
+			items = append(items, item)
+		}
+	}
+	slices.SortFunc(items, func(a, b domain.VaultItem) int { return int(a.Seq - b.Seq) })
+	return items[:min(limit, len(items))], nil
+}
+
+func newTestVaultItemService(repo *fakeVaultItemRepository) *VaultItemService {
+	recorder := NewEventRecorder(&fakeSecurityEventRepository{}, &fakeWebhookRepository{}, &fakeUserRepository{})
+	return NewVaultItemService(repo, fakeTransactor{}, recorder, NewVaultEventService(&fakeVaultEventBus{}, time.Second), NewVaultQuota(&fakeVaultUsageRepository{versions: &fakeVaultVersionRepository{}}, 1<<20, 1<<20))
+}
+
+func TestVaultItemService_SyncReportsConflicts(t *testing.T) {
+	repo := &fakeVaultItemRepository{}
+	s := newTestVaultItemService(repo)
+	ctx := context.Background()
+	a, b := uuid.New(), uuid.New()
+
+	first, err := s.Sync(ctx, "1", 0, []domain.VaultItemChange{
+		{ID: a, Data: []byte("a1")},
+		{ID: b, Data: []byte("b1")},
+	}, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(first.Items) != 2 || first.Cursor != 2 {
+		t.Fatalf("expected both items up to cursor 2, got %d items and cursor %d", len(first.Items), first.Cursor)
+	}
+
+	// Another device edits a, then this one edits a too and deletes b
+	if _, err := s.Sync(ctx, "1", 2, []domain.VaultItemChange{{ID: a, BaseRevision: 1, Data: []byte("a2")}}, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	second, err := s.Sync(ctx, "1", 2, []domain.VaultItemChange{
+		{ID: a, BaseRevision: 1, Data: []byte("a2-conflicting")},
+		{ID: b, BaseRevision: 1, Deleted: true},
+	}, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	conflict, deleted := second.Results[0], second.Results[1]
+	if conflict.Applied || conflict.Current == nil || string(conflict.Current.Data) != "a2" || conflict.Revision != 2 {
+		t.Errorf("expected a conflict with the other device's edit, got %+v", conflict)
+	}
+	if !deleted.Applied || deleted.Revision != 2 {
+		t.Errorf("expected the delete to be applied, got %+v", deleted)
+	}
+	if len(second.Items) != 2 || !second.Items[1].Deleted {
+		t.Errorf("expected the edit of a and the tombstone of b, got %+v", second.Items)
+	}
+}
+
+func TestVaultItemService_SyncPagination(t *testing.T) {
+	repo := &fakeVaultItemRepository{}
+	s := newTestVaultItemService(repo)
+	ctx := context.Background()
+
+	var changes []domain.VaultItemChange
+	for range 3 {
+		changes = append(changes, domain.VaultItemChange{ID: uuid.New(), Data: []byte("x")})
+	}
+	if _, err := s.Sync(ctx, "1", 0, changes, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	page, err := s.Sync(ctx, "1", 0, nil, 2)
+	if err != nil || len(page.Items) != 2 || !page.HasMore || page.Cursor != 2 {
+		t.Fatalf("expected a first page of 2 up to cursor 2, got %+v (%v)", page, err)
+	}
+	page, err = s.Sync(ctx, "1", page.Cursor, nil, 2)
+	if err != nil || len(page.Items) != 1 || page.HasMore || page.Cursor != 3 {
+		t.Fatalf("expected the last item, got %+v (%v)", page, err)
+	}
+}
+
+func TestVaultItemService_SyncValidation(t *testing.T) {
+	s := newTestVaultItemService(&fakeVaultItemRepository{})
+	id := uuid.New()
+
+	invalid := [][]domain.VaultItemChange{
+		{{ID: uuid.Nil, Data: []byte("x")}},
+		{{ID: id}},
+		{{ID: id, Deleted: true}},
+		{{ID: id, Data: []byte("x")}, {ID: id, BaseRevision: 1, Data: []byte("y")}},
+		{{ID: id, Data: make([]byte, maxVaultItemSize+1)}},
+	}
+	for _, changes := range invalid {
+		if _, err := s.Sync(context.Background(), "1", 0, changes, 0); !errors.Is(err, ErrInvalidVaultSync) {
+			t.Errorf("expected %+v to be rejected, got %v", changes, err)
+		}
+	}
+}
@@ -0,0 +1,288 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"main/internal/core/domain"
+	"main/internal/core/ports"
+	"strings"
+)
+
+var (
+	ErrVaultMemberNotFound      = errors.New("Member not found")
+	ErrVaultInviteNotFound      = errors.New("Invitation not found")
+	ErrVaultMemberExists        = errors.New("User is already a member of the vault")
+	ErrInvalidVaultRole         = errors.New("Role must be read, write or manage")
+	ErrVaultOwnerImmutable      = errors.New("The owner of a vault can't be changed or removed")
+	ErrVaultNotShared           = errors.New("The vault key must be set before sharing")
+	ErrVaultKeyRotationRequired = errors.New("The vault key must be rotated first")
+	ErrVaultKeyVersionMismatch  = errors.New("Vault key was rotated by another device")
+	ErrVaultMemberKeysMismatch  = errors.New("A key is needed for every member of the vault")
+	ErrInvalidWrappedKey        = errors.New("Wrapped key is empty or too large")
+	ErrVaultInviteeNotInOrg     = errors.New("The vault of an organization can only be shared with its members")
+)
+
+// maxWrappedVaultKeySize leaves room for a key wrapped with RSA-4096 and its
+// envelope
+const maxWrappedVaultKeySize = 4 << 10
+
+// VaultMemberService shares vaults. The clients encrypt a shared vault with
+// a symmetric key, which the server only stores wrapped with the public key
+// of every member. The roles are checked by the middleware, the checks here
+// are the ones that depend on the member acted on.
+type VaultMemberService struct {
+	memberRepo       ports.VaultMemberRepository
+	userRepo         ports.UserRepository
+	userKeysRepo     ports.UserKeysRepository
+	organizationRepo ports.OrganizationRepository
+	transactor       ports.Transactor
+}
+
+func NewVaultMemberService(
+	memberRepo ports.VaultMemberRepository,
+	userRepo ports.UserRepository,
+	userKeysRepo ports.UserKeysRepository,
+	organizationRepo ports.OrganizationRepository,
+	transactor ports.Transactor,
+) *VaultMemberService {
+	return &VaultMemberService{
+		memberRepo:       memberRepo,
+		userRepo:         userRepo,
+		userKeysRepo:     userKeysRepo,
+		organizationRepo: organizationRepo,
+		transactor:       transactor,
+	}
+}
+
+// ListMembers returns the members and pending invitations, the owner first
+func (s *VaultMemberService) ListMembers(ctx context.Context, vaultID string) ([]domain.VaultMember, error) {
+	return s.memberRepo.ListMembers(ctx, vaultID)
+}
+
+// Invite adds the user with the email of invite as a pending member. The
+// wrapped key must be of the current key of the vault, wrapped with the
+// current public key of the user. The vaults of an organization are only
+// shared with its members.
+func (s *VaultMemberService) Invite(ctx context.Context, vault *domain.Vault, inviterID string, invite domain.VaultMember) (*domain.VaultMember, error) {
+	if !invite.Role.Valid() {
+		return nil, ErrInvalidVaultRole
+	}
+	if err := validateWrappedKey(invite.WrappedKey); err != nil {
+		return nil, err
+	}
+	if vault.KeyVersion == 0 {
+		return nil, ErrVaultNotShared
+	}
+	if vault.RotationRequired {
+		return nil, ErrVaultKeyRotationRequired
+	}
+	if invite.KeyVersion != vault.KeyVersion {
+		return nil, ErrVaultKeyVersionMismatch
+	}
+
+	// Users without a key can't receive the vault key, the directory lookup
+	// tells the client as much already
+	user, err := s.userRepo.GetUserByEmail(ctx, strings.TrimSpace(invite.Email))
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserKeyNotFound
+	}
+	if vault.OrganizationID != "" {
+		orgMember, err := s.organizationRepo.GetMember(ctx, vault.OrganizationID, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		if orgMember == nil {
+			return nil, ErrVaultInviteeNotInOrg
+		}
+	}
+	if err := checkUserKeyVersion(ctx, s.userKeysRepo, user.ID, invite.UserKeyVersion); err != nil {
+		return nil, err
+	}
+
+	invite.VaultID = vault.ID
+	invite.UserID = user.ID
+	invite.InvitedBy = inviterID
+	member, err := s.memberRepo.CreateMember(ctx, invite)
+	if err != nil || member != nil {
+		return member, err
+	}
+
+	// Either the user is in already or the key was rotated meanwhile
+	existing, err := s.memberRepo.GetMember(ctx, vault.ID, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, ErrVaultMemberExists
+	}
+	return nil, ErrVaultKeyVersionMismatch
+}
+
+// Accept turns the pending invitation of the user into a membership
+func (s *VaultMemberService) Accept(ctx context.Context, userID, vaultID string) (*domain.VaultMember, error) {
+	member, err := s.memberRepo.GetMember(ctx, vaultID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if member == nil || member.Status != domain.VaultMemberInvited {
+		return nil, ErrVaultInviteNotFound
+	}
+
+	accepted, err := s.memberRepo.AcceptMember(ctx, member.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !accepted {
+		return nil, ErrVaultInviteNotFound
+	}
+	return s.memberRepo.GetMemberByID(ctx, member.ID)
+}
+
+// UpdateRole changes the role of a member other than the owner
+func (s *VaultMemberService) UpdateRole(ctx context.Context, vault *domain.Vault, memberID string, role domain.VaultRole) (*domain.VaultMember, error) {
+	if !role.Valid() {
+		return nil, ErrInvalidVaultRole
+	}
+
+	member, err := s.vaultMember(ctx, vault, memberID)
+	if err != nil {
+		return nil, err
+	}
+	if member.UserID == vault.UserID {
+		return nil, ErrVaultOwnerImmutable
+	}
+
+	updated, err := s.memberRepo.UpdateMemberRole(ctx, member.ID, role)
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return nil, ErrVaultMemberNotFound
+	}
+	member.Role = role
+	return member, nil
+}
+
+// Remove revokes a membership or an invitation. Managers can remove anyone
+// but the owner, the other members only themselves. A member who accepted
+// may have kept the key, so the vault then needs a new one before it's
+// shared any further.
+func (s *VaultMemberService) Remove(ctx context.Context, vault *domain.Vault, memberID string) error {
+	member, err := s.vaultMember(ctx, vault, memberID)
+	if err != nil {
+		return err
+	}
+	if member.UserID == vault.UserID {
+		return ErrVaultOwnerImmutable
+	}
+	actor := vault.Membership
+	if actor == nil || (actor.UserID != member.UserID && !actor.Role.Allows(domain.VaultRoleManage)) {
+		return ErrVaultForbidden
+	}
+
+	return s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		deleted, err := s.memberRepo.DeleteMember(ctx, member.ID)
+		if err != nil {
+			return err
+		}
+		if !deleted {
+			return ErrVaultMemberNotFound
+		}
+		if !member.Accepted() {
+			return nil
+		}
+		return s.memberRepo.RequireKeyRotation(ctx, vault.ID)
+	})
+}
+
+// RotateKey replaces the vault key with version keyVersion, which must be the
+// one after the current version, 1 to share the vault for the first time.
+// keys holds the new key wrapped for every member and pending invitation,
+// each with the current public key of the user.
+func (s *VaultMemberService) RotateKey(ctx context.Context, vault *domain.Vault, keyVersion int, keys []domain.VaultMemberKey) error {
+	if keyVersion != vault.KeyVersion+1 {
+		return ErrVaultKeyVersionMismatch
+	}
+	byMember := make(map[string]domain.VaultMemberKey, len(keys))
+	for _, key := range keys {
+		if err := validateWrappedKey(key.WrappedKey); err != nil {
+			return err
+		}
+		byMember[key.MemberID] = key
+	}
+	if len(byMember) != len(keys) {
+		return ErrVaultMemberKeysMismatch
+	}
+
+	return s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		// Bumping the version first locks the vault, no invitation can be
+		// added until the new keys are stored
+		rotated, err := s.memberRepo.RotateVaultKey(ctx, vault.ID, keyVersion)
+		if err != nil {
+			return err
+		}
+		if !rotated {
+			return ErrVaultKeyVersionMismatch
+		}
+
+		members, err := s.memberRepo.ListMembers(ctx, vault.ID)
+		if err != nil {
+			return err
+		}
+		if len(members) != len(byMember) {
+			return ErrVaultMemberKeysMismatch
+		}
+
+		for _, member := range members {
+			key, ok := byMember[member.ID]
+			if !ok {
+				return ErrVaultMemberKeysMismatch
+			}
+			if err := checkUserKeyVersion(ctx, s.userKeysRepo, member.UserID, key.UserKeyVersion); err != nil {
+				return err
+			}
+			if _, err := s.memberRepo.SetMemberKey(ctx, member.ID, key, keyVersion); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// vaultMember hides the members of other vaults as not found
+func (s *VaultMemberService) vaultMember(ctx context.Context, vault *domain.Vault, memberID string) (*domain.VaultMember, error) {
+	member, err := s.memberRepo.GetMemberByID(ctx, memberID)
+	if err != nil {
+		return nil, err
+	}
+	if member == nil || member.VaultID != vault.ID {
+		return nil, ErrVaultMemberNotFound
+	}
+	return member, nil
+}
+
+// checkUserKeyVersion makes sure a key is wrapped for the current keypair of
+// the user, the previous ones may be compromised
+func checkUserKeyVersion(ctx context.Context, userKeysRepo ports.UserKeysRepository, userID string, version int) error {
+	current, err := userKeysRepo.GetCurrentKey(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return ErrUserKeyNotFound
+	}
+	if current.Version != version {
+		return ErrUserKeyVersionMismatch
+	}
+	return nil
+}
+
+func validateWrappedKey(key []byte) error {
+	if len(key) == 0 || len(key) > maxWrappedVaultKeySize {
+		return ErrInvalidWrappedKey
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"main/internal/core/domain"
+	"testing"
+)
+
+// fakeVaultMemberRepository keeps the key state on the vaults of the fake
+// vault repository, like the SQL queries do
+type fakeVaultMemberRepository struct {
+	vaults  *fakeVaultRepository
+	members []*domain.VaultMember
+}
+
+func (r *fakeVaultMemberRepository) GetMember(ctx context.Context, vaultID, userID string) (*domain.VaultMember, error) {
+	for _, m := range r.members {
+		if m.VaultID == vaultID && m.UserID == userID {
+			member := *m
+			return &member, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeVaultMemberRepository) GetMemberByID(ctx context.Context, memberID string) (*domain.VaultMember, error) {
+	for _, m := range r.members {
+		if m.ID == memberID {
+			member := *m
+			return &member, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeVaultMemberRepository) ListMembers(ctx context.Context, vaultID string) ([]domain.VaultMember, error) {
+	var members []domain.VaultMember
+	for _, m := range r.members {
+		if m.VaultID == vaultID {
+			members = append(members, *m)
+		}
+	}
+	return members, nil
+}
+
+func (r *fakeVaultMemberRepository) CreateMember(ctx context.Context, member domain.VaultMember) (*domain.VaultMember, error) {
+	vault, _ := r.vaults.GetVault(ctx, member.VaultID)
+	existing, _ := r.GetMember(ctx, member.VaultID, member.UserID)
+	if vault == nil || existing != nil || vault.KeyVersion != member.KeyVersion || vault.RotationRequired {
+		return nil, nil
+	}
+	member.ID = fmt.Sprintf("m%d", len(r.members)+1)
+	member.Status = domain.VaultMemberInvited
+	r.members = append(r.members, &member)
+	return &member, nil
+}
+
+func (r *fakeVaultMemberRepository) AcceptMember(ctx context.Context, memberID string) (bool, error) {
+	for _, m := range r.members {
+		if m.ID == memberID && m.Status == domain.VaultMemberInvited {
+			m.Status = domain.VaultMemberAccepted
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *fakeVaultMemberRepository) UpdateMemberRole(ctx context.Context, memberID string, role domain.VaultRole) (bool, error) {
+	for _, m := range r.members {
+		if m.ID == memberID {
+			m.Role = role
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *fakeVaultMemberRepository) DeleteMember(ctx context.Context, memberID string) (bool, error) {
+	for i, m := range r.members {
+		if m.ID == memberID {
+			r.members = append(r.members[:i], r.members[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *fakeVaultMemberRepository) SetMemberKey(ctx context.Context, memberID string, key domain.VaultMemberKey, keyVersion int) (bool, error) {
+	for _, m := range r.members {
+		if m.ID == memberID {
+			m.WrappedKey, m.KeyVersion, m.UserKeyVersion = key.WrappedKey, keyVersion, key.UserKeyVersion
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *fakeVaultMemberRepository) RotateVaultKey(ctx context.Context, vaultID string, keyVersion int) (bool, error) {
+	vault, _ := r.vaults.GetVault(ctx, vaultID)
+	if vault == nil || vault.KeyVersion != keyVersion-1 {
+		return false, nil
+	}
+	vault.KeyVersion, vault.RotationRequired = keyVersion, false
+	return true, nil
+}
+
+func (r *fakeVaultMemberRepository) RequireKeyRotation(ctx context.Context, vaultID string) error {
+	if vault, _ := r.vaults.GetVault(ctx, vaultID); vault != nil && vault.KeyVersion > 0 {
+		vault.RotationRequired = true
+	}
+	return nil
+}
+
+func TestVaultMemberService_RemoveRequiresRotation(t *testing.T) {
+	vaults := &fakeVaultRepository{vaults: []*domain.Vault{{ID: "v1", UserID: "1", KeyVersion: 1}}}
+	members := &fakeVaultMemberRepository{vaults: vaults, members: []*domain.VaultMember{
+		{ID: "m1", VaultID: "v1", UserID: "1", Role: domain.VaultRoleManage, Status: domain.VaultMemberAccepted, KeyVersion: 1, UserKeyVersion: 1},
+		{ID: "m2", VaultID: "v1", UserID: "2", Role: domain.VaultRoleWrite, Status: domain.VaultMemberAccepted, KeyVersion: 1, UserKeyVersion: 1},
+		{ID: "m3", VaultID: "v1", UserID: "3", Role: domain.VaultRoleRead, Status: domain.VaultMemberInvited, KeyVersion: 1, UserKeyVersion: 1},
+	}}
+	vaultService := newTestVaultService(vaults, &fakeVaultVersionRepository{})
+	vaultService.memberRepo = members
+	userKeys := &fakeUserKeysRepository{keys: []domain.UserKey{{Version: 1}}}
+	s := NewVaultMemberService(members, &fakeUserRepository{}, userKeys, nil, fakeTransactor{})
+	ctx := context.Background()
+
+	if _, err := vaultService.AuthorizeVault(ctx, "2", "v1", domain.VaultRoleManage); !errors.Is(err, ErrVaultForbidden) {
+		t.Errorf("expected a writer to be refused managing, got %v", err)
+	}
+	if _, err := vaultService.AuthorizeVault(ctx, "3", "v1", domain.VaultRoleRead); !errors.Is(err, ErrVaultNotFound) {
+		t.Errorf("expected a pending invitation to hide the vault, got %v", err)
+	}
+	owned, err := vaultService.AuthorizeVault(ctx, "1", "v1", domain.VaultRoleManage)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Revoking an invitation leaves the key as it is
+	if err := s.Remove(ctx, owned, "m3"); err != nil || vaults.vaults[0].RotationRequired {
+		t.Fatalf("expected the invitation to be revoked without rotation, got %v", err)
+	}
+	if err := s.Remove(ctx, owned, "m1"); !errors.Is(err, ErrVaultOwnerImmutable) {
+		t.Errorf("expected the owner to stay, got %v", err)
+	}
+
+	// The writer leaves and may have kept the key
+	writer, _ := vaultService.AuthorizeVault(ctx, "2", "v1", domain.VaultRoleRead)
+	if err := s.Remove(ctx, writer, "m2"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !vaults.vaults[0].RotationRequired {
+		t.Fatal("expected removing a member to require a rotation")
+	}
+	if _, err := s.Invite(ctx, vaults.vaults[0], "1", domain.VaultMember{Email: "bob@example.com", Role: domain.VaultRoleRead, WrappedKey: []byte("k"), KeyVersion: 1}); !errors.Is(err, ErrVaultKeyRotationRequired) {
+		t.Errorf("expected sharing to wait for the rotation, got %v", err)
+	}
+
+	keys := []domain.VaultMemberKey{{MemberID: "m1", WrappedKey: []byte("k2"), UserKeyVersion: 1}}
+	if err := s.RotateKey(ctx, vaults.vaults[0], 3, keys); !errors.Is(err, ErrVaultKeyVersionMismatch) {
+		t.Errorf("expected a skipped version to be refused, got %v", err)
+	}
+	if err := s.RotateKey(ctx, vaults.vaults[0], 2, nil); !errors.Is(err, ErrVaultMemberKeysMismatch) {
+		t.Errorf("expected a rotation without the owner key to be refused, got %v", err)
+	}
+	// The fake transactor doesn't roll the refused rotation back
+	vaults.vaults[0].KeyVersion, vaults.vaults[0].RotationRequired = 1, true
+	if err := s.RotateKey(ctx, vaults.vaults[0], 2, keys); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v := vaults.vaults[0]; v.KeyVersion != 2 || v.RotationRequired || members.members[0].KeyVersion != 2 {
+		t.Errorf("expected the key at version 2, got %+v and %+v", v, members.members[0])
+	}
+}
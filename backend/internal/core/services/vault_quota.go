@@ -0,0 +1,56 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"main/internal/core/domain"
+	"main/internal/core/ports"
+)
+
+var (
+	ErrVaultTooLarge      = errors.New("Vault is larger than the maximum size")
+	ErrVaultQuotaExceeded = errors.New("Vault storage quota exceeded")
+)
+
+// VaultQuota bounds the storage of a user, shared by the vault and the item
+// writes
+type VaultQuota struct {
+	usageRepo ports.VaultUsageRepository
+	maxSize   int
+	quota     int64
+}
+
+func NewVaultQuota(usageRepo ports.VaultUsageRepository, maxSize int, quota int64) *VaultQuota {
+	return &VaultQuota{
+		usageRepo: usageRepo,
+		maxSize:   maxSize,
+		quota:     quota,
+	}
+}
+
+// MaxSize is the largest vault accepted, in bytes
+func (q *VaultQuota) MaxSize() int {
+	return q.maxSize
+}
+
+// Quota is the storage a user can take, in bytes
+func (q *VaultQuota) Quota() int64 {
+	return q.quota
+}
+
+func (q *VaultQuota) Usage(ctx context.Context, userID string) (*domain.VaultUsage, error) {
+	return q.usageRepo.GetUsageByUserID(ctx, userID)
+}
+
+// Check is called in the transaction of a write, after writing, so the new
+// usage is compared and the write rolled back when over quota
+func (q *VaultQuota) Check(ctx context.Context, userID string) error {
+	usage, err := q.usageRepo.GetUsageByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if usage.Total() > q.quota {
+		return ErrVaultQuotaExceeded
+	}
+	return nil
+}
@@ -2,29 +2,522 @@ package services
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
 	"main/internal/core/domain"
 	"main/internal/core/ports"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 )
 
+var (
+	ErrVaultNotFound           = errors.New("Vault not found")
+	ErrVaultForbidden          = errors.New("Your role doesn't allow this on the vault")
+	ErrInvalidVaultName        = errors.New("Vault name can't be empty")
+	ErrDefaultVaultUndeletable = errors.New("The default vault can't be deleted")
+	ErrVaultOwnerOnly          = errors.New("Only the owner can delete a vault")
+	ErrVaultRevisionMismatch   = errors.New("Vault was modified by another device")
+	ErrVaultDigestMismatch     = errors.New("Body doesn't match Content-Digest")
+	ErrVaultVersionNotFound    = errors.New("Vault version not found")
+	ErrTooManyVaultPollers     = errors.New("Too many pending polls for this vault")
+)
+
+type VaultConfig struct {
+	// VersionsKeep is how many versions are kept, the current one included
+	VersionsKeep int
+	// VersionsMaxAge prunes older versions, zero disables it
+	VersionsMaxAge time.Duration
+	// PollMaxWait caps the wait of WaitForUpdate
+	PollMaxWait time.Duration
+	// PollMaxWaiters is how many WaitForUpdate calls a user can have pending
+	PollMaxWaiters int
+}
+
 type VaultService struct {
-	vaultRepo ports.VaultRepository
+	vaultRepo           ports.VaultRepository
+	vaultVersionRepo    ports.VaultVersionRepository
+	memberRepo          ports.VaultMemberRepository
+	emergencyAccessRepo ports.EmergencyAccessRepository
+	transactor          ports.Transactor
+	eventRecorder       *EventRecorder
+	vaultEvents         *VaultEventService
+	quota               *VaultQuota
+	config              VaultConfig
+
+	pollersMu sync.Mutex
+	pollers   map[string]int
 }
 
 func NewVaultService(
 	vaultRepo ports.VaultRepository,
+	vaultVersionRepo ports.VaultVersionRepository,
+	memberRepo ports.VaultMemberRepository,
+	emergencyAccessRepo ports.EmergencyAccessRepository,
+	transactor ports.Transactor,
+	eventRecorder *EventRecorder,
+	vaultEvents *VaultEventService,
+	quota *VaultQuota,
+	config VaultConfig,
 ) *VaultService {
-	return &VaultService{vaultRepo: vaultRepo}
+	return &VaultService{
+		vaultRepo:           vaultRepo,
+		vaultVersionRepo:    vaultVersionRepo,
+		memberRepo:          memberRepo,
+		emergencyAccessRepo: emergencyAccessRepo,
+		transactor:          transactor,
+		eventRecorder:       eventRecorder,
+		vaultEvents:         vaultEvents,
+		quota:               quota,
+		config:              config,
+		pollers:             map[string]int{},
+	}
+}
+
+// CreateVault creates an empty vault, its content is written like the one
+// of the default vault. A vault of an organization can only be shared with
+// its members, organizationID is "" for the other vaults.
+func (s *VaultService) CreateVault(ctx context.Context, userID, organizationID, name string, vaultType domain.VaultType) (*domain.Vault, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, ErrInvalidVaultName
+	}
+	return s.vaultRepo.CreateVault(ctx, userID, organizationID, name, vaultType, false)
+}
+
+// ListVaults returns the vaults the user is a member of or invited to,
+// without their content
+func (s *VaultService) ListVaults(ctx context.Context, userID string) ([]domain.Vault, error) {
+	return s.vaultRepo.ListVaults(ctx, userID)
+}
+
+// AuthorizeVault returns the vault without its content, with the membership
+// of the user. The vaults the user isn't a member of, pending invitations
+// included, are hidden as not found, a role below role fails with
+// ErrVaultForbidden. A granted emergency access counts as a membership with
+// the role of its mode.
+func (s *VaultService) AuthorizeVault(ctx context.Context, userID, vaultID string, role domain.VaultRole) (*domain.Vault, error) {
+	vault, err := s.vaultRepo.GetVault(ctx, vaultID)
+	if err != nil {
+		return nil, err
+	}
+	if vault == nil {
+		return nil, ErrVaultNotFound
+	}
+
+	member, err := s.memberRepo.GetMember(ctx, vaultID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !member.Accepted() {
+		grant, err := s.emergencyAccessRepo.GetGrantedAccess(ctx, vaultID, userID)
+		if err != nil {
+			return nil, err
+		}
+		if grant == nil {
+			return nil, ErrVaultNotFound
+		}
+		member = grant.VaultMember()
+	}
+	if !member.Role.Allows(role) {
+		return nil, ErrVaultForbidden
+	}
+
+	vault.Membership = member
+	return vault, nil
+}
+
+// DefaultVault returns the vault of the /user/vault endpoints without its
+// content, nil until the user first writes it
+func (s *VaultService) DefaultVault(ctx context.Context, userID string) (*domain.Vault, error) {
+	return s.vaultRepo.GetDefaultVault(ctx, userID)
+}
+
+func (s *VaultService) UpdateVault(ctx context.Context, vaultID, name string, vaultType domain.VaultType) (*domain.Vault, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, ErrInvalidVaultName
+	}
+
+	vault, err := s.vaultRepo.UpdateVault(ctx, vaultID, name, vaultType)
+	if err != nil {
+		return nil, err
+	}
+	if vault == nil {
+		return nil, ErrVaultNotFound
+	}
+	return vault, nil
+}
+
+// DeleteVault deletes the vault with its history, the default vault stays
+// as the /user/vault endpoints refer to it
+func (s *VaultService) DeleteVault(ctx context.Context, vaultID string) error {
+	vault, err := s.vaultRepo.GetVault(ctx, vaultID)
+	if err != nil {
+		return err
+	}
+	if vault == nil {
+		return ErrVaultNotFound
+	}
+	if vault.Default {
+		return ErrDefaultVaultUndeletable
+	}
+
+	var deleted bool
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		deleted, err = s.vaultRepo.DeleteVault(ctx, vaultID)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrVaultNotFound
+	}
+	return nil
+}
+
+// OpenVault returns the vault without its content and a reader streaming the
+// content, to be closed, nil when the vault doesn't exist or has no content
+func (s *VaultService) OpenVault(ctx context.Context, vaultID string) (*domain.Vault, io.ReadCloser, error) {
+	vault, err := s.vaultRepo.GetVault(ctx, vaultID)
+	if err != nil || !vault.HasContent() {
+		return nil, nil, err
+	}
+
+	// The current vault is also the newest version, which is never pruned
+	_, content, err := s.vaultVersionRepo.OpenVersion(ctx, vaultID, vault.Revision)
+	if err != nil {
+		return nil, nil, err
+	}
+	if content == nil {
+		return nil, nil, fmt.Errorf("missing version of vault %s revision %d", vaultID, vault.Revision)
+	}
+	return vault, content, nil
+}
+
+// GetUsage returns the storage taken by the user
+func (s *VaultService) GetUsage(ctx context.Context, userID string) (*domain.VaultUsage, error) {
+	return s.quota.Usage(ctx, userID)
+}
+
+// Quota returns the vault size limit and the storage quota of every user
+func (s *VaultService) Quota() (maxSize int, quota int64) {
+	return s.quota.MaxSize(), s.quota.Quota()
+}
+
+// GetVault returns the vault without its content, nil when it doesn't exist
+func (s *VaultService) GetVault(ctx context.Context, vaultID string) (*domain.Vault, error) {
+	return s.vaultRepo.GetVault(ctx, vaultID)
+}
+
+// InsertVault replaces the content of the vault if it's still at
+// expectedRevision, 0 for the first upload and domain.AnyVaultRevision for
+// whatever content it has. Otherwise it returns
+// ErrVaultRevisionMismatch and the client has to merge with the current
+// content first. userID is the writer, the storage is charged to the owner.
+// The content is streamed to the storage, and discarded again when it turns
+// out larger than the maximum size or not to match its digest.
+func (s *VaultService) InsertVault(ctx context.Context, userID, vaultID string, content domain.VaultContent, expectedRevision int64) (*domain.Vault, error) {
+	if content.Size > int64(s.quota.MaxSize()) {
+		return nil, ErrVaultTooLarge
+	}
+
+	inserted, err := s.writeVault(ctx, vaultID, content, expectedRevision)
+	if err != nil {
+		return nil, err
+	}
+
+	s.eventRecorder.Record(ctx, domain.SecurityEvent{
+		UserID:  inserted.UserID,
+		ActorID: userID,
+		Type:    domain.SecurityEventVaultWrite,
+		Metadata: map[string]string{
+			"vault":    vaultID,
+			"size":     strconv.Itoa(inserted.Size),
+			"revision": strconv.FormatInt(inserted.Revision, 10),
+		},
+	})
+	s.publishUpdate(ctx, inserted)
+
+	return inserted, nil
+}
+
+// InsertDefaultVault is InsertVault for the default vault, which is created
+// by its first write
+func (s *VaultService) InsertDefaultVault(ctx context.Context, userID string, content domain.VaultContent, expectedRevision int64) (*domain.Vault, error) {
+	if content.Size > int64(s.quota.MaxSize()) {
+		return nil, ErrVaultTooLarge
+	}
+
+	current, err := s.vaultRepo.GetDefaultVault(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if current == nil {
+		if expectedRevision != 0 {
+			return nil, ErrVaultRevisionMismatch
+		}
+		if current, err = s.createDefaultVault(ctx, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.InsertVault(ctx, userID, current.ID, content, expectedRevision)
+}
+
+func (s *VaultService) ListVersions(ctx context.Context, vaultID string) ([]domain.VaultVersion, error) {
+	return s.vaultVersionRepo.GetVersionsByVaultID(ctx, vaultID)
+}
+
+// OpenVersion is GetVersion with the content streamed instead of loaded
+func (s *VaultService) OpenVersion(ctx context.Context, vaultID string, revision int64) (*domain.VaultVersion, io.ReadCloser, error) {
+	version, content, err := s.vaultVersionRepo.OpenVersion(ctx, vaultID, revision)
+	if err != nil {
+		return nil, nil, err
+	}
+	if version == nil {
+		return nil, nil, ErrVaultVersionNotFound
+	}
+	return version, content, nil
+}
+
+func (s *VaultService) GetVersion(ctx context.Context, vaultID string, revision int64) (*domain.VaultVersion, error) {
+	version, err := s.vaultVersionRepo.GetVersion(ctx, vaultID, revision)
+	if err != nil {
+		return nil, err
+	}
+	if version == nil {
+		return nil, ErrVaultVersionNotFound
+	}
+	return version, nil
 }
 
-func (s *VaultService) GetVaultByUserID(ctx context.Context, userID string) (*domain.Vault, error) {
-	return s.vaultRepo.GetVaultByUserID(ctx, userID)
+// RestoreVersion writes the content of an old version as a new revision, with
+// the same If-Match check as an upload
+func (s *VaultService) RestoreVersion(ctx context.Context, userID, vaultID string, revision, expectedRevision int64) (*domain.Vault, error) {
+	version, content, err := s.OpenVersion(ctx, vaultID, revision)
+	if err != nil {
+		return nil, err
+	}
+	defer content.Close()
+
+	inserted, err := s.writeVault(ctx, vaultID, domain.VaultContent{
+		Reader: content,
+		Size:   int64(version.Size),
+		SHA256: version.SHA256,
+	}, expectedRevision)
+	if err != nil {
+		return nil, err
+	}
+
+	s.eventRecorder.Record(ctx, domain.SecurityEvent{
+		UserID:  inserted.UserID,
+		ActorID: userID,
+		Type:    domain.SecurityEventVaultWrite,
+		Metadata: map[string]string{
+			"vault":        vaultID,
+			"size":         strconv.Itoa(version.Size),
+			"revision":     strconv.FormatInt(inserted.Revision, 10),
+			"restoredFrom": strconv.FormatInt(revision, 10),
+		},
+	})
+	s.publishUpdate(ctx, inserted)
+
+	return inserted, nil
+}
+
+// WaitForUpdate returns the vault without its content once its updatedAt is
+// after since, in Unix seconds, or when wait runs out. It listens to the
+// vault events of the user rather than querying the vault again and again.
+func (s *VaultService) WaitForUpdate(ctx context.Context, userID, vaultID string, since int64, wait time.Duration) (*domain.Vault, error) {
+	if !s.acquirePoller(userID) {
+		return nil, ErrTooManyVaultPollers
+	}
+	defer s.releasePoller(userID)
+
+	waitCtx, cancel := context.WithTimeout(ctx, min(wait, s.config.PollMaxWait))
+	defer cancel()
+
+	// Subscribing before reading the vault means no write can slip between both
+	events, err := s.vaultEvents.Subscribe(waitCtx, userID, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := s.vaultRepo.GetVault(ctx, vaultID)
+	if err != nil || current == nil || current.UpdatedAt.Unix() > since {
+		return current, err
+	}
+
+	// The channel is closed when the wait is over. Item writes have no
+	// revision and leave the vault as is.
+	for event := range events {
+		if event.Type == domain.VaultEventUpdated && event.VaultID == vaultID && event.Revision != 0 {
+			break
+		}
+	}
+
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	return s.vaultRepo.GetVault(ctx, vaultID)
+}
+
+func (s *VaultService) acquirePoller(userID string) bool {
+	s.pollersMu.Lock()
+	defer s.pollersMu.Unlock()
+
+	if s.pollers[userID] >= s.config.PollMaxWaiters {
+		return false
+	}
+	s.pollers[userID]++
+	return true
+}
+
+func (s *VaultService) releasePoller(userID string) {
+	s.pollersMu.Lock()
+	defer s.pollersMu.Unlock()
+
+	if s.pollers[userID] <= 1 {
+		delete(s.pollers, userID)
+		return
+	}
+	s.pollers[userID]--
+}
+
+// publishUpdate notifies the owner and, for a shared vault, the other
+// members who accepted
+func (s *VaultService) publishUpdate(ctx context.Context, vault *domain.Vault) {
+	userIDs := []string{vault.UserID}
+	if vault.KeyVersion > 0 {
+		members, err := s.memberRepo.ListMembers(ctx, vault.ID)
+		if err != nil {
+			log.Printf("failed to list members of vault %s: %v", vault.ID, err)
+		}
+		for _, member := range members {
+			if member.Accepted() && member.UserID != vault.UserID {
+				userIDs = append(userIDs, member.UserID)
+			}
+		}
+	}
+
+	for _, userID := range userIDs {
+		s.vaultEvents.Publish(ctx, domain.VaultEvent{
+			UserID:   userID,
+			Type:     domain.VaultEventUpdated,
+			VaultID:  vault.ID,
+			Revision: vault.Revision,
+		})
+	}
+}
+
+// createDefaultVault returns the default vault of the user, created by
+// whichever of two concurrent first writes comes first
+func (s *VaultService) createDefaultVault(ctx context.Context, userID string) (*domain.Vault, error) {
+	vault, err := s.vaultRepo.CreateVault(ctx, userID, "", domain.DefaultVaultName, domain.VaultTypePersonal, true)
+	if err != nil || vault != nil {
+		return vault, err
+	}
+	return s.vaultRepo.GetDefaultVault(ctx, userID)
+}
+
+// writeVault stages the content, then swaps it in, records the new version
+// and prunes the old ones in one short transaction, charging the storage to
+// the owner. The content is streamed before the transaction starts, so a
+// slow upload doesn't hold the vault locked.
+func (s *VaultService) writeVault(ctx context.Context, vaultID string, content domain.VaultContent, expectedRevision int64) (*domain.Vault, error) {
+	reader := &limitedVaultReader{reader: content.Reader, left: int64(s.quota.MaxSize())}
+
+	staged, err := s.vaultRepo.StageVaultContent(ctx, vaultID, reader, content.Size, expectedRevision)
+	if reader.err != nil {
+		// The storage fails on the error of the content, which is the cause
+		return nil, reader.err
+	}
+	if err != nil {
+		return nil, err
+	}
+	if staged == nil {
+		return nil, ErrVaultRevisionMismatch
+	}
+	// The staged content is deleted unless a vault ends up referring to it,
+	// also when the request was cancelled meanwhile
+	discardCtx := context.WithoutCancel(ctx)
+	if content.SHA256 != "" && staged.SHA256 != content.SHA256 {
+		s.vaultRepo.DiscardVaultContent(discardCtx, staged)
+		return nil, ErrVaultDigestMismatch
+	}
+
+	var inserted *domain.Vault
+	err = s.transactor.WithinTx(ctx, func(ctx context.Context) error {
+		var err error
+		inserted, err = s.vaultRepo.InsertVaultContent(ctx, vaultID, staged, expectedRevision)
+		if err != nil {
+			return err
+		}
+		if inserted == nil {
+			return ErrVaultRevisionMismatch
+		}
+
+		err = s.vaultVersionRepo.CreateVersion(ctx, domain.VaultVersion{
+			VaultID:  inserted.ID,
+			UserID:   inserted.UserID,
+			Revision: inserted.Revision,
+			Size:     inserted.Size,
+			SHA256:   inserted.SHA256,
+			DeviceID: domain.ClientInfoFromContext(ctx).DeviceID,
+		})
+		if err != nil {
+			return err
+		}
+
+		var before time.Time
+		if s.config.VersionsMaxAge > 0 {
+			before = time.Now().Add(-s.config.VersionsMaxAge)
+		}
+		if _, err = s.vaultVersionRepo.PruneVersions(ctx, vaultID, s.config.VersionsKeep, before); err != nil {
+			return err
+		}
+
+		return s.quota.Check(ctx, inserted.UserID)
+	})
+	if err != nil {
+		s.vaultRepo.DiscardVaultContent(discardCtx, staged)
+		return nil, err
+	}
+
+	return inserted, nil
 }
 
-func (s *VaultService) GetVaultUpdatedAtByUserID(ctx context.Context, userID string) (*time.Time, error) {
-	return s.vaultRepo.GetVaultUpdatedAtByUserID(ctx, userID)
+// limitedVaultReader fails with ErrVaultTooLarge once more than left bytes
+// are read, and remembers the error the content failed with
+type limitedVaultReader struct {
+	reader io.Reader
+	left   int64
+	err    error
 }
 
-func (s *VaultService) InsertVaultByUserID(ctx context.Context, userID string, vault []byte) (*domain.Vault, error) {
-	return s.vaultRepo.InsertVaultByUserID(ctx, userID, vault)
+func (r *limitedVaultReader) Read(p []byte) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+	// Reading one byte past the limit tells a vault of exactly the maximum
+	// size from a larger one
+	if int64(len(p)) > r.left+1 {
+		p = p[:r.left+1]
+	}
+	n, err := r.reader.Read(p)
+	r.left -= int64(n)
+	if r.left < 0 {
+		r.err = ErrVaultTooLarge
+		return 0, r.err
+	}
+	if err != nil && err != io.EOF {
+		r.err = err
+	}
+	return n, err
 }
@@ -0,0 +1,467 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"main/internal/core/domain"
+	"testing"
+	"time"
+)
+
+// fakeVaultRepository keeps the vaults in memory and mimics the
+// compare-and-swap of the SQL queries
+type fakeVaultRepository struct {
+	vaults []*domain.Vault
+	// staged holds the staged contents not swapped in or discarded yet
+	staged   map[string][]byte
+	stageSeq int
+}
+
+func (r *fakeVaultRepository) CreateVault(ctx context.Context, userID, organizationID, name string, vaultType domain.VaultType, isDefault bool) (*domain.Vault, error) {
+	if isDefault {
+		if current, _ := r.GetDefaultVault(ctx, userID); current != nil {
+			return nil, nil
+		}
+	}
+	vault := &domain.Vault{ID: fmt.Sprintf("v%d", len(r.vaults)+1), UserID: userID, Name: name, Type: vaultType, Default: isDefault}
+	r.vaults = append(r.vaults, vault)
+	return vault, nil
+}
+
+func (r *fakeVaultRepository) GetVault(ctx context.Context, vaultID string) (*domain.Vault, error) {
+	for _, v := range r.vaults {
+		if v.ID == vaultID {
+			return v, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeVaultRepository) GetDefaultVault(ctx context.Context, userID string) (*domain.Vault, error) {
+	for _, v := range r.vaults {
+		if v.UserID == userID && v.Default {
+			return v, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeVaultRepository) ListVaults(ctx context.Context, userID string) ([]domain.Vault, error) {
+	var vaults []domain.Vault
+	for _, v := range r.vaults {
+		if v.UserID == userID {
+			vaults = append(vaults, *v)
+		}
+	}
+	return vaults, nil
+}
+
+func (r *fakeVaultRepository) UpdateVault(ctx context.Context, vaultID, name string, vaultType domain.VaultType) (*domain.Vault, error) {
+	vault, _ := r.GetVault(ctx, vaultID)
+	if vault != nil {
+		vault.Name, vault.Type = name, vaultType
+	}
+	return vault, nil
+}
+
+func (r *fakeVaultRepository) DeleteVault(ctx context.Context, vaultID string) (bool, error) {
+	for i, v := range r.vaults {
+		if v.ID == vaultID && !v.Default {
+			r.vaults = append(r.vaults[:i], r.vaults[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *fakeVaultRepository) GetVaultContent(ctx context.Context, vaultID string) (*domain.Vault, error) {
+	return r.GetVault(ctx, vaultID)
+}
+
+func (r *fakeVaultRepository) StageVaultContent(ctx context.Context, vaultID string, content io.Reader, size int64, expectedRevision int64) (*domain.StagedVaultContent, error) {
+	current, _ := r.GetVault(ctx, vaultID)
+	if current == nil || !domain.VaultRevisionMatches(current.Revision, expectedRevision) {
+		return nil, nil
+	}
+	vault, err := io.ReadAll(content)
+	if err != nil {
+		return nil, err
+	}
+	if r.staged == nil {
+		r.staged = map[string][]byte{}
+	}
+	r.stageSeq++
+	digest := sha256.Sum256(vault)
+	staged := &domain.StagedVaultContent{
+		Key:    fmt.Sprintf("%s/%d", vaultID, r.stageSeq),
+		Size:   len(vault),
+		SHA256: hex.EncodeToString(digest[:]),
+	}
+	r.staged[staged.Key] = vault
+	return staged, nil
+}
+
+func (r *fakeVaultRepository) InsertVaultContent(ctx context.Context, vaultID string, staged *domain.StagedVaultContent, expectedRevision int64) (*domain.Vault, error) {
+	current, _ := r.GetVault(ctx, vaultID)
+	if current == nil || !domain.VaultRevisionMatches(current.Revision, expectedRevision) {
+		return nil, nil
+	}
+	current.Vault = r.staged[staged.Key]
+	current.Revision++
+	current.Size = staged.Size
+	current.SHA256 = staged.SHA256
+	delete(r.staged, staged.Key)
+	return current, nil
+}
+
+func (r *fakeVaultRepository) DiscardVaultContent(ctx context.Context, staged *domain.StagedVaultContent) {
+	delete(r.staged, staged.Key)
+}
+
+func (r *fakeVaultRepository) ListVaultIDs(ctx context.Context, after string, limit int) ([]string, error) {
+	var ids []string
+	for _, v := range r.vaults {
+		if v.ID > after && v.HasContent() && len(ids) < limit {
+			ids = append(ids, v.ID)
+		}
+	}
+	return ids, nil
+}
+
+// fakeVaultVersionRepository versions the current content of the vaults,
+// as the SQL repository shares their blob
+type fakeVaultVersionRepository struct {
+	vaults   *fakeVaultRepository
+	versions []domain.VaultVersion
+}
+
+func (r *fakeVaultVersionRepository) CreateVersion(ctx context.Context, version domain.VaultVersion) error {
+	current, _ := r.vaults.GetVault(ctx, version.VaultID)
+	if current == nil || current.Revision != version.Revision || current.SHA256 != version.SHA256 {
+		return fmt.Errorf("vault %s has no content at revision %d to version", version.VaultID, version.Revision)
+	}
+	version.Vault = current.Vault
+	r.versions = append([]domain.VaultVersion{version}, r.versions...)
+	return nil
+}
+
+func (r *fakeVaultVersionRepository) GetVersionsByVaultID(ctx context.Context, vaultID string) ([]domain.VaultVersion, error) {
+	var versions []domain.VaultVersion
+	for _, v := range r.versions {
+		if v.VaultID == vaultID {
+			versions = append(versions, v)
+		}
+	}
+	return versions, nil
+}
+
+func (r *fakeVaultVersionRepository) GetVersion(ctx context.Context, vaultID string, revision int64) (*domain.VaultVersion, error) {
+	for _, v := range r.versions {
+		if v.VaultID == vaultID && v.Revision == revision {
+			return &v, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeVaultVersionRepository) PruneVersions(ctx context.Context, vaultID string, keep int, before time.Time) (int64, error) {
+	var kept []domain.VaultVersion
+	var pruned int64
+	count := 0
+	for _, v := range r.versions {
+		if v.VaultID == vaultID {
+			if count++; count > keep {
+				pruned++
+				continue
+			}
+		}
+		kept = append(kept, v)
+	}
+	r.versions = kept
+	return pruned, nil
+}
+
+func (r *fakeVaultVersionRepository) OpenVersion(ctx context.Context, vaultID string, revision int64) (*domain.VaultVersion, io.ReadCloser, error) {
+	version, _ := r.GetVersion(ctx, vaultID, revision)
+	if version == nil {
+		return nil, nil, nil
+	}
+	return version, io.NopCloser(bytes.NewReader(version.Vault)), nil
+}
+
+// fakeVaultUsageRepository counts the kept versions, the current one included
+type fakeVaultUsageRepository struct {
+	versions *fakeVaultVersionRepository
+}
+
+func (r *fakeVaultUsageRepository) GetUsageByUserID(ctx context.Context, userID string) (*domain.VaultUsage, error) {
+	usage := &domain.VaultUsage{}
+	for _, v := range r.versions.versions {
+		usage.HistoryBytes += int64(v.Size)
+	}
+	return usage, nil
+}
+
+// fakeTransactor runs fn without a transaction
+type fakeTransactor struct{}
+
+func (fakeTransactor) WithinTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func newTestVaultService(repo *fakeVaultRepository, versions *fakeVaultVersionRepository) *VaultService {
+	versions.vaults = repo
+	recorder := NewEventRecorder(&fakeSecurityEventRepository{}, &fakeWebhookRepository{}, &fakeUserRepository{})
+	quota := NewVaultQuota(&fakeVaultUsageRepository{versions: versions}, 16, 24)
+	return NewVaultService(repo, versions, &fakeVaultMemberRepository{vaults: repo}, &fakeEmergencyAccessRepository{}, fakeTransactor{}, recorder, NewVaultEventService(&fakeVaultEventBus{}, time.Second), quota, VaultConfig{VersionsKeep: 2})
+}
+
+// testVaultContent is a vault upload of known size without a digest
+func testVaultContent(vault []byte) domain.VaultContent {
+	return domain.VaultContent{Reader: bytes.NewReader(vault), Size: int64(len(vault))}
+}
+
+func TestVaultService_InsertRejectsStaleRevision(t *testing.T) {
+	repo := &fakeVaultRepository{}
+	s := newTestVaultService(repo, &fakeVaultVersionRepository{})
+	ctx := context.Background()
+
+	created, err := s.InsertDefaultVault(ctx, "1", testVaultContent([]byte("first")), 0)
+	if err != nil || created.Revision != 1 {
+		t.Fatalf("expected revision 1, got %+v (%v)", created, err)
+	}
+
+	// Two devices based on revision 1, only the first one wins
+	if _, err := s.InsertDefaultVault(ctx, "1", testVaultContent([]byte("laptop")), 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := s.InsertDefaultVault(ctx, "1", testVaultContent([]byte("phone")), 1); !errors.Is(err, ErrVaultRevisionMismatch) {
+		t.Fatalf("expected a revision mismatch, got %v", err)
+	}
+	if string(repo.vaults[0].Vault) != "laptop" || repo.vaults[0].Revision != 2 {
+		t.Errorf("expected the laptop vault at revision 2, got %q at %d", repo.vaults[0].Vault, repo.vaults[0].Revision)
+	}
+
+	if _, err := s.InsertDefaultVault(ctx, "1", testVaultContent([]byte("again")), 0); !errors.Is(err, ErrVaultRevisionMismatch) {
+		t.Errorf("expected creating an existing vault to fail, got %v", err)
+	}
+}
+
+func TestVaultService_InsertAnyRevision(t *testing.T) {
+	repo := &fakeVaultRepository{}
+	s := newTestVaultService(repo, &fakeVaultVersionRepository{})
+	ctx := context.Background()
+
+	// If-Match: * doesn't match a vault that was never written
+	if _, err := s.InsertDefaultVault(ctx, "1", testVaultContent([]byte("first")), domain.AnyVaultRevision); !errors.Is(err, ErrVaultRevisionMismatch) {
+		t.Fatalf("expected a revision mismatch, got %v", err)
+	}
+
+	for i, content := range []string{"first", "second"} {
+		if _, err := s.InsertDefaultVault(ctx, "1", testVaultContent([]byte(content)), int64(i)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	inserted, err := s.InsertDefaultVault(ctx, "1", testVaultContent([]byte("any")), domain.AnyVaultRevision)
+	if err != nil || inserted.Revision != 3 {
+		t.Fatalf("expected the vault to be overwritten at revision 3, got %+v (%v)", inserted, err)
+	}
+}
+
+func TestVaultService_VersionsAndRestore(t *testing.T) {
+	repo := &fakeVaultRepository{}
+	versions := &fakeVaultVersionRepository{}
+	s := newTestVaultService(repo, versions)
+	ctx := domain.WithClientInfo(context.Background(), domain.ClientInfo{DeviceID: "laptop"})
+
+	for i, content := range []string{"one", "two", "corrupt"} {
+		if _, err := s.InsertDefaultVault(ctx, "1", testVaultContent([]byte(content)), int64(i)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if len(versions.versions) != 2 || versions.versions[0].Revision != 3 || versions.versions[1].Revision != 2 {
+		t.Fatalf("expected revisions 3 and 2 to be kept, got %+v", versions.versions)
+	}
+	if v := versions.versions[1]; v.DeviceID != "laptop" || v.Size != 3 || len(v.SHA256) != 64 {
+		t.Errorf("unexpected version metadata %+v", v)
+	}
+
+	if _, err := s.RestoreVersion(ctx, "1", "v1", 1, 3); !errors.Is(err, ErrVaultVersionNotFound) {
+		t.Errorf("expected a pruned version to be gone, got %v", err)
+	}
+	if _, err := s.RestoreVersion(ctx, "1", "v1", 2, 2); !errors.Is(err, ErrVaultRevisionMismatch) {
+		t.Errorf("expected a stale If-Match to be rejected, got %v", err)
+	}
+
+	restored, err := s.RestoreVersion(ctx, "1", "v1", 2, 3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if restored.Revision != 4 || string(repo.vaults[0].Vault) != "two" {
+		t.Errorf("expected revision 4 with the content of 2, got %d with %q", restored.Revision, repo.vaults[0].Vault)
+	}
+}
+
+func TestVaultService_WaitForUpdate(t *testing.T) {
+	updatedAt := time.Unix(1000, 0)
+	repo := &fakeVaultRepository{vaults: []*domain.Vault{{ID: "v1", UserID: "1", Default: true, Revision: 1, UpdatedAt: updatedAt}}}
+	recorder := NewEventRecorder(&fakeSecurityEventRepository{}, &fakeWebhookRepository{}, &fakeUserRepository{})
+	versions := &fakeVaultVersionRepository{}
+	quota := NewVaultQuota(&fakeVaultUsageRepository{versions: versions}, 16, 24)
+	s := NewVaultService(repo, versions, &fakeVaultMemberRepository{vaults: repo}, &fakeEmergencyAccessRepository{}, fakeTransactor{}, recorder, NewVaultEventService(&fakeVaultEventBus{}, time.Second), quota,
+		VaultConfig{VersionsKeep: 2, PollMaxWait: 10 * time.Millisecond, PollMaxWaiters: 1})
+	ctx := context.Background()
+
+	// Already newer than since, no wait
+	vault, err := s.WaitForUpdate(ctx, "1", "v1", 999, time.Hour)
+	if err != nil || vault.Revision != 1 {
+		t.Fatalf("expected the current vault, got %+v (%v)", vault, err)
+	}
+
+	// Nothing happens, the wait is capped by PollMaxWait
+	vault, err = s.WaitForUpdate(ctx, "1", "v1", 1000, time.Hour)
+	if err != nil || vault.Revision != 1 {
+		t.Fatalf("expected the current vault after the wait, got %+v (%v)", vault, err)
+	}
+
+	s.acquirePoller("1")
+	if _, err := s.WaitForUpdate(ctx, "1", "v1", 1000, time.Hour); !errors.Is(err, ErrTooManyVaultPollers) {
+		t.Errorf("expected ErrTooManyVaultPollers, got %v", err)
+	}
+	s.releasePoller("1")
+	if len(s.pollers) != 0 {
+		t.Errorf("expected the pollers to be released, got %v", s.pollers)
+	}
+}
+
+func TestVaultService_InsertEnforcesSizeAndQuota(t *testing.T) {
+	repo := &fakeVaultRepository{}
+	s := newTestVaultService(repo, &fakeVaultVersionRepository{})
+	ctx := context.Background()
+
+	if _, err := s.InsertDefaultVault(ctx, "1", testVaultContent(bytes.Repeat([]byte("a"), 17)), 0); !errors.Is(err, ErrVaultTooLarge) {
+		t.Fatalf("expected ErrVaultTooLarge, got %v", err)
+	}
+
+	// Two versions of 12 bytes fill the quota of 24, with 2 versions kept a
+	// larger third one goes over
+	for revision := range int64(2) {
+		if _, err := s.InsertDefaultVault(ctx, "1", testVaultContent(bytes.Repeat([]byte("a"), 12)), revision); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if _, err := s.InsertDefaultVault(ctx, "1", testVaultContent(bytes.Repeat([]byte("b"), 13)), 2); !errors.Is(err, ErrVaultQuotaExceeded) {
+		t.Errorf("expected ErrVaultQuotaExceeded, got %v", err)
+	}
+}
+
+func TestVaultService_InsertChecksStreamedContent(t *testing.T) {
+	repo := &fakeVaultRepository{}
+	s := newTestVaultService(repo, &fakeVaultVersionRepository{})
+	ctx := context.Background()
+
+	// Without a size up front the limit is enforced while reading
+	tooLarge := domain.VaultContent{Reader: bytes.NewReader(bytes.Repeat([]byte("a"), 17)), Size: -1}
+	if _, err := s.InsertDefaultVault(ctx, "1", tooLarge, 0); !errors.Is(err, ErrVaultTooLarge) {
+		t.Fatalf("expected ErrVaultTooLarge, got %v", err)
+	}
+
+	maxSize := domain.VaultContent{Reader: bytes.NewReader(bytes.Repeat([]byte("a"), 16)), Size: -1}
+	if _, err := s.InsertDefaultVault(ctx, "1", maxSize, 0); err != nil {
+		t.Fatalf("expected a vault of the maximum size to be stored, got %v", err)
+	}
+
+	digest := sha256.Sum256([]byte("other"))
+	mismatch := testVaultContent([]byte("vault"))
+	mismatch.SHA256 = hex.EncodeToString(digest[:])
+	if _, err := s.InsertDefaultVault(ctx, "1", mismatch, 1); !errors.Is(err, ErrVaultDigestMismatch) {
+		t.Fatalf("expected ErrVaultDigestMismatch, got %v", err)
+	}
+	if len(repo.staged) != 0 {
+		t.Errorf("expected the mismatching content to be discarded, got %d staged", len(repo.staged))
+	}
+}
+
+// writeOnEOF makes another write once its content is read, between the
+// staging and the swap of the write reading it
+type writeOnEOF struct {
+	reader io.Reader
+	write  func()
+}
+
+func (r *writeOnEOF) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	if err == io.EOF && r.write != nil {
+		r.write()
+		r.write = nil
+	}
+	return n, err
+}
+
+func TestVaultService_InsertDiscardsStagedContentOfLostSwap(t *testing.T) {
+	repo := &fakeVaultRepository{}
+	s := newTestVaultService(repo, &fakeVaultVersionRepository{})
+	ctx := context.Background()
+
+	if _, err := s.InsertDefaultVault(ctx, "1", testVaultContent([]byte("first")), 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	slow := &writeOnEOF{reader: bytes.NewReader([]byte("laptop")), write: func() {
+		if _, err := s.InsertDefaultVault(ctx, "1", testVaultContent([]byte("phone")), 1); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}}
+	if _, err := s.InsertDefaultVault(ctx, "1", domain.VaultContent{Reader: slow, Size: -1}, 1); !errors.Is(err, ErrVaultRevisionMismatch) {
+		t.Fatalf("expected ErrVaultRevisionMismatch, got %v", err)
+	}
+	if len(repo.staged) != 0 {
+		t.Errorf("expected the content of the lost write to be discarded, got %d staged", len(repo.staged))
+	}
+	if current := repo.vaults[0]; string(current.Vault) != "phone" || current.Revision != 2 {
+		t.Errorf("expected the write that swapped first to be kept, got %q at revision %d", current.Vault, current.Revision)
+	}
+}
+
+func TestVaultService_NamedVaults(t *testing.T) {
+	repo := &fakeVaultRepository{}
+	versions := &fakeVaultVersionRepository{}
+	s := newTestVaultService(repo, versions)
+	ctx := context.Background()
+
+	if _, err := s.CreateVault(ctx, "1", "", "  ", domain.VaultTypeWork); !errors.Is(err, ErrInvalidVaultName) {
+		t.Fatalf("expected ErrInvalidVaultName, got %v", err)
+	}
+	work, err := s.CreateVault(ctx, "1", "", "Work", domain.VaultTypeWork)
+	if err != nil || work.Revision != 0 || work.Default {
+		t.Fatalf("expected an empty vault, got %+v (%v)", work, err)
+	}
+	if _, err := s.InsertVault(ctx, "1", work.ID, testVaultContent([]byte("work")), 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The first write to /user/vault creates the default vault next to it
+	personal, err := s.InsertDefaultVault(ctx, "1", testVaultContent([]byte("personal")), 0)
+	if err != nil || !personal.Default || personal.ID == work.ID || personal.Revision != 1 {
+		t.Fatalf("expected a new default vault at revision 1, got %+v (%v)", personal, err)
+	}
+
+	if _, err := s.AuthorizeVault(ctx, "2", work.ID, domain.VaultRoleRead); !errors.Is(err, ErrVaultNotFound) {
+		t.Errorf("expected the vault of another user to be hidden, got %v", err)
+	}
+	if err := s.DeleteVault(ctx, personal.ID); !errors.Is(err, ErrDefaultVaultUndeletable) {
+		t.Errorf("expected ErrDefaultVaultUndeletable, got %v", err)
+	}
+	if err := s.DeleteVault(ctx, work.ID); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if vaults, _ := s.ListVaults(ctx, "1"); len(vaults) != 1 || vaults[0].ID != personal.ID {
+		t.Errorf("expected only the default vault to be left, got %+v", vaults)
+	}
+}
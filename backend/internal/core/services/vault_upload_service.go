@@ -0,0 +1,188 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"main/internal/core/domain"
+	"main/internal/core/ports"
+	"regexp"
+	"time"
+)
+
+var (
+	ErrVaultUploadNotFound       = errors.New("Upload not found or expired")
+	ErrVaultUploadOffsetMismatch = errors.New("Chunk doesn't start at the upload offset")
+	ErrVaultUploadChunkTooLarge  = errors.New("Chunk goes past the announced size")
+	ErrVaultUploadIncomplete     = errors.New("Upload is incomplete")
+	ErrVaultUploadHashMismatch   = errors.New("Upload doesn't match the announced hash")
+	ErrTooManyVaultUploads       = errors.New("Too many uploads in progress")
+)
+
+// vaultUploadIDPattern matches the IDs the repository assigns, other IDs
+// are not looked up
+var vaultUploadIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
+type VaultUploadConfig struct {
+	// TTL expires an upload after its last chunk
+	TTL time.Duration
+	// MaxUploads is how many uploads a user can have in progress
+	MaxUploads int
+}
+
+// VaultUploadService receives a vault in chunks over several requests, for
+// clients that lose their connection mid upload. The complete upload is
+// stored by VaultService like a single request upload.
+type VaultUploadService struct {
+	uploadRepo   ports.VaultUploadRepository
+	vaultService *VaultService
+	quota        *VaultQuota
+	config       VaultUploadConfig
+}
+
+func NewVaultUploadService(
+	uploadRepo ports.VaultUploadRepository,
+	vaultService *VaultService,
+	quota *VaultQuota,
+	config VaultUploadConfig,
+) *VaultUploadService {
+	return &VaultUploadService{
+		uploadRepo:   uploadRepo,
+		vaultService: vaultService,
+		quota:        quota,
+		config:       config,
+	}
+}
+
+// CreateUpload refuses right away a vault that couldn't be stored, the
+// quota of the owner is checked again on commit. The upload is stored to
+// vaultID, which needs the write role, or to the default vault when it's "".
+func (s *VaultUploadService) CreateUpload(ctx context.Context, userID, vaultID string, size int64, sha256Hex string) (*domain.VaultUpload, error) {
+	if size > int64(s.quota.MaxSize()) {
+		return nil, ErrVaultTooLarge
+	}
+	ownerID := userID
+	if vaultID != "" {
+		vault, err := s.vaultService.AuthorizeVault(ctx, userID, vaultID, domain.VaultRoleWrite)
+		if err != nil {
+			return nil, err
+		}
+		ownerID = vault.UserID
+	}
+
+	usage, err := s.quota.Usage(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	if usage.Total()+size > s.quota.Quota() {
+		return nil, ErrVaultQuotaExceeded
+	}
+
+	upload, err := s.uploadRepo.CreateUpload(ctx, domain.VaultUpload{
+		UserID:  userID,
+		VaultID: vaultID,
+		Size:    size,
+		SHA256:  sha256Hex,
+	}, s.config.TTL, s.config.MaxUploads)
+	if err != nil {
+		return nil, err
+	}
+	if upload == nil {
+		return nil, ErrTooManyVaultUploads
+	}
+	return upload, nil
+}
+
+func (s *VaultUploadService) GetUpload(ctx context.Context, userID, id string) (*domain.VaultUpload, error) {
+	if !vaultUploadIDPattern.MatchString(id) {
+		return nil, ErrVaultUploadNotFound
+	}
+
+	upload, err := s.uploadRepo.GetUpload(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if upload == nil {
+		return nil, ErrVaultUploadNotFound
+	}
+	return upload, nil
+}
+
+// AppendChunk returns ErrVaultUploadOffsetMismatch along with the upload
+// when offset isn't where the upload is at, the client resumes from there
+func (s *VaultUploadService) AppendChunk(ctx context.Context, userID, id string, offset int64, chunk []byte) (*domain.VaultUpload, error) {
+	upload, err := s.GetUpload(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if offset+int64(len(chunk)) > upload.Size {
+		return nil, ErrVaultUploadChunkTooLarge
+	}
+
+	upload, appended, err := s.uploadRepo.AppendChunk(ctx, userID, id, offset, chunk, s.config.TTL)
+	if err != nil {
+		return nil, err
+	}
+	if upload == nil {
+		return nil, ErrVaultUploadNotFound
+	}
+	if !appended {
+		return upload, ErrVaultUploadOffsetMismatch
+	}
+	return upload, nil
+}
+
+// CommitUpload stores the complete upload as the vault if it's still at
+// expectedRevision, see VaultService.InsertVault. The upload ends
+// unless it's incomplete or storing failed unexpectedly, a conflicting
+// upload has to be merged and sent again.
+func (s *VaultUploadService) CommitUpload(ctx context.Context, userID, id string, expectedRevision int64) (*domain.VaultUpload, *domain.Vault, error) {
+	upload, err := s.GetUpload(ctx, userID, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if upload.Offset < upload.Size {
+		return upload, nil, ErrVaultUploadIncomplete
+	}
+
+	// The content is hashed while it's stored, a mismatch rolls the write back
+	content := domain.VaultContent{
+		Reader: s.uploadRepo.OpenContent(ctx, userID, id),
+		Size:   upload.Size,
+		SHA256: upload.SHA256,
+	}
+
+	var inserted *domain.Vault
+	if upload.VaultID == "" {
+		inserted, err = s.vaultService.InsertDefaultVault(ctx, userID, content, expectedRevision)
+	} else if _, err = s.vaultService.AuthorizeVault(ctx, userID, upload.VaultID, domain.VaultRoleWrite); err == nil {
+		// The role may have been changed since the upload was created
+		inserted, err = s.vaultService.InsertVault(ctx, userID, upload.VaultID, content, expectedRevision)
+	}
+	if errors.Is(err, ErrVaultDigestMismatch) {
+		s.uploadRepo.DeleteUpload(ctx, userID, id)
+		return nil, nil, ErrVaultUploadHashMismatch
+	}
+	if err != nil && !errors.Is(err, ErrVaultRevisionMismatch) && !errors.Is(err, ErrVaultQuotaExceeded) {
+		return nil, nil, err
+	}
+
+	if _, deleteErr := s.uploadRepo.DeleteUpload(ctx, userID, id); deleteErr != nil {
+		return nil, nil, deleteErr
+	}
+	return upload, inserted, err
+}
+
+func (s *VaultUploadService) CancelUpload(ctx context.Context, userID, id string) error {
+	if !vaultUploadIDPattern.MatchString(id) {
+		return ErrVaultUploadNotFound
+	}
+
+	deleted, err := s.uploadRepo.DeleteUpload(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrVaultUploadNotFound
+	}
+	return nil
+}
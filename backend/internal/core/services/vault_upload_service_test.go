@@ -0,0 +1,132 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"main/internal/core/domain"
+	"testing"
+	"time"
+)
+
+// fakeVaultUploadRepository keeps the uploads in memory, without expiry
+type fakeVaultUploadRepository struct {
+	uploads map[string]*domain.VaultUpload
+	content map[string][]byte
+	nextID  int
+}
+
+func newFakeVaultUploadRepository() *fakeVaultUploadRepository {
+	return &fakeVaultUploadRepository{uploads: map[string]*domain.VaultUpload{}, content: map[string][]byte{}}
+}
+
+func (r *fakeVaultUploadRepository) CreateUpload(ctx context.Context, upload domain.VaultUpload, ttl time.Duration, maxUploads int) (*domain.VaultUpload, error) {
+	if len(r.uploads) >= maxUploads {
+		return nil, nil
+	}
+	r.nextID++
+	upload.ID = string(rune('a' + r.nextID))
+	upload.ExpiresAt = time.Now().Add(ttl)
+	r.uploads[upload.ID] = &upload
+	return &upload, nil
+}
+
+func (r *fakeVaultUploadRepository) GetUpload(ctx context.Context, userID, id string) (*domain.VaultUpload, error) {
+	upload, ok := r.uploads[id]
+	if !ok || upload.UserID != userID {
+		return nil, nil
+	}
+	copied := *upload
+	return &copied, nil
+}
+
+func (r *fakeVaultUploadRepository) AppendChunk(ctx context.Context, userID, id string, offset int64, chunk []byte, ttl time.Duration) (*domain.VaultUpload, bool, error) {
+	upload, _ := r.GetUpload(ctx, userID, id)
+	if upload == nil {
+		return nil, false, nil
+	}
+	if upload.Offset != offset {
+		return upload, false, nil
+	}
+	r.content[id] = append(r.content[id], chunk...)
+	r.uploads[id].Offset += int64(len(chunk))
+	upload.Offset = r.uploads[id].Offset
+	return upload, true, nil
+}
+
+func (r *fakeVaultUploadRepository) OpenContent(ctx context.Context, userID, id string) io.Reader {
+	return bytes.NewReader(r.content[id])
+}
+
+func (r *fakeVaultUploadRepository) DeleteUpload(ctx context.Context, userID, id string) (bool, error) {
+	_, ok := r.uploads[id]
+	delete(r.uploads, id)
+	delete(r.content, id)
+	return ok, nil
+}
+
+func TestVaultUploadService_ResumeAndCommit(t *testing.T) {
+	repo := &fakeVaultRepository{}
+	versions := &fakeVaultVersionRepository{}
+	vaults := newTestVaultService(repo, versions)
+	uploads := newFakeVaultUploadRepository()
+	s := NewVaultUploadService(uploads, vaults, vaults.quota, VaultUploadConfig{TTL: time.Hour, MaxUploads: 1})
+	ctx := context.Background()
+
+	vault := []byte("chunked vault")
+	digest := sha256.Sum256(vault)
+	upload, err := s.CreateUpload(ctx, "1", "", int64(len(vault)), hex.EncodeToString(digest[:]))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := s.CreateUpload(ctx, "1", "", 1, hex.EncodeToString(digest[:])); !errors.Is(err, ErrTooManyVaultUploads) {
+		t.Fatalf("expected ErrTooManyVaultUploads, got %v", err)
+	}
+
+	if _, err := s.AppendChunk(ctx, "1", upload.ID, 0, vault[:7]); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// A chunk resent after a lost response tells the client where to resume
+	resumed, err := s.AppendChunk(ctx, "1", upload.ID, 0, vault[:7])
+	if !errors.Is(err, ErrVaultUploadOffsetMismatch) || resumed.Offset != 7 {
+		t.Fatalf("expected an offset mismatch at 7, got %+v (%v)", resumed, err)
+	}
+	if _, err := s.AppendChunk(ctx, "1", upload.ID, 7, append(vault[7:], '!')); !errors.Is(err, ErrVaultUploadChunkTooLarge) {
+		t.Fatalf("expected ErrVaultUploadChunkTooLarge, got %v", err)
+	}
+	if _, _, err := s.CommitUpload(ctx, "1", upload.ID, 0); !errors.Is(err, ErrVaultUploadIncomplete) {
+		t.Fatalf("expected ErrVaultUploadIncomplete, got %v", err)
+	}
+
+	if _, err := s.AppendChunk(ctx, "1", upload.ID, 7, vault[7:]); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_, inserted, err := s.CommitUpload(ctx, "1", upload.ID, 0)
+	if err != nil || inserted.Revision != 1 || string(repo.vaults[0].Vault) != string(vault) {
+		t.Fatalf("expected the vault stored at revision 1, got %+v (%v)", inserted, err)
+	}
+	if _, err := s.GetUpload(ctx, "1", upload.ID); !errors.Is(err, ErrVaultUploadNotFound) {
+		t.Errorf("expected the upload to end with the commit, got %v", err)
+	}
+}
+
+func TestVaultUploadService_CommitChecksHash(t *testing.T) {
+	vaults := newTestVaultService(&fakeVaultRepository{}, &fakeVaultVersionRepository{})
+	s := NewVaultUploadService(newFakeVaultUploadRepository(), vaults, vaults.quota, VaultUploadConfig{TTL: time.Hour, MaxUploads: 1})
+	ctx := context.Background()
+
+	digest := sha256.Sum256([]byte("expected"))
+	upload, err := s.CreateUpload(ctx, "1", "", 8, hex.EncodeToString(digest[:]))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := s.AppendChunk(ctx, "1", upload.ID, 0, []byte("tampered")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, _, err := s.CommitUpload(ctx, "1", upload.ID, 0); !errors.Is(err, ErrVaultUploadHashMismatch) {
+		t.Errorf("expected ErrVaultUploadHashMismatch, got %v", err)
+	}
+}
@@ -0,0 +1,193 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"fmt"
+	"log"
+	"main/internal/core/domain"
+	"main/internal/core/ports"
+	"main/internal/utils"
+	"net/url"
+	"slices"
+	"time"
+)
+
+var (
+	ErrWebhookNotFound  = errors.New("Webhook not found")
+	ErrWebhookForbidden = errors.New("Only admins can subscribe to instance-wide events")
+)
+
+// Exposed on /debug/vars
+var webhookMetrics = expvar.NewMap("webhooks")
+
+var webhookEventTypes = []domain.SecurityEventType{
+	domain.SecurityEventLogin,
+	domain.SecurityEventLoginFailed,
+	domain.SecurityEventTokenRefresh,
+	domain.SecurityEventLogout,
+	domain.SecurityEventRegistration,
+	domain.SecurityEventVaultWrite,
+}
+
+type WebhookConfig struct {
+	PollInterval time.Duration
+	BatchSize    int
+	MaxAttempts  int
+	BackoffBase  time.Duration
+	BackoffMax   time.Duration
+	// Lease is how long a claimed delivery stays invisible to other workers
+	Lease time.Duration
+}
+
+type WebhookService struct {
+	webhookRepository ports.WebhookRepository
+	sender            ports.WebhookSender
+	config            WebhookConfig
+}
+
+func NewWebhookService(webhookRepo ports.WebhookRepository, sender ports.WebhookSender, config WebhookConfig) *WebhookService {
+	return &WebhookService{webhookRepository: webhookRepo, sender: sender, config: config}
+}
+
+// CreateSubscription registers a webhook for the user with a generated secret.
+// No events means every event type.
+func (s *WebhookService) CreateSubscription(ctx context.Context, userID, endpoint string, scope domain.WebhookScope, events []domain.SecurityEventType, isAdmin bool) (*domain.WebhookSubscription, error) {
+	if scope == domain.WebhookScopeInstance && !isAdmin {
+		return nil, ErrWebhookForbidden
+	}
+
+	parsed, err := url.Parse(endpoint)
+	if err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Host == "" {
+		return nil, fmt.Errorf("Webhook URL must be an absolute http or https URL")
+	}
+
+	for _, e := range events {
+		if !slices.Contains(webhookEventTypes, e) {
+			return nil, fmt.Errorf("Unknown event type %q", e)
+		}
+	}
+
+	secret, err := utils.GenerateRandomString(32)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.webhookRepository.CreateSubscription(ctx, domain.WebhookSubscription{
+		UserID: userID,
+		Scope:  scope,
+		URL:    endpoint,
+		Secret: secret,
+		Events: events,
+	})
+}
+
+func (s *WebhookService) ListSubscriptions(ctx context.Context, userID string) ([]domain.WebhookSubscription, error) {
+	return s.webhookRepository.GetSubscriptionsByUser(ctx, userID)
+}
+
+func (s *WebhookService) DeleteSubscription(ctx context.Context, userID, subscriptionID string) error {
+	if _, err := s.ownedSubscription(ctx, userID, subscriptionID); err != nil {
+		return err
+	}
+
+	deleted, err := s.webhookRepository.DeleteSubscription(ctx, subscriptionID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrWebhookNotFound
+	}
+	return nil
+}
+
+// ListDeliveries returns the delivery log of one of the user's webhooks, newest first
+func (s *WebhookService) ListDeliveries(ctx context.Context, userID, subscriptionID string, limit int) ([]domain.WebhookDelivery, error) {
+	subscription, err := s.ownedSubscription(ctx, userID, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.webhookRepository.GetDeliveriesBySubscription(ctx, subscription.ID, limit)
+}
+
+// ownedSubscription hides the subscriptions of other users as not found
+func (s *WebhookService) ownedSubscription(ctx context.Context, userID, subscriptionID string) (*domain.WebhookSubscription, error) {
+	subscription, err := s.webhookRepository.GetSubscriptionByPublicID(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+	if subscription == nil || subscription.UserID != userID {
+		return nil, ErrWebhookNotFound
+	}
+	return subscription, nil
+}
+
+// Run delivers queued webhooks until ctx is cancelled
+func (s *WebhookService) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			delivered, err := s.ProcessBatch(ctx)
+			if err != nil {
+				log.Printf("webhooks: failed to process batch: %v", err)
+			}
+			// Keep draining while batches are full
+			if err != nil || delivered < s.config.BatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProcessBatch claims due deliveries and tries each of them once
+func (s *WebhookService) ProcessBatch(ctx context.Context) (int, error) {
+	deliveries, err := s.webhookRepository.ClaimDeliveries(ctx, s.config.BatchSize, s.config.Lease)
+	if err != nil {
+		return 0, err
+	}
+	webhookMetrics.Add("claimed", int64(len(deliveries)))
+
+	for _, delivery := range deliveries {
+		status, err := s.sender.Send(ctx, delivery)
+		if err != nil {
+			s.handleFailure(ctx, delivery, status, err)
+			continue
+		}
+
+		if err := s.webhookRepository.MarkDeliveryDelivered(ctx, delivery.ID, status); err != nil {
+			log.Printf("webhooks: failed to mark delivery %s as delivered: %v", delivery.PublicID, err)
+			continue
+		}
+		webhookMetrics.Add("delivered", 1)
+	}
+
+	return len(deliveries), nil
+}
+
+func (s *WebhookService) handleFailure(ctx context.Context, delivery domain.WebhookDelivery, responseStatus int, deliveryErr error) {
+	status := domain.WebhookDeliveryPending
+	nextAttemptAt := time.Now().Add(utils.ExponentialBackoff(delivery.Attempts, s.config.BackoffBase, s.config.BackoffMax))
+
+	if delivery.Attempts >= s.config.MaxAttempts {
+		status = domain.WebhookDeliveryDead
+		webhookMetrics.Add("dead_lettered", 1)
+		log.Printf("webhooks: delivery %s dead-lettered after %d attempts: %v", delivery.PublicID, delivery.Attempts, deliveryErr)
+	} else {
+		webhookMetrics.Add("failed", 1)
+	}
+
+	err := s.webhookRepository.MarkDeliveryFailed(ctx, delivery.ID, status, responseStatus, deliveryErr.Error(), nextAttemptAt)
+	if err != nil {
+		log.Printf("webhooks: failed to record failure of delivery %s: %v", delivery.PublicID, err)
+	}
+}
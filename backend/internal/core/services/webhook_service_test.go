@@ -0,0 +1,112 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"main/internal/core/domain"
+	"main/internal/core/ports"
+	"strings"
+	"testing"
+	"time"
+)
+
+type fakeWebhookRepository struct {
+	ports.WebhookRepository
+	payloads  [][]byte
+	claimable []domain.WebhookDelivery
+	delivered []string
+	failed    map[string]domain.WebhookDeliveryStatus
+}
+
+func (r *fakeWebhookRepository) EnqueueDeliveries(ctx context.Context, userID string, eventType domain.SecurityEventType, payload []byte) (int64, error) {
+	r.payloads = append(r.payloads, payload)
+	return 1, nil
+}
+
+func (r *fakeWebhookRepository) ClaimDeliveries(ctx context.Context, batchSize int, lease time.Duration) ([]domain.WebhookDelivery, error) {
+	claimed := r.claimable
+	r.claimable = nil
+	return claimed, nil
+}
+
+func (r *fakeWebhookRepository) MarkDeliveryDelivered(ctx context.Context, id string, responseStatus int) error {
+	r.delivered = append(r.delivered, id)
+	return nil
+}
+
+func (r *fakeWebhookRepository) MarkDeliveryFailed(ctx context.Context, id string, status domain.WebhookDeliveryStatus, responseStatus int, lastError string, nextAttemptAt time.Time) error {
+	if r.failed == nil {
+		r.failed = map[string]domain.WebhookDeliveryStatus{}
+	}
+	r.failed[id] = status
+	return nil
+}
+
+type fakeUserRepository struct {
+	ports.UserRepository
+	user *domain.User
+}
+
+func (r *fakeUserRepository) GetUserByID(ctx context.Context, id string) (*domain.User, error) {
+	return r.user, nil
+}
+
+func (r *fakeUserRepository) GetUserByEmail(ctx context.Context, email string) (*domain.User, error) {
+	if r.user == nil || !strings.EqualFold(r.user.Email, email) {
+		return nil, nil
+	}
+	return r.user, nil
+}
+
+type fakeWebhookSender struct {
+	failing map[string]bool
+}
+
+func (s *fakeWebhookSender) Send(ctx context.Context, delivery domain.WebhookDelivery) (int, error) {
+	if s.failing[delivery.ID] {
+		return 503, errors.New("unexpected status 503")
+	}
+	return 204, nil
+}
+
+func TestWebhookService_ProcessBatch(t *testing.T) {
+	repo := &fakeWebhookRepository{claimable: []domain.WebhookDelivery{
+		{ID: "1", Attempts: 1},
+		{ID: "2", Attempts: 1},
+		{ID: "3", Attempts: 3},
+	}}
+	sender := &fakeWebhookSender{failing: map[string]bool{"2": true, "3": true}}
+	s := NewWebhookService(repo, sender, WebhookConfig{BatchSize: 10, MaxAttempts: 3, BackoffBase: time.Second, BackoffMax: time.Minute})
+
+	processed, err := s.ProcessBatch(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if processed != 3 {
+		t.Errorf("expected 3 deliveries processed, got %d", processed)
+	}
+	if len(repo.delivered) != 1 || repo.delivered[0] != "1" {
+		t.Errorf("expected delivery 1 to be delivered, got %v", repo.delivered)
+	}
+	if repo.failed["2"] != domain.WebhookDeliveryPending {
+		t.Errorf("expected delivery 2 to be retried, got %q", repo.failed["2"])
+	}
+	if repo.failed["3"] != domain.WebhookDeliveryDead {
+		t.Errorf("expected delivery 3 to be dead-lettered, got %q", repo.failed["3"])
+	}
+}
+
+func TestWebhookService_CreateSubscriptionValidation(t *testing.T) {
+	s := NewWebhookService(&fakeWebhookRepository{}, &fakeWebhookSender{}, WebhookConfig{})
+	ctx := context.Background()
+
+	if _, err := s.CreateSubscription(ctx, "1", "https://example.com/hook", domain.WebhookScopeInstance, nil, false); !errors.Is(err, ErrWebhookForbidden) {
+		t.Errorf("expected instance scope to require admin, got %v", err)
+	}
+	if _, err := s.CreateSubscription(ctx, "1", "ftp://example.com/hook", domain.WebhookScopeUser, nil, false); err == nil {
+		t.Error("expected a non http URL to be rejected")
+	}
+	if _, err := s.CreateSubscription(ctx, "1", "https://example.com/hook", domain.WebhookScopeUser, []domain.SecurityEventType{"unknown"}, false); err == nil {
+		t.Error("expected an unknown event type to be rejected")
+	}
+}
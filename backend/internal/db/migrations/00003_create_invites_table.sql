@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE invites (
+    id SERIAL PRIMARY KEY,
+    public_id UUID NOT NULL UNIQUE DEFAULT gen_random_uuid(),
+    token_hash TEXT NOT NULL UNIQUE,
+    email TEXT,
+    created_by INTEGER NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP,
+    used_by_email TEXT,
+    revoked_at TIMESTAMP,
+    CONSTRAINT fk_invite_creator
+          FOREIGN KEY (created_by)
+          REFERENCES users(id)
+          ON DELETE CASCADE
+);
+
+CREATE INDEX idx_invites_created_by
+ON invites (created_by, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE invites;
+-- +goose StatementEnd
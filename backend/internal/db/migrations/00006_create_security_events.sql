@@ -0,0 +1,49 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE security_events (
+    id BIGSERIAL PRIMARY KEY,
+    public_id UUID NOT NULL UNIQUE DEFAULT gen_random_uuid(),
+    -- The account the event belongs to, NULL for a failed login on an unknown email
+    user_id INTEGER,
+    -- Who performed the action, NULL when anonymous
+    actor_id INTEGER,
+    type TEXT NOT NULL,
+    ip TEXT NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    device_id TEXT NOT NULL DEFAULT '',
+    metadata JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_security_event_user
+          FOREIGN KEY (user_id)
+          REFERENCES users(id)
+          ON DELETE CASCADE,
+    CONSTRAINT fk_security_event_actor
+          FOREIGN KEY (actor_id)
+          REFERENCES users(id)
+          ON DELETE SET NULL
+);
+
+CREATE INDEX idx_security_events_user_id
+ON security_events (user_id, id);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- The log is append-only, rows go away only with the user they belong to
+CREATE FUNCTION reject_security_event_update() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'security_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER security_events_append_only
+BEFORE UPDATE ON security_events
+FOR EACH ROW EXECUTE FUNCTION reject_security_event_update();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE security_events;
+DROP FUNCTION reject_security_event_update();
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Every revision written to vaults, the newest one being the current vault.
+-- Older rows are pruned by count and age on write.
+CREATE TABLE vault_versions (
+    user_id INTEGER NOT NULL,
+    revision BIGINT NOT NULL,
+    vault BYTEA NOT NULL,
+    size INTEGER NOT NULL,
+    -- Hex encoded SHA-256 of vault
+    sha256 TEXT NOT NULL,
+    device_id TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, revision),
+    CONSTRAINT fk_vault_version_user
+          FOREIGN KEY (user_id)
+          REFERENCES users(id)
+          ON DELETE CASCADE
+);
+
+INSERT INTO vault_versions (user_id, revision, vault, size, sha256, created_at)
+SELECT user_id, revision, vault, octet_length(vault), encode(sha256(vault), 'hex'), COALESCE(updated_at, NOW())
+FROM vaults;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE vault_versions;
+-- +goose StatementEnd
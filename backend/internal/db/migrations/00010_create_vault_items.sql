@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Per user change counter of the item sync. Bumping it locks the row, so
+-- the changes of a user are committed in the order of their sequence.
+CREATE TABLE vault_item_sequences (
+    user_id INTEGER PRIMARY KEY,
+    seq BIGINT NOT NULL DEFAULT 0,
+    CONSTRAINT fk_vault_item_sequence_user
+          FOREIGN KEY (user_id)
+          REFERENCES users(id)
+          ON DELETE CASCADE
+);
+
+-- Opaque encrypted items, deleted ones are kept as tombstones so other
+-- devices learn about the deletion
+CREATE TABLE vault_items (
+    user_id INTEGER NOT NULL,
+    item_id UUID NOT NULL,
+    revision BIGINT NOT NULL DEFAULT 1,
+    data BYTEA NOT NULL DEFAULT '',
+    deleted BOOLEAN NOT NULL DEFAULT FALSE,
+    change_seq BIGINT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, item_id),
+    CONSTRAINT fk_vault_item_user
+          FOREIGN KEY (user_id)
+          REFERENCES users(id)
+          ON DELETE CASCADE
+);
+
+CREATE INDEX idx_vault_items_user_change_seq
+ON vault_items (user_id, change_seq);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE vault_items;
+DROP TABLE vault_item_sequences;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Replay buffer of the Postgres vault event bus, live delivery goes through
+-- NOTIFY. Rows older than the retention are pruned on publish.
+CREATE TABLE vault_events (
+    id BIGSERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL,
+    type TEXT NOT NULL,
+    payload JSONB NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_vault_event_user
+          FOREIGN KEY (user_id)
+          REFERENCES users(id)
+          ON DELETE CASCADE
+);
+
+CREATE INDEX idx_vault_events_user_id
+ON vault_events (user_id, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE vault_events;
+-- +goose StatementEnd
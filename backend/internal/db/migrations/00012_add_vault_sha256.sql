@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Hex encoded SHA-256 of vault, the entity tag of downloads is derived from it
+ALTER TABLE vaults
+ADD COLUMN sha256 TEXT NOT NULL DEFAULT '';
+
+UPDATE vaults
+SET sha256 = encode(sha256(vault), 'hex');
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE vaults
+DROP COLUMN sha256;
+-- +goose StatementEnd
@@ -0,0 +1,43 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Per user data key encrypting the stored vaults, wrapped by the
+-- key-encryption key kek_id
+CREATE TABLE vault_keys (
+    user_id INTEGER PRIMARY KEY,
+    kek_id TEXT NOT NULL,
+    wrapped_key BYTEA NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_vault_key_user
+          FOREIGN KEY (user_id)
+          REFERENCES users(id)
+          ON DELETE CASCADE
+);
+
+CREATE INDEX idx_vault_keys_kek_id
+ON vault_keys (kek_id);
+
+-- Rows written before encryption was enabled stay readable as they are.
+-- size is the plaintext size, the stored vault is larger once encrypted.
+ALTER TABLE vaults
+ADD COLUMN encrypted BOOLEAN NOT NULL DEFAULT FALSE,
+ADD COLUMN size INTEGER NOT NULL DEFAULT 0;
+
+UPDATE vaults
+SET size = octet_length(vault);
+
+ALTER TABLE vault_versions
+ADD COLUMN encrypted BOOLEAN NOT NULL DEFAULT FALSE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE vault_versions
+DROP COLUMN encrypted;
+
+ALTER TABLE vaults
+DROP COLUMN size,
+DROP COLUMN encrypted;
+
+DROP TABLE vault_keys;
+-- +goose StatementEnd
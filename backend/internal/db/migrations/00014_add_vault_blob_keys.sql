@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The contents move to the blob store, vault only holds those not migrated
+-- yet. The current vault and the version of the same revision share a blob.
+ALTER TABLE vaults
+ADD COLUMN blob_key TEXT,
+ALTER COLUMN vault DROP NOT NULL;
+
+ALTER TABLE vault_versions
+ADD COLUMN blob_key TEXT,
+ALTER COLUMN vault DROP NOT NULL;
+
+CREATE INDEX idx_vaults_blob_key
+ON vaults (blob_key);
+
+CREATE INDEX idx_vault_versions_blob_key
+ON vault_versions (blob_key);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- Run the migration tool backwards first, rows whose content is only in
+-- the blob store can't be restored here
+DROP INDEX idx_vault_versions_blob_key;
+DROP INDEX idx_vaults_blob_key;
+
+ALTER TABLE vault_versions
+DROP COLUMN blob_key,
+ALTER COLUMN vault SET NOT NULL;
+
+ALTER TABLE vaults
+DROP COLUMN blob_key,
+ALTER COLUMN vault SET NOT NULL;
+-- +goose StatementEnd
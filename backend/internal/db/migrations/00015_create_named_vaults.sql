@@ -0,0 +1,76 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A user can keep several named vaults, the /user/vault endpoints refer to
+-- their default one. A vault created without content is at revision 0.
+ALTER TABLE vaults
+DROP CONSTRAINT vaults_pkey;
+
+ALTER TABLE vaults
+ADD COLUMN id SERIAL PRIMARY KEY,
+ADD COLUMN public_id UUID NOT NULL UNIQUE DEFAULT gen_random_uuid(),
+ADD COLUMN name TEXT NOT NULL DEFAULT 'Personal',
+-- 'personal', 'work' or 'other', only a hint for the clients
+ADD COLUMN type TEXT NOT NULL DEFAULT 'personal',
+ADD COLUMN is_default BOOLEAN NOT NULL DEFAULT FALSE,
+ALTER COLUMN revision SET DEFAULT 0;
+
+UPDATE vaults
+SET is_default = TRUE;
+
+CREATE UNIQUE INDEX idx_vaults_user_default
+ON vaults (user_id) WHERE is_default;
+
+-- Versions belong to a vault, user_id stays for the usage and the data key
+ALTER TABLE vault_versions
+ADD COLUMN vault_id INTEGER;
+
+UPDATE vault_versions vv
+SET vault_id = v.id
+FROM vaults v
+WHERE v.user_id = vv.user_id;
+
+DELETE FROM vault_versions
+WHERE vault_id IS NULL;
+
+ALTER TABLE vault_versions
+DROP CONSTRAINT vault_versions_pkey,
+ALTER COLUMN vault_id SET NOT NULL,
+ADD PRIMARY KEY (vault_id, revision),
+ADD CONSTRAINT fk_vault_version_vault
+      FOREIGN KEY (vault_id)
+      REFERENCES vaults(id)
+      ON DELETE CASCADE;
+
+CREATE INDEX idx_vault_versions_user_id
+ON vault_versions (user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- Only the default vaults are kept, the blobs of the others are left behind
+DELETE FROM vaults
+WHERE NOT is_default;
+
+DROP INDEX idx_vault_versions_user_id;
+
+ALTER TABLE vault_versions
+DROP CONSTRAINT fk_vault_version_vault,
+DROP CONSTRAINT vault_versions_pkey,
+DROP COLUMN vault_id,
+ADD PRIMARY KEY (user_id, revision);
+
+DELETE FROM vaults
+WHERE revision = 0;
+
+DROP INDEX idx_vaults_user_default;
+
+ALTER TABLE vaults
+DROP CONSTRAINT vaults_pkey,
+DROP COLUMN id,
+DROP COLUMN public_id,
+DROP COLUMN name,
+DROP COLUMN type,
+DROP COLUMN is_default,
+ALTER COLUMN revision SET DEFAULT 1,
+ADD PRIMARY KEY (user_id);
+-- +goose StatementEnd
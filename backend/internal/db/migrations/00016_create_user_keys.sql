@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Keypairs of the users for sharing, generated by the clients. The private
+-- key is encrypted by the client, the server can't read it. A rotation adds
+-- the next version, the older ones stay to open what was shared with them.
+CREATE TABLE user_keys (
+    user_id INTEGER NOT NULL,
+    version INTEGER NOT NULL,
+    algorithm TEXT NOT NULL,
+    public_key BYTEA NOT NULL,
+    encrypted_private_key BYTEA NOT NULL,
+    -- Hex encoded SHA-256 of public_key
+    fingerprint TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, version),
+    CONSTRAINT fk_user_key_user
+          FOREIGN KEY (user_id)
+          REFERENCES users(id)
+          ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE user_keys;
+-- +goose StatementEnd
@@ -0,0 +1,59 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A shared vault is encrypted by the clients with a symmetric key, which is
+-- wrapped once per member with their public key. key_version counts the
+-- rotations of that key, 0 until the vault is shared.
+ALTER TABLE vaults
+ADD COLUMN key_version INTEGER NOT NULL DEFAULT 0,
+-- Set when a member who had the key leaves, cleared by the next rotation
+ADD COLUMN rotation_required BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- The owner of a vault is a member with the manage role
+CREATE TABLE vault_members (
+    id SERIAL PRIMARY KEY,
+    public_id UUID NOT NULL UNIQUE DEFAULT gen_random_uuid(),
+    vault_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    -- 'read', 'write' or 'manage'
+    role TEXT NOT NULL,
+    -- 'invited' until the member accepts
+    status TEXT NOT NULL DEFAULT 'invited',
+    -- The vault key wrapped with version user_key_version of the public key
+    -- of the member, NULL for vaults that aren't shared
+    wrapped_key BYTEA,
+    key_version INTEGER NOT NULL DEFAULT 0,
+    user_key_version INTEGER NOT NULL DEFAULT 0,
+    invited_by INTEGER,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    accepted_at TIMESTAMP,
+    UNIQUE (vault_id, user_id),
+    CONSTRAINT fk_vault_member_vault
+          FOREIGN KEY (vault_id)
+          REFERENCES vaults(id)
+          ON DELETE CASCADE,
+    CONSTRAINT fk_vault_member_user
+          FOREIGN KEY (user_id)
+          REFERENCES users(id)
+          ON DELETE CASCADE,
+    CONSTRAINT fk_vault_member_invited_by
+          FOREIGN KEY (invited_by)
+          REFERENCES users(id)
+          ON DELETE SET NULL
+);
+
+CREATE INDEX idx_vault_members_user_id
+ON vault_members (user_id);
+
+INSERT INTO vault_members (vault_id, user_id, role, status, accepted_at)
+SELECT id, user_id, 'manage', 'accepted', created_at
+FROM vaults;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE vault_members;
+
+ALTER TABLE vaults
+DROP COLUMN rotation_required,
+DROP COLUMN key_version;
+-- +goose StatementEnd
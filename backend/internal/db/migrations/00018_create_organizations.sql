@@ -0,0 +1,122 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE organizations (
+    id SERIAL PRIMARY KEY,
+    public_id UUID NOT NULL UNIQUE DEFAULT gen_random_uuid(),
+    name TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE organization_members (
+    id SERIAL PRIMARY KEY,
+    public_id UUID NOT NULL UNIQUE DEFAULT gen_random_uuid(),
+    organization_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    -- 'owner', 'admin' or 'member'
+    role TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (organization_id, user_id),
+    CONSTRAINT fk_organization_member_organization
+          FOREIGN KEY (organization_id)
+          REFERENCES organizations(id)
+          ON DELETE CASCADE,
+    CONSTRAINT fk_organization_member_user
+          FOREIGN KEY (user_id)
+          REFERENCES users(id)
+          ON DELETE CASCADE
+);
+
+CREATE INDEX idx_organization_members_user_id
+ON organization_members (user_id);
+
+-- Like the instance invites only the hash of the token is stored, the
+-- token itself is in the invite email
+CREATE TABLE organization_invites (
+    id SERIAL PRIMARY KEY,
+    public_id UUID NOT NULL UNIQUE DEFAULT gen_random_uuid(),
+    organization_id INTEGER NOT NULL,
+    email TEXT NOT NULL,
+    role TEXT NOT NULL,
+    token_hash TEXT NOT NULL UNIQUE,
+    invited_by INTEGER,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    expires_at TIMESTAMP NOT NULL,
+    CONSTRAINT fk_organization_invite_organization
+          FOREIGN KEY (organization_id)
+          REFERENCES organizations(id)
+          ON DELETE CASCADE,
+    CONSTRAINT fk_organization_invite_invited_by
+          FOREIGN KEY (invited_by)
+          REFERENCES users(id)
+          ON DELETE SET NULL
+);
+
+CREATE INDEX idx_organization_invites_organization_id
+ON organization_invites (organization_id, created_at);
+
+CREATE TABLE organization_groups (
+    id SERIAL PRIMARY KEY,
+    public_id UUID NOT NULL UNIQUE DEFAULT gen_random_uuid(),
+    organization_id INTEGER NOT NULL,
+    name TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (organization_id, name),
+    CONSTRAINT fk_organization_group_organization
+          FOREIGN KEY (organization_id)
+          REFERENCES organizations(id)
+          ON DELETE CASCADE
+);
+
+CREATE TABLE organization_group_members (
+    group_id INTEGER NOT NULL,
+    member_id INTEGER NOT NULL,
+    PRIMARY KEY (group_id, member_id),
+    CONSTRAINT fk_organization_group_member_group
+          FOREIGN KEY (group_id)
+          REFERENCES organization_groups(id)
+          ON DELETE CASCADE,
+    CONSTRAINT fk_organization_group_member_member
+          FOREIGN KEY (member_id)
+          REFERENCES organization_members(id)
+          ON DELETE CASCADE
+);
+
+-- No row means no policy, zero values don't restrict anything
+CREATE TABLE organization_policies (
+    organization_id INTEGER PRIMARY KEY,
+    require_2fa BOOLEAN NOT NULL DEFAULT FALSE,
+    min_kdf_iterations INTEGER NOT NULL DEFAULT 0,
+    -- Empty means any domain
+    allowed_email_domains TEXT[] NOT NULL DEFAULT '{}',
+    session_max_lifetime_seconds INTEGER NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_organization_policy_organization
+          FOREIGN KEY (organization_id)
+          REFERENCES organizations(id)
+          ON DELETE CASCADE
+);
+
+-- Vaults of an organization are shared among its members, they stay with
+-- their owner when the organization is deleted
+ALTER TABLE vaults
+ADD COLUMN organization_id INTEGER
+    CONSTRAINT fk_vault_organization
+    REFERENCES organizations(id)
+    ON DELETE SET NULL;
+
+CREATE INDEX idx_vaults_organization_id
+ON vaults (organization_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE vaults
+DROP COLUMN organization_id;
+
+DROP TABLE organization_policies;
+DROP TABLE organization_group_members;
+DROP TABLE organization_groups;
+DROP TABLE organization_invites;
+DROP TABLE organization_members;
+DROP TABLE organizations;
+-- +goose StatementEnd
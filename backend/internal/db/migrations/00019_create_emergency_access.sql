@@ -0,0 +1,54 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A grantor gives a trusted contact, the grantee, emergency access to one
+-- of their vaults. The vault key is wrapped for the grantee when the access
+-- is set up, the server only hands it out once the access is granted.
+CREATE TABLE emergency_access (
+    id SERIAL PRIMARY KEY,
+    public_id UUID NOT NULL UNIQUE DEFAULT gen_random_uuid(),
+    grantor_id INTEGER NOT NULL,
+    grantee_id INTEGER NOT NULL,
+    vault_id INTEGER NOT NULL,
+    -- 'view' or 'takeover'
+    mode TEXT NOT NULL,
+    -- 'invited', 'accepted', 'requested' or 'granted'
+    status TEXT NOT NULL DEFAULT 'invited',
+    -- How long a request waits for the grantor before it is granted
+    wait_seconds INTEGER NOT NULL,
+    -- The vault key wrapped with version user_key_version of the public key
+    -- of the grantee
+    wrapped_key BYTEA NOT NULL,
+    key_version INTEGER NOT NULL,
+    user_key_version INTEGER NOT NULL,
+    requested_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (vault_id, grantee_id),
+    CONSTRAINT fk_emergency_access_grantor
+          FOREIGN KEY (grantor_id)
+          REFERENCES users(id)
+          ON DELETE CASCADE,
+    CONSTRAINT fk_emergency_access_grantee
+          FOREIGN KEY (grantee_id)
+          REFERENCES users(id)
+          ON DELETE CASCADE,
+    CONSTRAINT fk_emergency_access_vault
+          FOREIGN KEY (vault_id)
+          REFERENCES vaults(id)
+          ON DELETE CASCADE
+);
+
+CREATE INDEX idx_emergency_access_grantor_id
+ON emergency_access (grantor_id);
+
+CREATE INDEX idx_emergency_access_grantee_id
+ON emergency_access (grantee_id);
+
+CREATE INDEX idx_emergency_access_requested_at
+ON emergency_access (requested_at)
+WHERE status = 'requested';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE emergency_access;
+-- +goose StatementEnd
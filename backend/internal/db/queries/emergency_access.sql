@@ -0,0 +1,87 @@
+-- name: CreateEmergencyAccess :one
+-- Returns no rows when the vault isn't the grantor's or the grantee has
+-- access to it already
+INSERT INTO emergency_access (grantor_id, grantee_id, vault_id, mode, wait_seconds, wrapped_key, key_version, user_key_version)
+SELECT v.user_id, sqlc.arg(grantee_id), v.id, sqlc.arg(mode), sqlc.arg(wait_seconds), sqlc.arg(wrapped_key), sqlc.arg(key_version), sqlc.arg(user_key_version)
+FROM vaults v
+WHERE v.public_id = sqlc.arg(vault_id)
+  AND v.user_id = sqlc.arg(grantor_id)
+ON CONFLICT (vault_id, grantee_id) DO NOTHING
+RETURNING public_id;
+
+-- name: GetEmergencyAccess :one
+SELECT e.public_id, e.grantor_id, g.email AS grantor_email, g.name AS grantor_name,
+       e.grantee_id, c.email AS grantee_email, c.name AS grantee_name,
+       v.public_id AS vault_id, v.name AS vault_name, e.mode, e.status, e.wait_seconds,
+       e.wrapped_key, e.key_version, e.user_key_version, e.requested_at, e.created_at
+FROM emergency_access e
+JOIN users g ON g.id = e.grantor_id
+JOIN users c ON c.id = e.grantee_id
+JOIN vaults v ON v.id = e.vault_id
+WHERE e.public_id = $1;
+
+-- name: GetGrantedEmergencyAccess :one
+SELECT e.public_id, e.grantor_id, g.email AS grantor_email, g.name AS grantor_name,
+       e.grantee_id, c.email AS grantee_email, c.name AS grantee_name,
+       v.public_id AS vault_id, v.name AS vault_name, e.mode, e.status, e.wait_seconds,
+       e.wrapped_key, e.key_version, e.user_key_version, e.requested_at, e.created_at
+FROM emergency_access e
+JOIN users g ON g.id = e.grantor_id
+JOIN users c ON c.id = e.grantee_id
+JOIN vaults v ON v.id = e.vault_id
+WHERE v.public_id = $1 AND e.grantee_id = $2 AND e.status = 'granted';
+
+-- name: ListEmergencyAccessByGrantor :many
+SELECT e.public_id, e.grantor_id, g.email AS grantor_email, g.name AS grantor_name,
+       e.grantee_id, c.email AS grantee_email, c.name AS grantee_name,
+       v.public_id AS vault_id, v.name AS vault_name, e.mode, e.status, e.wait_seconds,
+       e.wrapped_key, e.key_version, e.user_key_version, e.requested_at, e.created_at
+FROM emergency_access e
+JOIN users g ON g.id = e.grantor_id
+JOIN users c ON c.id = e.grantee_id
+JOIN vaults v ON v.id = e.vault_id
+WHERE e.grantor_id = $1
+ORDER BY e.id;
+
+-- name: ListEmergencyAccessByGrantee :many
+SELECT e.public_id, e.grantor_id, g.email AS grantor_email, g.name AS grantor_name,
+       e.grantee_id, c.email AS grantee_email, c.name AS grantee_name,
+       v.public_id AS vault_id, v.name AS vault_name, e.mode, e.status, e.wait_seconds,
+       e.wrapped_key, e.key_version, e.user_key_version, e.requested_at, e.created_at
+FROM emergency_access e
+JOIN users g ON g.id = e.grantor_id
+JOIN users c ON c.id = e.grantee_id
+JOIN vaults v ON v.id = e.vault_id
+WHERE e.grantee_id = $1
+ORDER BY e.id;
+
+-- name: UpdateEmergencyAccessStatus :execrows
+-- Only applies if the status is still the one the caller checked
+UPDATE emergency_access
+SET status = sqlc.arg(status)
+WHERE public_id = sqlc.arg(public_id)
+  AND status = sqlc.arg(current_status);
+
+-- name: RequestEmergencyAccess :execrows
+UPDATE emergency_access
+SET status = 'requested',
+    requested_at = $2
+WHERE public_id = $1 AND status = 'accepted';
+
+-- name: SetEmergencyAccessKey :execrows
+UPDATE emergency_access
+SET wrapped_key = $2,
+    key_version = $3,
+    user_key_version = $4
+WHERE public_id = $1;
+
+-- name: DeleteEmergencyAccess :execrows
+DELETE FROM emergency_access
+WHERE public_id = $1;
+
+-- name: GrantExpiredEmergencyAccess :execrows
+-- Grants the requests the grantor didn't reject within the waiting period
+UPDATE emergency_access
+SET status = 'granted'
+WHERE status = 'requested'
+  AND requested_at + wait_seconds * INTERVAL '1 second' <= NOW();
@@ -0,0 +1,38 @@
+-- name: CreateInvite :one
+INSERT INTO invites (token_hash, email, created_by, expires_at)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetInviteByTokenHash :one
+SELECT * FROM invites
+WHERE token_hash = $1;
+
+-- name: GetInviteByPublicID :one
+SELECT * FROM invites
+WHERE public_id = $1;
+
+-- name: GetInvites :many
+SELECT * FROM invites
+ORDER BY created_at DESC;
+
+-- name: GetInvitesByCreator :many
+SELECT * FROM invites
+WHERE created_by = $1
+ORDER BY created_at DESC;
+
+-- name: UseInvite :one
+UPDATE invites
+SET used_at = NOW(),
+    used_by_email = $2
+WHERE public_id = $1
+  AND used_at IS NULL
+  AND revoked_at IS NULL
+  AND expires_at > NOW()
+RETURNING *;
+
+-- name: RevokeInvite :execrows
+UPDATE invites
+SET revoked_at = NOW()
+WHERE public_id = $1
+  AND used_at IS NULL
+  AND revoked_at IS NULL;
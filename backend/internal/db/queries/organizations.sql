@@ -0,0 +1,206 @@
+-- name: CreateOrganization :one
+INSERT INTO organizations (name)
+VALUES ($1)
+RETURNING *;
+
+-- name: GetOrganization :one
+SELECT * FROM organizations
+WHERE public_id = $1;
+
+-- name: ListOrganizationsByUserID :many
+SELECT o.public_id, o.name, o.created_at, m.public_id AS member_id, m.role
+FROM organizations o
+JOIN organization_members m ON m.organization_id = o.id
+WHERE m.user_id = $1
+ORDER BY o.id;
+
+-- name: UpdateOrganizationName :execrows
+UPDATE organizations
+SET name = $2
+WHERE public_id = $1;
+
+-- name: DeleteOrganization :execrows
+DELETE FROM organizations
+WHERE public_id = $1;
+
+-- name: CreateOrganizationMember :one
+-- Returns no rows when the user is already a member
+INSERT INTO organization_members (organization_id, user_id, role)
+SELECT o.id, sqlc.arg(user_id), sqlc.arg(role)
+FROM organizations o
+WHERE o.public_id = sqlc.arg(organization_id)
+ON CONFLICT (organization_id, user_id) DO NOTHING
+RETURNING public_id;
+
+-- name: GetOrganizationMember :one
+SELECT m.public_id, o.public_id AS organization_id, m.user_id, u.public_id AS user_public_id, u.email, u.name, m.role, m.created_at
+FROM organization_members m
+JOIN organizations o ON o.id = m.organization_id
+JOIN users u ON u.id = m.user_id
+WHERE o.public_id = $1 AND m.user_id = $2;
+
+-- name: GetOrganizationMemberByPublicID :one
+SELECT m.public_id, o.public_id AS organization_id, m.user_id, u.public_id AS user_public_id, u.email, u.name, m.role, m.created_at
+FROM organization_members m
+JOIN organizations o ON o.id = m.organization_id
+JOIN users u ON u.id = m.user_id
+WHERE m.public_id = $1;
+
+-- name: ListOrganizationMembers :many
+SELECT m.public_id, o.public_id AS organization_id, m.user_id, u.public_id AS user_public_id, u.email, u.name, m.role, m.created_at
+FROM organization_members m
+JOIN organizations o ON o.id = m.organization_id
+JOIN users u ON u.id = m.user_id
+WHERE o.public_id = $1
+ORDER BY m.id;
+
+-- name: UpdateOrganizationMemberRole :execrows
+UPDATE organization_members
+SET role = $2
+WHERE public_id = $1;
+
+-- name: DeleteOrganizationMember :execrows
+DELETE FROM organization_members
+WHERE public_id = $1;
+
+-- name: CountOrganizationOwners :one
+SELECT COUNT(*)
+FROM organization_members m
+JOIN organizations o ON o.id = m.organization_id
+WHERE o.public_id = $1 AND m.role = 'owner';
+
+-- name: RequireOrganizationVaultKeyRotation :exec
+-- Flags the vaults of the organization the user has the key of
+UPDATE vaults v
+SET rotation_required = TRUE
+FROM organizations o, vault_members vm
+WHERE v.organization_id = o.id
+  AND vm.vault_id = v.id
+  AND o.public_id = sqlc.arg(organization_id)
+  AND vm.user_id = sqlc.arg(user_id)
+  AND vm.status = 'accepted'
+  AND v.user_id <> sqlc.arg(user_id)
+  AND v.key_version > 0;
+
+-- name: DeleteOrganizationVaultMembers :exec
+-- Removes the user from the vaults of the organization they don't own
+DELETE FROM vault_members vm
+USING vaults v, organizations o
+WHERE vm.vault_id = v.id
+  AND v.organization_id = o.id
+  AND o.public_id = sqlc.arg(organization_id)
+  AND vm.user_id = sqlc.arg(user_id)
+  AND v.user_id <> sqlc.arg(user_id);
+
+-- name: CreateOrganizationInvite :one
+INSERT INTO organization_invites (organization_id, email, role, token_hash, invited_by, expires_at)
+SELECT o.id, sqlc.arg(email), sqlc.arg(role), sqlc.arg(token_hash), sqlc.arg(invited_by), sqlc.arg(expires_at)
+FROM organizations o
+WHERE o.public_id = sqlc.arg(organization_id)
+RETURNING public_id, created_at;
+
+-- name: GetOrganizationInviteByTokenHash :one
+SELECT i.public_id, o.public_id AS organization_id, o.name AS organization_name, i.email, i.role, i.created_at, i.expires_at
+FROM organization_invites i
+JOIN organizations o ON o.id = i.organization_id
+WHERE i.token_hash = $1;
+
+-- name: ListOrganizationInvites :many
+SELECT i.public_id, o.public_id AS organization_id, o.name AS organization_name, i.email, i.role, i.created_at, i.expires_at
+FROM organization_invites i
+JOIN organizations o ON o.id = i.organization_id
+WHERE o.public_id = $1 AND i.expires_at > NOW()
+ORDER BY i.created_at DESC;
+
+-- name: DeleteOrganizationInvite :execrows
+DELETE FROM organization_invites i
+USING organizations o
+WHERE i.organization_id = o.id
+  AND o.public_id = sqlc.arg(organization_id)
+  AND i.public_id = sqlc.arg(invite_id);
+
+-- name: GetOrganizationPolicies :one
+SELECT p.require_2fa, p.min_kdf_iterations, p.allowed_email_domains, p.session_max_lifetime_seconds
+FROM organization_policies p
+JOIN organizations o ON o.id = p.organization_id
+WHERE o.public_id = $1;
+
+-- name: UpsertOrganizationPolicies :exec
+INSERT INTO organization_policies (organization_id, require_2fa, min_kdf_iterations, allowed_email_domains, session_max_lifetime_seconds)
+SELECT o.id, sqlc.arg(require_2fa), sqlc.arg(min_kdf_iterations), sqlc.arg(allowed_email_domains)::text[], sqlc.arg(session_max_lifetime_seconds)
+FROM organizations o
+WHERE o.public_id = sqlc.arg(organization_id)
+ON CONFLICT (organization_id) DO UPDATE
+SET require_2fa = EXCLUDED.require_2fa,
+    min_kdf_iterations = EXCLUDED.min_kdf_iterations,
+    allowed_email_domains = EXCLUDED.allowed_email_domains,
+    session_max_lifetime_seconds = EXCLUDED.session_max_lifetime_seconds,
+    updated_at = NOW();
+
+-- name: ListPoliciesByUserID :many
+-- The policies of every organization the user is a member of
+SELECT p.require_2fa, p.min_kdf_iterations, p.allowed_email_domains, p.session_max_lifetime_seconds
+FROM organization_policies p
+JOIN organization_members m ON m.organization_id = p.organization_id
+WHERE m.user_id = $1;
+
+-- name: CreateOrganizationGroup :one
+-- Returns no rows when the organization has a group with that name
+INSERT INTO organization_groups (organization_id, name)
+SELECT o.id, sqlc.arg(name)
+FROM organizations o
+WHERE o.public_id = sqlc.arg(organization_id)
+ON CONFLICT (organization_id, name) DO NOTHING
+RETURNING public_id, created_at;
+
+-- name: GetOrganizationGroup :one
+SELECT g.public_id, o.public_id AS organization_id, g.name, g.created_at
+FROM organization_groups g
+JOIN organizations o ON o.id = g.organization_id
+WHERE g.public_id = $1;
+
+-- name: ListOrganizationGroups :many
+SELECT g.public_id, o.public_id AS organization_id, g.name, g.created_at
+FROM organization_groups g
+JOIN organizations o ON o.id = g.organization_id
+WHERE o.public_id = $1
+ORDER BY g.name;
+
+-- name: ListOrganizationGroupMembers :many
+SELECT g.public_id AS group_id, m.public_id AS member_id
+FROM organization_group_members gm
+JOIN organization_groups g ON g.id = gm.group_id
+JOIN organization_members m ON m.id = gm.member_id
+JOIN organizations o ON o.id = g.organization_id
+WHERE o.public_id = $1
+ORDER BY m.id;
+
+-- name: UpdateOrganizationGroupName :execrows
+-- Leaves the group alone when another group of the organization has the name
+UPDATE organization_groups g
+SET name = sqlc.arg(name)
+WHERE g.public_id = sqlc.arg(public_id)
+  AND NOT EXISTS (
+    SELECT 1 FROM organization_groups other
+    WHERE other.organization_id = g.organization_id
+      AND other.name = sqlc.arg(name)
+      AND other.id <> g.id
+  );
+
+-- name: DeleteOrganizationGroup :execrows
+DELETE FROM organization_groups
+WHERE public_id = $1;
+
+-- name: ClearOrganizationGroupMembers :exec
+DELETE FROM organization_group_members gm
+USING organization_groups g
+WHERE gm.group_id = g.id AND g.public_id = $1;
+
+-- name: AddOrganizationGroupMember :execrows
+-- Only members of the organization of the group are added
+INSERT INTO organization_group_members (group_id, member_id)
+SELECT g.id, m.id
+FROM organization_groups g
+JOIN organization_members m ON m.organization_id = g.organization_id
+WHERE g.public_id = sqlc.arg(group_id) AND m.public_id = sqlc.arg(member_id)
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,55 @@
+-- name: EnqueueOutboxMessage :exec
+INSERT INTO email_outbox (idempotency_key, kind, payload)
+VALUES ($1, $2, $3)
+ON CONFLICT (idempotency_key) DO NOTHING;
+
+-- name: ClaimOutboxMessages :many
+UPDATE email_outbox
+SET attempts = attempts + 1,
+    locked_until = sqlc.arg(locked_until),
+    updated_at = NOW()
+WHERE id IN (
+    SELECT id FROM email_outbox
+    WHERE status = 'pending'
+      AND next_attempt_at <= NOW()
+      AND (locked_until IS NULL OR locked_until < NOW())
+    ORDER BY next_attempt_at
+    LIMIT sqlc.arg(batch_size)
+    FOR UPDATE SKIP LOCKED
+)
+RETURNING *;
+
+-- name: MarkOutboxMessageSent :exec
+UPDATE email_outbox
+SET status = 'sent',
+    payload = '{}',
+    sent_at = NOW(),
+    locked_until = NULL,
+    last_error = NULL,
+    updated_at = NOW()
+WHERE id = $1;
+
+-- name: MarkOutboxMessageFailed :exec
+UPDATE email_outbox
+SET status = $2,
+    last_error = $3,
+    next_attempt_at = $4,
+    locked_until = NULL,
+    updated_at = NOW()
+WHERE id = $1;
+
+-- name: GetOutboxMessagesByStatus :many
+SELECT * FROM email_outbox
+WHERE status = $1
+ORDER BY created_at DESC
+LIMIT $2;
+
+-- name: RequeueOutboxMessage :execrows
+UPDATE email_outbox
+SET status = 'pending',
+    attempts = 0,
+    next_attempt_at = NOW(),
+    locked_until = NULL,
+    updated_at = NOW()
+WHERE public_id = $1
+  AND status = 'dead';
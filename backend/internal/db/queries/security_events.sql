@@ -0,0 +1,17 @@
+-- name: CreateSecurityEvent :exec
+INSERT INTO security_events (user_id, actor_id, type, ip, user_agent, device_id, metadata)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: GetSecurityEventsByUser :many
+-- Newest first, the page continues after the event with public_id "after"
+SELECT * FROM security_events
+WHERE security_events.user_id = sqlc.arg(user_id)
+  AND (sqlc.narg(types)::text[] IS NULL OR security_events.type = ANY(sqlc.narg(types)::text[]))
+  AND (sqlc.narg(since)::timestamp IS NULL OR security_events.created_at >= sqlc.narg(since)::timestamp)
+  AND (sqlc.narg(until)::timestamp IS NULL OR security_events.created_at < sqlc.narg(until)::timestamp)
+  AND (sqlc.narg(after)::uuid IS NULL OR security_events.id < (
+      SELECT e.id FROM security_events e
+      WHERE e.public_id = sqlc.narg(after)::uuid AND e.user_id = sqlc.arg(user_id)
+  ))
+ORDER BY security_events.id DESC
+LIMIT sqlc.arg(page_size);
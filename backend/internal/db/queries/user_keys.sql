@@ -0,0 +1,43 @@
+-- name: CreateUserKey :one
+-- Only inserts the version after the current one, two devices rotating at
+-- the same time conflict on the primary key and one gets no row
+INSERT INTO user_keys (user_id, version, algorithm, public_key, encrypted_private_key, fingerprint)
+SELECT sqlc.arg(user_id), sqlc.arg(version), sqlc.arg(algorithm), sqlc.arg(public_key), sqlc.arg(encrypted_private_key), sqlc.arg(fingerprint)
+WHERE COALESCE((SELECT MAX(version) FROM user_keys WHERE user_id = sqlc.arg(user_id)), 0) = sqlc.arg(version)::integer - 1
+ON CONFLICT (user_id, version) DO NOTHING
+RETURNING *;
+
+-- name: GetCurrentUserKey :one
+SELECT *
+FROM user_keys
+WHERE user_id = $1
+ORDER BY version DESC
+LIMIT 1;
+
+-- name: GetUserKey :one
+SELECT *
+FROM user_keys
+WHERE user_id = $1
+  AND version = $2;
+
+-- name: GetUserKeysByUserID :many
+SELECT *
+FROM user_keys
+WHERE user_id = $1
+ORDER BY version DESC;
+
+-- name: GetCurrentPublicKeyByEmail :one
+SELECT u.public_id, k.version, k.algorithm, k.public_key, k.fingerprint, k.created_at
+FROM user_keys k
+JOIN users u ON u.id = k.user_id
+WHERE u.email = $1
+ORDER BY k.version DESC
+LIMIT 1;
+
+-- name: GetCurrentPublicKeyByPublicID :one
+SELECT u.public_id, k.version, k.algorithm, k.public_key, k.fingerprint, k.created_at
+FROM user_keys k
+JOIN users u ON u.id = k.user_id
+WHERE u.public_id = $1
+ORDER BY k.version DESC
+LIMIT 1;
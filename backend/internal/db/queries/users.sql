@@ -1,6 +1,6 @@
 -- name: CreateUser :one
-INSERT INTO users (name, email, password_hash)
-VALUES ($1, $2, $3)
+INSERT INTO users (name, email, password_hash, locale)
+VALUES ($1, $2, $3, $4)
 RETURNING *;
 
 -- name: GetUserByPublicID :one
@@ -18,3 +18,8 @@ WHERE email = $1;
 -- name: GetUsers :many
 SELECT * FROM users
 ORDER BY id;
+
+-- name: UpdateUserPasswordHash :execrows
+UPDATE users
+SET password_hash = $2, updated_at = NOW()
+WHERE id = $1;
@@ -1,18 +1,115 @@
--- name: GetVaultByUserID :one
+-- name: CreateVault :one
+-- Returns no rows when creating a default vault for a user that has one.
+-- The user becomes the first member of the vault.
+WITH vault AS (
+    INSERT INTO vaults (user_id, name, type, is_default, organization_id)
+    VALUES ($1, $2, $3, $4, (SELECT o.id FROM organizations o WHERE o.public_id = sqlc.narg(organization_id)))
+    ON CONFLICT (user_id) WHERE is_default DO NOTHING
+    RETURNING *
+), owner AS (
+    INSERT INTO vault_members (vault_id, user_id, role, status, accepted_at)
+    SELECT id, user_id, 'manage', 'accepted', NOW()
+    FROM vault
+)
+SELECT v.public_id, v.user_id, v.name, v.type, v.is_default, v.revision, v.size, v.sha256, v.key_version, v.rotation_required, v.created_at, v.updated_at,
+       o.public_id AS organization_id
+FROM vault v
+LEFT JOIN organizations o ON o.id = v.organization_id;
+
+-- name: GetVault :one
+-- The vault without its content
+SELECT v.public_id, v.user_id, v.name, v.type, v.is_default, v.revision, v.size, v.sha256, v.key_version, v.rotation_required, v.created_at, v.updated_at,
+       o.public_id AS organization_id
+FROM vaults v
+LEFT JOIN organizations o ON o.id = v.organization_id
+WHERE v.public_id = $1;
+
+-- name: GetDefaultVault :one
+SELECT v.public_id, v.user_id, v.name, v.type, v.is_default, v.revision, v.size, v.sha256, v.key_version, v.rotation_required, v.created_at, v.updated_at,
+       o.public_id AS organization_id
+FROM vaults v
+LEFT JOIN organizations o ON o.id = v.organization_id
+WHERE v.user_id = $1 AND v.is_default;
+
+-- name: ListVaultsByMember :many
+-- The vaults the user is a member of or invited to, their default vault
+-- first, then by creation
+SELECT v.public_id, v.user_id, v.name, v.type, v.is_default, v.revision, v.size, v.sha256, v.key_version, v.rotation_required, v.created_at, v.updated_at,
+       o.public_id AS organization_id, m.public_id AS member_id, m.role, m.status
+FROM vaults v
+JOIN vault_members m ON m.vault_id = v.id
+LEFT JOIN organizations o ON o.id = v.organization_id
+WHERE m.user_id = $1
+ORDER BY (v.is_default AND v.user_id = $1) DESC, v.id;
+
+-- name: UpdateVault :one
+-- Renaming leaves updated_at alone, it's the time of the last content write
+WITH vault AS (
+    UPDATE vaults
+    SET name = $2,
+        type = $3
+    WHERE vaults.public_id = $1
+    RETURNING *
+)
+SELECT v.public_id, v.user_id, v.name, v.type, v.is_default, v.revision, v.size, v.sha256, v.key_version, v.rotation_required, v.created_at, v.updated_at,
+       o.public_id AS organization_id
+FROM vault v
+LEFT JOIN organizations o ON o.id = v.organization_id;
+
+-- name: DeleteVault :execrows
+-- The versions are deleted along, the default vault can't be deleted
+DELETE FROM vaults
+WHERE public_id = $1 AND NOT is_default;
+
+-- name: GetVaultContent :one
 SELECT *
 FROM vaults
-WHERE user_id = $1;
+WHERE public_id = $1;
 
--- name: GetVaultUpdatedAtByUserID :one
-SELECT updated_at
+-- name: GetVaultBlobKeyForUpdate :one
+-- Locks the vault until the end of the transaction
+SELECT user_id, revision, blob_key
 FROM vaults
-WHERE user_id=$1;
-
--- name: InsertVaultByUserID :one
-INSERT INTO vaults (user_id, vault)
-VALUES ($1, $2)
-ON CONFLICT (user_id)
-DO UPDATE SET
-    vault = EXCLUDED.vault,
+WHERE public_id = $1
+FOR UPDATE;
+
+-- name: GetVaultBlobAtRevision :one
+SELECT blob_key, sha256, encrypted
+FROM vaults
+WHERE public_id = $1 AND revision = $2 AND vault IS NULL;
+
+-- name: IsVaultBlobReferenced :one
+SELECT (EXISTS (SELECT 1 FROM vaults v WHERE v.blob_key = sqlc.arg(blob_key)::text)
+    OR EXISTS (SELECT 1 FROM vault_versions vv WHERE vv.blob_key = sqlc.arg(blob_key)::text))::boolean AS referenced;
+
+-- name: UpdateVaultIfRevision :one
+-- Returns no rows when the stored revision is not the expected one
+UPDATE vaults
+SET vault = NULL,
+    blob_key = $2,
+    size = $3,
+    sha256 = $4,
+    encrypted = $5,
+    revision = revision + 1,
     updated_at = NOW()
+WHERE public_id = $1
+  AND revision = sqlc.arg(expected_revision)
 RETURNING *;
+
+-- name: GetVaultUsageByUserID :one
+-- The current vaults are also the newest versions, they're only counted once
+SELECT
+    COALESCE((SELECT SUM(v.size) FROM vaults v WHERE v.user_id = $1), 0)::bigint AS vault_bytes,
+    COALESCE((SELECT SUM(vv.size) FROM vault_versions vv
+              JOIN vaults v ON v.id = vv.vault_id
+              WHERE vv.user_id = $1
+                AND vv.revision <> v.revision), 0)::bigint AS history_bytes,
+    COALESCE((SELECT SUM(octet_length(vi.data)) FROM vault_items vi WHERE vi.user_id = $1), 0)::bigint AS item_bytes;
+
+-- name: ListVaultIDs :many
+-- The vaults with content, in the order of their ID
+SELECT public_id
+FROM vaults
+WHERE public_id > $1 AND revision > 0
+ORDER BY public_id
+LIMIT $2;
@@ -0,0 +1,38 @@
+-- name: GetVaultsToMigrate :many
+-- The vaults still in the database, and the unencrypted ones when encrypting
+SELECT public_id
+FROM vaults
+WHERE vault IS NOT NULL
+   OR (sqlc.arg(encrypt)::boolean AND NOT encrypted AND blob_key IS NOT NULL)
+ORDER BY id
+LIMIT sqlc.arg(batch_size);
+
+-- name: MigrateVault :execrows
+-- Skipped when the vault was written or migrated in the meantime
+UPDATE vaults
+SET vault = NULL,
+    blob_key = sqlc.arg(blob_key),
+    encrypted = sqlc.arg(encrypted)
+WHERE public_id = sqlc.arg(vault_id)
+  AND revision = sqlc.arg(revision)
+  AND blob_key IS NOT DISTINCT FROM sqlc.narg(old_blob_key)
+  AND encrypted = sqlc.arg(old_encrypted);
+
+-- name: GetVaultVersionsToMigrate :many
+SELECT v.public_id AS vault_id, vv.revision
+FROM vault_versions vv
+JOIN vaults v ON v.id = vv.vault_id
+WHERE vv.vault IS NOT NULL
+   OR (sqlc.arg(encrypt)::boolean AND NOT vv.encrypted)
+ORDER BY vv.vault_id, vv.revision
+LIMIT sqlc.arg(batch_size);
+
+-- name: MigrateVaultVersion :execrows
+UPDATE vault_versions
+SET vault = NULL,
+    blob_key = sqlc.arg(blob_key),
+    encrypted = sqlc.arg(encrypted)
+WHERE vault_versions.vault_id = (SELECT v.id FROM vaults v WHERE v.public_id = sqlc.arg(vault_id))
+  AND vault_versions.revision = sqlc.arg(revision)
+  AND vault_versions.blob_key IS NOT DISTINCT FROM sqlc.narg(old_blob_key)
+  AND vault_versions.encrypted = sqlc.arg(old_encrypted);
@@ -0,0 +1,18 @@
+-- name: CreateVaultEvent :one
+INSERT INTO vault_events (user_id, type, payload)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: NotifyVaultEvent :exec
+SELECT pg_notify('vault_events', sqlc.arg(payload)::text);
+
+-- name: GetVaultEventsSince :many
+SELECT *
+FROM vault_events
+WHERE user_id = $1 AND id > sqlc.arg(last_id)
+ORDER BY id
+LIMIT sqlc.arg(page_size);
+
+-- name: PruneVaultEvents :exec
+DELETE FROM vault_events
+WHERE user_id = $1 AND created_at < sqlc.arg(before);
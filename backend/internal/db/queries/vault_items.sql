@@ -0,0 +1,38 @@
+-- name: NextVaultItemSeq :one
+INSERT INTO vault_item_sequences (user_id, seq)
+VALUES ($1, 1)
+ON CONFLICT (user_id)
+DO UPDATE SET seq = vault_item_sequences.seq + 1
+RETURNING seq;
+
+-- name: CreateVaultItem :one
+-- Returns no rows when the item already exists
+INSERT INTO vault_items (user_id, item_id, data, deleted, change_seq)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (user_id, item_id) DO NOTHING
+RETURNING *;
+
+-- name: UpdateVaultItemIfRevision :one
+-- Returns no rows when the stored revision is not the expected one
+UPDATE vault_items
+SET data = $3,
+    deleted = $4,
+    change_seq = $5,
+    revision = revision + 1,
+    updated_at = NOW()
+WHERE user_id = $1
+  AND item_id = $2
+  AND revision = sqlc.arg(expected_revision)
+RETURNING *;
+
+-- name: GetVaultItem :one
+SELECT *
+FROM vault_items
+WHERE user_id = $1 AND item_id = $2;
+
+-- name: GetVaultItemsChangedSince :many
+SELECT *
+FROM vault_items
+WHERE user_id = $1 AND change_seq > sqlc.arg(cursor)
+ORDER BY change_seq
+LIMIT sqlc.arg(page_size);
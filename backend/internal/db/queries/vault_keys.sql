@@ -0,0 +1,26 @@
+-- name: CreateVaultKey :exec
+-- Keeps the existing key when two writers create one at the same time
+INSERT INTO vault_keys (user_id, kek_id, wrapped_key)
+VALUES ($1, $2, $3)
+ON CONFLICT (user_id) DO NOTHING;
+
+-- name: GetVaultKey :one
+SELECT *
+FROM vault_keys
+WHERE user_id = $1;
+
+-- name: GetVaultKeysNotWrappedWith :many
+SELECT *
+FROM vault_keys
+WHERE kek_id <> $1
+ORDER BY user_id
+LIMIT $2;
+
+-- name: RewrapVaultKey :execrows
+-- Only applies if the key wasn't rewrapped in the meantime
+UPDATE vault_keys
+SET kek_id = sqlc.arg(new_kek_id),
+    wrapped_key = sqlc.arg(wrapped_key),
+    updated_at = NOW()
+WHERE user_id = sqlc.arg(user_id)
+  AND kek_id = sqlc.arg(old_kek_id);
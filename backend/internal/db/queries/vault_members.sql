@@ -0,0 +1,73 @@
+-- name: CreateVaultMember :one
+-- Returns no rows when the user is already a member or invited, or when the
+-- key was rotated meanwhile. Locking the vault makes a concurrent rotation
+-- either see the new member or reject it.
+INSERT INTO vault_members (vault_id, user_id, role, wrapped_key, key_version, user_key_version, invited_by)
+SELECT v.id, sqlc.arg(user_id), sqlc.arg(role), sqlc.arg(wrapped_key), sqlc.arg(key_version), sqlc.arg(user_key_version), sqlc.arg(invited_by)
+FROM vaults v
+WHERE v.public_id = sqlc.arg(vault_id)
+  AND v.key_version = sqlc.arg(key_version)
+  AND NOT v.rotation_required
+FOR SHARE
+ON CONFLICT (vault_id, user_id) DO NOTHING
+RETURNING public_id;
+
+-- name: GetVaultMember :one
+SELECT m.public_id, v.public_id AS vault_id, m.user_id, u.public_id AS user_public_id, u.email, u.name,
+       m.role, m.status, m.wrapped_key, m.key_version, m.user_key_version, m.created_at, m.accepted_at
+FROM vault_members m
+JOIN vaults v ON v.id = m.vault_id
+JOIN users u ON u.id = m.user_id
+WHERE v.public_id = $1 AND m.user_id = $2;
+
+-- name: GetVaultMemberByPublicID :one
+SELECT m.public_id, v.public_id AS vault_id, m.user_id, u.public_id AS user_public_id, u.email, u.name,
+       m.role, m.status, m.wrapped_key, m.key_version, m.user_key_version, m.created_at, m.accepted_at
+FROM vault_members m
+JOIN vaults v ON v.id = m.vault_id
+JOIN users u ON u.id = m.user_id
+WHERE m.public_id = $1;
+
+-- name: ListVaultMembers :many
+SELECT m.public_id, v.public_id AS vault_id, m.user_id, u.public_id AS user_public_id, u.email, u.name,
+       m.role, m.status, m.wrapped_key, m.key_version, m.user_key_version, m.created_at, m.accepted_at
+FROM vault_members m
+JOIN vaults v ON v.id = m.vault_id
+JOIN users u ON u.id = m.user_id
+WHERE v.public_id = $1
+ORDER BY m.id;
+
+-- name: AcceptVaultMember :execrows
+UPDATE vault_members
+SET status = 'accepted',
+    accepted_at = NOW()
+WHERE public_id = $1 AND status = 'invited';
+
+-- name: UpdateVaultMemberRole :execrows
+UPDATE vault_members
+SET role = $2
+WHERE public_id = $1;
+
+-- name: DeleteVaultMember :execrows
+DELETE FROM vault_members
+WHERE public_id = $1;
+
+-- name: SetVaultMemberKey :execrows
+UPDATE vault_members
+SET wrapped_key = $2,
+    key_version = $3,
+    user_key_version = $4
+WHERE public_id = $1;
+
+-- name: RotateVaultKey :execrows
+-- Only applies if the key wasn't rotated in the meantime
+UPDATE vaults
+SET key_version = sqlc.arg(key_version),
+    rotation_required = FALSE
+WHERE public_id = sqlc.arg(vault_id)
+  AND key_version = sqlc.arg(key_version)::integer - 1;
+
+-- name: RequireVaultKeyRotation :exec
+UPDATE vaults
+SET rotation_required = TRUE
+WHERE public_id = $1 AND key_version > 0;
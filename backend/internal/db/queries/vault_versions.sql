@@ -0,0 +1,66 @@
+-- name: CreateVaultVersion :exec
+INSERT INTO vault_versions (vault_id, user_id, revision, blob_key, size, sha256, device_id, encrypted)
+SELECT v.id, v.user_id, sqlc.arg(revision), sqlc.arg(blob_key), sqlc.arg(size), sqlc.arg(sha256), sqlc.arg(device_id), sqlc.arg(encrypted)
+FROM vaults v
+WHERE v.public_id = sqlc.arg(vault_id);
+
+-- name: GetVaultVersionsByVaultID :many
+-- Newest first, without the content
+SELECT vv.user_id, vv.revision, vv.size, vv.sha256, vv.device_id, vv.created_at
+FROM vault_versions vv
+JOIN vaults v ON v.id = vv.vault_id
+WHERE v.public_id = $1
+ORDER BY vv.revision DESC;
+
+-- name: GetVaultVersion :one
+SELECT vv.*
+FROM vault_versions vv
+JOIN vaults v ON v.id = vv.vault_id
+WHERE v.public_id = $1 AND vv.revision = $2;
+
+-- name: GetVaultVersionInfo :one
+-- Like GetVaultVersion, without the content
+SELECT vv.user_id, vv.revision, vv.size, vv.sha256, vv.device_id, vv.encrypted, vv.blob_key, vv.created_at
+FROM vault_versions vv
+JOIN vaults v ON v.id = vv.vault_id
+WHERE v.public_id = $1 AND vv.revision = $2;
+
+-- name: GetVaultVersionBlobAtRevision :one
+SELECT vv.blob_key, vv.sha256, vv.encrypted
+FROM vault_versions vv
+JOIN vaults v ON v.id = vv.vault_id
+WHERE v.public_id = $1 AND vv.revision = $2 AND vv.vault IS NULL;
+
+-- name: GetVaultVersionChunk :one
+-- For the versions not moved to the blob store yet, start is 1 based like
+-- substring
+SELECT substring(vv.vault FROM sqlc.arg(start)::int FOR sqlc.arg(length)::int)::bytea AS chunk
+FROM vault_versions vv
+JOIN vaults v ON v.id = vv.vault_id
+WHERE v.public_id = sqlc.arg(vault_id) AND vv.revision = sqlc.arg(revision);
+
+-- name: GetVaultVersionBlobKeys :many
+-- The blobs of every version, to release when the vault is deleted
+SELECT vv.blob_key
+FROM vault_versions vv
+JOIN vaults v ON v.id = vv.vault_id
+WHERE v.public_id = $1 AND vv.blob_key IS NOT NULL;
+
+-- name: PruneVaultVersions :many
+-- Drops the versions past the newest "keep" ones and those created before
+-- "before", the newest version is always kept. Returns the blobs of the
+-- dropped versions.
+DELETE FROM vault_versions
+WHERE vault_versions.vault_id = (SELECT vaults.id FROM vaults WHERE vaults.public_id = sqlc.arg(vault_id))
+  AND vault_versions.revision IN (
+      SELECT ranked.revision FROM (
+          SELECT vv.revision, vv.created_at, ROW_NUMBER() OVER (ORDER BY vv.revision DESC) AS position
+          FROM vault_versions vv
+          JOIN vaults v ON v.id = vv.vault_id
+          WHERE v.public_id = sqlc.arg(vault_id)
+      ) ranked
+      WHERE ranked.position > 1
+        AND (ranked.position > sqlc.arg(keep)::bigint
+             OR (sqlc.narg(before)::timestamp IS NOT NULL AND ranked.created_at < sqlc.narg(before)::timestamp))
+  )
+RETURNING blob_key;
@@ -0,0 +1,77 @@
+-- name: CreateWebhookSubscription :one
+INSERT INTO webhook_subscriptions (user_id, scope, url, secret, events)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetWebhookSubscriptionsByUser :many
+SELECT * FROM webhook_subscriptions
+WHERE user_id = $1
+ORDER BY created_at DESC;
+
+-- name: GetWebhookSubscriptionByPublicID :one
+SELECT * FROM webhook_subscriptions
+WHERE public_id = $1;
+
+-- name: DeleteWebhookSubscription :execrows
+DELETE FROM webhook_subscriptions
+WHERE public_id = $1;
+
+-- name: EnqueueWebhookDeliveries :execrows
+-- One delivery per subscription interested in the event: the user's own
+-- subscriptions and the instance-wide ones
+INSERT INTO webhook_deliveries (subscription_id, event_type, payload)
+SELECT s.id, sqlc.arg(event_type)::text, sqlc.arg(payload)::jsonb
+FROM webhook_subscriptions s
+WHERE (s.scope = 'instance' OR s.user_id = sqlc.narg(user_id)::integer)
+  AND (cardinality(s.events) = 0 OR sqlc.arg(event_type)::text = ANY(s.events));
+
+-- name: ClaimWebhookDeliveries :many
+-- The subscription URL and secret come along, so the worker needs no second query
+WITH claimed AS (
+    UPDATE webhook_deliveries
+    SET attempts = attempts + 1,
+        locked_until = sqlc.arg(locked_until),
+        updated_at = NOW()
+    WHERE webhook_deliveries.id IN (
+        SELECT d.id FROM webhook_deliveries d
+        WHERE d.status = 'pending'
+          AND d.next_attempt_at <= NOW()
+          AND (d.locked_until IS NULL OR d.locked_until < NOW())
+        ORDER BY d.next_attempt_at
+        LIMIT sqlc.arg(batch_size)
+        FOR UPDATE SKIP LOCKED
+    )
+    RETURNING *
+)
+SELECT claimed.id, claimed.public_id, claimed.subscription_id, claimed.event_type, claimed.payload,
+       claimed.status, claimed.attempts, claimed.response_status, claimed.last_error,
+       claimed.next_attempt_at, claimed.created_at, claimed.delivered_at,
+       s.public_id AS subscription_public_id, s.url, s.secret
+FROM claimed
+JOIN webhook_subscriptions s ON s.id = claimed.subscription_id;
+
+-- name: MarkWebhookDeliveryDelivered :exec
+UPDATE webhook_deliveries
+SET status = 'delivered',
+    response_status = $2,
+    delivered_at = NOW(),
+    locked_until = NULL,
+    last_error = NULL,
+    updated_at = NOW()
+WHERE id = $1;
+
+-- name: MarkWebhookDeliveryFailed :exec
+UPDATE webhook_deliveries
+SET status = $2,
+    response_status = $3,
+    last_error = $4,
+    next_attempt_at = $5,
+    locked_until = NULL,
+    updated_at = NOW()
+WHERE id = $1;
+
+-- name: GetWebhookDeliveriesBySubscription :many
+SELECT * FROM webhook_deliveries
+WHERE subscription_id = $1
+ORDER BY id DESC
+LIMIT $2;
@@ -6,11 +6,120 @@ package db
 
 import (
 	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+type EmailOutbox struct {
+	ID             int64
+	PublicID       uuid.UUID
+	IdempotencyKey string
+	Kind           string
+	Payload        json.RawMessage
+	Status         string
+	Attempts       int32
+	LastError      sql.NullString
+	NextAttemptAt  time.Time
+	LockedUntil    sql.NullTime
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	SentAt         sql.NullTime
+}
+
+type EmergencyAccess struct {
+	ID             int32
+	PublicID       uuid.UUID
+	GrantorID      int32
+	GranteeID      int32
+	VaultID        int32
+	Mode           string
+	Status         string
+	WaitSeconds    int32
+	WrappedKey     []byte
+	KeyVersion     int32
+	UserKeyVersion int32
+	RequestedAt    sql.NullTime
+	CreatedAt      time.Time
+}
+
+type Invite struct {
+	ID          int32
+	PublicID    uuid.UUID
+	TokenHash   string
+	Email       sql.NullString
+	CreatedBy   int32
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+	UsedAt      sql.NullTime
+	UsedByEmail sql.NullString
+	RevokedAt   sql.NullTime
+}
+
+type Organization struct {
+	ID        int32
+	PublicID  uuid.UUID
+	Name      string
+	CreatedAt time.Time
+}
+
+type OrganizationGroup struct {
+	ID             int32
+	PublicID       uuid.UUID
+	OrganizationID int32
+	Name           string
+	CreatedAt      time.Time
+}
+
+type OrganizationGroupMember struct {
+	GroupID  int32
+	MemberID int32
+}
+
+type OrganizationInvite struct {
+	ID             int32
+	PublicID       uuid.UUID
+	OrganizationID int32
+	Email          string
+	Role           string
+	TokenHash      string
+	InvitedBy      sql.NullInt32
+	CreatedAt      time.Time
+	ExpiresAt      time.Time
+}
+
+type OrganizationMember struct {
+	ID             int32
+	PublicID       uuid.UUID
+	OrganizationID int32
+	UserID         int32
+	Role           string
+	CreatedAt      time.Time
+}
+
+type OrganizationPolicy struct {
+	OrganizationID            int32
+	Require2fa                bool
+	MinKdfIterations          int32
+	AllowedEmailDomains       []string
+	SessionMaxLifetimeSeconds int32
+	UpdatedAt                 time.Time
+}
+
+type SecurityEvent struct {
+	ID        int64
+	PublicID  uuid.UUID
+	UserID    sql.NullInt32
+	ActorID   sql.NullInt32
+	Type      string
+	Ip        string
+	UserAgent string
+	DeviceID  string
+	Metadata  json.RawMessage
+	CreatedAt time.Time
+}
+
 type User struct {
 	ID           int32
 	PublicID     uuid.UUID
@@ -19,11 +128,123 @@ type User struct {
 	PasswordHash string
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
+	Locale       string
+}
+
+type UserKey struct {
+	UserID              int32
+	Version             int32
+	Algorithm           string
+	PublicKey           []byte
+	EncryptedPrivateKey []byte
+	Fingerprint         string
+	CreatedAt           time.Time
 }
 
 type Vault struct {
+	UserID           int32
+	Vault            []byte
+	CreatedAt        sql.NullTime
+	UpdatedAt        sql.NullTime
+	Revision         int64
+	Sha256           string
+	Encrypted        bool
+	Size             int32
+	BlobKey          sql.NullString
+	ID               int32
+	PublicID         uuid.UUID
+	Name             string
+	Type             string
+	IsDefault        bool
+	KeyVersion       int32
+	RotationRequired bool
+	OrganizationID   sql.NullInt32
+}
+
+type VaultEvent struct {
+	ID        int64
+	UserID    int32
+	Type      string
+	Payload   json.RawMessage
+	CreatedAt time.Time
+}
+
+type VaultItem struct {
+	UserID    int32
+	ItemID    uuid.UUID
+	Revision  int64
+	Data      []byte
+	Deleted   bool
+	ChangeSeq int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type VaultItemSequence struct {
+	UserID int32
+	Seq    int64
+}
+
+type VaultKey struct {
+	UserID     int32
+	KekID      string
+	WrappedKey []byte
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type VaultMember struct {
+	ID             int32
+	PublicID       uuid.UUID
+	VaultID        int32
+	UserID         int32
+	Role           string
+	Status         string
+	WrappedKey     []byte
+	KeyVersion     int32
+	UserKeyVersion int32
+	InvitedBy      sql.NullInt32
+	CreatedAt      time.Time
+	AcceptedAt     sql.NullTime
+}
+
+type VaultVersion struct {
 	UserID    int32
+	Revision  int64
 	Vault     []byte
-	CreatedAt sql.NullTime
-	UpdatedAt sql.NullTime
+	Size      int32
+	Sha256    string
+	DeviceID  string
+	CreatedAt time.Time
+	Encrypted bool
+	BlobKey   sql.NullString
+	VaultID   int32
+}
+
+type WebhookDelivery struct {
+	ID             int64
+	PublicID       uuid.UUID
+	SubscriptionID int32
+	EventType      string
+	Payload        json.RawMessage
+	Status         string
+	Attempts       int32
+	ResponseStatus sql.NullInt32
+	LastError      sql.NullString
+	NextAttemptAt  time.Time
+	LockedUntil    sql.NullTime
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeliveredAt    sql.NullTime
+}
+
+type WebhookSubscription struct {
+	ID        int32
+	PublicID  uuid.UUID
+	UserID    int32
+	Scope     string
+	Url       string
+	Secret    string
+	Events    []string
+	CreatedAt time.Time
 }
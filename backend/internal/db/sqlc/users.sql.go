@@ -12,19 +12,25 @@ import (
 )
 
 const createUser = `-- name: CreateUser :one
-INSERT INTO users (name, email, password_hash)
-VALUES ($1, $2, $3)
-RETURNING id, public_id, name, email, password_hash, created_at, updated_at
+INSERT INTO users (name, email, password_hash, locale)
+VALUES ($1, $2, $3, $4)
+RETURNING id, public_id, name, email, password_hash, created_at, updated_at, locale
 `
 
 type CreateUserParams struct {
 	Name         string
 	Email        string
 	PasswordHash string
+	Locale       string
 }
 
 func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, error) {
-	row := q.db.QueryRowContext(ctx, createUser, arg.Name, arg.Email, arg.PasswordHash)
+	row := q.db.QueryRowContext(ctx, createUser,
+		arg.Name,
+		arg.Email,
+		arg.PasswordHash,
+		arg.Locale,
+	)
 	var i User
 	err := row.Scan(
 		&i.ID,
@@ -34,12 +40,13 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		&i.PasswordHash,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Locale,
 	)
 	return i, err
 }
 
 const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT id, public_id, name, email, password_hash, created_at, updated_at FROM users
+SELECT id, public_id, name, email, password_hash, created_at, updated_at, locale FROM users
 WHERE email = $1
 `
 
@@ -54,12 +61,13 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error
 		&i.PasswordHash,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Locale,
 	)
 	return i, err
 }
 
 const getUserByID = `-- name: GetUserByID :one
-SELECT id, public_id, name, email, password_hash, created_at, updated_at FROM users
+SELECT id, public_id, name, email, password_hash, created_at, updated_at, locale FROM users
 WHERE id = $1
 `
 
@@ -74,12 +82,13 @@ func (q *Queries) GetUserByID(ctx context.Context, id int32) (User, error) {
 		&i.PasswordHash,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Locale,
 	)
 	return i, err
 }
 
 const getUserByPublicID = `-- name: GetUserByPublicID :one
-SELECT id, public_id, name, email, password_hash, created_at, updated_at FROM users
+SELECT id, public_id, name, email, password_hash, created_at, updated_at, locale FROM users
 WHERE public_id = $1
 `
 
@@ -94,12 +103,13 @@ func (q *Queries) GetUserByPublicID(ctx context.Context, publicID uuid.UUID) (Us
 		&i.PasswordHash,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Locale,
 	)
 	return i, err
 }
 
 const getUsers = `-- name: GetUsers :many
-SELECT id, public_id, name, email, password_hash, created_at, updated_at FROM users
+SELECT id, public_id, name, email, password_hash, created_at, updated_at, locale FROM users
 ORDER BY id
 `
 
@@ -120,6 +130,7 @@ func (q *Queries) GetUsers(ctx context.Context) ([]User, error) {
 			&i.PasswordHash,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Locale,
 		); err != nil {
 			return nil, err
 		}
@@ -133,3 +144,22 @@ func (q *Queries) GetUsers(ctx context.Context) ([]User, error) {
 	}
 	return items, nil
 }
+
+const updateUserPasswordHash = `-- name: UpdateUserPasswordHash :execrows
+UPDATE users
+SET password_hash = $2, updated_at = NOW()
+WHERE id = $1
+`
+
+type UpdateUserPasswordHashParams struct {
+	ID           int32
+	PasswordHash string
+}
+
+func (q *Queries) UpdateUserPasswordHash(ctx context.Context, arg UpdateUserPasswordHashParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateUserPasswordHash, arg.ID, arg.PasswordHash)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -8,62 +8,515 @@ package db
 import (
 	"context"
 	"database/sql"
+
+	"github.com/google/uuid"
+)
+
+const createVault = `-- name: CreateVault :one
+WITH vault AS (
+    INSERT INTO vaults (user_id, name, type, is_default, organization_id)
+    VALUES ($1, $2, $3, $4, (SELECT o.id FROM organizations o WHERE o.public_id = $5))
+    ON CONFLICT (user_id) WHERE is_default DO NOTHING
+    RETURNING user_id, vault, created_at, updated_at, revision, sha256, encrypted, size, blob_key, id, public_id, name, type, is_default, key_version, rotation_required, organization_id
+), owner AS (
+    INSERT INTO vault_members (vault_id, user_id, role, status, accepted_at)
+    SELECT id, user_id, 'manage', 'accepted', NOW()
+    FROM vault
 )
+SELECT v.public_id, v.user_id, v.name, v.type, v.is_default, v.revision, v.size, v.sha256, v.key_version, v.rotation_required, v.created_at, v.updated_at,
+       o.public_id AS organization_id
+FROM vault v
+LEFT JOIN organizations o ON o.id = v.organization_id
+`
+
+type CreateVaultParams struct {
+	UserID         int32
+	Name           string
+	Type           string
+	IsDefault      bool
+	OrganizationID uuid.NullUUID
+}
+
+type CreateVaultRow struct {
+	PublicID         uuid.UUID
+	UserID           int32
+	Name             string
+	Type             string
+	IsDefault        bool
+	Revision         int64
+	Size             int32
+	Sha256           string
+	KeyVersion       int32
+	RotationRequired bool
+	CreatedAt        sql.NullTime
+	UpdatedAt        sql.NullTime
+	OrganizationID   uuid.NullUUID
+}
+
+// Returns no rows when creating a default vault for a user that has one.
+// The user becomes the first member of the vault.
+func (q *Queries) CreateVault(ctx context.Context, arg CreateVaultParams) (CreateVaultRow, error) {
+	row := q.db.QueryRowContext(ctx, createVault,
+		arg.UserID,
+		arg.Name,
+		arg.Type,
+		arg.IsDefault,
+		arg.OrganizationID,
+	)
+	var i CreateVaultRow
+	err := row.Scan(
+		&i.PublicID,
+		&i.UserID,
+		&i.Name,
+		&i.Type,
+		&i.IsDefault,
+		&i.Revision,
+		&i.Size,
+		&i.Sha256,
+		&i.KeyVersion,
+		&i.RotationRequired,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.OrganizationID,
+	)
+	return i, err
+}
+
+const deleteVault = `-- name: DeleteVault :execrows
+DELETE FROM vaults
+WHERE public_id = $1 AND NOT is_default
+`
+
+// The versions are deleted along, the default vault can't be deleted
+func (q *Queries) DeleteVault(ctx context.Context, publicID uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteVault, publicID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const getDefaultVault = `-- name: GetDefaultVault :one
+SELECT v.public_id, v.user_id, v.name, v.type, v.is_default, v.revision, v.size, v.sha256, v.key_version, v.rotation_required, v.created_at, v.updated_at,
+       o.public_id AS organization_id
+FROM vaults v
+LEFT JOIN organizations o ON o.id = v.organization_id
+WHERE v.user_id = $1 AND v.is_default
+`
+
+type GetDefaultVaultRow struct {
+	PublicID         uuid.UUID
+	UserID           int32
+	Name             string
+	Type             string
+	IsDefault        bool
+	Revision         int64
+	Size             int32
+	Sha256           string
+	KeyVersion       int32
+	RotationRequired bool
+	CreatedAt        sql.NullTime
+	UpdatedAt        sql.NullTime
+	OrganizationID   uuid.NullUUID
+}
+
+func (q *Queries) GetDefaultVault(ctx context.Context, userID int32) (GetDefaultVaultRow, error) {
+	row := q.db.QueryRowContext(ctx, getDefaultVault, userID)
+	var i GetDefaultVaultRow
+	err := row.Scan(
+		&i.PublicID,
+		&i.UserID,
+		&i.Name,
+		&i.Type,
+		&i.IsDefault,
+		&i.Revision,
+		&i.Size,
+		&i.Sha256,
+		&i.KeyVersion,
+		&i.RotationRequired,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.OrganizationID,
+	)
+	return i, err
+}
+
+const getVault = `-- name: GetVault :one
+SELECT v.public_id, v.user_id, v.name, v.type, v.is_default, v.revision, v.size, v.sha256, v.key_version, v.rotation_required, v.created_at, v.updated_at,
+       o.public_id AS organization_id
+FROM vaults v
+LEFT JOIN organizations o ON o.id = v.organization_id
+WHERE v.public_id = $1
+`
+
+type GetVaultRow struct {
+	PublicID         uuid.UUID
+	UserID           int32
+	Name             string
+	Type             string
+	IsDefault        bool
+	Revision         int64
+	Size             int32
+	Sha256           string
+	KeyVersion       int32
+	RotationRequired bool
+	CreatedAt        sql.NullTime
+	UpdatedAt        sql.NullTime
+	OrganizationID   uuid.NullUUID
+}
+
+// The vault without its content
+func (q *Queries) GetVault(ctx context.Context, publicID uuid.UUID) (GetVaultRow, error) {
+	row := q.db.QueryRowContext(ctx, getVault, publicID)
+	var i GetVaultRow
+	err := row.Scan(
+		&i.PublicID,
+		&i.UserID,
+		&i.Name,
+		&i.Type,
+		&i.IsDefault,
+		&i.Revision,
+		&i.Size,
+		&i.Sha256,
+		&i.KeyVersion,
+		&i.RotationRequired,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.OrganizationID,
+	)
+	return i, err
+}
+
+const getVaultBlobAtRevision = `-- name: GetVaultBlobAtRevision :one
+SELECT blob_key, sha256, encrypted
+FROM vaults
+WHERE public_id = $1 AND revision = $2 AND vault IS NULL
+`
+
+type GetVaultBlobAtRevisionParams struct {
+	PublicID uuid.UUID
+	Revision int64
+}
+
+type GetVaultBlobAtRevisionRow struct {
+	BlobKey   sql.NullString
+	Sha256    string
+	Encrypted bool
+}
 
-const getVaultByUserID = `-- name: GetVaultByUserID :one
-SELECT user_id, vault, created_at, updated_at
+func (q *Queries) GetVaultBlobAtRevision(ctx context.Context, arg GetVaultBlobAtRevisionParams) (GetVaultBlobAtRevisionRow, error) {
+	row := q.db.QueryRowContext(ctx, getVaultBlobAtRevision, arg.PublicID, arg.Revision)
+	var i GetVaultBlobAtRevisionRow
+	err := row.Scan(&i.BlobKey, &i.Sha256, &i.Encrypted)
+	return i, err
+}
+
+const getVaultBlobKeyForUpdate = `-- name: GetVaultBlobKeyForUpdate :one
+SELECT user_id, revision, blob_key
 FROM vaults
-WHERE user_id = $1
+WHERE public_id = $1
+FOR UPDATE
 `
 
-func (q *Queries) GetVaultByUserID(ctx context.Context, userID int32) (Vault, error) {
-	row := q.db.QueryRowContext(ctx, getVaultByUserID, userID)
+type GetVaultBlobKeyForUpdateRow struct {
+	UserID   int32
+	Revision int64
+	BlobKey  sql.NullString
+}
+
+// Locks the vault until the end of the transaction
+func (q *Queries) GetVaultBlobKeyForUpdate(ctx context.Context, publicID uuid.UUID) (GetVaultBlobKeyForUpdateRow, error) {
+	row := q.db.QueryRowContext(ctx, getVaultBlobKeyForUpdate, publicID)
+	var i GetVaultBlobKeyForUpdateRow
+	err := row.Scan(&i.UserID, &i.Revision, &i.BlobKey)
+	return i, err
+}
+
+const getVaultContent = `-- name: GetVaultContent :one
+SELECT user_id, vault, created_at, updated_at, revision, sha256, encrypted, size, blob_key, id, public_id, name, type, is_default, key_version, rotation_required, organization_id
+FROM vaults
+WHERE public_id = $1
+`
+
+func (q *Queries) GetVaultContent(ctx context.Context, publicID uuid.UUID) (Vault, error) {
+	row := q.db.QueryRowContext(ctx, getVaultContent, publicID)
 	var i Vault
 	err := row.Scan(
 		&i.UserID,
 		&i.Vault,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Revision,
+		&i.Sha256,
+		&i.Encrypted,
+		&i.Size,
+		&i.BlobKey,
+		&i.ID,
+		&i.PublicID,
+		&i.Name,
+		&i.Type,
+		&i.IsDefault,
+		&i.KeyVersion,
+		&i.RotationRequired,
+		&i.OrganizationID,
 	)
 	return i, err
 }
 
-const getVaultUpdatedAtByUserID = `-- name: GetVaultUpdatedAtByUserID :one
-SELECT updated_at
+const getVaultUsageByUserID = `-- name: GetVaultUsageByUserID :one
+SELECT
+    COALESCE((SELECT SUM(v.size) FROM vaults v WHERE v.user_id = $1), 0)::bigint AS vault_bytes,
+    COALESCE((SELECT SUM(vv.size) FROM vault_versions vv
+              JOIN vaults v ON v.id = vv.vault_id
+              WHERE vv.user_id = $1
+                AND vv.revision <> v.revision), 0)::bigint AS history_bytes,
+    COALESCE((SELECT SUM(octet_length(vi.data)) FROM vault_items vi WHERE vi.user_id = $1), 0)::bigint AS item_bytes
+`
+
+type GetVaultUsageByUserIDRow struct {
+	VaultBytes   int64
+	HistoryBytes int64
+	ItemBytes    int64
+}
+
+// The current vaults are also the newest versions, they're only counted once
+func (q *Queries) GetVaultUsageByUserID(ctx context.Context, userID int32) (GetVaultUsageByUserIDRow, error) {
+	row := q.db.QueryRowContext(ctx, getVaultUsageByUserID, userID)
+	var i GetVaultUsageByUserIDRow
+	err := row.Scan(&i.VaultBytes, &i.HistoryBytes, &i.ItemBytes)
+	return i, err
+}
+
+const isVaultBlobReferenced = `-- name: IsVaultBlobReferenced :one
+SELECT (EXISTS (SELECT 1 FROM vaults v WHERE v.blob_key = $1::text)
+    OR EXISTS (SELECT 1 FROM vault_versions vv WHERE vv.blob_key = $1::text))::boolean AS referenced
+`
+
+func (q *Queries) IsVaultBlobReferenced(ctx context.Context, blobKey string) (bool, error) {
+	row := q.db.QueryRowContext(ctx, isVaultBlobReferenced, blobKey)
+	var referenced bool
+	err := row.Scan(&referenced)
+	return referenced, err
+}
+
+const listVaultIDs = `-- name: ListVaultIDs :many
+SELECT public_id
 FROM vaults
-WHERE user_id=$1
+WHERE public_id > $1 AND revision > 0
+ORDER BY public_id
+LIMIT $2
 `
 
-func (q *Queries) GetVaultUpdatedAtByUserID(ctx context.Context, userID int32) (sql.NullTime, error) {
-	row := q.db.QueryRowContext(ctx, getVaultUpdatedAtByUserID, userID)
-	var updated_at sql.NullTime
-	err := row.Scan(&updated_at)
-	return updated_at, err
+type ListVaultIDsParams struct {
+	PublicID uuid.UUID
+	Limit    int32
 }
 
-const insertVaultByUserID = `-- name: InsertVaultByUserID :one
-INSERT INTO vaults (user_id, vault)
-VALUES ($1, $2)
-ON CONFLICT (user_id)
-DO UPDATE SET
-    vault = EXCLUDED.vault,
+// The vaults with content, in the order of their ID
+func (q *Queries) ListVaultIDs(ctx context.Context, arg ListVaultIDsParams) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, listVaultIDs, arg.PublicID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var public_id uuid.UUID
+		if err := rows.Scan(&public_id); err != nil {
+			return nil, err
+		}
+		items = append(items, public_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listVaultsByMember = `-- name: ListVaultsByMember :many
+SELECT v.public_id, v.user_id, v.name, v.type, v.is_default, v.revision, v.size, v.sha256, v.key_version, v.rotation_required, v.created_at, v.updated_at,
+       o.public_id AS organization_id, m.public_id AS member_id, m.role, m.status
+FROM vaults v
+JOIN vault_members m ON m.vault_id = v.id
+LEFT JOIN organizations o ON o.id = v.organization_id
+WHERE m.user_id = $1
+ORDER BY (v.is_default AND v.user_id = $1) DESC, v.id
+`
+
+type ListVaultsByMemberRow struct {
+	PublicID         uuid.UUID
+	UserID           int32
+	Name             string
+	Type             string
+	IsDefault        bool
+	Revision         int64
+	Size             int32
+	Sha256           string
+	KeyVersion       int32
+	RotationRequired bool
+	CreatedAt        sql.NullTime
+	UpdatedAt        sql.NullTime
+	OrganizationID   uuid.NullUUID
+	MemberID         uuid.UUID
+	Role             string
+	Status           string
+}
+
+// The vaults the user is a member of or invited to, their default vault
+// first, then by creation
+func (q *Queries) ListVaultsByMember(ctx context.Context, userID int32) ([]ListVaultsByMemberRow, error) {
+	rows, err := q.db.QueryContext(ctx, listVaultsByMember, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListVaultsByMemberRow
+	for rows.Next() {
+		var i ListVaultsByMemberRow
+		if err := rows.Scan(
+			&i.PublicID,
+			&i.UserID,
+			&i.Name,
+			&i.Type,
+			&i.IsDefault,
+			&i.Revision,
+			&i.Size,
+			&i.Sha256,
+			&i.KeyVersion,
+			&i.RotationRequired,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.OrganizationID,
+			&i.MemberID,
+			&i.Role,
+			&i.Status,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateVault = `-- name: UpdateVault :one
+WITH vault AS (
+    UPDATE vaults
+    SET name = $2,
+        type = $3
+    WHERE vaults.public_id = $1
+    RETURNING user_id, vault, created_at, updated_at, revision, sha256, encrypted, size, blob_key, id, public_id, name, type, is_default, key_version, rotation_required, organization_id
+)
+SELECT v.public_id, v.user_id, v.name, v.type, v.is_default, v.revision, v.size, v.sha256, v.key_version, v.rotation_required, v.created_at, v.updated_at,
+       o.public_id AS organization_id
+FROM vault v
+LEFT JOIN organizations o ON o.id = v.organization_id
+`
+
+type UpdateVaultParams struct {
+	PublicID uuid.UUID
+	Name     string
+	Type     string
+}
+
+type UpdateVaultRow struct {
+	PublicID         uuid.UUID
+	UserID           int32
+	Name             string
+	Type             string
+	IsDefault        bool
+	Revision         int64
+	Size             int32
+	Sha256           string
+	KeyVersion       int32
+	RotationRequired bool
+	CreatedAt        sql.NullTime
+	UpdatedAt        sql.NullTime
+	OrganizationID   uuid.NullUUID
+}
+
+// Renaming leaves updated_at alone, it's the time of the last content write
+func (q *Queries) UpdateVault(ctx context.Context, arg UpdateVaultParams) (UpdateVaultRow, error) {
+	row := q.db.QueryRowContext(ctx, updateVault, arg.PublicID, arg.Name, arg.Type)
+	var i UpdateVaultRow
+	err := row.Scan(
+		&i.PublicID,
+		&i.UserID,
+		&i.Name,
+		&i.Type,
+		&i.IsDefault,
+		&i.Revision,
+		&i.Size,
+		&i.Sha256,
+		&i.KeyVersion,
+		&i.RotationRequired,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.OrganizationID,
+	)
+	return i, err
+}
+
+const updateVaultIfRevision = `-- name: UpdateVaultIfRevision :one
+UPDATE vaults
+SET vault = NULL,
+    blob_key = $2,
+    size = $3,
+    sha256 = $4,
+    encrypted = $5,
+    revision = revision + 1,
     updated_at = NOW()
-RETURNING user_id, vault, created_at, updated_at
+WHERE public_id = $1
+  AND revision = $6
+RETURNING user_id, vault, created_at, updated_at, revision, sha256, encrypted, size, blob_key, id, public_id, name, type, is_default, key_version, rotation_required, organization_id
 `
 
-type InsertVaultByUserIDParams struct {
-	UserID int32
-	Vault  []byte
+type UpdateVaultIfRevisionParams struct {
+	PublicID         uuid.UUID
+	BlobKey          sql.NullString
+	Size             int32
+	Sha256           string
+	Encrypted        bool
+	ExpectedRevision int64
 }
 
-func (q *Queries) InsertVaultByUserID(ctx context.Context, arg InsertVaultByUserIDParams) (Vault, error) {
-	row := q.db.QueryRowContext(ctx, insertVaultByUserID, arg.UserID, arg.Vault)
+// Returns no rows when the stored revision is not the expected one
+func (q *Queries) UpdateVaultIfRevision(ctx context.Context, arg UpdateVaultIfRevisionParams) (Vault, error) {
+	row := q.db.QueryRowContext(ctx, updateVaultIfRevision,
+		arg.PublicID,
+		arg.BlobKey,
+		arg.Size,
+		arg.Sha256,
+		arg.Encrypted,
+		arg.ExpectedRevision,
+	)
 	var i Vault
 	err := row.Scan(
 		&i.UserID,
 		&i.Vault,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Revision,
+		&i.Sha256,
+		&i.Encrypted,
+		&i.Size,
+		&i.BlobKey,
+		&i.ID,
+		&i.PublicID,
+		&i.Name,
+		&i.Type,
+		&i.IsDefault,
+		&i.KeyVersion,
+		&i.RotationRequired,
+		&i.OrganizationID,
 	)
 	return i, err
 }
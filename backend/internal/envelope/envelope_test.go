@@ -0,0 +1,140 @@
+package envelope
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"io"
+	"testing"
+	"testing/iotest"
+)
+
+func testKeyring(t *testing.T, active string, ids ...string) *Keyring {
+	t.Helper()
+	var entries []string
+	for _, id := range ids {
+		key := make([]byte, keySize)
+		rand.Read(key)
+		entries = append(entries, id+":"+base64.StdEncoding.EncodeToString(key))
+	}
+	keyring, err := NewKeyring(entries, active)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return keyring
+}
+
+func TestSealOpen(t *testing.T) {
+	keyring := testKeyring(t, "k1", "k1")
+	key, _, err := keyring.NewDataKey([]byte("user:1"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	aad := []byte("user:1")
+
+	for _, size := range []int{0, 1, SegmentSize, SegmentSize + 1, 20*SegmentSize + 7} {
+		plaintext := make([]byte, size)
+		rand.Read(plaintext)
+
+		sealed, err := Seal(key, plaintext, aad)
+		if err != nil {
+			t.Fatalf("size %d: unexpected error: %v", size, err)
+		}
+		if int64(len(sealed)) != SealedSize(int64(size)) || PlainSize(int64(len(sealed))) != int64(size) {
+			t.Errorf("size %d: sealed to %d bytes, expected %d", size, len(sealed), SealedSize(int64(size)))
+		}
+
+		opened, err := Open(key, sealed, aad)
+		if err != nil || !bytes.Equal(opened, plaintext) {
+			t.Errorf("size %d: expected the plaintext back, got %d bytes (%v)", size, len(opened), err)
+		}
+
+		// Streamed with small reads, fetching as the repository does
+		reader, _ := NewReader(key, aad, int64(size), func(offset, length int64) ([]byte, error) {
+			return sealed[offset:min(offset+length, int64(len(sealed)))], nil
+		})
+		streamed, err := io.ReadAll(io.LimitReader(reader, int64(size)+1))
+		if err != nil || !bytes.Equal(streamed, plaintext) {
+			t.Errorf("size %d: expected the streamed plaintext, got %d bytes (%v)", size, len(streamed), err)
+		}
+	}
+}
+
+func TestSealerReadsInSmallSteps(t *testing.T) {
+	key := make([]byte, keySize)
+	rand.Read(key)
+	aad := []byte("user:1")
+
+	for _, size := range []int{0, SegmentSize, 3*SegmentSize + 5} {
+		plaintext := make([]byte, size)
+		rand.Read(plaintext)
+
+		sealer, err := NewSealer(key, aad, iotest.OneByteReader(bytes.NewReader(plaintext)))
+		if err != nil {
+			t.Fatalf("size %d: unexpected error: %v", size, err)
+		}
+		sealed, err := io.ReadAll(iotest.HalfReader(sealer))
+		if err != nil || int64(len(sealed)) != SealedSize(int64(size)) {
+			t.Fatalf("size %d: expected %d sealed bytes, got %d (%v)", size, SealedSize(int64(size)), len(sealed), err)
+		}
+		if opened, err := Open(key, sealed, aad); err != nil || !bytes.Equal(opened, plaintext) {
+			t.Errorf("size %d: expected the plaintext back, got %d bytes (%v)", size, len(opened), err)
+		}
+	}
+
+	failing := errors.New("connection reset")
+	sealer, _ := NewSealer(key, aad, iotest.ErrReader(failing))
+	if _, err := io.ReadAll(sealer); !errors.Is(err, failing) {
+		t.Errorf("expected the read error of the plaintext, got %v", err)
+	}
+}
+
+func TestOpenRejectsTampering(t *testing.T) {
+	key := make([]byte, keySize)
+	rand.Read(key)
+	plaintext := make([]byte, 2*SegmentSize)
+	sealed, _ := Seal(key, plaintext, []byte("user:1"))
+
+	if _, err := Open(key, sealed, []byte("user:2")); !errors.Is(err, ErrCorrupted) {
+		t.Errorf("expected another owner to be rejected, got %v", err)
+	}
+	// Dropping the last segment leaves a blob of a valid size
+	if _, err := Open(key, sealed[:HeaderSize+SealedSegmentSize], []byte("user:1")); !errors.Is(err, ErrCorrupted) {
+		t.Errorf("expected the truncation to be detected, got %v", err)
+	}
+	flipped := bytes.Clone(sealed)
+	flipped[HeaderSize+10] ^= 1
+	if _, err := Open(key, flipped, []byte("user:1")); !errors.Is(err, ErrCorrupted) {
+		t.Errorf("expected the flipped bit to be detected, got %v", err)
+	}
+}
+
+func TestKeyringRewrap(t *testing.T) {
+	old := testKeyring(t, "k1", "k1")
+	key, wrapped, err := old.NewDataKey([]byte("user:1"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// After the rotation k2 is active and k1 still unwraps the old keys
+	rotated, err := NewKeyring([]string{"k1:" + base64.StdEncoding.EncodeToString(old.keys["k1"]), "k2:" + base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{2}, keySize))}, "k2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	unwrapped, err := rotated.Unwrap("k1", wrapped, []byte("user:1"))
+	if err != nil || !bytes.Equal(unwrapped, key) {
+		t.Fatalf("expected the data key back, got %v", err)
+	}
+	rewrapped, err := rotated.Wrap(rotated.ActiveID(), unwrapped, []byte("user:1"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if again, err := rotated.Unwrap("k2", rewrapped, []byte("user:1")); err != nil || !bytes.Equal(again, key) {
+		t.Errorf("expected the rewrapped data key to unwrap with k2, got %v", err)
+	}
+
+	if _, err := old.Unwrap("k2", rewrapped, []byte("user:1")); !errors.Is(err, ErrUnknownKEK) {
+		t.Errorf("expected ErrUnknownKEK, got %v", err)
+	}
+}
@@ -0,0 +1,137 @@
+// Package envelope encrypts vaults at rest. Every user has a data key that
+// encrypts their vaults, the data keys are stored wrapped by a key-encryption
+// key (KEK) of the keyring.
+package envelope
+
+import (
+	"bufio"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+const keySize = 32
+
+var ErrUnknownKEK = errors.New("unknown key-encryption key")
+
+// Keyring holds the KEKs by ID. New data keys are wrapped with the active
+// one, the others are only kept to unwrap the data keys not rewrapped yet.
+type Keyring struct {
+	keys   map[string][]byte
+	active string
+}
+
+// NewKeyring parses entries formatted as "<id>:<base64 key>", the keys are
+// 32 bytes for AES-256
+func NewKeyring(entries []string, active string) (*Keyring, error) {
+	keys := make(map[string][]byte, len(entries))
+	for _, entry := range entries {
+		id, encoded, ok := strings.Cut(entry, ":")
+		if !ok || id == "" {
+			return nil, fmt.Errorf("invalid key-encryption key entry, expected <id>:<base64 key>")
+		}
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil || len(key) != keySize {
+			return nil, fmt.Errorf("key-encryption key %s must be %d base64 encoded bytes", id, keySize)
+		}
+		if _, exists := keys[id]; exists {
+			return nil, fmt.Errorf("duplicate key-encryption key %s", id)
+		}
+		keys[id] = key
+	}
+
+	if _, ok := keys[active]; !ok {
+		return nil, fmt.Errorf("active key-encryption key %q is not in the keyring", active)
+	}
+
+	return &Keyring{keys: keys, active: active}, nil
+}
+
+// ReadKeyFile reads the keyring entries of a file, one per line. Blank lines
+// and lines starting with # are skipped.
+func ReadKeyFile(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var entries []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		entries = append(entries, line)
+	}
+	return entries, scanner.Err()
+}
+
+// ActiveID is the ID of the KEK new data keys are wrapped with
+func (k *Keyring) ActiveID() string {
+	return k.active
+}
+
+// NewDataKey returns a random data key wrapped with the active KEK
+func (k *Keyring) NewDataKey(aad []byte) (key, wrapped []byte, err error) {
+	key = make([]byte, keySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, nil, err
+	}
+
+	wrapped, err = k.Wrap(k.active, key, aad)
+	if err != nil {
+		return nil, nil, err
+	}
+	return key, wrapped, nil
+}
+
+// Wrap encrypts a data key with a KEK, aad binds it to its owner
+func (k *Keyring) Wrap(kekID string, key, aad []byte) ([]byte, error) {
+	aead, err := k.aead(kekID)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, key, aad), nil
+}
+
+// Unwrap decrypts a data key wrapped by Wrap
+func (k *Keyring) Unwrap(kekID string, wrapped, aad []byte) ([]byte, error) {
+	aead, err := k.aead(kekID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(wrapped) < aead.NonceSize() {
+		return nil, errors.New("wrapped data key is too short")
+	}
+	nonce, ciphertext := wrapped[:aead.NonceSize()], wrapped[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, aad)
+}
+
+func (k *Keyring) aead(kekID string) (cipher.AEAD, error) {
+	kek, ok := k.keys[kekID]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownKEK, kekID)
+	}
+	return newAEAD(kek)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,267 @@
+package envelope
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// Sealed vaults are split in segments encrypted on their own with AES-GCM,
+// so a download can be decrypted while it is read instead of as a whole:
+//
+//	header:  version (1 byte) | nonce prefix (7 bytes)
+//	segment: ciphertext of up to SegmentSize bytes | tag (16 bytes)
+//
+// The nonce of a segment is the prefix, its index and whether it's the last
+// one, which stops segments from being reordered or the blob truncated.
+const (
+	formatVersion = 1
+	prefixSize    = 7
+	HeaderSize    = 1 + prefixSize
+	SegmentSize   = 64 << 10
+	tagSize       = 16
+	// SealedSegmentSize is the size of a full segment once sealed
+	SealedSegmentSize = SegmentSize + tagSize
+)
+
+var ErrCorrupted = errors.New("sealed vault is corrupted")
+
+// SealedSize is the size of a sealed plaintext of size bytes
+func SealedSize(size int64) int64 {
+	return HeaderSize + segmentCount(size)*tagSize + size
+}
+
+// Seal encrypts plaintext with a data key, aad binds it to its owner
+func Seal(key, plaintext, aad []byte) ([]byte, error) {
+	sealer, err := NewSealer(key, aad, bytes.NewReader(plaintext))
+	if err != nil {
+		return nil, err
+	}
+
+	out := bytes.NewBuffer(make([]byte, 0, SealedSize(int64(len(plaintext)))))
+	if _, err := io.Copy(out, sealer); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// NewSealer encrypts plaintext while it is read, like Seal without the whole
+// vault in memory: only one segment is buffered at a time
+func NewSealer(key, aad []byte, plaintext io.Reader) (io.Reader, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := make([]byte, prefixSize)
+	if _, err := rand.Read(prefix); err != nil {
+		return nil, err
+	}
+
+	return &sealer{
+		aead:   aead,
+		aad:    aad,
+		prefix: prefix,
+		src:    bufio.NewReaderSize(plaintext, SegmentSize),
+		sealed: append([]byte{formatVersion}, prefix...),
+	}, nil
+}
+
+type sealer struct {
+	aead   cipher.AEAD
+	aad    []byte
+	prefix []byte
+	src    *bufio.Reader
+	// index is the index of the next segment to seal
+	index   int64
+	segment []byte
+	out     []byte
+	sealed  []byte
+	done    bool
+}
+
+func (s *sealer) Read(p []byte) (int, error) {
+	for len(s.sealed) == 0 {
+		if s.done {
+			return 0, io.EOF
+		}
+		if err := s.sealSegment(); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, s.sealed)
+	s.sealed = s.sealed[n:]
+	return n, nil
+}
+
+func (s *sealer) sealSegment() error {
+	if s.segment == nil {
+		s.segment = make([]byte, SegmentSize)
+		s.out = make([]byte, 0, SealedSegmentSize)
+	}
+
+	n, err := io.ReadFull(s.src, s.segment)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return err
+	}
+	// A full segment is the last one when nothing follows it
+	last := n < SegmentSize
+	if !last {
+		if _, err := s.src.Peek(1); err == io.EOF {
+			last = true
+		} else if err != nil {
+			return err
+		}
+	}
+
+	s.sealed = s.aead.Seal(s.out[:0], segmentNonce(s.prefix, s.index, last), s.segment[:n], s.aad)
+	s.index++
+	s.done = last
+	return nil
+}
+
+// PlainSize is the size of the plaintext of a sealed vault of size bytes,
+// -1 when no sealed vault has that size
+func PlainSize(size int64) int64 {
+	body := size - HeaderSize
+	if body < tagSize {
+		return -1
+	}
+	count := (body + SealedSegmentSize - 1) / SealedSegmentSize
+	if body-count*tagSize < (count-1)*SegmentSize {
+		return -1
+	}
+	return body - count*tagSize
+}
+
+// Open decrypts a whole vault sealed by Seal
+func Open(key, sealed, aad []byte) ([]byte, error) {
+	size := PlainSize(int64(len(sealed)))
+	if size < 0 {
+		return nil, ErrCorrupted
+	}
+
+	reader, err := NewReader(key, aad, size, func(offset, length int64) ([]byte, error) {
+		return sealed[offset:min(offset+length, int64(len(sealed)))], nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(reader)
+}
+
+// FetchFunc reads length bytes of the sealed vault from offset, or less at
+// the end
+type FetchFunc func(offset, length int64) ([]byte, error)
+
+// NewReader decrypts a sealed vault of a known plaintext size while fetching
+// it a few segments at a time
+func NewReader(key, aad []byte, size int64, fetch FetchFunc) (io.Reader, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	return &reader{aead: aead, aad: aad, fetch: fetch, size: size, count: segmentCount(size)}, nil
+}
+
+// segmentsPerFetch bounds the memory of a reader to about 1 MiB
+const segmentsPerFetch = 16
+
+type reader struct {
+	aead   cipher.AEAD
+	aad    []byte
+	fetch  FetchFunc
+	size   int64
+	count  int64
+	prefix []byte
+	// next is the index of the next segment to fetch
+	next    int64
+	fetched []byte
+	plain   []byte
+	done    bool
+}
+
+func (r *reader) Read(p []byte) (int, error) {
+	for len(r.plain) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		if err := r.openSegment(); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, r.plain)
+	r.plain = r.plain[n:]
+	return n, nil
+}
+
+func (r *reader) openSegment() error {
+	if r.prefix == nil {
+		header, err := r.fetch(0, HeaderSize)
+		if err != nil {
+			return err
+		}
+		if len(header) != HeaderSize || header[0] != formatVersion {
+			return ErrCorrupted
+		}
+		r.prefix = header[1:]
+	}
+
+	if len(r.fetched) == 0 {
+		if r.next >= r.count {
+			return ErrCorrupted
+		}
+		offset := HeaderSize + r.next*SealedSegmentSize
+		chunk, err := r.fetch(offset, segmentsPerFetch*SealedSegmentSize)
+		if err != nil {
+			return err
+		}
+		if len(chunk) == 0 {
+			return ErrCorrupted
+		}
+		r.fetched = chunk
+	}
+
+	index := r.next
+	last := index == r.count-1
+	expected := SealedSegmentSize
+	if last {
+		expected = int(r.size-index*SegmentSize) + tagSize
+	}
+	if len(r.fetched) < expected {
+		return ErrCorrupted
+	}
+	sealed := r.fetched[:expected]
+	r.fetched = r.fetched[expected:]
+	r.next++
+
+	plain, err := r.aead.Open(nil, segmentNonce(r.prefix, index, last), sealed, r.aad)
+	if err != nil {
+		return ErrCorrupted
+	}
+
+	r.plain = plain
+	r.done = last
+	return nil
+}
+
+func segmentCount(size int64) int64 {
+	// An empty vault still has its final segment
+	return max((size+SegmentSize-1)/SegmentSize, 1)
+}
+
+func segmentNonce(prefix []byte, index int64, last bool) []byte {
+	nonce := make([]byte, 0, 12)
+	nonce = append(nonce, prefix...)
+	nonce = binary.BigEndian.AppendUint32(nonce, uint32(index))
+	if last {
+		return append(nonce, 1)
+	}
+	return append(nonce, 0)
+}
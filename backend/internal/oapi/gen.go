@@ -30,960 +30,11240 @@ const (
 	CookieAuthScopes = "CookieAuth.Scopes"
 )
 
-// CreateUserRequest defines model for CreateUserRequest.
-type CreateUserRequest struct {
-	Email    openapi_types.Email `json:"email"`
-	Name     string              `json:"name"`
-	Password string              `json:"password"`
-}
+// Defines values for CreateWebhookRequestScope.
+const (
+	CreateWebhookRequestScopeInstance CreateWebhookRequestScope = "instance"
+	CreateWebhookRequestScopeUser     CreateWebhookRequestScope = "user"
+)
 
-// ErrorResponse defines model for ErrorResponse.
-type ErrorResponse struct {
-	Code    int    `json:"code"`
-	Message string `json:"message"`
-}
+// Defines values for EmergencyAccessMode.
+const (
+	Takeover EmergencyAccessMode = "takeover"
+	View     EmergencyAccessMode = "view"
+)
 
-// LoginRequest defines model for LoginRequest.
-type LoginRequest struct {
-	// DeviceID Unique identifier for the client device (used for token management)
-	DeviceID string              `json:"deviceID"`
-	Email    openapi_types.Email `json:"email"`
-	Password string              `json:"password"`
-}
+// Defines values for EmergencyAccessStatus.
+const (
+	EmergencyAccessStatusAccepted  EmergencyAccessStatus = "accepted"
+	EmergencyAccessStatusGranted   EmergencyAccessStatus = "granted"
+	EmergencyAccessStatusInvited   EmergencyAccessStatus = "invited"
+	EmergencyAccessStatusRequested EmergencyAccessStatus = "requested"
+)
 
-// TokenResponse defines model for TokenResponse.
-type TokenResponse struct {
-	// Token Base64 representation of the token
+// Defines values for InviteResponseStatus.
+const (
+	InviteResponseStatusExpired InviteResponseStatus = "expired"
+	InviteResponseStatusPending InviteResponseStatus = "pending"
+	InviteResponseStatusRevoked InviteResponseStatus = "revoked"
+	InviteResponseStatusUsed    InviteResponseStatus = "used"
+)
+
+// Defines values for OrganizationRole.
+const (
+	Admin  OrganizationRole = "admin"
+	Member OrganizationRole = "member"
+	Owner  OrganizationRole = "owner"
+)
+
+// Defines values for OutboxMessageResponseStatus.
+const (
+	OutboxMessageResponseStatusDead    OutboxMessageResponseStatus = "dead"
+	OutboxMessageResponseStatusPending OutboxMessageResponseStatus = "pending"
+	OutboxMessageResponseStatusSent    OutboxMessageResponseStatus = "sent"
+)
+
+// Defines values for SecurityEventType.
+const (
+	Login        SecurityEventType = "login"
+	LoginFailed  SecurityEventType = "login_failed"
+	Logout       SecurityEventType = "logout"
+	Registration SecurityEventType = "registration"
+	TokenRefresh SecurityEventType = "token_refresh"
+	VaultWrite   SecurityEventType = "vault_write"
+)
+
+// Defines values for UserKeyAlgorithm.
+const (
+	EcdhP256   UserKeyAlgorithm = "ecdh-p256"
+	RsaOaep256 UserKeyAlgorithm = "rsa-oaep-256"
+	X25519     UserKeyAlgorithm = "x25519"
+)
+
+// Defines values for VaultIntegrityProblemStatus.
+const (
+	Corrupted  VaultIntegrityProblemStatus = "corrupted"
+	Restored   VaultIntegrityProblemStatus = "restored"
+	Unreadable VaultIntegrityProblemStatus = "unreadable"
+)
+
+// Defines values for VaultItemResultStatus.
+const (
+	Applied  VaultItemResultStatus = "applied"
+	Conflict VaultItemResultStatus = "conflict"
+)
+
+// Defines values for VaultMemberStatus.
+const (
+	VaultMemberStatusAccepted VaultMemberStatus = "accepted"
+	VaultMemberStatusInvited  VaultMemberStatus = "invited"
+)
+
+// Defines values for VaultRole.
+const (
+	Manage VaultRole = "manage"
+	Read   VaultRole = "read"
+	Write  VaultRole = "write"
+)
+
+// Defines values for VaultType.
+const (
+	Other    VaultType = "other"
+	Personal VaultType = "personal"
+	Work     VaultType = "work"
+)
+
+// Defines values for WebhookDeliveryResponseStatus.
+const (
+	WebhookDeliveryResponseStatusDead      WebhookDeliveryResponseStatus = "dead"
+	WebhookDeliveryResponseStatusDelivered WebhookDeliveryResponseStatus = "delivered"
+	WebhookDeliveryResponseStatusPending   WebhookDeliveryResponseStatus = "pending"
+)
+
+// Defines values for WebhookResponseScope.
+const (
+	WebhookResponseScopeInstance WebhookResponseScope = "instance"
+	WebhookResponseScopeUser     WebhookResponseScope = "user"
+)
+
+// Defines values for ListOutboxMessagesParamsStatus.
+const (
+	ListOutboxMessagesParamsStatusDead    ListOutboxMessagesParamsStatus = "dead"
+	ListOutboxMessagesParamsStatusPending ListOutboxMessagesParamsStatus = "pending"
+	ListOutboxMessagesParamsStatusSent    ListOutboxMessagesParamsStatus = "sent"
+)
+
+// AcceptOrganizationInviteRequest defines model for AcceptOrganizationInviteRequest.
+type AcceptOrganizationInviteRequest struct {
 	Token string `json:"token"`
 }
 
-// UserResponse defines model for UserResponse.
-type UserResponse struct {
+// CreateInviteRequest defines model for CreateInviteRequest.
+type CreateInviteRequest struct {
+	// Email Only this email will be able to redeem the invite
+	Email *openapi_types.Email `json:"email,omitempty"`
+}
+
+// CreateOrganizationInviteRequest defines model for CreateOrganizationInviteRequest.
+type CreateOrganizationInviteRequest struct {
 	Email openapi_types.Email `json:"email"`
-	Id    openapi_types.UUID  `json:"id"`
-	Name  *string             `json:"name,omitempty"`
+
+	// Role Every role includes the ones before it
+	Role OrganizationRole `json:"role"`
 }
 
-// BadRequest defines model for BadRequest.
-type BadRequest = ErrorResponse
+// CreateSendRequest defines model for CreateSendRequest.
+type CreateSendRequest struct {
+	// ExpiresIn Seconds until the send is deleted
+	ExpiresIn int64 `json:"expiresIn"`
+	MaxViews  int   `json:"maxViews"`
 
-// InternalServerError defines model for InternalServerError.
-type InternalServerError = ErrorResponse
+	// Password Password to ask before a view, on top of the key in the link
+	Password *string `json:"password,omitempty"`
 
-// ConfirmUserParams defines parameters for ConfirmUser.
-type ConfirmUserParams struct {
-	// Code Email confirmation code
-	Code string `form:"code" json:"code"`
+	// Payload The encrypted secret
+	Payload []byte `json:"payload"`
 }
 
-// IssueTokenJSONRequestBody defines body for IssueToken for application/json ContentType.
-type IssueTokenJSONRequestBody = LoginRequest
+// CreateUserRequest defines model for CreateUserRequest.
+type CreateUserRequest struct {
+	Email openapi_types.Email `json:"email"`
 
-// CreateUserJSONRequestBody defines body for CreateUser for application/json ContentType.
-type CreateUserJSONRequestBody = CreateUserRequest
+	// InviteToken Invite token, required when registration is invite-only
+	InviteToken *string `json:"inviteToken,omitempty"`
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Logout current user
-	// (POST /logout)
-	LogoutUser(w http.ResponseWriter, r *http.Request)
-	// Refresh access and refresh tokens
-	// (POST /refresh)
-	RefreshToken(w http.ResponseWriter, r *http.Request)
-	// Issue access and refresh tokens
-	// (POST /token)
-	IssueToken(w http.ResponseWriter, r *http.Request)
-	// Get current user
-	// (GET /user)
-	GetCurrentUser(w http.ResponseWriter, r *http.Request)
-	// Create a new user
-	// (POST /user)
-	CreateUser(w http.ResponseWriter, r *http.Request)
-	// Create a new user
-	// (POST /user/confirm)
-	ConfirmUser(w http.ResponseWriter, r *http.Request, params ConfirmUserParams)
-	// Get current user's vault
-	// (GET /user/vault)
-	GetUserVault(w http.ResponseWriter, r *http.Request)
-	// Create or update current user's vault
-	// (POST /user/vault)
-	InsertUserVault(w http.ResponseWriter, r *http.Request)
-	// Get current user's vault
-	// (GET /user/vault/poll)
-	PollUserVault(w http.ResponseWriter, r *http.Request)
-	// List all users
-	// (GET /users)
-	ListUsers(w http.ResponseWriter, r *http.Request)
+	// Locale Preferred language for emails, defaults to en
+	Locale   *string `json:"locale,omitempty"`
+	Name     string  `json:"name"`
+	Password string  `json:"password"`
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
-}
+// CreateVaultRequest defines model for CreateVaultRequest.
+type CreateVaultRequest struct {
+	Name string `json:"name"`
 
-type MiddlewareFunc func(http.Handler) http.Handler
+	// Type Only a hint for the clients, for instance to pick an icon
+	Type *VaultType `json:"type,omitempty"`
+}
 
-// LogoutUser operation middleware
-func (siw *ServerInterfaceWrapper) LogoutUser(w http.ResponseWriter, r *http.Request) {
+// CreateVaultUploadRequest defines model for CreateVaultUploadRequest.
+type CreateVaultUploadRequest struct {
+	// Sha256 Hex encoded SHA-256 of the whole vault
+	Sha256 string `json:"sha256"`
 
-	ctx := r.Context()
+	// Size Size of the whole vault in bytes
+	Size int64 `json:"size"`
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// VaultId Vault the upload is committed to, the default vault when absent
+	VaultId *openapi_types.UUID `json:"vaultId,omitempty"`
+}
 
-	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+// CreateWebhookRequest defines model for CreateWebhookRequest.
+type CreateWebhookRequest struct {
+	// Events Event types to deliver, all of them when empty
+	Events *[]SecurityEventType `json:"events,omitempty"`
 
-	r = r.WithContext(ctx)
+	// Scope instance receives the events of every user and requires admin privileges
+	Scope *CreateWebhookRequestScope `json:"scope,omitempty"`
+	Url   string                     `json:"url"`
+}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.LogoutUser(w, r)
-	}))
+// CreateWebhookRequestScope instance receives the events of every user and requires admin privileges
+type CreateWebhookRequestScope string
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// EmergencyAccessListResponse defines model for EmergencyAccessListResponse.
+type EmergencyAccessListResponse struct {
+	// Granted The accesses the current user gave
+	Granted []EmergencyAccessResponse `json:"granted"`
 
-	handler.ServeHTTP(w, r)
+	// Trusted The accesses the current user was given
+	Trusted []EmergencyAccessResponse `json:"trusted"`
 }
 
-// RefreshToken operation middleware
-func (siw *ServerInterfaceWrapper) RefreshToken(w http.ResponseWriter, r *http.Request) {
+// EmergencyAccessMode view reads the vault, takeover manages it
+type EmergencyAccessMode string
 
-	ctx := r.Context()
+// EmergencyAccessResponse defines model for EmergencyAccessResponse.
+type EmergencyAccessResponse struct {
+	CreatedAt    int64  `json:"createdAt"`
+	GranteeEmail string `json:"granteeEmail"`
+	GranteeName  string `json:"granteeName"`
+	GrantorEmail string `json:"grantorEmail"`
+	GrantorName  string `json:"grantorName"`
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// GrantsAt When a pending request is granted unless rejected
+	GrantsAt *int64 `json:"grantsAt,omitempty"`
+	Id       string `json:"id"`
 
-	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+	// KeyVersion Version of the vault key wrapped for the contact
+	KeyVersion int `json:"keyVersion"`
 
-	r = r.WithContext(ctx)
+	// Mode view reads the vault, takeover manages it
+	Mode        EmergencyAccessMode   `json:"mode"`
+	RequestedAt *int64                `json:"requestedAt,omitempty"`
+	Status      EmergencyAccessStatus `json:"status"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RefreshToken(w, r)
-	}))
+	// UserKeyVersion Version of the public key of the contact it's wrapped with
+	UserKeyVersion int    `json:"userKeyVersion"`
+	VaultId        string `json:"vaultId"`
+	VaultName      string `json:"vaultName"`
+	WaitDays       int    `json:"waitDays"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// EmergencyAccessStatus defines model for EmergencyAccessStatus.
+type EmergencyAccessStatus string
 
-	handler.ServeHTTP(w, r)
+// ErrorResponse defines model for ErrorResponse.
+type ErrorResponse struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
 }
 
-// IssueToken operation middleware
-func (siw *ServerInterfaceWrapper) IssueToken(w http.ResponseWriter, r *http.Request) {
+// InviteEmergencyContactRequest defines model for InviteEmergencyContactRequest.
+type InviteEmergencyContactRequest struct {
+	Email openapi_types.Email `json:"email"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.IssueToken(w, r)
-	}))
+	// KeyVersion Current version of the vault key
+	KeyVersion int `json:"keyVersion"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Mode view reads the vault, takeover manages it
+	Mode EmergencyAccessMode `json:"mode"`
 
-	handler.ServeHTTP(w, r)
+	// UserKeyVersion Current version of the public key of the contact
+	UserKeyVersion int                `json:"userKeyVersion"`
+	VaultId        openapi_types.UUID `json:"vaultId"`
+	WaitDays       int                `json:"waitDays"`
+	WrappedKey     []byte             `json:"wrappedKey"`
 }
 
-// GetCurrentUser operation middleware
-func (siw *ServerInterfaceWrapper) GetCurrentUser(w http.ResponseWriter, r *http.Request) {
+// InviteResponse defines model for InviteResponse.
+type InviteResponse struct {
+	// CreatedAt Unix epoch timestamp (seconds since 1970-01-01T00:00:00Z)
+	CreatedAt int64                `json:"createdAt"`
+	Email     *openapi_types.Email `json:"email,omitempty"`
 
-	ctx := r.Context()
+	// ExpiresAt Unix epoch timestamp (seconds since 1970-01-01T00:00:00Z)
+	ExpiresAt int64                `json:"expiresAt"`
+	Id        openapi_types.UUID   `json:"id"`
+	Status    InviteResponseStatus `json:"status"`
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// Token Invite token, only returned when the invite is created
+	Token *string `json:"token,omitempty"`
+}
 
-	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+// InviteResponseStatus defines model for InviteResponse.Status.
+type InviteResponseStatus string
 
-	r = r.WithContext(ctx)
+// InviteVaultMemberRequest defines model for InviteVaultMemberRequest.
+type InviteVaultMemberRequest struct {
+	Email openapi_types.Email `json:"email"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCurrentUser(w, r)
-	}))
+	// KeyVersion Current version of the vault key
+	KeyVersion int `json:"keyVersion"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Role Every role includes the ones before it
+	Role VaultRole `json:"role"`
 
-	handler.ServeHTTP(w, r)
+	// UserKeyVersion Current version of the public key of the user
+	UserKeyVersion int    `json:"userKeyVersion"`
+	WrappedKey     []byte `json:"wrappedKey"`
 }
 
-// CreateUser operation middleware
-func (siw *ServerInterfaceWrapper) CreateUser(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateUser(w, r)
-	}))
+// LoginRequest defines model for LoginRequest.
+type LoginRequest struct {
+	// DeviceID Unique identifier for the client device (used for token management)
+	DeviceID string              `json:"deviceID"`
+	Email    openapi_types.Email `json:"email"`
+	Password string              `json:"password"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// OrganizationGroupRequest defines model for OrganizationGroupRequest.
+type OrganizationGroupRequest struct {
+	// MemberIds The memberships in the group, they replace the current ones
+	MemberIds *[]string `json:"memberIds,omitempty"`
+	Name      string    `json:"name"`
+}
 
-	handler.ServeHTTP(w, r)
+// OrganizationGroupResponse defines model for OrganizationGroupResponse.
+type OrganizationGroupResponse struct {
+	CreatedAt int64    `json:"createdAt"`
+	Id        string   `json:"id"`
+	MemberIds []string `json:"memberIds"`
+	Name      string   `json:"name"`
 }
 
-// ConfirmUser operation middleware
-func (siw *ServerInterfaceWrapper) ConfirmUser(w http.ResponseWriter, r *http.Request) {
+// OrganizationInviteResponse defines model for OrganizationInviteResponse.
+type OrganizationInviteResponse struct {
+	CreatedAt int64  `json:"createdAt"`
+	Email     string `json:"email"`
+	ExpiresAt int64  `json:"expiresAt"`
+	Id        string `json:"id"`
 
-	var err error
+	// Role Every role includes the ones before it
+	Role OrganizationRole `json:"role"`
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ConfirmUserParams
+	// Token Invite token, only returned when the invite is created
+	Token *string `json:"token,omitempty"`
+}
 
-	// ------------- Required query parameter "code" -------------
+// OrganizationMemberResponse defines model for OrganizationMemberResponse.
+type OrganizationMemberResponse struct {
+	CreatedAt int64  `json:"createdAt"`
+	Email     string `json:"email"`
+	Id        string `json:"id"`
+	Name      string `json:"name"`
 
-	if paramValue := r.URL.Query().Get("code"); paramValue != "" {
+	// Role Every role includes the ones before it
+	Role   OrganizationRole   `json:"role"`
+	UserId openapi_types.UUID `json:"userId"`
+}
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "code"})
-		return
-	}
+// OrganizationPolicies defines model for OrganizationPolicies.
+type OrganizationPolicies struct {
+	// AllowedEmailDomains The members must have an email in one of these domains or their subdomains, any domain when empty
+	AllowedEmailDomains []string `json:"allowedEmailDomains"`
 
-	err = runtime.BindQueryParameter("form", true, true, "code", r.URL.Query(), &params.Code)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
-		return
-	}
+	// MinKdfIterations Minimum iterations of the password hash, rounded up to a power of two. Passwords are rehashed at the next login, 0 for the default.
+	MinKdfIterations int `json:"minKdfIterations"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ConfirmUser(w, r, params)
-	}))
+	// Require2fa Can't be enabled yet, the server doesn't support two-factor authentication
+	Require2fa bool `json:"require2fa"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// SessionMaxLifetime Seconds a login lasts across token refreshes, 0 for no limit and at least 3600 otherwise
+	SessionMaxLifetime int `json:"sessionMaxLifetime"`
+}
 
-	handler.ServeHTTP(w, r)
+// OrganizationRequest defines model for OrganizationRequest.
+type OrganizationRequest struct {
+	Name string `json:"name"`
 }
 
-// GetUserVault operation middleware
-func (siw *ServerInterfaceWrapper) GetUserVault(w http.ResponseWriter, r *http.Request) {
+// OrganizationResponse defines model for OrganizationResponse.
+type OrganizationResponse struct {
+	CreatedAt int64  `json:"createdAt"`
+	Id        string `json:"id"`
 
-	ctx := r.Context()
+	// MemberId The membership of the current user
+	MemberId string `json:"memberId"`
+	Name     string `json:"name"`
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// Role Every role includes the ones before it
+	Role OrganizationRole `json:"role"`
+}
 
-	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+// OrganizationRole Every role includes the ones before it
+type OrganizationRole string
 
-	r = r.WithContext(ctx)
+// OutboxMessageResponse defines model for OutboxMessageResponse.
+type OutboxMessageResponse struct {
+	Attempts int `json:"attempts"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUserVault(w, r)
-	}))
+	// CreatedAt Unix epoch timestamp (seconds since 1970-01-01T00:00:00Z)
+	CreatedAt int64              `json:"createdAt"`
+	Id        openapi_types.UUID `json:"id"`
+	Kind      string             `json:"kind"`
+	LastError *string            `json:"lastError,omitempty"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// NextAttemptAt Unix epoch timestamp (seconds since 1970-01-01T00:00:00Z)
+	NextAttemptAt int64 `json:"nextAttemptAt"`
 
-	handler.ServeHTTP(w, r)
-}
+	// Recipient Recipient email, cleared once the message is sent
+	Recipient *string `json:"recipient,omitempty"`
 
-// InsertUserVault operation middleware
-func (siw *ServerInterfaceWrapper) InsertUserVault(w http.ResponseWriter, r *http.Request) {
+	// SentAt Unix epoch timestamp (seconds since 1970-01-01T00:00:00Z)
+	SentAt *int64                      `json:"sentAt,omitempty"`
+	Status OutboxMessageResponseStatus `json:"status"`
+}
 
-	ctx := r.Context()
+// OutboxMessageResponseStatus defines model for OutboxMessageResponse.Status.
+type OutboxMessageResponseStatus string
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+// PublicKeyResponse defines model for PublicKeyResponse.
+type PublicKeyResponse struct {
+	// Algorithm x25519 public keys are the raw 32 bytes, the others DER encoded SPKI as exported by Web Crypto
+	Algorithm UserKeyAlgorithm `json:"algorithm"`
+	CreatedAt int64            `json:"createdAt"`
 
-	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+	// Fingerprint Hex encoded SHA-256 of the public key
+	Fingerprint string             `json:"fingerprint"`
+	PublicKey   []byte             `json:"publicKey"`
+	UserId      openapi_types.UUID `json:"userId"`
+	Version     int                `json:"version"`
+}
 
-	r = r.WithContext(ctx)
+// PublishUserKeyRequest defines model for PublishUserKeyRequest.
+type PublishUserKeyRequest struct {
+	// Algorithm x25519 public keys are the raw 32 bytes, the others DER encoded SPKI as exported by Web Crypto
+	Algorithm UserKeyAlgorithm `json:"algorithm"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.InsertUserVault(w, r)
-	}))
+	// EncryptedPrivateKey Encrypted by the client, opaque to the server
+	EncryptedPrivateKey []byte `json:"encryptedPrivateKey"`
+	PublicKey           []byte `json:"publicKey"`
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	// Version The current version plus one, 1 for the first setup
+	Version int `json:"version"`
+}
 
-	handler.ServeHTTP(w, r)
+// RotateVaultKeyRequest defines model for RotateVaultKeyRequest.
+type RotateVaultKeyRequest struct {
+	KeyVersion int              `json:"keyVersion"`
+	Keys       []VaultMemberKey `json:"keys"`
 }
 
-// PollUserVault operation middleware
-func (siw *ServerInterfaceWrapper) PollUserVault(w http.ResponseWriter, r *http.Request) {
+// SecurityEventListResponse defines model for SecurityEventListResponse.
+type SecurityEventListResponse struct {
+	Events []SecurityEventResponse `json:"events"`
 
-	ctx := r.Context()
+	// NextCursor Cursor of the next page, missing on the last one
+	NextCursor *openapi_types.UUID `json:"nextCursor,omitempty"`
+}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+// SecurityEventResponse defines model for SecurityEventResponse.
+type SecurityEventResponse struct {
+	// CreatedAt Unix epoch timestamp (seconds since 1970-01-01T00:00:00Z)
+	CreatedAt int64              `json:"createdAt"`
+	DeviceId  string             `json:"deviceId"`
+	Id        openapi_types.UUID `json:"id"`
+	Ip        string             `json:"ip"`
+	Metadata  map[string]string  `json:"metadata"`
+	Type      SecurityEventType  `json:"type"`
+	UserAgent string             `json:"userAgent"`
+}
 
-	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+// SecurityEventType defines model for SecurityEventType.
+type SecurityEventType string
 
-	r = r.WithContext(ctx)
+// SendContentResponse defines model for SendContentResponse.
+type SendContentResponse struct {
+	ExpiresAt int64  `json:"expiresAt"`
+	Payload   []byte `json:"payload"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PollUserVault(w, r)
-	}))
+	// ViewsLeft 0 when this was the last view
+	ViewsLeft int `json:"viewsLeft"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// SendResponse defines model for SendResponse.
+type SendResponse struct {
+	CreatedAt   int64  `json:"createdAt"`
+	ExpiresAt   int64  `json:"expiresAt"`
+	HasPassword bool   `json:"hasPassword"`
+	Id          string `json:"id"`
+	MaxViews    int    `json:"maxViews"`
+	ViewsLeft   int    `json:"viewsLeft"`
+}
 
-	handler.ServeHTTP(w, r)
+// TokenResponse defines model for TokenResponse.
+type TokenResponse struct {
+	// Token Base64 representation of the token
+	Token string `json:"token"`
 }
 
-// ListUsers operation middleware
-func (siw *ServerInterfaceWrapper) ListUsers(w http.ResponseWriter, r *http.Request) {
+// UpdateEmergencyAccessKeyRequest defines model for UpdateEmergencyAccessKeyRequest.
+type UpdateEmergencyAccessKeyRequest struct {
+	// KeyVersion Current version of the vault key
+	KeyVersion int `json:"keyVersion"`
 
-	ctx := r.Context()
+	// UserKeyVersion Current version of the public key of the contact
+	UserKeyVersion int    `json:"userKeyVersion"`
+	WrappedKey     []byte `json:"wrappedKey"`
+}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+// UpdateOrganizationMemberRequest defines model for UpdateOrganizationMemberRequest.
+type UpdateOrganizationMemberRequest struct {
+	// Role Every role includes the ones before it
+	Role OrganizationRole `json:"role"`
+}
 
-	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+// UpdateVaultMemberRequest defines model for UpdateVaultMemberRequest.
+type UpdateVaultMemberRequest struct {
+	// Role Every role includes the ones before it
+	Role VaultRole `json:"role"`
+}
 
-	r = r.WithContext(ctx)
+// UpdateVaultRequest defines model for UpdateVaultRequest.
+type UpdateVaultRequest struct {
+	Name *string `json:"name,omitempty"`
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListUsers(w, r)
-	}))
+	// Type Only a hint for the clients, for instance to pick an icon
+	Type *VaultType `json:"type,omitempty"`
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+// UserKeyAlgorithm x25519 public keys are the raw 32 bytes, the others DER encoded SPKI as exported by Web Crypto
+type UserKeyAlgorithm string
 
-	handler.ServeHTTP(w, r)
-}
+// UserKeyConflictResponse defines model for UserKeyConflictResponse.
+type UserKeyConflictResponse struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
+	// Version Current version, 0 before the first setup
+	Version int `json:"version"`
 }
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+// UserKeyResponse defines model for UserKeyResponse.
+type UserKeyResponse struct {
+	// Algorithm x25519 public keys are the raw 32 bytes, the others DER encoded SPKI as exported by Web Crypto
+	Algorithm           UserKeyAlgorithm `json:"algorithm"`
+	CreatedAt           int64            `json:"createdAt"`
+	EncryptedPrivateKey []byte           `json:"encryptedPrivateKey"`
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
+	// Fingerprint Hex encoded SHA-256 of the public key
+	Fingerprint string `json:"fingerprint"`
+	PublicKey   []byte `json:"publicKey"`
+	Version     int    `json:"version"`
 }
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
+// UserResponse defines model for UserResponse.
+type UserResponse struct {
+	Email  openapi_types.Email `json:"email"`
+	Id     openapi_types.UUID  `json:"id"`
+	Locale *string             `json:"locale,omitempty"`
+	Name   *string             `json:"name,omitempty"`
 }
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+// VaultConflictResponse defines model for VaultConflictResponse.
+type VaultConflictResponse struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
+	// Revision Current revision of the vault
+	Revision int64 `json:"revision"`
 }
 
-type RequiredParamError struct {
-	ParamName string
-}
+// VaultIntegrityProblem defines model for VaultIntegrityProblem.
+type VaultIntegrityProblem struct {
+	Error *string `json:"error,omitempty"`
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	// RestoredFrom Version the vault was rolled back to
+	RestoredFrom *int64 `json:"restoredFrom,omitempty"`
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	// RestoredRevision Revision the restored content was written as
+	RestoredRevision *int64 `json:"restoredRevision,omitempty"`
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	// Revision Revision of the vault that failed verification
+	Revision int64 `json:"revision"`
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
+	// Status restored vaults were rolled back, unreadable ones couldn't be read from storage, which may be temporary
+	Status  VaultIntegrityProblemStatus `json:"status"`
+	UserId  string                      `json:"userId"`
+	VaultId string                      `json:"vaultId"`
 }
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+// VaultIntegrityProblemStatus restored vaults were rolled back, unreadable ones couldn't be read from storage, which may be temporary
+type VaultIntegrityProblemStatus string
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+// VaultItem defines model for VaultItem.
+type VaultItem struct {
+	// Data Encrypted item, missing on tombstones
+	Data     *[]byte            `json:"data,omitempty"`
+	Deleted  bool               `json:"deleted"`
+	Id       openapi_types.UUID `json:"id"`
+	Revision int64              `json:"revision"`
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
+	// UpdatedAt Unix epoch timestamp (seconds since 1970-01-01T00:00:00Z)
+	UpdatedAt int64 `json:"updatedAt"`
 }
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+// VaultItemChange defines model for VaultItemChange.
+type VaultItemChange struct {
+	// BaseRevision Revision the change was made on, 0 for a new item
+	BaseRevision int64 `json:"baseRevision"`
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+	// Data Encrypted item, required unless deleted
+	Data    *[]byte `json:"data,omitempty"`
+	Deleted *bool   `json:"deleted,omitempty"`
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{})
+	// Id Generated by the client when creating the item
+	Id openapi_types.UUID `json:"id"`
 }
 
-// ServeMux is an abstraction of http.ServeMux.
-type ServeMux interface {
-	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
-	ServeHTTP(w http.ResponseWriter, r *http.Request)
-}
+// VaultItemResult defines model for VaultItemResult.
+type VaultItemResult struct {
+	Current *VaultItem         `json:"current,omitempty"`
+	Id      openapi_types.UUID `json:"id"`
 
-type StdHTTPServerOptions struct {
-	BaseURL          string
-	BaseRouter       ServeMux
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	// Revision New revision when applied, current one on a conflict, 0 if the item doesn't exist
+	Revision int64                 `json:"revision"`
+	Status   VaultItemResultStatus `json:"status"`
 }
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseRouter: m,
-	})
-}
+// VaultItemResultStatus defines model for VaultItemResult.Status.
+type VaultItemResultStatus string
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: m,
-	})
+// VaultKeyResponse defines model for VaultKeyResponse.
+type VaultKeyResponse struct {
+	KeyVersion       int  `json:"keyVersion"`
+	RotationRequired bool `json:"rotationRequired"`
+
+	// UserKeyVersion Version of the keypair to unwrap it with
+	UserKeyVersion int     `json:"userKeyVersion"`
+	WrappedKey     *[]byte `json:"wrappedKey,omitempty"`
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
-	m := options.BaseRouter
+// VaultMemberKey defines model for VaultMemberKey.
+type VaultMemberKey struct {
+	MemberId       string `json:"memberId"`
+	UserKeyVersion int    `json:"userKeyVersion"`
+	WrappedKey     []byte `json:"wrappedKey"`
+}
 
-	if m == nil {
-		m = http.NewServeMux()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
+// VaultMemberResponse defines model for VaultMemberResponse.
+type VaultMemberResponse struct {
+	AcceptedAt *int64 `json:"acceptedAt,omitempty"`
+	CreatedAt  int64  `json:"createdAt"`
+	Email      string `json:"email"`
+	Id         string `json:"id"`
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
-	}
+	// KeyVersion Version of the vault key wrapped for the member
+	KeyVersion int    `json:"keyVersion"`
+	Name       string `json:"name"`
 
-	m.HandleFunc("POST "+options.BaseURL+"/logout", wrapper.LogoutUser)
-	m.HandleFunc("POST "+options.BaseURL+"/refresh", wrapper.RefreshToken)
-	m.HandleFunc("POST "+options.BaseURL+"/token", wrapper.IssueToken)
-	m.HandleFunc("GET "+options.BaseURL+"/user", wrapper.GetCurrentUser)
-	m.HandleFunc("POST "+options.BaseURL+"/user", wrapper.CreateUser)
-	m.HandleFunc("POST "+options.BaseURL+"/user/confirm", wrapper.ConfirmUser)
-	m.HandleFunc("GET "+options.BaseURL+"/user/vault", wrapper.GetUserVault)
-	m.HandleFunc("POST "+options.BaseURL+"/user/vault", wrapper.InsertUserVault)
-	m.HandleFunc("GET "+options.BaseURL+"/user/vault/poll", wrapper.PollUserVault)
-	m.HandleFunc("GET "+options.BaseURL+"/users", wrapper.ListUsers)
+	// Role Every role includes the ones before it
+	Role   VaultRole          `json:"role"`
+	Status VaultMemberStatus  `json:"status"`
+	UserId openapi_types.UUID `json:"userId"`
 
-	return m
+	// UserKeyVersion Version of the public key of the member it's wrapped with
+	UserKeyVersion int `json:"userKeyVersion"`
 }
 
-type BadRequestJSONResponse ErrorResponse
-
-type InternalServerErrorJSONResponse ErrorResponse
+// VaultMemberStatus defines model for VaultMemberStatus.
+type VaultMemberStatus string
 
-type LogoutUserRequestObject struct {
-}
+// VaultResponse defines model for VaultResponse.
+type VaultResponse struct {
+	// ContentDigest SHA-256 digest of the content (RFC 9530), absent without content
+	ContentDigest *string `json:"contentDigest,omitempty"`
+	CreatedAt     int64   `json:"createdAt"`
+	Id            string  `json:"id"`
 
-type LogoutUserResponseObject interface {
-	VisitLogoutUserResponse(w http.ResponseWriter) error
-}
+	// IsDefault The vault of the /user/vault endpoints
+	IsDefault bool `json:"isDefault"`
 
-type LogoutUser204ResponseHeaders struct {
-	SetCookie string
-}
+	// KeyVersion Version of the vault key, 0 for vaults that aren't shared
+	KeyVersion       int               `json:"keyVersion"`
+	MembershipStatus VaultMemberStatus `json:"membershipStatus"`
+	Name             string            `json:"name"`
 
-type LogoutUser204Response struct {
-	Headers LogoutUser204ResponseHeaders
-}
+	// OrganizationId The organization the vault is shared in
+	OrganizationId *string `json:"organizationId,omitempty"`
 
-func (response LogoutUser204Response) VisitLogoutUserResponse(w http.ResponseWriter) error {
-	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
-	w.WriteHeader(204)
-	return nil
-}
+	// Revision Current revision, 0 until the content is first written
+	Revision int64 `json:"revision"`
 
-type LogoutUser401JSONResponse ErrorResponse
+	// Role Every role includes the ones before it
+	Role VaultRole `json:"role"`
 
-func (response LogoutUser401JSONResponse) VisitLogoutUserResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// RotationRequired A member who had the key left, it must be rotated
+	RotationRequired bool  `json:"rotationRequired"`
+	Size             int64 `json:"size"`
 
-	return json.NewEncoder(w).Encode(response)
+	// Type Only a hint for the clients, for instance to pick an icon
+	Type      VaultType `json:"type"`
+	UpdatedAt int64     `json:"updatedAt"`
 }
 
-type LogoutUser500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+// VaultRole Every role includes the ones before it
+type VaultRole string
 
-func (response LogoutUser500JSONResponse) VisitLogoutUserResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// VaultSyncRequest defines model for VaultSyncRequest.
+type VaultSyncRequest struct {
+	Changes *[]VaultItemChange `json:"changes,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Cursor Cursor of the last sync, 0 for everything
+	Cursor int64 `json:"cursor"`
 
-type RefreshTokenRequestObject struct {
+	// Limit Maximum number of changed items to return, defaults to 500
+	Limit *int `json:"limit,omitempty"`
 }
 
-type RefreshTokenResponseObject interface {
-	VisitRefreshTokenResponse(w http.ResponseWriter) error
-}
+// VaultSyncResponse defines model for VaultSyncResponse.
+type VaultSyncResponse struct {
+	// Cursor Cursor to send on the next sync
+	Cursor  int64       `json:"cursor"`
+	HasMore bool        `json:"hasMore"`
+	Items   []VaultItem `json:"items"`
 
-type RefreshToken200ResponseHeaders struct {
-	SetCookie string
+	// Results Outcome of each change, in request order
+	Results []VaultItemResult `json:"results"`
 }
 
-type RefreshToken200JSONResponse struct {
-	Body    TokenResponse
-	Headers RefreshToken200ResponseHeaders
-}
+// VaultType Only a hint for the clients, for instance to pick an icon
+type VaultType string
 
-func (response RefreshToken200JSONResponse) VisitRefreshTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
-	w.WriteHeader(200)
+// VaultUploadResponse defines model for VaultUploadResponse.
+type VaultUploadResponse struct {
+	// ExpiresAt Unix timestamp, pushed back by every chunk
+	ExpiresAt int64  `json:"expiresAt"`
+	Id        string `json:"id"`
 
-	return json.NewEncoder(w).Encode(response.Body)
+	// Offset Bytes received so far, where the next chunk starts
+	Offset int64 `json:"offset"`
+	Size   int64 `json:"size"`
+
+	// VaultId Absent for the default vault
+	VaultId *string `json:"vaultId,omitempty"`
 }
 
-type RefreshToken401JSONResponse ErrorResponse
+// VaultUsageResponse defines model for VaultUsageResponse.
+type VaultUsageResponse struct {
+	// HistoryBytes Size of the kept versions, the current one excluded
+	HistoryBytes int64 `json:"historyBytes"`
 
-func (response RefreshToken401JSONResponse) VisitRefreshTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	// ItemBytes Size of the synced items
+	ItemBytes int64 `json:"itemBytes"`
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// MaxVaultBytes Largest vault upload accepted
+	MaxVaultBytes int64 `json:"maxVaultBytes"`
+	QuotaBytes    int64 `json:"quotaBytes"`
 
-type RefreshToken500JSONResponse struct {
-	InternalServerErrorJSONResponse
+	// UsedBytes Total counted towards the quota
+	UsedBytes int64 `json:"usedBytes"`
+
+	// VaultBytes Size of the current vault
+	VaultBytes int64 `json:"vaultBytes"`
 }
 
-func (response RefreshToken500JSONResponse) VisitRefreshTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// VaultVerifyResponse defines model for VaultVerifyResponse.
+type VaultVerifyResponse struct {
+	// Checked How many vaults were verified
+	Checked int `json:"checked"`
 
-	return json.NewEncoder(w).Encode(response)
+	// Next Cursor of the next batch, absent once every vault was verified
+	Next     *string                 `json:"next,omitempty"`
+	Problems []VaultIntegrityProblem `json:"problems"`
 }
 
-type IssueTokenRequestObject struct {
-	Body *IssueTokenJSONRequestBody
-}
+// VaultVersionResponse defines model for VaultVersionResponse.
+type VaultVersionResponse struct {
+	// CreatedAt Unix epoch timestamp (seconds since 1970-01-01T00:00:00Z)
+	CreatedAt int64 `json:"createdAt"`
 
-type IssueTokenResponseObject interface {
-	VisitIssueTokenResponse(w http.ResponseWriter) error
-}
+	// DeviceId Device that uploaded the version, empty when unknown
+	DeviceId string `json:"deviceId"`
+	Revision int64  `json:"revision"`
 
-type IssueToken200ResponseHeaders struct {
-	SetCookie string
-}
+	// Sha256 Hex encoded SHA-256 of the vault
+	Sha256 string `json:"sha256"`
 
-type IssueToken200JSONResponse struct {
-	Body    TokenResponse
-	Headers IssueToken200ResponseHeaders
+	// Size Size of the vault in bytes
+	Size int `json:"size"`
 }
 
-func (response IssueToken200JSONResponse) VisitIssueTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
-	w.WriteHeader(200)
+// WebhookDeliveryResponse defines model for WebhookDeliveryResponse.
+type WebhookDeliveryResponse struct {
+	Attempts int `json:"attempts"`
 
-	return json.NewEncoder(w).Encode(response.Body)
-}
+	// CreatedAt Unix epoch timestamp (seconds since 1970-01-01T00:00:00Z)
+	CreatedAt int64 `json:"createdAt"`
 
-type IssueToken400JSONResponse struct{ BadRequestJSONResponse }
+	// DeliveredAt Unix epoch timestamp (seconds since 1970-01-01T00:00:00Z)
+	DeliveredAt *int64             `json:"deliveredAt,omitempty"`
+	Event       SecurityEventType  `json:"event"`
+	Id          openapi_types.UUID `json:"id"`
+	LastError   *string            `json:"lastError,omitempty"`
 
-func (response IssueToken400JSONResponse) VisitIssueTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// NextAttemptAt Unix epoch timestamp (seconds since 1970-01-01T00:00:00Z)
+	NextAttemptAt int64 `json:"nextAttemptAt"`
 
-	return json.NewEncoder(w).Encode(response)
+	// ResponseStatus HTTP status of the last attempt, missing when there was no response
+	ResponseStatus *int                          `json:"responseStatus,omitempty"`
+	Status         WebhookDeliveryResponseStatus `json:"status"`
 }
 
-type IssueToken401JSONResponse ErrorResponse
+// WebhookDeliveryResponseStatus defines model for WebhookDeliveryResponse.Status.
+type WebhookDeliveryResponseStatus string
 
-func (response IssueToken401JSONResponse) VisitIssueTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// WebhookResponse defines model for WebhookResponse.
+type WebhookResponse struct {
+	// CreatedAt Unix epoch timestamp (seconds since 1970-01-01T00:00:00Z)
+	CreatedAt int64                `json:"createdAt"`
+	Events    []SecurityEventType  `json:"events"`
+	Id        openapi_types.UUID   `json:"id"`
+	Scope     WebhookResponseScope `json:"scope"`
 
-	return json.NewEncoder(w).Encode(response)
+	// Secret HMAC-SHA256 key of the X-Signature header, only returned on creation
+	Secret *string `json:"secret,omitempty"`
+	Url    string  `json:"url"`
 }
 
-type IssueToken500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+// WebhookResponseScope defines model for WebhookResponse.Scope.
+type WebhookResponseScope string
 
-func (response IssueToken500JSONResponse) VisitIssueTokenResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// EmergencyAccessID defines model for EmergencyAccessID.
+type EmergencyAccessID = openapi_types.UUID
 
-	return json.NewEncoder(w).Encode(response)
-}
+// OrganizationGroupID defines model for OrganizationGroupID.
+type OrganizationGroupID = openapi_types.UUID
 
-type GetCurrentUserRequestObject struct {
-}
+// OrganizationID defines model for OrganizationID.
+type OrganizationID = openapi_types.UUID
 
-type GetCurrentUserResponseObject interface {
-	VisitGetCurrentUserResponse(w http.ResponseWriter) error
-}
+// OrganizationInviteID defines model for OrganizationInviteID.
+type OrganizationInviteID = openapi_types.UUID
 
-type GetCurrentUser200JSONResponse UserResponse
+// OrganizationMemberID defines model for OrganizationMemberID.
+type OrganizationMemberID = openapi_types.UUID
 
-func (response GetCurrentUser200JSONResponse) VisitGetCurrentUserResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// SendID defines model for SendID.
+type SendID = string
 
-	return json.NewEncoder(w).Encode(response)
-}
+// VaultID defines model for VaultID.
+type VaultID = openapi_types.UUID
 
-type GetCurrentUser401JSONResponse ErrorResponse
+// VaultMemberID defines model for VaultMemberID.
+type VaultMemberID = openapi_types.UUID
 
-func (response GetCurrentUser401JSONResponse) VisitGetCurrentUserResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// VaultRevision defines model for VaultRevision.
+type VaultRevision = int64
 
-	return json.NewEncoder(w).Encode(response)
-}
+// BadRequest defines model for BadRequest.
+type BadRequest = ErrorResponse
 
-type GetCurrentUser500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+// Forbidden defines model for Forbidden.
+type Forbidden = ErrorResponse
 
-func (response GetCurrentUser500JSONResponse) VisitGetCurrentUserResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// InternalServerError defines model for InternalServerError.
+type InternalServerError = ErrorResponse
 
-	return json.NewEncoder(w).Encode(response)
+// NotFound defines model for NotFound.
+type NotFound = ErrorResponse
+
+// PayloadTooLarge defines model for PayloadTooLarge.
+type PayloadTooLarge = ErrorResponse
+
+// QuotaExceeded defines model for QuotaExceeded.
+type QuotaExceeded = ErrorResponse
+
+// TooManyRequests defines model for TooManyRequests.
+type TooManyRequests = ErrorResponse
+
+// Unauthorized defines model for Unauthorized.
+type Unauthorized = ErrorResponse
+
+// ListOutboxMessagesParams defines parameters for ListOutboxMessages.
+type ListOutboxMessagesParams struct {
+	// Status Message status, defaults to dead
+	Status *ListOutboxMessagesParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+	Limit  *int                            `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
-type CreateUserRequestObject struct {
-	Body *CreateUserJSONRequestBody
+// ListOutboxMessagesParamsStatus defines parameters for ListOutboxMessages.
+type ListOutboxMessagesParamsStatus string
+
+// VerifyVaultsParams defines parameters for VerifyVaults.
+type VerifyVaultsParams struct {
+	// After Cursor returned by the previous batch
+	After *string `form:"after,omitempty" json:"after,omitempty"`
+	Limit *int    `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Rollback Restore corrupted vaults from their newest intact version
+	Rollback *bool `form:"rollback,omitempty" json:"rollback,omitempty"`
 }
 
-type CreateUserResponseObject interface {
-	VisitCreateUserResponse(w http.ResponseWriter) error
+// ViewSendParams defines parameters for ViewSend.
+type ViewSendParams struct {
+	// XSendPassword Password of the send, when it has one
+	XSendPassword *string `json:"X-Send-Password,omitempty"`
 }
 
-type CreateUser201Response struct {
+// ConfirmUserParams defines parameters for ConfirmUser.
+type ConfirmUserParams struct {
+	// Code Email confirmation code
+	Code string `form:"code" json:"code"`
 }
 
-func (response CreateUser201Response) VisitCreateUserResponse(w http.ResponseWriter) error {
-	w.WriteHeader(201)
-	return nil
+// ListUserEventsParams defines parameters for ListUserEvents.
+type ListUserEventsParams struct {
+	// Type Only return events of these types
+	Type *[]SecurityEventType `form:"type,omitempty" json:"type,omitempty"`
+
+	// From Only events at or after this Unix epoch timestamp
+	From *int64 `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Only events before this Unix epoch timestamp
+	To     *int64              `form:"to,omitempty" json:"to,omitempty"`
+	Cursor *openapi_types.UUID `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Limit  *int                `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
-type CreateUser400JSONResponse struct{ BadRequestJSONResponse }
+// GetUserVaultParams defines parameters for GetUserVault.
+type GetUserVaultParams struct {
+	// IfNoneMatch ETags of the vault the client already has, or *
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
 
-func (response CreateUser400JSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// IfModifiedSince Update time of the vault the client already has, as an HTTP date
+	IfModifiedSince *string `json:"If-Modified-Since,omitempty"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+// InsertUserVaultParams defines parameters for InsertUserVault.
+type InsertUserVaultParams struct {
+	// IfMatch ETag of the revision the upload is based on, required
+	IfMatch *string `json:"If-Match,omitempty"`
+
+	// ContentDigest SHA-256 digest of the body (RFC 9530)
+	ContentDigest *string `json:"Content-Digest,omitempty"`
 }
 
-type CreateUser500JSONResponse struct {
-	InternalServerErrorJSONResponse
+// StreamVaultEventsParams defines parameters for StreamVaultEvents.
+type StreamVaultEventsParams struct {
+	// LastEventID ID of the last event received, sent by EventSource on reconnect
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
 }
 
-func (response CreateUser500JSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// PollUserVaultParams defines parameters for PollUserVault.
+type PollUserVaultParams struct {
+	// Since Unix epoch timestamp, the updatedAt the client already has
+	Since *int64 `form:"since,omitempty" json:"since,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
+	// Wait How long to wait for an update, capped by the server
+	Wait *string `form:"wait,omitempty" json:"wait,omitempty"`
 }
 
-type ConfirmUserRequestObject struct {
-	Params ConfirmUserParams
+// AppendVaultUploadParams defines parameters for AppendVaultUpload.
+type AppendVaultUploadParams struct {
+	Offset int64 `form:"offset" json:"offset"`
 }
 
-type ConfirmUserResponseObject interface {
-	VisitConfirmUserResponse(w http.ResponseWriter) error
+// CommitVaultUploadParams defines parameters for CommitVaultUpload.
+type CommitVaultUploadParams struct {
+	// IfMatch ETag of the revision the upload is based on, required
+	IfMatch *string `json:"If-Match,omitempty"`
 }
 
-type ConfirmUser200JSONResponse UserResponse
+// RestoreVaultVersionParams defines parameters for RestoreVaultVersion.
+type RestoreVaultVersionParams struct {
+	// IfMatch ETag of the current vault, required
+	IfMatch *string `json:"If-Match,omitempty"`
+}
 
-func (response ConfirmUser200JSONResponse) VisitConfirmUserResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetVaultContentParams defines parameters for GetVaultContent.
+type GetVaultContentParams struct {
+	// IfNoneMatch ETags of the vault the client already has, or *
+	IfNoneMatch *string `json:"If-None-Match,omitempty"`
 
-	return json.NewEncoder(w).Encode(response)
+	// IfModifiedSince Update time of the vault the client already has, as an HTTP date
+	IfModifiedSince *string `json:"If-Modified-Since,omitempty"`
 }
 
-type ConfirmUser400JSONResponse struct{ BadRequestJSONResponse }
+// PutVaultContentParams defines parameters for PutVaultContent.
+type PutVaultContentParams struct {
+	// IfMatch ETag of the revision the upload is based on, required
+	IfMatch *string `json:"If-Match,omitempty"`
 
-func (response ConfirmUser400JSONResponse) VisitConfirmUserResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// ContentDigest SHA-256 digest of the body (RFC 9530)
+	ContentDigest *string `json:"Content-Digest,omitempty"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+// ListWebhookDeliveriesParams defines parameters for ListWebhookDeliveries.
+type ListWebhookDeliveriesParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
-type ConfirmUser500JSONResponse struct {
-	InternalServerErrorJSONResponse
+// LookupPublicKeyParams defines parameters for LookupPublicKey.
+type LookupPublicKeyParams struct {
+	Email  *openapi_types.Email `form:"email,omitempty" json:"email,omitempty"`
+	UserId *openapi_types.UUID  `form:"userId,omitempty" json:"userId,omitempty"`
 }
 
-func (response ConfirmUser500JSONResponse) VisitConfirmUserResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+// CreateInviteJSONRequestBody defines body for CreateInvite for application/json ContentType.
+type CreateInviteJSONRequestBody = CreateInviteRequest
 
-	return json.NewEncoder(w).Encode(response)
-}
+// CreateOrganizationJSONRequestBody defines body for CreateOrganization for application/json ContentType.
+type CreateOrganizationJSONRequestBody = OrganizationRequest
 
-type GetUserVaultRequestObject struct {
-}
+// AcceptOrganizationInviteJSONRequestBody defines body for AcceptOrganizationInvite for application/json ContentType.
+type AcceptOrganizationInviteJSONRequestBody = AcceptOrganizationInviteRequest
 
-type GetUserVaultResponseObject interface {
-	VisitGetUserVaultResponse(w http.ResponseWriter) error
-}
+// UpdateOrganizationJSONRequestBody defines body for UpdateOrganization for application/json ContentType.
+type UpdateOrganizationJSONRequestBody = OrganizationRequest
 
-type GetUserVault200MultipartResponse func(writer *multipart.Writer) error
+// CreateOrganizationGroupJSONRequestBody defines body for CreateOrganizationGroup for application/json ContentType.
+type CreateOrganizationGroupJSONRequestBody = OrganizationGroupRequest
 
-func (response GetUserVault200MultipartResponse) VisitGetUserVaultResponse(w http.ResponseWriter) error {
-	writer := multipart.NewWriter(w)
-	w.Header().Set("Content-Type", writer.FormDataContentType())
-	w.WriteHeader(200)
+// UpdateOrganizationGroupJSONRequestBody defines body for UpdateOrganizationGroup for application/json ContentType.
+type UpdateOrganizationGroupJSONRequestBody = OrganizationGroupRequest
 
-	defer writer.Close()
-	return response(writer)
+// CreateOrganizationInviteJSONRequestBody defines body for CreateOrganizationInvite for application/json ContentType.
+type CreateOrganizationInviteJSONRequestBody = CreateOrganizationInviteRequest
+
+// UpdateOrganizationMemberJSONRequestBody defines body for UpdateOrganizationMember for application/json ContentType.
+type UpdateOrganizationMemberJSONRequestBody = UpdateOrganizationMemberRequest
+
+// UpdateOrganizationPoliciesJSONRequestBody defines body for UpdateOrganizationPolicies for application/json ContentType.
+type UpdateOrganizationPoliciesJSONRequestBody = OrganizationPolicies
+
+// CreateOrganizationVaultJSONRequestBody defines body for CreateOrganizationVault for application/json ContentType.
+type CreateOrganizationVaultJSONRequestBody = CreateVaultRequest
+
+// CreateSendJSONRequestBody defines body for CreateSend for application/json ContentType.
+type CreateSendJSONRequestBody = CreateSendRequest
+
+// IssueTokenJSONRequestBody defines body for IssueToken for application/json ContentType.
+type IssueTokenJSONRequestBody = LoginRequest
+
+// CreateUserJSONRequestBody defines body for CreateUser for application/json ContentType.
+type CreateUserJSONRequestBody = CreateUserRequest
+
+// InviteEmergencyContactJSONRequestBody defines body for InviteEmergencyContact for application/json ContentType.
+type InviteEmergencyContactJSONRequestBody = InviteEmergencyContactRequest
+
+// UpdateEmergencyAccessKeyJSONRequestBody defines body for UpdateEmergencyAccessKey for application/json ContentType.
+type UpdateEmergencyAccessKeyJSONRequestBody = UpdateEmergencyAccessKeyRequest
+
+// PublishUserKeyJSONRequestBody defines body for PublishUserKey for application/json ContentType.
+type PublishUserKeyJSONRequestBody = PublishUserKeyRequest
+
+// SyncUserVaultJSONRequestBody defines body for SyncUserVault for application/json ContentType.
+type SyncUserVaultJSONRequestBody = VaultSyncRequest
+
+// CreateVaultUploadJSONRequestBody defines body for CreateVaultUpload for application/json ContentType.
+type CreateVaultUploadJSONRequestBody = CreateVaultUploadRequest
+
+// CreateVaultJSONRequestBody defines body for CreateVault for application/json ContentType.
+type CreateVaultJSONRequestBody = CreateVaultRequest
+
+// UpdateVaultJSONRequestBody defines body for UpdateVault for application/json ContentType.
+type UpdateVaultJSONRequestBody = UpdateVaultRequest
+
+// RotateVaultKeyJSONRequestBody defines body for RotateVaultKey for application/json ContentType.
+type RotateVaultKeyJSONRequestBody = RotateVaultKeyRequest
+
+// InviteVaultMemberJSONRequestBody defines body for InviteVaultMember for application/json ContentType.
+type InviteVaultMemberJSONRequestBody = InviteVaultMemberRequest
+
+// UpdateVaultMemberJSONRequestBody defines body for UpdateVaultMember for application/json ContentType.
+type UpdateVaultMemberJSONRequestBody = UpdateVaultMemberRequest
+
+// CreateWebhookJSONRequestBody defines body for CreateWebhook for application/json ContentType.
+type CreateWebhookJSONRequestBody = CreateWebhookRequest
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// List email outbox messages by status
+	// (GET /admin/outbox)
+	ListOutboxMessages(w http.ResponseWriter, r *http.Request, params ListOutboxMessagesParams)
+	// Requeue a dead-lettered outbox message
+	// (POST /admin/outbox/{messageID}/requeue)
+	RequeueOutboxMessage(w http.ResponseWriter, r *http.Request, messageID openapi_types.UUID)
+	// Verify the integrity of stored vaults
+	// (POST /admin/vaults/verify)
+	VerifyVaults(w http.ResponseWriter, r *http.Request, params VerifyVaultsParams)
+	// List invites created by the current user, or all invites for admins
+	// (GET /invites)
+	ListInvites(w http.ResponseWriter, r *http.Request)
+	// Create a single-use registration invite
+	// (POST /invites)
+	CreateInvite(w http.ResponseWriter, r *http.Request)
+	// Revoke an unused invite
+	// (DELETE /invites/{inviteID})
+	RevokeInvite(w http.ResponseWriter, r *http.Request, inviteID openapi_types.UUID)
+	// Logout current user
+	// (POST /logout)
+	LogoutUser(w http.ResponseWriter, r *http.Request)
+	// List the organizations of the current user
+	// (GET /organizations)
+	ListOrganizations(w http.ResponseWriter, r *http.Request)
+	// Create an organization
+	// (POST /organizations)
+	CreateOrganization(w http.ResponseWriter, r *http.Request)
+	// Join an organization with an emailed invite
+	// (POST /organizations/invites/accept)
+	AcceptOrganizationInvite(w http.ResponseWriter, r *http.Request)
+	// Delete an organization
+	// (DELETE /organizations/{organizationID})
+	DeleteOrganization(w http.ResponseWriter, r *http.Request, organizationID OrganizationID)
+	// Get an organization of the current user
+	// (GET /organizations/{organizationID})
+	GetOrganization(w http.ResponseWriter, r *http.Request, organizationID OrganizationID)
+	// Rename an organization
+	// (PATCH /organizations/{organizationID})
+	UpdateOrganization(w http.ResponseWriter, r *http.Request, organizationID OrganizationID)
+	// List the groups of an organization
+	// (GET /organizations/{organizationID}/groups)
+	ListOrganizationGroups(w http.ResponseWriter, r *http.Request, organizationID OrganizationID)
+	// Create a group
+	// (POST /organizations/{organizationID}/groups)
+	CreateOrganizationGroup(w http.ResponseWriter, r *http.Request, organizationID OrganizationID)
+	// Delete a group
+	// (DELETE /organizations/{organizationID}/groups/{groupID})
+	DeleteOrganizationGroup(w http.ResponseWriter, r *http.Request, organizationID OrganizationID, groupID OrganizationGroupID)
+	// Rename a group and replace its members
+	// (PUT /organizations/{organizationID}/groups/{groupID})
+	UpdateOrganizationGroup(w http.ResponseWriter, r *http.Request, organizationID OrganizationID, groupID OrganizationGroupID)
+	// List the pending invites of an organization
+	// (GET /organizations/{organizationID}/invites)
+	ListOrganizationInvites(w http.ResponseWriter, r *http.Request, organizationID OrganizationID)
+	// Invite someone to an organization by email
+	// (POST /organizations/{organizationID}/invites)
+	CreateOrganizationInvite(w http.ResponseWriter, r *http.Request, organizationID OrganizationID)
+	// Revoke a pending invite
+	// (DELETE /organizations/{organizationID}/invites/{inviteID})
+	RevokeOrganizationInvite(w http.ResponseWriter, r *http.Request, organizationID OrganizationID, inviteID OrganizationInviteID)
+	// List the members of an organization
+	// (GET /organizations/{organizationID}/members)
+	ListOrganizationMembers(w http.ResponseWriter, r *http.Request, organizationID OrganizationID)
+	// Remove a member from an organization
+	// (DELETE /organizations/{organizationID}/members/{memberID})
+	RemoveOrganizationMember(w http.ResponseWriter, r *http.Request, organizationID OrganizationID, memberID OrganizationMemberID)
+	// Change the role of a member
+	// (PATCH /organizations/{organizationID}/members/{memberID})
+	UpdateOrganizationMember(w http.ResponseWriter, r *http.Request, organizationID OrganizationID, memberID OrganizationMemberID)
+	// Get the policies of an organization
+	// (GET /organizations/{organizationID}/policies)
+	GetOrganizationPolicies(w http.ResponseWriter, r *http.Request, organizationID OrganizationID)
+	// Replace the policies of an organization
+	// (PUT /organizations/{organizationID}/policies)
+	UpdateOrganizationPolicies(w http.ResponseWriter, r *http.Request, organizationID OrganizationID)
+	// Create a vault of an organization
+	// (POST /organizations/{organizationID}/vaults)
+	CreateOrganizationVault(w http.ResponseWriter, r *http.Request, organizationID OrganizationID)
+	// Refresh access and refresh tokens
+	// (POST /refresh)
+	RefreshToken(w http.ResponseWriter, r *http.Request)
+	// List the active sends of the current user
+	// (GET /sends)
+	ListSends(w http.ResponseWriter, r *http.Request)
+	// Share a secret through a link
+	// (POST /sends)
+	CreateSend(w http.ResponseWriter, r *http.Request)
+	// Revoke a send
+	// (DELETE /sends/{sendID})
+	RevokeSend(w http.ResponseWriter, r *http.Request, sendID SendID)
+	// View a send
+	// (GET /sends/{sendID})
+	ViewSend(w http.ResponseWriter, r *http.Request, sendID SendID, params ViewSendParams)
+	// Issue access and refresh tokens
+	// (POST /token)
+	IssueToken(w http.ResponseWriter, r *http.Request)
+	// Get current user
+	// (GET /user)
+	GetCurrentUser(w http.ResponseWriter, r *http.Request)
+	// Create a new user
+	// (POST /user)
+	CreateUser(w http.ResponseWriter, r *http.Request)
+	// Create a new user
+	// (POST /user/confirm)
+	ConfirmUser(w http.ResponseWriter, r *http.Request, params ConfirmUserParams)
+	// List the emergency accesses of the current user
+	// (GET /user/emergency-access)
+	ListEmergencyAccess(w http.ResponseWriter, r *http.Request)
+	// Make a user a trusted contact for a vault
+	// (POST /user/emergency-access)
+	InviteEmergencyContact(w http.ResponseWriter, r *http.Request)
+	// Revoke an emergency access
+	// (DELETE /user/emergency-access/{emergencyAccessID})
+	RevokeEmergencyAccess(w http.ResponseWriter, r *http.Request, emergencyAccessID EmergencyAccessID)
+	// Accept to be a trusted contact
+	// (POST /user/emergency-access/{emergencyAccessID}/accept)
+	AcceptEmergencyAccess(w http.ResponseWriter, r *http.Request, emergencyAccessID EmergencyAccessID)
+	// Grant a requested emergency access without waiting
+	// (POST /user/emergency-access/{emergencyAccessID}/approve)
+	ApproveEmergencyAccess(w http.ResponseWriter, r *http.Request, emergencyAccessID EmergencyAccessID)
+	// Wrap the vault key for the contact again
+	// (PUT /user/emergency-access/{emergencyAccessID}/key)
+	UpdateEmergencyAccessKey(w http.ResponseWriter, r *http.Request, emergencyAccessID EmergencyAccessID)
+	// Reject a requested or granted emergency access
+	// (POST /user/emergency-access/{emergencyAccessID}/reject)
+	RejectEmergencyAccess(w http.ResponseWriter, r *http.Request, emergencyAccessID EmergencyAccessID)
+	// Request an emergency access
+	// (POST /user/emergency-access/{emergencyAccessID}/request)
+	RequestEmergencyAccess(w http.ResponseWriter, r *http.Request, emergencyAccessID EmergencyAccessID)
+	// List the security events of the current user
+	// (GET /user/events)
+	ListUserEvents(w http.ResponseWriter, r *http.Request, params ListUserEventsParams)
+	// List the keypairs of the current user
+	// (GET /user/keys)
+	ListUserKeys(w http.ResponseWriter, r *http.Request)
+	// Set up or rotate the keypair of the current user
+	// (POST /user/keys)
+	PublishUserKey(w http.ResponseWriter, r *http.Request)
+	// Get a version of the keypair of the current user
+	// (GET /user/keys/{version})
+	GetUserKey(w http.ResponseWriter, r *http.Request, version int)
+	// Get the policies the current user has to follow
+	// (GET /user/policies)
+	GetUserPolicies(w http.ResponseWriter, r *http.Request)
+	// Get current user's vault
+	// (GET /user/vault)
+	GetUserVault(w http.ResponseWriter, r *http.Request, params GetUserVaultParams)
+	// Create or update current user's vault
+	// (POST /user/vault)
+	InsertUserVault(w http.ResponseWriter, r *http.Request, params InsertUserVaultParams)
+	// Stream the changes of the current user's vault
+	// (GET /user/vault/events)
+	StreamVaultEvents(w http.ResponseWriter, r *http.Request, params StreamVaultEventsParams)
+	// Get current user's vault
+	// (GET /user/vault/poll)
+	PollUserVault(w http.ResponseWriter, r *http.Request, params PollUserVaultParams)
+	// Sync the items of the current user's vault
+	// (POST /user/vault/sync)
+	SyncUserVault(w http.ResponseWriter, r *http.Request)
+	// Start a resumable vault upload
+	// (POST /user/vault/uploads)
+	CreateVaultUpload(w http.ResponseWriter, r *http.Request)
+	// Abandon a vault upload
+	// (DELETE /user/vault/uploads/{uploadId})
+	CancelVaultUpload(w http.ResponseWriter, r *http.Request, uploadId string)
+	// Get the offset of a vault upload
+	// (GET /user/vault/uploads/{uploadId})
+	GetVaultUpload(w http.ResponseWriter, r *http.Request, uploadId string)
+	// Send a chunk of a vault upload
+	// (PATCH /user/vault/uploads/{uploadId})
+	AppendVaultUpload(w http.ResponseWriter, r *http.Request, uploadId string, params AppendVaultUploadParams)
+	// Store a completed vault upload
+	// (POST /user/vault/uploads/{uploadId}/commit)
+	CommitVaultUpload(w http.ResponseWriter, r *http.Request, uploadId string, params CommitVaultUploadParams)
+	// Get the storage used by the current user
+	// (GET /user/vault/usage)
+	GetVaultUsage(w http.ResponseWriter, r *http.Request)
+	// List the stored versions of the current user's vault
+	// (GET /user/vault/versions)
+	ListVaultVersions(w http.ResponseWriter, r *http.Request)
+	// Download a version of the current user's vault
+	// (GET /user/vault/versions/{revision})
+	GetVaultVersion(w http.ResponseWriter, r *http.Request, revision VaultRevision)
+	// Restore a version as the current vault
+	// (POST /user/vault/versions/{revision}/restore)
+	RestoreVaultVersion(w http.ResponseWriter, r *http.Request, revision VaultRevision, params RestoreVaultVersionParams)
+	// List the vaults of the current user
+	// (GET /user/vaults)
+	ListVaults(w http.ResponseWriter, r *http.Request)
+	// Create a named vault
+	// (POST /user/vaults)
+	CreateVault(w http.ResponseWriter, r *http.Request)
+	// Delete a vault with its versions
+	// (DELETE /user/vaults/{vaultID})
+	DeleteVault(w http.ResponseWriter, r *http.Request, vaultID VaultID)
+	// Get a vault of the current user
+	// (GET /user/vaults/{vaultID})
+	GetVault(w http.ResponseWriter, r *http.Request, vaultID VaultID)
+	// Rename a vault or change its type
+	// (PATCH /user/vaults/{vaultID})
+	UpdateVault(w http.ResponseWriter, r *http.Request, vaultID VaultID)
+	// Accept an invitation to a vault
+	// (POST /user/vaults/{vaultID}/accept)
+	AcceptVaultInvite(w http.ResponseWriter, r *http.Request, vaultID VaultID)
+	// Download the content of a vault
+	// (GET /user/vaults/{vaultID}/content)
+	GetVaultContent(w http.ResponseWriter, r *http.Request, vaultID VaultID, params GetVaultContentParams)
+	// Replace the content of a vault
+	// (PUT /user/vaults/{vaultID}/content)
+	PutVaultContent(w http.ResponseWriter, r *http.Request, vaultID VaultID, params PutVaultContentParams)
+	// Get the vault key wrapped for the current user
+	// (GET /user/vaults/{vaultID}/key)
+	GetVaultKey(w http.ResponseWriter, r *http.Request, vaultID VaultID)
+	// Set or rotate the vault key
+	// (PUT /user/vaults/{vaultID}/key)
+	RotateVaultKey(w http.ResponseWriter, r *http.Request, vaultID VaultID)
+	// List the members of a vault
+	// (GET /user/vaults/{vaultID}/members)
+	ListVaultMembers(w http.ResponseWriter, r *http.Request, vaultID VaultID)
+	// Invite a user to a vault
+	// (POST /user/vaults/{vaultID}/members)
+	InviteVaultMember(w http.ResponseWriter, r *http.Request, vaultID VaultID)
+	// Remove a member or revoke an invitation
+	// (DELETE /user/vaults/{vaultID}/members/{memberID})
+	RemoveVaultMember(w http.ResponseWriter, r *http.Request, vaultID VaultID, memberID VaultMemberID)
+	// Change the role of a member
+	// (PATCH /user/vaults/{vaultID}/members/{memberID})
+	UpdateVaultMember(w http.ResponseWriter, r *http.Request, vaultID VaultID, memberID VaultMemberID)
+	// List the webhooks of the current user
+	// (GET /user/webhooks)
+	ListWebhooks(w http.ResponseWriter, r *http.Request)
+	// Subscribe a URL to security events
+	// (POST /user/webhooks)
+	CreateWebhook(w http.ResponseWriter, r *http.Request)
+	// Delete a webhook
+	// (DELETE /user/webhooks/{webhookID})
+	DeleteWebhook(w http.ResponseWriter, r *http.Request, webhookID openapi_types.UUID)
+	// List the recent deliveries of a webhook
+	// (GET /user/webhooks/{webhookID}/deliveries)
+	ListWebhookDeliveries(w http.ResponseWriter, r *http.Request, webhookID openapi_types.UUID, params ListWebhookDeliveriesParams)
+	// List all users
+	// (GET /users)
+	ListUsers(w http.ResponseWriter, r *http.Request)
+	// Look up the public key of a user
+	// (GET /users/keys)
+	LookupPublicKey(w http.ResponseWriter, r *http.Request, params LookupPublicKeyParams)
 }
 
-type GetUserVault200SchemaResponse struct {
-	Body          io.Reader
-	ContentLength int64
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
 }
 
-func (response GetUserVault200SchemaResponse) VisitGetUserVaultResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "schema")
-	if response.ContentLength != 0 {
-		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// ListOutboxMessages operation middleware
+func (siw *ServerInterfaceWrapper) ListOutboxMessages(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListOutboxMessagesParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
 	}
-	w.WriteHeader(200)
 
-	if closer, ok := response.Body.(io.ReadCloser); ok {
-		defer closer.Close()
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
 	}
-	_, err := io.Copy(w, response.Body)
-	return err
-}
 
-type GetUserVault401JSONResponse ErrorResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListOutboxMessages(w, r, params)
+	}))
 
-func (response GetUserVault401JSONResponse) VisitGetUserVaultResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetUserVault404JSONResponse ErrorResponse
+// RequeueOutboxMessage operation middleware
+func (siw *ServerInterfaceWrapper) RequeueOutboxMessage(w http.ResponseWriter, r *http.Request) {
 
-func (response GetUserVault404JSONResponse) VisitGetUserVaultResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "messageID" -------------
+	var messageID openapi_types.UUID
 
-type GetUserVault500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "messageID", r.PathValue("messageID"), &messageID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "messageID", Err: err})
+		return
+	}
 
-func (response GetUserVault500JSONResponse) VisitGetUserVaultResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type InsertUserVaultRequestObject struct {
-	Body io.Reader
-}
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
 
-type InsertUserVaultResponseObject interface {
-	VisitInsertUserVaultResponse(w http.ResponseWriter) error
-}
+	r = r.WithContext(ctx)
 
-type InsertUserVault204Response struct {
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RequeueOutboxMessage(w, r, messageID)
+	}))
 
-func (response InsertUserVault204Response) VisitInsertUserVaultResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type InsertUserVault401JSONResponse ErrorResponse
+// VerifyVaults operation middleware
+func (siw *ServerInterfaceWrapper) VerifyVaults(w http.ResponseWriter, r *http.Request) {
 
-func (response InsertUserVault401JSONResponse) VisitInsertUserVaultResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx := r.Context()
 
-type InsertUserVault500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response InsertUserVault500JSONResponse) VisitInsertUserVaultResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	r = r.WithContext(ctx)
 
-type PollUserVaultRequestObject struct {
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params VerifyVaultsParams
 
-type PollUserVaultResponseObject interface {
-	VisitPollUserVaultResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "after" -------------
 
-type PollUserVault200JSONResponse struct {
-	// UpdatedAt Unix epoch timestamp (seconds since 1970-01-01T00:00:00Z)
-	UpdatedAt int64 `json:"updatedAt"`
-}
+	err = runtime.BindQueryParameter("form", true, false, "after", r.URL.Query(), &params.After)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "after", Err: err})
+		return
+	}
 
-func (response PollUserVault200JSONResponse) VisitPollUserVaultResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "rollback" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "rollback", r.URL.Query(), &params.Rollback)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rollback", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.VerifyVaults(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListInvites operation middleware
+func (siw *ServerInterfaceWrapper) ListInvites(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListInvites(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateInvite operation middleware
+func (siw *ServerInterfaceWrapper) CreateInvite(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateInvite(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RevokeInvite operation middleware
+func (siw *ServerInterfaceWrapper) RevokeInvite(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "inviteID" -------------
+	var inviteID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "inviteID", r.PathValue("inviteID"), &inviteID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "inviteID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeInvite(w, r, inviteID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// LogoutUser operation middleware
+func (siw *ServerInterfaceWrapper) LogoutUser(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.LogoutUser(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListOrganizations operation middleware
+func (siw *ServerInterfaceWrapper) ListOrganizations(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListOrganizations(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateOrganization operation middleware
+func (siw *ServerInterfaceWrapper) CreateOrganization(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateOrganization(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AcceptOrganizationInvite operation middleware
+func (siw *ServerInterfaceWrapper) AcceptOrganizationInvite(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AcceptOrganizationInvite(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteOrganization operation middleware
+func (siw *ServerInterfaceWrapper) DeleteOrganization(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "organizationID" -------------
+	var organizationID OrganizationID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationID", r.PathValue("organizationID"), &organizationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteOrganization(w, r, organizationID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetOrganization operation middleware
+func (siw *ServerInterfaceWrapper) GetOrganization(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "organizationID" -------------
+	var organizationID OrganizationID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationID", r.PathValue("organizationID"), &organizationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOrganization(w, r, organizationID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateOrganization operation middleware
+func (siw *ServerInterfaceWrapper) UpdateOrganization(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "organizationID" -------------
+	var organizationID OrganizationID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationID", r.PathValue("organizationID"), &organizationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateOrganization(w, r, organizationID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListOrganizationGroups operation middleware
+func (siw *ServerInterfaceWrapper) ListOrganizationGroups(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "organizationID" -------------
+	var organizationID OrganizationID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationID", r.PathValue("organizationID"), &organizationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListOrganizationGroups(w, r, organizationID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateOrganizationGroup operation middleware
+func (siw *ServerInterfaceWrapper) CreateOrganizationGroup(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "organizationID" -------------
+	var organizationID OrganizationID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationID", r.PathValue("organizationID"), &organizationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateOrganizationGroup(w, r, organizationID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteOrganizationGroup operation middleware
+func (siw *ServerInterfaceWrapper) DeleteOrganizationGroup(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "organizationID" -------------
+	var organizationID OrganizationID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationID", r.PathValue("organizationID"), &organizationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "groupID" -------------
+	var groupID OrganizationGroupID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "groupID", r.PathValue("groupID"), &groupID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteOrganizationGroup(w, r, organizationID, groupID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateOrganizationGroup operation middleware
+func (siw *ServerInterfaceWrapper) UpdateOrganizationGroup(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "organizationID" -------------
+	var organizationID OrganizationID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationID", r.PathValue("organizationID"), &organizationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "groupID" -------------
+	var groupID OrganizationGroupID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "groupID", r.PathValue("groupID"), &groupID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateOrganizationGroup(w, r, organizationID, groupID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListOrganizationInvites operation middleware
+func (siw *ServerInterfaceWrapper) ListOrganizationInvites(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "organizationID" -------------
+	var organizationID OrganizationID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationID", r.PathValue("organizationID"), &organizationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListOrganizationInvites(w, r, organizationID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateOrganizationInvite operation middleware
+func (siw *ServerInterfaceWrapper) CreateOrganizationInvite(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "organizationID" -------------
+	var organizationID OrganizationID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationID", r.PathValue("organizationID"), &organizationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateOrganizationInvite(w, r, organizationID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RevokeOrganizationInvite operation middleware
+func (siw *ServerInterfaceWrapper) RevokeOrganizationInvite(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "organizationID" -------------
+	var organizationID OrganizationID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationID", r.PathValue("organizationID"), &organizationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "inviteID" -------------
+	var inviteID OrganizationInviteID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "inviteID", r.PathValue("inviteID"), &inviteID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "inviteID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeOrganizationInvite(w, r, organizationID, inviteID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListOrganizationMembers operation middleware
+func (siw *ServerInterfaceWrapper) ListOrganizationMembers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "organizationID" -------------
+	var organizationID OrganizationID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationID", r.PathValue("organizationID"), &organizationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListOrganizationMembers(w, r, organizationID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RemoveOrganizationMember operation middleware
+func (siw *ServerInterfaceWrapper) RemoveOrganizationMember(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "organizationID" -------------
+	var organizationID OrganizationID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationID", r.PathValue("organizationID"), &organizationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "memberID" -------------
+	var memberID OrganizationMemberID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "memberID", r.PathValue("memberID"), &memberID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "memberID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemoveOrganizationMember(w, r, organizationID, memberID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateOrganizationMember operation middleware
+func (siw *ServerInterfaceWrapper) UpdateOrganizationMember(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "organizationID" -------------
+	var organizationID OrganizationID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationID", r.PathValue("organizationID"), &organizationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "memberID" -------------
+	var memberID OrganizationMemberID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "memberID", r.PathValue("memberID"), &memberID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "memberID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateOrganizationMember(w, r, organizationID, memberID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetOrganizationPolicies operation middleware
+func (siw *ServerInterfaceWrapper) GetOrganizationPolicies(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "organizationID" -------------
+	var organizationID OrganizationID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationID", r.PathValue("organizationID"), &organizationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOrganizationPolicies(w, r, organizationID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateOrganizationPolicies operation middleware
+func (siw *ServerInterfaceWrapper) UpdateOrganizationPolicies(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "organizationID" -------------
+	var organizationID OrganizationID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationID", r.PathValue("organizationID"), &organizationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateOrganizationPolicies(w, r, organizationID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateOrganizationVault operation middleware
+func (siw *ServerInterfaceWrapper) CreateOrganizationVault(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "organizationID" -------------
+	var organizationID OrganizationID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "organizationID", r.PathValue("organizationID"), &organizationID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "organizationID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateOrganizationVault(w, r, organizationID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RefreshToken operation middleware
+func (siw *ServerInterfaceWrapper) RefreshToken(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RefreshToken(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListSends operation middleware
+func (siw *ServerInterfaceWrapper) ListSends(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListSends(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateSend operation middleware
+func (siw *ServerInterfaceWrapper) CreateSend(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateSend(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RevokeSend operation middleware
+func (siw *ServerInterfaceWrapper) RevokeSend(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "sendID" -------------
+	var sendID SendID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sendID", r.PathValue("sendID"), &sendID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sendID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeSend(w, r, sendID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ViewSend operation middleware
+func (siw *ServerInterfaceWrapper) ViewSend(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "sendID" -------------
+	var sendID SendID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sendID", r.PathValue("sendID"), &sendID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sendID", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ViewSendParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "X-Send-Password" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Send-Password")]; found {
+		var XSendPassword string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "X-Send-Password", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Send-Password", valueList[0], &XSendPassword, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "X-Send-Password", Err: err})
+			return
+		}
+
+		params.XSendPassword = &XSendPassword
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ViewSend(w, r, sendID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// IssueToken operation middleware
+func (siw *ServerInterfaceWrapper) IssueToken(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.IssueToken(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCurrentUser operation middleware
+func (siw *ServerInterfaceWrapper) GetCurrentUser(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCurrentUser(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateUser operation middleware
+func (siw *ServerInterfaceWrapper) CreateUser(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateUser(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ConfirmUser operation middleware
+func (siw *ServerInterfaceWrapper) ConfirmUser(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ConfirmUserParams
+
+	// ------------- Required query parameter "code" -------------
+
+	if paramValue := r.URL.Query().Get("code"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "code"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "code", r.URL.Query(), &params.Code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ConfirmUser(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListEmergencyAccess operation middleware
+func (siw *ServerInterfaceWrapper) ListEmergencyAccess(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListEmergencyAccess(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// InviteEmergencyContact operation middleware
+func (siw *ServerInterfaceWrapper) InviteEmergencyContact(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.InviteEmergencyContact(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RevokeEmergencyAccess operation middleware
+func (siw *ServerInterfaceWrapper) RevokeEmergencyAccess(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "emergencyAccessID" -------------
+	var emergencyAccessID EmergencyAccessID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "emergencyAccessID", r.PathValue("emergencyAccessID"), &emergencyAccessID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "emergencyAccessID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeEmergencyAccess(w, r, emergencyAccessID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AcceptEmergencyAccess operation middleware
+func (siw *ServerInterfaceWrapper) AcceptEmergencyAccess(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "emergencyAccessID" -------------
+	var emergencyAccessID EmergencyAccessID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "emergencyAccessID", r.PathValue("emergencyAccessID"), &emergencyAccessID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "emergencyAccessID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AcceptEmergencyAccess(w, r, emergencyAccessID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ApproveEmergencyAccess operation middleware
+func (siw *ServerInterfaceWrapper) ApproveEmergencyAccess(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "emergencyAccessID" -------------
+	var emergencyAccessID EmergencyAccessID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "emergencyAccessID", r.PathValue("emergencyAccessID"), &emergencyAccessID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "emergencyAccessID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ApproveEmergencyAccess(w, r, emergencyAccessID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateEmergencyAccessKey operation middleware
+func (siw *ServerInterfaceWrapper) UpdateEmergencyAccessKey(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "emergencyAccessID" -------------
+	var emergencyAccessID EmergencyAccessID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "emergencyAccessID", r.PathValue("emergencyAccessID"), &emergencyAccessID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "emergencyAccessID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateEmergencyAccessKey(w, r, emergencyAccessID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RejectEmergencyAccess operation middleware
+func (siw *ServerInterfaceWrapper) RejectEmergencyAccess(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "emergencyAccessID" -------------
+	var emergencyAccessID EmergencyAccessID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "emergencyAccessID", r.PathValue("emergencyAccessID"), &emergencyAccessID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "emergencyAccessID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RejectEmergencyAccess(w, r, emergencyAccessID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RequestEmergencyAccess operation middleware
+func (siw *ServerInterfaceWrapper) RequestEmergencyAccess(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "emergencyAccessID" -------------
+	var emergencyAccessID EmergencyAccessID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "emergencyAccessID", r.PathValue("emergencyAccessID"), &emergencyAccessID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "emergencyAccessID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RequestEmergencyAccess(w, r, emergencyAccessID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListUserEvents operation middleware
+func (siw *ServerInterfaceWrapper) ListUserEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListUserEventsParams
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListUserEvents(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListUserKeys operation middleware
+func (siw *ServerInterfaceWrapper) ListUserKeys(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListUserKeys(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PublishUserKey operation middleware
+func (siw *ServerInterfaceWrapper) PublishUserKey(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PublishUserKey(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUserKey operation middleware
+func (siw *ServerInterfaceWrapper) GetUserKey(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "version" -------------
+	var version int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "version", r.PathValue("version"), &version, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "version", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUserKey(w, r, version)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUserPolicies operation middleware
+func (siw *ServerInterfaceWrapper) GetUserPolicies(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUserPolicies(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetUserVault operation middleware
+func (siw *ServerInterfaceWrapper) GetUserVault(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserVaultParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	// ------------- Optional header parameter "If-Modified-Since" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Modified-Since")]; found {
+		var IfModifiedSince string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Modified-Since", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Modified-Since", valueList[0], &IfModifiedSince, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Modified-Since", Err: err})
+			return
+		}
+
+		params.IfModifiedSince = &IfModifiedSince
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUserVault(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// InsertUserVault operation middleware
+func (siw *ServerInterfaceWrapper) InsertUserVault(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params InsertUserVaultParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	// ------------- Optional header parameter "Content-Digest" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Content-Digest")]; found {
+		var ContentDigest string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Content-Digest", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Content-Digest", valueList[0], &ContentDigest, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Content-Digest", Err: err})
+			return
+		}
+
+		params.ContentDigest = &ContentDigest
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.InsertUserVault(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StreamVaultEvents operation middleware
+func (siw *ServerInterfaceWrapper) StreamVaultEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamVaultEventsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Last-Event-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Last-Event-ID", Err: err})
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StreamVaultEvents(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PollUserVault operation middleware
+func (siw *ServerInterfaceWrapper) PollUserVault(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PollUserVaultParams
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "wait" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "wait", r.URL.Query(), &params.Wait)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "wait", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PollUserVault(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SyncUserVault operation middleware
+func (siw *ServerInterfaceWrapper) SyncUserVault(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SyncUserVault(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateVaultUpload operation middleware
+func (siw *ServerInterfaceWrapper) CreateVaultUpload(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateVaultUpload(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CancelVaultUpload operation middleware
+func (siw *ServerInterfaceWrapper) CancelVaultUpload(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uploadId" -------------
+	var uploadId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uploadId", r.PathValue("uploadId"), &uploadId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uploadId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CancelVaultUpload(w, r, uploadId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetVaultUpload operation middleware
+func (siw *ServerInterfaceWrapper) GetVaultUpload(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uploadId" -------------
+	var uploadId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uploadId", r.PathValue("uploadId"), &uploadId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uploadId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVaultUpload(w, r, uploadId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AppendVaultUpload operation middleware
+func (siw *ServerInterfaceWrapper) AppendVaultUpload(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uploadId" -------------
+	var uploadId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uploadId", r.PathValue("uploadId"), &uploadId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uploadId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AppendVaultUploadParams
+
+	// ------------- Required query parameter "offset" -------------
+
+	if paramValue := r.URL.Query().Get("offset"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "offset"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AppendVaultUpload(w, r, uploadId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CommitVaultUpload operation middleware
+func (siw *ServerInterfaceWrapper) CommitVaultUpload(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "uploadId" -------------
+	var uploadId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "uploadId", r.PathValue("uploadId"), &uploadId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "uploadId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CommitVaultUploadParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CommitVaultUpload(w, r, uploadId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetVaultUsage operation middleware
+func (siw *ServerInterfaceWrapper) GetVaultUsage(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVaultUsage(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListVaultVersions operation middleware
+func (siw *ServerInterfaceWrapper) ListVaultVersions(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListVaultVersions(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetVaultVersion operation middleware
+func (siw *ServerInterfaceWrapper) GetVaultVersion(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "revision" -------------
+	var revision VaultRevision
+
+	err = runtime.BindStyledParameterWithOptions("simple", "revision", r.PathValue("revision"), &revision, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "revision", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVaultVersion(w, r, revision)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RestoreVaultVersion operation middleware
+func (siw *ServerInterfaceWrapper) RestoreVaultVersion(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "revision" -------------
+	var revision VaultRevision
+
+	err = runtime.BindStyledParameterWithOptions("simple", "revision", r.PathValue("revision"), &revision, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "revision", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RestoreVaultVersionParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestoreVaultVersion(w, r, revision, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListVaults operation middleware
+func (siw *ServerInterfaceWrapper) ListVaults(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListVaults(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateVault operation middleware
+func (siw *ServerInterfaceWrapper) CreateVault(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateVault(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteVault operation middleware
+func (siw *ServerInterfaceWrapper) DeleteVault(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "vaultID" -------------
+	var vaultID VaultID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "vaultID", r.PathValue("vaultID"), &vaultID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vaultID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteVault(w, r, vaultID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetVault operation middleware
+func (siw *ServerInterfaceWrapper) GetVault(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "vaultID" -------------
+	var vaultID VaultID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "vaultID", r.PathValue("vaultID"), &vaultID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vaultID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVault(w, r, vaultID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateVault operation middleware
+func (siw *ServerInterfaceWrapper) UpdateVault(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "vaultID" -------------
+	var vaultID VaultID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "vaultID", r.PathValue("vaultID"), &vaultID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vaultID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateVault(w, r, vaultID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AcceptVaultInvite operation middleware
+func (siw *ServerInterfaceWrapper) AcceptVaultInvite(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "vaultID" -------------
+	var vaultID VaultID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "vaultID", r.PathValue("vaultID"), &vaultID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vaultID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AcceptVaultInvite(w, r, vaultID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetVaultContent operation middleware
+func (siw *ServerInterfaceWrapper) GetVaultContent(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "vaultID" -------------
+	var vaultID VaultID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "vaultID", r.PathValue("vaultID"), &vaultID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vaultID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetVaultContentParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	// ------------- Optional header parameter "If-Modified-Since" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Modified-Since")]; found {
+		var IfModifiedSince string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Modified-Since", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Modified-Since", valueList[0], &IfModifiedSince, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Modified-Since", Err: err})
+			return
+		}
+
+		params.IfModifiedSince = &IfModifiedSince
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVaultContent(w, r, vaultID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutVaultContent operation middleware
+func (siw *ServerInterfaceWrapper) PutVaultContent(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "vaultID" -------------
+	var vaultID VaultID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "vaultID", r.PathValue("vaultID"), &vaultID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vaultID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutVaultContentParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	// ------------- Optional header parameter "Content-Digest" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Content-Digest")]; found {
+		var ContentDigest string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Content-Digest", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Content-Digest", valueList[0], &ContentDigest, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Content-Digest", Err: err})
+			return
+		}
+
+		params.ContentDigest = &ContentDigest
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutVaultContent(w, r, vaultID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetVaultKey operation middleware
+func (siw *ServerInterfaceWrapper) GetVaultKey(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "vaultID" -------------
+	var vaultID VaultID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "vaultID", r.PathValue("vaultID"), &vaultID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vaultID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVaultKey(w, r, vaultID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RotateVaultKey operation middleware
+func (siw *ServerInterfaceWrapper) RotateVaultKey(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "vaultID" -------------
+	var vaultID VaultID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "vaultID", r.PathValue("vaultID"), &vaultID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vaultID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RotateVaultKey(w, r, vaultID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListVaultMembers operation middleware
+func (siw *ServerInterfaceWrapper) ListVaultMembers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "vaultID" -------------
+	var vaultID VaultID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "vaultID", r.PathValue("vaultID"), &vaultID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vaultID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListVaultMembers(w, r, vaultID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// InviteVaultMember operation middleware
+func (siw *ServerInterfaceWrapper) InviteVaultMember(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "vaultID" -------------
+	var vaultID VaultID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "vaultID", r.PathValue("vaultID"), &vaultID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vaultID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.InviteVaultMember(w, r, vaultID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RemoveVaultMember operation middleware
+func (siw *ServerInterfaceWrapper) RemoveVaultMember(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "vaultID" -------------
+	var vaultID VaultID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "vaultID", r.PathValue("vaultID"), &vaultID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vaultID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "memberID" -------------
+	var memberID VaultMemberID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "memberID", r.PathValue("memberID"), &memberID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "memberID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemoveVaultMember(w, r, vaultID, memberID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateVaultMember operation middleware
+func (siw *ServerInterfaceWrapper) UpdateVaultMember(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "vaultID" -------------
+	var vaultID VaultID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "vaultID", r.PathValue("vaultID"), &vaultID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vaultID", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "memberID" -------------
+	var memberID VaultMemberID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "memberID", r.PathValue("memberID"), &memberID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "memberID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateVaultMember(w, r, vaultID, memberID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWebhooks(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateWebhook(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteWebhook operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "webhookID" -------------
+	var webhookID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookID", r.PathValue("webhookID"), &webhookID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteWebhook(w, r, webhookID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListWebhookDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "webhookID" -------------
+	var webhookID openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "webhookID", r.PathValue("webhookID"), &webhookID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "webhookID", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWebhookDeliveriesParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWebhookDeliveries(w, r, webhookID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListUsers operation middleware
+func (siw *ServerInterfaceWrapper) ListUsers(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListUsers(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// LookupPublicKey operation middleware
+func (siw *ServerInterfaceWrapper) LookupPublicKey(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	ctx = context.WithValue(ctx, CookieAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LookupPublicKeyParams
+
+	// ------------- Optional query parameter "email" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "email", r.URL.Query(), &params.Email)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "email", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "userId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "userId", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.LookupPublicKey(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{})
+}
+
+// ServeMux is an abstraction of http.ServeMux.
+type ServeMux interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+}
+
+type StdHTTPServerOptions struct {
+	BaseURL          string
+	BaseRouter       ServeMux
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter: m,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: m,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
+	m := options.BaseRouter
+
+	if m == nil {
+		m = http.NewServeMux()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	m.HandleFunc("GET "+options.BaseURL+"/admin/outbox", wrapper.ListOutboxMessages)
+	m.HandleFunc("POST "+options.BaseURL+"/admin/outbox/{messageID}/requeue", wrapper.RequeueOutboxMessage)
+	m.HandleFunc("POST "+options.BaseURL+"/admin/vaults/verify", wrapper.VerifyVaults)
+	m.HandleFunc("GET "+options.BaseURL+"/invites", wrapper.ListInvites)
+	m.HandleFunc("POST "+options.BaseURL+"/invites", wrapper.CreateInvite)
+	m.HandleFunc("DELETE "+options.BaseURL+"/invites/{inviteID}", wrapper.RevokeInvite)
+	m.HandleFunc("POST "+options.BaseURL+"/logout", wrapper.LogoutUser)
+	m.HandleFunc("GET "+options.BaseURL+"/organizations", wrapper.ListOrganizations)
+	m.HandleFunc("POST "+options.BaseURL+"/organizations", wrapper.CreateOrganization)
+	m.HandleFunc("POST "+options.BaseURL+"/organizations/invites/accept", wrapper.AcceptOrganizationInvite)
+	m.HandleFunc("DELETE "+options.BaseURL+"/organizations/{organizationID}", wrapper.DeleteOrganization)
+	m.HandleFunc("GET "+options.BaseURL+"/organizations/{organizationID}", wrapper.GetOrganization)
+	m.HandleFunc("PATCH "+options.BaseURL+"/organizations/{organizationID}", wrapper.UpdateOrganization)
+	m.HandleFunc("GET "+options.BaseURL+"/organizations/{organizationID}/groups", wrapper.ListOrganizationGroups)
+	m.HandleFunc("POST "+options.BaseURL+"/organizations/{organizationID}/groups", wrapper.CreateOrganizationGroup)
+	m.HandleFunc("DELETE "+options.BaseURL+"/organizations/{organizationID}/groups/{groupID}", wrapper.DeleteOrganizationGroup)
+	m.HandleFunc("PUT "+options.BaseURL+"/organizations/{organizationID}/groups/{groupID}", wrapper.UpdateOrganizationGroup)
+	m.HandleFunc("GET "+options.BaseURL+"/organizations/{organizationID}/invites", wrapper.ListOrganizationInvites)
+	m.HandleFunc("POST "+options.BaseURL+"/organizations/{organizationID}/invites", wrapper.CreateOrganizationInvite)
+	m.HandleFunc("DELETE "+options.BaseURL+"/organizations/{organizationID}/invites/{inviteID}", wrapper.RevokeOrganizationInvite)
+	m.HandleFunc("GET "+options.BaseURL+"/organizations/{organizationID}/members", wrapper.ListOrganizationMembers)
+	m.HandleFunc("DELETE "+options.BaseURL+"/organizations/{organizationID}/members/{memberID}", wrapper.RemoveOrganizationMember)
+	m.HandleFunc("PATCH "+options.BaseURL+"/organizations/{organizationID}/members/{memberID}", wrapper.UpdateOrganizationMember)
+	m.HandleFunc("GET "+options.BaseURL+"/organizations/{organizationID}/policies", wrapper.GetOrganizationPolicies)
+	m.HandleFunc("PUT "+options.BaseURL+"/organizations/{organizationID}/policies", wrapper.UpdateOrganizationPolicies)
+	m.HandleFunc("POST "+options.BaseURL+"/organizations/{organizationID}/vaults", wrapper.CreateOrganizationVault)
+	m.HandleFunc("POST "+options.BaseURL+"/refresh", wrapper.RefreshToken)
+	m.HandleFunc("GET "+options.BaseURL+"/sends", wrapper.ListSends)
+	m.HandleFunc("POST "+options.BaseURL+"/sends", wrapper.CreateSend)
+	m.HandleFunc("DELETE "+options.BaseURL+"/sends/{sendID}", wrapper.RevokeSend)
+	m.HandleFunc("GET "+options.BaseURL+"/sends/{sendID}", wrapper.ViewSend)
+	m.HandleFunc("POST "+options.BaseURL+"/token", wrapper.IssueToken)
+	m.HandleFunc("GET "+options.BaseURL+"/user", wrapper.GetCurrentUser)
+	m.HandleFunc("POST "+options.BaseURL+"/user", wrapper.CreateUser)
+	m.HandleFunc("POST "+options.BaseURL+"/user/confirm", wrapper.ConfirmUser)
+	m.HandleFunc("GET "+options.BaseURL+"/user/emergency-access", wrapper.ListEmergencyAccess)
+	m.HandleFunc("POST "+options.BaseURL+"/user/emergency-access", wrapper.InviteEmergencyContact)
+	m.HandleFunc("DELETE "+options.BaseURL+"/user/emergency-access/{emergencyAccessID}", wrapper.RevokeEmergencyAccess)
+	m.HandleFunc("POST "+options.BaseURL+"/user/emergency-access/{emergencyAccessID}/accept", wrapper.AcceptEmergencyAccess)
+	m.HandleFunc("POST "+options.BaseURL+"/user/emergency-access/{emergencyAccessID}/approve", wrapper.ApproveEmergencyAccess)
+	m.HandleFunc("PUT "+options.BaseURL+"/user/emergency-access/{emergencyAccessID}/key", wrapper.UpdateEmergencyAccessKey)
+	m.HandleFunc("POST "+options.BaseURL+"/user/emergency-access/{emergencyAccessID}/reject", wrapper.RejectEmergencyAccess)
+	m.HandleFunc("POST "+options.BaseURL+"/user/emergency-access/{emergencyAccessID}/request", wrapper.RequestEmergencyAccess)
+	m.HandleFunc("GET "+options.BaseURL+"/user/events", wrapper.ListUserEvents)
+	m.HandleFunc("GET "+options.BaseURL+"/user/keys", wrapper.ListUserKeys)
+	m.HandleFunc("POST "+options.BaseURL+"/user/keys", wrapper.PublishUserKey)
+	m.HandleFunc("GET "+options.BaseURL+"/user/keys/{version}", wrapper.GetUserKey)
+	m.HandleFunc("GET "+options.BaseURL+"/user/policies", wrapper.GetUserPolicies)
+	m.HandleFunc("GET "+options.BaseURL+"/user/vault", wrapper.GetUserVault)
+	m.HandleFunc("POST "+options.BaseURL+"/user/vault", wrapper.InsertUserVault)
+	m.HandleFunc("GET "+options.BaseURL+"/user/vault/events", wrapper.StreamVaultEvents)
+	m.HandleFunc("GET "+options.BaseURL+"/user/vault/poll", wrapper.PollUserVault)
+	m.HandleFunc("POST "+options.BaseURL+"/user/vault/sync", wrapper.SyncUserVault)
+	m.HandleFunc("POST "+options.BaseURL+"/user/vault/uploads", wrapper.CreateVaultUpload)
+	m.HandleFunc("DELETE "+options.BaseURL+"/user/vault/uploads/{uploadId}", wrapper.CancelVaultUpload)
+	m.HandleFunc("GET "+options.BaseURL+"/user/vault/uploads/{uploadId}", wrapper.GetVaultUpload)
+	m.HandleFunc("PATCH "+options.BaseURL+"/user/vault/uploads/{uploadId}", wrapper.AppendVaultUpload)
+	m.HandleFunc("POST "+options.BaseURL+"/user/vault/uploads/{uploadId}/commit", wrapper.CommitVaultUpload)
+	m.HandleFunc("GET "+options.BaseURL+"/user/vault/usage", wrapper.GetVaultUsage)
+	m.HandleFunc("GET "+options.BaseURL+"/user/vault/versions", wrapper.ListVaultVersions)
+	m.HandleFunc("GET "+options.BaseURL+"/user/vault/versions/{revision}", wrapper.GetVaultVersion)
+	m.HandleFunc("POST "+options.BaseURL+"/user/vault/versions/{revision}/restore", wrapper.RestoreVaultVersion)
+	m.HandleFunc("GET "+options.BaseURL+"/user/vaults", wrapper.ListVaults)
+	m.HandleFunc("POST "+options.BaseURL+"/user/vaults", wrapper.CreateVault)
+	m.HandleFunc("DELETE "+options.BaseURL+"/user/vaults/{vaultID}", wrapper.DeleteVault)
+	m.HandleFunc("GET "+options.BaseURL+"/user/vaults/{vaultID}", wrapper.GetVault)
+	m.HandleFunc("PATCH "+options.BaseURL+"/user/vaults/{vaultID}", wrapper.UpdateVault)
+	m.HandleFunc("POST "+options.BaseURL+"/user/vaults/{vaultID}/accept", wrapper.AcceptVaultInvite)
+	m.HandleFunc("GET "+options.BaseURL+"/user/vaults/{vaultID}/content", wrapper.GetVaultContent)
+	m.HandleFunc("PUT "+options.BaseURL+"/user/vaults/{vaultID}/content", wrapper.PutVaultContent)
+	m.HandleFunc("GET "+options.BaseURL+"/user/vaults/{vaultID}/key", wrapper.GetVaultKey)
+	m.HandleFunc("PUT "+options.BaseURL+"/user/vaults/{vaultID}/key", wrapper.RotateVaultKey)
+	m.HandleFunc("GET "+options.BaseURL+"/user/vaults/{vaultID}/members", wrapper.ListVaultMembers)
+	m.HandleFunc("POST "+options.BaseURL+"/user/vaults/{vaultID}/members", wrapper.InviteVaultMember)
+	m.HandleFunc("DELETE "+options.BaseURL+"/user/vaults/{vaultID}/members/{memberID}", wrapper.RemoveVaultMember)
+	m.HandleFunc("PATCH "+options.BaseURL+"/user/vaults/{vaultID}/members/{memberID}", wrapper.UpdateVaultMember)
+	m.HandleFunc("GET "+options.BaseURL+"/user/webhooks", wrapper.ListWebhooks)
+	m.HandleFunc("POST "+options.BaseURL+"/user/webhooks", wrapper.CreateWebhook)
+	m.HandleFunc("DELETE "+options.BaseURL+"/user/webhooks/{webhookID}", wrapper.DeleteWebhook)
+	m.HandleFunc("GET "+options.BaseURL+"/user/webhooks/{webhookID}/deliveries", wrapper.ListWebhookDeliveries)
+	m.HandleFunc("GET "+options.BaseURL+"/users", wrapper.ListUsers)
+	m.HandleFunc("GET "+options.BaseURL+"/users/keys", wrapper.LookupPublicKey)
+
+	return m
+}
+
+type BadRequestJSONResponse ErrorResponse
+
+type ForbiddenJSONResponse ErrorResponse
+
+type InternalServerErrorJSONResponse ErrorResponse
+
+type NotFoundJSONResponse ErrorResponse
+
+type PayloadTooLargeJSONResponse ErrorResponse
+
+type QuotaExceededJSONResponse ErrorResponse
+
+type TooManyRequestsJSONResponse ErrorResponse
+
+type UnauthorizedJSONResponse ErrorResponse
+
+type ListOutboxMessagesRequestObject struct {
+	Params ListOutboxMessagesParams
+}
+
+type ListOutboxMessagesResponseObject interface {
+	VisitListOutboxMessagesResponse(w http.ResponseWriter) error
+}
+
+type ListOutboxMessages200JSONResponse []OutboxMessageResponse
+
+func (response ListOutboxMessages200JSONResponse) VisitListOutboxMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOutboxMessages401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListOutboxMessages401JSONResponse) VisitListOutboxMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOutboxMessages403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListOutboxMessages403JSONResponse) VisitListOutboxMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOutboxMessages500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListOutboxMessages500JSONResponse) VisitListOutboxMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequeueOutboxMessageRequestObject struct {
+	MessageID openapi_types.UUID `json:"messageID"`
+}
+
+type RequeueOutboxMessageResponseObject interface {
+	VisitRequeueOutboxMessageResponse(w http.ResponseWriter) error
+}
+
+type RequeueOutboxMessage204Response struct {
+}
+
+func (response RequeueOutboxMessage204Response) VisitRequeueOutboxMessageResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RequeueOutboxMessage401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RequeueOutboxMessage401JSONResponse) VisitRequeueOutboxMessageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequeueOutboxMessage403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RequeueOutboxMessage403JSONResponse) VisitRequeueOutboxMessageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequeueOutboxMessage404JSONResponse ErrorResponse
+
+func (response RequeueOutboxMessage404JSONResponse) VisitRequeueOutboxMessageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequeueOutboxMessage500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response RequeueOutboxMessage500JSONResponse) VisitRequeueOutboxMessageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyVaultsRequestObject struct {
+	Params VerifyVaultsParams
+}
+
+type VerifyVaultsResponseObject interface {
+	VisitVerifyVaultsResponse(w http.ResponseWriter) error
+}
+
+type VerifyVaults200JSONResponse VaultVerifyResponse
+
+func (response VerifyVaults200JSONResponse) VisitVerifyVaultsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyVaults401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response VerifyVaults401JSONResponse) VisitVerifyVaultsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyVaults403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response VerifyVaults403JSONResponse) VisitVerifyVaultsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type VerifyVaults500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response VerifyVaults500JSONResponse) VisitVerifyVaultsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListInvitesRequestObject struct {
+}
+
+type ListInvitesResponseObject interface {
+	VisitListInvitesResponse(w http.ResponseWriter) error
+}
+
+type ListInvites200JSONResponse []InviteResponse
+
+func (response ListInvites200JSONResponse) VisitListInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListInvites401JSONResponse ErrorResponse
+
+func (response ListInvites401JSONResponse) VisitListInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListInvites500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListInvites500JSONResponse) VisitListInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateInviteRequestObject struct {
+	Body *CreateInviteJSONRequestBody
+}
+
+type CreateInviteResponseObject interface {
+	VisitCreateInviteResponse(w http.ResponseWriter) error
+}
+
+type CreateInvite201JSONResponse InviteResponse
+
+func (response CreateInvite201JSONResponse) VisitCreateInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateInvite400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateInvite400JSONResponse) VisitCreateInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateInvite401JSONResponse ErrorResponse
+
+func (response CreateInvite401JSONResponse) VisitCreateInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateInvite500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CreateInvite500JSONResponse) VisitCreateInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeInviteRequestObject struct {
+	InviteID openapi_types.UUID `json:"inviteID"`
+}
+
+type RevokeInviteResponseObject interface {
+	VisitRevokeInviteResponse(w http.ResponseWriter) error
+}
+
+type RevokeInvite204Response struct {
+}
+
+func (response RevokeInvite204Response) VisitRevokeInviteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RevokeInvite400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RevokeInvite400JSONResponse) VisitRevokeInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeInvite401JSONResponse ErrorResponse
+
+func (response RevokeInvite401JSONResponse) VisitRevokeInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeInvite403JSONResponse ErrorResponse
+
+func (response RevokeInvite403JSONResponse) VisitRevokeInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeInvite404JSONResponse ErrorResponse
+
+func (response RevokeInvite404JSONResponse) VisitRevokeInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeInvite500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response RevokeInvite500JSONResponse) VisitRevokeInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LogoutUserRequestObject struct {
+}
+
+type LogoutUserResponseObject interface {
+	VisitLogoutUserResponse(w http.ResponseWriter) error
+}
+
+type LogoutUser204ResponseHeaders struct {
+	SetCookie string
+}
+
+type LogoutUser204Response struct {
+	Headers LogoutUser204ResponseHeaders
+}
+
+func (response LogoutUser204Response) VisitLogoutUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(204)
+	return nil
+}
+
+type LogoutUser401JSONResponse ErrorResponse
+
+func (response LogoutUser401JSONResponse) VisitLogoutUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LogoutUser500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response LogoutUser500JSONResponse) VisitLogoutUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrganizationsRequestObject struct {
+}
+
+type ListOrganizationsResponseObject interface {
+	VisitListOrganizationsResponse(w http.ResponseWriter) error
+}
+
+type ListOrganizations200JSONResponse []OrganizationResponse
+
+func (response ListOrganizations200JSONResponse) VisitListOrganizationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrganizations401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListOrganizations401JSONResponse) VisitListOrganizationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrganizations500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListOrganizations500JSONResponse) VisitListOrganizationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrganizationRequestObject struct {
+	Body *CreateOrganizationJSONRequestBody
+}
+
+type CreateOrganizationResponseObject interface {
+	VisitCreateOrganizationResponse(w http.ResponseWriter) error
+}
+
+type CreateOrganization201JSONResponse OrganizationResponse
+
+func (response CreateOrganization201JSONResponse) VisitCreateOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrganization400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateOrganization400JSONResponse) VisitCreateOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrganization401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateOrganization401JSONResponse) VisitCreateOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrganization500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CreateOrganization500JSONResponse) VisitCreateOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptOrganizationInviteRequestObject struct {
+	Body *AcceptOrganizationInviteJSONRequestBody
+}
+
+type AcceptOrganizationInviteResponseObject interface {
+	VisitAcceptOrganizationInviteResponse(w http.ResponseWriter) error
+}
+
+type AcceptOrganizationInvite200JSONResponse OrganizationResponse
+
+func (response AcceptOrganizationInvite200JSONResponse) VisitAcceptOrganizationInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptOrganizationInvite401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response AcceptOrganizationInvite401JSONResponse) VisitAcceptOrganizationInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptOrganizationInvite403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response AcceptOrganizationInvite403JSONResponse) VisitAcceptOrganizationInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptOrganizationInvite404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response AcceptOrganizationInvite404JSONResponse) VisitAcceptOrganizationInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptOrganizationInvite409JSONResponse ErrorResponse
+
+func (response AcceptOrganizationInvite409JSONResponse) VisitAcceptOrganizationInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptOrganizationInvite500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response AcceptOrganizationInvite500JSONResponse) VisitAcceptOrganizationInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOrganizationRequestObject struct {
+	OrganizationID OrganizationID `json:"organizationID"`
+}
+
+type DeleteOrganizationResponseObject interface {
+	VisitDeleteOrganizationResponse(w http.ResponseWriter) error
+}
+
+type DeleteOrganization204Response struct {
+}
+
+func (response DeleteOrganization204Response) VisitDeleteOrganizationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteOrganization401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteOrganization401JSONResponse) VisitDeleteOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOrganization403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteOrganization403JSONResponse) VisitDeleteOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOrganization404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteOrganization404JSONResponse) VisitDeleteOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOrganization500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeleteOrganization500JSONResponse) VisitDeleteOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrganizationRequestObject struct {
+	OrganizationID OrganizationID `json:"organizationID"`
+}
+
+type GetOrganizationResponseObject interface {
+	VisitGetOrganizationResponse(w http.ResponseWriter) error
+}
+
+type GetOrganization200JSONResponse OrganizationResponse
+
+func (response GetOrganization200JSONResponse) VisitGetOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrganization401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetOrganization401JSONResponse) VisitGetOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrganization404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetOrganization404JSONResponse) VisitGetOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrganization500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetOrganization500JSONResponse) VisitGetOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganizationRequestObject struct {
+	OrganizationID OrganizationID `json:"organizationID"`
+	Body           *UpdateOrganizationJSONRequestBody
+}
+
+type UpdateOrganizationResponseObject interface {
+	VisitUpdateOrganizationResponse(w http.ResponseWriter) error
+}
+
+type UpdateOrganization200JSONResponse OrganizationResponse
+
+func (response UpdateOrganization200JSONResponse) VisitUpdateOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganization400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateOrganization400JSONResponse) VisitUpdateOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganization401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateOrganization401JSONResponse) VisitUpdateOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganization403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UpdateOrganization403JSONResponse) VisitUpdateOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganization404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateOrganization404JSONResponse) VisitUpdateOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganization500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response UpdateOrganization500JSONResponse) VisitUpdateOrganizationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrganizationGroupsRequestObject struct {
+	OrganizationID OrganizationID `json:"organizationID"`
+}
+
+type ListOrganizationGroupsResponseObject interface {
+	VisitListOrganizationGroupsResponse(w http.ResponseWriter) error
+}
+
+type ListOrganizationGroups200JSONResponse []OrganizationGroupResponse
+
+func (response ListOrganizationGroups200JSONResponse) VisitListOrganizationGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrganizationGroups401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListOrganizationGroups401JSONResponse) VisitListOrganizationGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrganizationGroups404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListOrganizationGroups404JSONResponse) VisitListOrganizationGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrganizationGroups500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListOrganizationGroups500JSONResponse) VisitListOrganizationGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrganizationGroupRequestObject struct {
+	OrganizationID OrganizationID `json:"organizationID"`
+	Body           *CreateOrganizationGroupJSONRequestBody
+}
+
+type CreateOrganizationGroupResponseObject interface {
+	VisitCreateOrganizationGroupResponse(w http.ResponseWriter) error
+}
+
+type CreateOrganizationGroup201JSONResponse OrganizationGroupResponse
+
+func (response CreateOrganizationGroup201JSONResponse) VisitCreateOrganizationGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrganizationGroup400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateOrganizationGroup400JSONResponse) VisitCreateOrganizationGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrganizationGroup401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateOrganizationGroup401JSONResponse) VisitCreateOrganizationGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrganizationGroup403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateOrganizationGroup403JSONResponse) VisitCreateOrganizationGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrganizationGroup404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CreateOrganizationGroup404JSONResponse) VisitCreateOrganizationGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrganizationGroup409JSONResponse ErrorResponse
+
+func (response CreateOrganizationGroup409JSONResponse) VisitCreateOrganizationGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrganizationGroup500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CreateOrganizationGroup500JSONResponse) VisitCreateOrganizationGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOrganizationGroupRequestObject struct {
+	OrganizationID OrganizationID      `json:"organizationID"`
+	GroupID        OrganizationGroupID `json:"groupID"`
+}
+
+type DeleteOrganizationGroupResponseObject interface {
+	VisitDeleteOrganizationGroupResponse(w http.ResponseWriter) error
+}
+
+type DeleteOrganizationGroup204Response struct {
+}
+
+func (response DeleteOrganizationGroup204Response) VisitDeleteOrganizationGroupResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteOrganizationGroup401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteOrganizationGroup401JSONResponse) VisitDeleteOrganizationGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOrganizationGroup403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteOrganizationGroup403JSONResponse) VisitDeleteOrganizationGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOrganizationGroup404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteOrganizationGroup404JSONResponse) VisitDeleteOrganizationGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOrganizationGroup500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeleteOrganizationGroup500JSONResponse) VisitDeleteOrganizationGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganizationGroupRequestObject struct {
+	OrganizationID OrganizationID      `json:"organizationID"`
+	GroupID        OrganizationGroupID `json:"groupID"`
+	Body           *UpdateOrganizationGroupJSONRequestBody
+}
+
+type UpdateOrganizationGroupResponseObject interface {
+	VisitUpdateOrganizationGroupResponse(w http.ResponseWriter) error
+}
+
+type UpdateOrganizationGroup200JSONResponse OrganizationGroupResponse
+
+func (response UpdateOrganizationGroup200JSONResponse) VisitUpdateOrganizationGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganizationGroup400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateOrganizationGroup400JSONResponse) VisitUpdateOrganizationGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganizationGroup401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateOrganizationGroup401JSONResponse) VisitUpdateOrganizationGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganizationGroup403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UpdateOrganizationGroup403JSONResponse) VisitUpdateOrganizationGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganizationGroup404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateOrganizationGroup404JSONResponse) VisitUpdateOrganizationGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganizationGroup409JSONResponse ErrorResponse
+
+func (response UpdateOrganizationGroup409JSONResponse) VisitUpdateOrganizationGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganizationGroup500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response UpdateOrganizationGroup500JSONResponse) VisitUpdateOrganizationGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrganizationInvitesRequestObject struct {
+	OrganizationID OrganizationID `json:"organizationID"`
+}
+
+type ListOrganizationInvitesResponseObject interface {
+	VisitListOrganizationInvitesResponse(w http.ResponseWriter) error
+}
+
+type ListOrganizationInvites200JSONResponse []OrganizationInviteResponse
+
+func (response ListOrganizationInvites200JSONResponse) VisitListOrganizationInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrganizationInvites401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListOrganizationInvites401JSONResponse) VisitListOrganizationInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrganizationInvites403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListOrganizationInvites403JSONResponse) VisitListOrganizationInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrganizationInvites404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListOrganizationInvites404JSONResponse) VisitListOrganizationInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrganizationInvites500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListOrganizationInvites500JSONResponse) VisitListOrganizationInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrganizationInviteRequestObject struct {
+	OrganizationID OrganizationID `json:"organizationID"`
+	Body           *CreateOrganizationInviteJSONRequestBody
+}
+
+type CreateOrganizationInviteResponseObject interface {
+	VisitCreateOrganizationInviteResponse(w http.ResponseWriter) error
+}
+
+type CreateOrganizationInvite201JSONResponse OrganizationInviteResponse
+
+func (response CreateOrganizationInvite201JSONResponse) VisitCreateOrganizationInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrganizationInvite400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateOrganizationInvite400JSONResponse) VisitCreateOrganizationInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrganizationInvite401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateOrganizationInvite401JSONResponse) VisitCreateOrganizationInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrganizationInvite403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateOrganizationInvite403JSONResponse) VisitCreateOrganizationInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrganizationInvite404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CreateOrganizationInvite404JSONResponse) VisitCreateOrganizationInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrganizationInvite409JSONResponse ErrorResponse
+
+func (response CreateOrganizationInvite409JSONResponse) VisitCreateOrganizationInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrganizationInvite500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CreateOrganizationInvite500JSONResponse) VisitCreateOrganizationInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeOrganizationInviteRequestObject struct {
+	OrganizationID OrganizationID       `json:"organizationID"`
+	InviteID       OrganizationInviteID `json:"inviteID"`
+}
+
+type RevokeOrganizationInviteResponseObject interface {
+	VisitRevokeOrganizationInviteResponse(w http.ResponseWriter) error
+}
+
+type RevokeOrganizationInvite204Response struct {
+}
+
+func (response RevokeOrganizationInvite204Response) VisitRevokeOrganizationInviteResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RevokeOrganizationInvite401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RevokeOrganizationInvite401JSONResponse) VisitRevokeOrganizationInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeOrganizationInvite403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RevokeOrganizationInvite403JSONResponse) VisitRevokeOrganizationInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeOrganizationInvite404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RevokeOrganizationInvite404JSONResponse) VisitRevokeOrganizationInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeOrganizationInvite500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response RevokeOrganizationInvite500JSONResponse) VisitRevokeOrganizationInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrganizationMembersRequestObject struct {
+	OrganizationID OrganizationID `json:"organizationID"`
+}
+
+type ListOrganizationMembersResponseObject interface {
+	VisitListOrganizationMembersResponse(w http.ResponseWriter) error
+}
+
+type ListOrganizationMembers200JSONResponse []OrganizationMemberResponse
+
+func (response ListOrganizationMembers200JSONResponse) VisitListOrganizationMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrganizationMembers401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListOrganizationMembers401JSONResponse) VisitListOrganizationMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrganizationMembers404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListOrganizationMembers404JSONResponse) VisitListOrganizationMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListOrganizationMembers500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListOrganizationMembers500JSONResponse) VisitListOrganizationMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveOrganizationMemberRequestObject struct {
+	OrganizationID OrganizationID       `json:"organizationID"`
+	MemberID       OrganizationMemberID `json:"memberID"`
+}
+
+type RemoveOrganizationMemberResponseObject interface {
+	VisitRemoveOrganizationMemberResponse(w http.ResponseWriter) error
+}
+
+type RemoveOrganizationMember204Response struct {
+}
+
+func (response RemoveOrganizationMember204Response) VisitRemoveOrganizationMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RemoveOrganizationMember401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RemoveOrganizationMember401JSONResponse) VisitRemoveOrganizationMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveOrganizationMember403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RemoveOrganizationMember403JSONResponse) VisitRemoveOrganizationMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveOrganizationMember404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RemoveOrganizationMember404JSONResponse) VisitRemoveOrganizationMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveOrganizationMember409JSONResponse ErrorResponse
+
+func (response RemoveOrganizationMember409JSONResponse) VisitRemoveOrganizationMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveOrganizationMember500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response RemoveOrganizationMember500JSONResponse) VisitRemoveOrganizationMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganizationMemberRequestObject struct {
+	OrganizationID OrganizationID       `json:"organizationID"`
+	MemberID       OrganizationMemberID `json:"memberID"`
+	Body           *UpdateOrganizationMemberJSONRequestBody
+}
+
+type UpdateOrganizationMemberResponseObject interface {
+	VisitUpdateOrganizationMemberResponse(w http.ResponseWriter) error
+}
+
+type UpdateOrganizationMember200JSONResponse OrganizationMemberResponse
+
+func (response UpdateOrganizationMember200JSONResponse) VisitUpdateOrganizationMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganizationMember400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateOrganizationMember400JSONResponse) VisitUpdateOrganizationMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganizationMember401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateOrganizationMember401JSONResponse) VisitUpdateOrganizationMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganizationMember403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UpdateOrganizationMember403JSONResponse) VisitUpdateOrganizationMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganizationMember404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateOrganizationMember404JSONResponse) VisitUpdateOrganizationMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganizationMember409JSONResponse ErrorResponse
+
+func (response UpdateOrganizationMember409JSONResponse) VisitUpdateOrganizationMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganizationMember500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response UpdateOrganizationMember500JSONResponse) VisitUpdateOrganizationMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrganizationPoliciesRequestObject struct {
+	OrganizationID OrganizationID `json:"organizationID"`
+}
+
+type GetOrganizationPoliciesResponseObject interface {
+	VisitGetOrganizationPoliciesResponse(w http.ResponseWriter) error
+}
+
+type GetOrganizationPolicies200JSONResponse OrganizationPolicies
+
+func (response GetOrganizationPolicies200JSONResponse) VisitGetOrganizationPoliciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrganizationPolicies401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetOrganizationPolicies401JSONResponse) VisitGetOrganizationPoliciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrganizationPolicies404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetOrganizationPolicies404JSONResponse) VisitGetOrganizationPoliciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOrganizationPolicies500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetOrganizationPolicies500JSONResponse) VisitGetOrganizationPoliciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganizationPoliciesRequestObject struct {
+	OrganizationID OrganizationID `json:"organizationID"`
+	Body           *UpdateOrganizationPoliciesJSONRequestBody
+}
+
+type UpdateOrganizationPoliciesResponseObject interface {
+	VisitUpdateOrganizationPoliciesResponse(w http.ResponseWriter) error
+}
+
+type UpdateOrganizationPolicies200JSONResponse OrganizationPolicies
+
+func (response UpdateOrganizationPolicies200JSONResponse) VisitUpdateOrganizationPoliciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganizationPolicies400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateOrganizationPolicies400JSONResponse) VisitUpdateOrganizationPoliciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganizationPolicies401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateOrganizationPolicies401JSONResponse) VisitUpdateOrganizationPoliciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganizationPolicies403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UpdateOrganizationPolicies403JSONResponse) VisitUpdateOrganizationPoliciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganizationPolicies404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateOrganizationPolicies404JSONResponse) VisitUpdateOrganizationPoliciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOrganizationPolicies500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response UpdateOrganizationPolicies500JSONResponse) VisitUpdateOrganizationPoliciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrganizationVaultRequestObject struct {
+	OrganizationID OrganizationID `json:"organizationID"`
+	Body           *CreateOrganizationVaultJSONRequestBody
+}
+
+type CreateOrganizationVaultResponseObject interface {
+	VisitCreateOrganizationVaultResponse(w http.ResponseWriter) error
+}
+
+type CreateOrganizationVault201JSONResponse VaultResponse
+
+func (response CreateOrganizationVault201JSONResponse) VisitCreateOrganizationVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrganizationVault400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateOrganizationVault400JSONResponse) VisitCreateOrganizationVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrganizationVault401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateOrganizationVault401JSONResponse) VisitCreateOrganizationVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrganizationVault403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateOrganizationVault403JSONResponse) VisitCreateOrganizationVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrganizationVault404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CreateOrganizationVault404JSONResponse) VisitCreateOrganizationVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOrganizationVault500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CreateOrganizationVault500JSONResponse) VisitCreateOrganizationVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RefreshTokenRequestObject struct {
+}
+
+type RefreshTokenResponseObject interface {
+	VisitRefreshTokenResponse(w http.ResponseWriter) error
+}
+
+type RefreshToken200ResponseHeaders struct {
+	SetCookie string
+}
+
+type RefreshToken200JSONResponse struct {
+	Body    TokenResponse
+	Headers RefreshToken200ResponseHeaders
+}
+
+func (response RefreshToken200JSONResponse) VisitRefreshTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RefreshToken401JSONResponse ErrorResponse
+
+func (response RefreshToken401JSONResponse) VisitRefreshTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RefreshToken500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response RefreshToken500JSONResponse) VisitRefreshTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSendsRequestObject struct {
+}
+
+type ListSendsResponseObject interface {
+	VisitListSendsResponse(w http.ResponseWriter) error
+}
+
+type ListSends200JSONResponse []SendResponse
+
+func (response ListSends200JSONResponse) VisitListSendsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSends401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListSends401JSONResponse) VisitListSendsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSends500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListSends500JSONResponse) VisitListSendsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSendRequestObject struct {
+	Body *CreateSendJSONRequestBody
+}
+
+type CreateSendResponseObject interface {
+	VisitCreateSendResponse(w http.ResponseWriter) error
+}
+
+type CreateSend201JSONResponse SendResponse
+
+func (response CreateSend201JSONResponse) VisitCreateSendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSend400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateSend400JSONResponse) VisitCreateSendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSend401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateSend401JSONResponse) VisitCreateSendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSend429JSONResponse ErrorResponse
+
+func (response CreateSend429JSONResponse) VisitCreateSendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSend500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CreateSend500JSONResponse) VisitCreateSendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeSendRequestObject struct {
+	SendID SendID `json:"sendID"`
+}
+
+type RevokeSendResponseObject interface {
+	VisitRevokeSendResponse(w http.ResponseWriter) error
+}
+
+type RevokeSend204Response struct {
+}
+
+func (response RevokeSend204Response) VisitRevokeSendResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RevokeSend401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RevokeSend401JSONResponse) VisitRevokeSendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeSend404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RevokeSend404JSONResponse) VisitRevokeSendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeSend500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response RevokeSend500JSONResponse) VisitRevokeSendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ViewSendRequestObject struct {
+	SendID SendID `json:"sendID"`
+	Params ViewSendParams
+}
+
+type ViewSendResponseObject interface {
+	VisitViewSendResponse(w http.ResponseWriter) error
+}
+
+type ViewSend200ResponseHeaders struct {
+	CacheControl string
+}
+
+type ViewSend200JSONResponse struct {
+	Body    SendContentResponse
+	Headers ViewSend200ResponseHeaders
+}
+
+func (response ViewSend200JSONResponse) VisitViewSendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ViewSend401JSONResponse ErrorResponse
+
+func (response ViewSend401JSONResponse) VisitViewSendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ViewSend404JSONResponse ErrorResponse
+
+func (response ViewSend404JSONResponse) VisitViewSendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ViewSend500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ViewSend500JSONResponse) VisitViewSendResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IssueTokenRequestObject struct {
+	Body *IssueTokenJSONRequestBody
+}
+
+type IssueTokenResponseObject interface {
+	VisitIssueTokenResponse(w http.ResponseWriter) error
+}
+
+type IssueToken200ResponseHeaders struct {
+	SetCookie string
+}
+
+type IssueToken200JSONResponse struct {
+	Body    TokenResponse
+	Headers IssueToken200ResponseHeaders
+}
+
+func (response IssueToken200JSONResponse) VisitIssueTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type IssueToken400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response IssueToken400JSONResponse) VisitIssueTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IssueToken401JSONResponse ErrorResponse
+
+func (response IssueToken401JSONResponse) VisitIssueTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IssueToken500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response IssueToken500JSONResponse) VisitIssueTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCurrentUserRequestObject struct {
+}
+
+type GetCurrentUserResponseObject interface {
+	VisitGetCurrentUserResponse(w http.ResponseWriter) error
+}
+
+type GetCurrentUser200JSONResponse UserResponse
+
+func (response GetCurrentUser200JSONResponse) VisitGetCurrentUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCurrentUser401JSONResponse ErrorResponse
+
+func (response GetCurrentUser401JSONResponse) VisitGetCurrentUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCurrentUser500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetCurrentUser500JSONResponse) VisitGetCurrentUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateUserRequestObject struct {
+	Body *CreateUserJSONRequestBody
+}
+
+type CreateUserResponseObject interface {
+	VisitCreateUserResponse(w http.ResponseWriter) error
+}
+
+type CreateUser201Response struct {
+}
+
+func (response CreateUser201Response) VisitCreateUserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(201)
+	return nil
+}
+
+type CreateUser400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateUser400JSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateUser500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CreateUser500JSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmUserRequestObject struct {
+	Params ConfirmUserParams
+}
+
+type ConfirmUserResponseObject interface {
+	VisitConfirmUserResponse(w http.ResponseWriter) error
+}
+
+type ConfirmUser200JSONResponse UserResponse
+
+func (response ConfirmUser200JSONResponse) VisitConfirmUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmUser400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ConfirmUser400JSONResponse) VisitConfirmUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmUser500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ConfirmUser500JSONResponse) VisitConfirmUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListEmergencyAccessRequestObject struct {
+}
+
+type ListEmergencyAccessResponseObject interface {
+	VisitListEmergencyAccessResponse(w http.ResponseWriter) error
+}
+
+type ListEmergencyAccess200JSONResponse EmergencyAccessListResponse
+
+func (response ListEmergencyAccess200JSONResponse) VisitListEmergencyAccessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListEmergencyAccess401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListEmergencyAccess401JSONResponse) VisitListEmergencyAccessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListEmergencyAccess500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListEmergencyAccess500JSONResponse) VisitListEmergencyAccessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InviteEmergencyContactRequestObject struct {
+	Body *InviteEmergencyContactJSONRequestBody
+}
+
+type InviteEmergencyContactResponseObject interface {
+	VisitInviteEmergencyContactResponse(w http.ResponseWriter) error
+}
+
+type InviteEmergencyContact201JSONResponse EmergencyAccessResponse
+
+func (response InviteEmergencyContact201JSONResponse) VisitInviteEmergencyContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InviteEmergencyContact400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response InviteEmergencyContact400JSONResponse) VisitInviteEmergencyContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InviteEmergencyContact401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response InviteEmergencyContact401JSONResponse) VisitInviteEmergencyContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InviteEmergencyContact403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response InviteEmergencyContact403JSONResponse) VisitInviteEmergencyContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InviteEmergencyContact404JSONResponse ErrorResponse
+
+func (response InviteEmergencyContact404JSONResponse) VisitInviteEmergencyContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InviteEmergencyContact409JSONResponse ErrorResponse
+
+func (response InviteEmergencyContact409JSONResponse) VisitInviteEmergencyContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InviteEmergencyContact500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response InviteEmergencyContact500JSONResponse) VisitInviteEmergencyContactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeEmergencyAccessRequestObject struct {
+	EmergencyAccessID EmergencyAccessID `json:"emergencyAccessID"`
+}
+
+type RevokeEmergencyAccessResponseObject interface {
+	VisitRevokeEmergencyAccessResponse(w http.ResponseWriter) error
+}
+
+type RevokeEmergencyAccess204Response struct {
+}
+
+func (response RevokeEmergencyAccess204Response) VisitRevokeEmergencyAccessResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RevokeEmergencyAccess401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RevokeEmergencyAccess401JSONResponse) VisitRevokeEmergencyAccessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeEmergencyAccess404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RevokeEmergencyAccess404JSONResponse) VisitRevokeEmergencyAccessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeEmergencyAccess500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response RevokeEmergencyAccess500JSONResponse) VisitRevokeEmergencyAccessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptEmergencyAccessRequestObject struct {
+	EmergencyAccessID EmergencyAccessID `json:"emergencyAccessID"`
+}
+
+type AcceptEmergencyAccessResponseObject interface {
+	VisitAcceptEmergencyAccessResponse(w http.ResponseWriter) error
+}
+
+type AcceptEmergencyAccess200JSONResponse EmergencyAccessResponse
+
+func (response AcceptEmergencyAccess200JSONResponse) VisitAcceptEmergencyAccessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptEmergencyAccess401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response AcceptEmergencyAccess401JSONResponse) VisitAcceptEmergencyAccessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptEmergencyAccess404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response AcceptEmergencyAccess404JSONResponse) VisitAcceptEmergencyAccessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptEmergencyAccess409JSONResponse ErrorResponse
+
+func (response AcceptEmergencyAccess409JSONResponse) VisitAcceptEmergencyAccessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptEmergencyAccess500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response AcceptEmergencyAccess500JSONResponse) VisitAcceptEmergencyAccessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveEmergencyAccessRequestObject struct {
+	EmergencyAccessID EmergencyAccessID `json:"emergencyAccessID"`
+}
+
+type ApproveEmergencyAccessResponseObject interface {
+	VisitApproveEmergencyAccessResponse(w http.ResponseWriter) error
+}
+
+type ApproveEmergencyAccess200JSONResponse EmergencyAccessResponse
+
+func (response ApproveEmergencyAccess200JSONResponse) VisitApproveEmergencyAccessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveEmergencyAccess401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ApproveEmergencyAccess401JSONResponse) VisitApproveEmergencyAccessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveEmergencyAccess404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ApproveEmergencyAccess404JSONResponse) VisitApproveEmergencyAccessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveEmergencyAccess409JSONResponse ErrorResponse
+
+func (response ApproveEmergencyAccess409JSONResponse) VisitApproveEmergencyAccessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ApproveEmergencyAccess500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ApproveEmergencyAccess500JSONResponse) VisitApproveEmergencyAccessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateEmergencyAccessKeyRequestObject struct {
+	EmergencyAccessID EmergencyAccessID `json:"emergencyAccessID"`
+	Body              *UpdateEmergencyAccessKeyJSONRequestBody
+}
+
+type UpdateEmergencyAccessKeyResponseObject interface {
+	VisitUpdateEmergencyAccessKeyResponse(w http.ResponseWriter) error
+}
+
+type UpdateEmergencyAccessKey200JSONResponse EmergencyAccessResponse
+
+func (response UpdateEmergencyAccessKey200JSONResponse) VisitUpdateEmergencyAccessKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateEmergencyAccessKey400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateEmergencyAccessKey400JSONResponse) VisitUpdateEmergencyAccessKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateEmergencyAccessKey401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateEmergencyAccessKey401JSONResponse) VisitUpdateEmergencyAccessKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateEmergencyAccessKey404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateEmergencyAccessKey404JSONResponse) VisitUpdateEmergencyAccessKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateEmergencyAccessKey409JSONResponse ErrorResponse
+
+func (response UpdateEmergencyAccessKey409JSONResponse) VisitUpdateEmergencyAccessKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateEmergencyAccessKey500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response UpdateEmergencyAccessKey500JSONResponse) VisitUpdateEmergencyAccessKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectEmergencyAccessRequestObject struct {
+	EmergencyAccessID EmergencyAccessID `json:"emergencyAccessID"`
+}
+
+type RejectEmergencyAccessResponseObject interface {
+	VisitRejectEmergencyAccessResponse(w http.ResponseWriter) error
+}
+
+type RejectEmergencyAccess200JSONResponse EmergencyAccessResponse
+
+func (response RejectEmergencyAccess200JSONResponse) VisitRejectEmergencyAccessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectEmergencyAccess401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RejectEmergencyAccess401JSONResponse) VisitRejectEmergencyAccessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectEmergencyAccess404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RejectEmergencyAccess404JSONResponse) VisitRejectEmergencyAccessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectEmergencyAccess409JSONResponse ErrorResponse
+
+func (response RejectEmergencyAccess409JSONResponse) VisitRejectEmergencyAccessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectEmergencyAccess500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response RejectEmergencyAccess500JSONResponse) VisitRejectEmergencyAccessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestEmergencyAccessRequestObject struct {
+	EmergencyAccessID EmergencyAccessID `json:"emergencyAccessID"`
+}
+
+type RequestEmergencyAccessResponseObject interface {
+	VisitRequestEmergencyAccessResponse(w http.ResponseWriter) error
+}
+
+type RequestEmergencyAccess200JSONResponse EmergencyAccessResponse
+
+func (response RequestEmergencyAccess200JSONResponse) VisitRequestEmergencyAccessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestEmergencyAccess401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RequestEmergencyAccess401JSONResponse) VisitRequestEmergencyAccessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestEmergencyAccess404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RequestEmergencyAccess404JSONResponse) VisitRequestEmergencyAccessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestEmergencyAccess409JSONResponse ErrorResponse
+
+func (response RequestEmergencyAccess409JSONResponse) VisitRequestEmergencyAccessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RequestEmergencyAccess500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response RequestEmergencyAccess500JSONResponse) VisitRequestEmergencyAccessResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUserEventsRequestObject struct {
+	Params ListUserEventsParams
+}
+
+type ListUserEventsResponseObject interface {
+	VisitListUserEventsResponse(w http.ResponseWriter) error
+}
+
+type ListUserEvents200JSONResponse SecurityEventListResponse
+
+func (response ListUserEvents200JSONResponse) VisitListUserEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUserEvents400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListUserEvents400JSONResponse) VisitListUserEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUserEvents401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListUserEvents401JSONResponse) VisitListUserEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUserEvents500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListUserEvents500JSONResponse) VisitListUserEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUserKeysRequestObject struct {
+}
+
+type ListUserKeysResponseObject interface {
+	VisitListUserKeysResponse(w http.ResponseWriter) error
+}
+
+type ListUserKeys200JSONResponse []UserKeyResponse
+
+func (response ListUserKeys200JSONResponse) VisitListUserKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUserKeys401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListUserKeys401JSONResponse) VisitListUserKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUserKeys500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListUserKeys500JSONResponse) VisitListUserKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PublishUserKeyRequestObject struct {
+	Body *PublishUserKeyJSONRequestBody
+}
+
+type PublishUserKeyResponseObject interface {
+	VisitPublishUserKeyResponse(w http.ResponseWriter) error
+}
+
+type PublishUserKey201JSONResponse UserKeyResponse
+
+func (response PublishUserKey201JSONResponse) VisitPublishUserKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PublishUserKey400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PublishUserKey400JSONResponse) VisitPublishUserKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PublishUserKey401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PublishUserKey401JSONResponse) VisitPublishUserKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PublishUserKey409JSONResponse UserKeyConflictResponse
+
+func (response PublishUserKey409JSONResponse) VisitPublishUserKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PublishUserKey500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response PublishUserKey500JSONResponse) VisitPublishUserKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserKeyRequestObject struct {
+	Version int `json:"version"`
+}
+
+type GetUserKeyResponseObject interface {
+	VisitGetUserKeyResponse(w http.ResponseWriter) error
+}
+
+type GetUserKey200JSONResponse UserKeyResponse
+
+func (response GetUserKey200JSONResponse) VisitGetUserKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserKey401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetUserKey401JSONResponse) VisitGetUserKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserKey404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetUserKey404JSONResponse) VisitGetUserKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserKey500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetUserKey500JSONResponse) VisitGetUserKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserPoliciesRequestObject struct {
+}
+
+type GetUserPoliciesResponseObject interface {
+	VisitGetUserPoliciesResponse(w http.ResponseWriter) error
+}
+
+type GetUserPolicies200JSONResponse OrganizationPolicies
+
+func (response GetUserPolicies200JSONResponse) VisitGetUserPoliciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserPolicies401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetUserPolicies401JSONResponse) VisitGetUserPoliciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserPolicies500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetUserPolicies500JSONResponse) VisitGetUserPoliciesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserVaultRequestObject struct {
+	Params GetUserVaultParams
+}
+
+type GetUserVaultResponseObject interface {
+	VisitGetUserVaultResponse(w http.ResponseWriter) error
+}
+
+type GetUserVault200ResponseHeaders struct {
+	CacheControl string
+	ETag         string
+	LastModified string
+}
+
+type GetUserVault200MultipartResponse struct {
+	Body    func(writer *multipart.Writer) error
+	Headers GetUserVault200ResponseHeaders
+}
+
+func (response GetUserVault200MultipartResponse) VisitGetUserVaultResponse(w http.ResponseWriter) error {
+	writer := multipart.NewWriter(w)
+	w.Header().Set("Content-Type", writer.FormDataContentType())
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.WriteHeader(200)
+
+	defer writer.Close()
+	return response.Body(writer)
+}
+
+type GetUserVault200SchemaResponse struct {
+	Body          io.Reader
+	Headers       GetUserVault200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetUserVault200SchemaResponse) VisitGetUserVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "schema")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetUserVault304ResponseHeaders struct {
+	CacheControl string
+	ETag         string
+	LastModified string
+}
+
+type GetUserVault304Response struct {
+	Headers GetUserVault304ResponseHeaders
+}
+
+func (response GetUserVault304Response) VisitGetUserVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.WriteHeader(304)
+	return nil
+}
+
+type GetUserVault401JSONResponse ErrorResponse
+
+func (response GetUserVault401JSONResponse) VisitGetUserVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserVault404JSONResponse ErrorResponse
+
+func (response GetUserVault404JSONResponse) VisitGetUserVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUserVault500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetUserVault500JSONResponse) VisitGetUserVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InsertUserVaultRequestObject struct {
+	Params InsertUserVaultParams
+	Body   io.Reader
+}
+
+type InsertUserVaultResponseObject interface {
+	VisitInsertUserVaultResponse(w http.ResponseWriter) error
+}
+
+type InsertUserVault204ResponseHeaders struct {
+	ETag string
+}
+
+type InsertUserVault204Response struct {
+	Headers InsertUserVault204ResponseHeaders
+}
+
+func (response InsertUserVault204Response) VisitInsertUserVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(204)
+	return nil
+}
+
+type InsertUserVault400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response InsertUserVault400JSONResponse) VisitInsertUserVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InsertUserVault401JSONResponse ErrorResponse
+
+func (response InsertUserVault401JSONResponse) VisitInsertUserVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InsertUserVault412ResponseHeaders struct {
+	ETag string
+}
+
+type InsertUserVault412JSONResponse struct {
+	Body    VaultConflictResponse
+	Headers InsertUserVault412ResponseHeaders
+}
+
+func (response InsertUserVault412JSONResponse) VisitInsertUserVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type InsertUserVault413JSONResponse struct{ PayloadTooLargeJSONResponse }
+
+func (response InsertUserVault413JSONResponse) VisitInsertUserVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InsertUserVault428JSONResponse ErrorResponse
+
+func (response InsertUserVault428JSONResponse) VisitInsertUserVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(428)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InsertUserVault500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response InsertUserVault500JSONResponse) VisitInsertUserVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InsertUserVault507JSONResponse struct{ QuotaExceededJSONResponse }
+
+func (response InsertUserVault507JSONResponse) VisitInsertUserVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(507)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamVaultEventsRequestObject struct {
+	Params StreamVaultEventsParams
+}
+
+type StreamVaultEventsResponseObject interface {
+	VisitStreamVaultEventsResponse(w http.ResponseWriter) error
+}
+
+type StreamVaultEvents200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response StreamVaultEvents200TexteventStreamResponse) VisitStreamVaultEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type StreamVaultEvents400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response StreamVaultEvents400JSONResponse) VisitStreamVaultEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamVaultEvents401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response StreamVaultEvents401JSONResponse) VisitStreamVaultEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamVaultEvents500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response StreamVaultEvents500JSONResponse) VisitStreamVaultEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PollUserVaultRequestObject struct {
+	Params PollUserVaultParams
+}
+
+type PollUserVaultResponseObject interface {
+	VisitPollUserVaultResponse(w http.ResponseWriter) error
+}
+
+type PollUserVault200ResponseHeaders struct {
+	ETag string
+}
+
+type PollUserVault200JSONResponse struct {
+	Body struct {
+		// ContentDigest SHA-256 digest of the vault, in Content-Digest format
+		ContentDigest string `json:"contentDigest"`
+		Revision      int64  `json:"revision"`
+
+		// UpdatedAt Unix epoch timestamp (seconds since 1970-01-01T00:00:00Z)
+		UpdatedAt int64 `json:"updatedAt"`
+	}
+	Headers PollUserVault200ResponseHeaders
+}
+
+func (response PollUserVault200JSONResponse) VisitPollUserVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PollUserVault400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PollUserVault400JSONResponse) VisitPollUserVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PollUserVault401JSONResponse ErrorResponse
+
+func (response PollUserVault401JSONResponse) VisitPollUserVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PollUserVault404JSONResponse ErrorResponse
+
+func (response PollUserVault404JSONResponse) VisitPollUserVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PollUserVault429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response PollUserVault429JSONResponse) VisitPollUserVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PollUserVault500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response PollUserVault500JSONResponse) VisitPollUserVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SyncUserVaultRequestObject struct {
+	Body *SyncUserVaultJSONRequestBody
+}
+
+type SyncUserVaultResponseObject interface {
+	VisitSyncUserVaultResponse(w http.ResponseWriter) error
+}
+
+type SyncUserVault200JSONResponse VaultSyncResponse
+
+func (response SyncUserVault200JSONResponse) VisitSyncUserVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SyncUserVault400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SyncUserVault400JSONResponse) VisitSyncUserVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SyncUserVault401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response SyncUserVault401JSONResponse) VisitSyncUserVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SyncUserVault500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response SyncUserVault500JSONResponse) VisitSyncUserVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SyncUserVault507JSONResponse struct{ QuotaExceededJSONResponse }
+
+func (response SyncUserVault507JSONResponse) VisitSyncUserVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(507)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateVaultUploadRequestObject struct {
+	Body *CreateVaultUploadJSONRequestBody
+}
+
+type CreateVaultUploadResponseObject interface {
+	VisitCreateVaultUploadResponse(w http.ResponseWriter) error
+}
+
+type CreateVaultUpload201JSONResponse VaultUploadResponse
+
+func (response CreateVaultUpload201JSONResponse) VisitCreateVaultUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateVaultUpload400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateVaultUpload400JSONResponse) VisitCreateVaultUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateVaultUpload401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateVaultUpload401JSONResponse) VisitCreateVaultUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateVaultUpload404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CreateVaultUpload404JSONResponse) VisitCreateVaultUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateVaultUpload413JSONResponse struct{ PayloadTooLargeJSONResponse }
+
+func (response CreateVaultUpload413JSONResponse) VisitCreateVaultUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateVaultUpload429JSONResponse struct{ TooManyRequestsJSONResponse }
+
+func (response CreateVaultUpload429JSONResponse) VisitCreateVaultUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateVaultUpload500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CreateVaultUpload500JSONResponse) VisitCreateVaultUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateVaultUpload507JSONResponse struct{ QuotaExceededJSONResponse }
+
+func (response CreateVaultUpload507JSONResponse) VisitCreateVaultUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(507)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelVaultUploadRequestObject struct {
+	UploadId string `json:"uploadId"`
+}
+
+type CancelVaultUploadResponseObject interface {
+	VisitCancelVaultUploadResponse(w http.ResponseWriter) error
+}
+
+type CancelVaultUpload204Response struct {
+}
+
+func (response CancelVaultUpload204Response) VisitCancelVaultUploadResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type CancelVaultUpload401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CancelVaultUpload401JSONResponse) VisitCancelVaultUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelVaultUpload404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CancelVaultUpload404JSONResponse) VisitCancelVaultUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelVaultUpload500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CancelVaultUpload500JSONResponse) VisitCancelVaultUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVaultUploadRequestObject struct {
+	UploadId string `json:"uploadId"`
+}
+
+type GetVaultUploadResponseObject interface {
+	VisitGetVaultUploadResponse(w http.ResponseWriter) error
+}
+
+type GetVaultUpload200JSONResponse VaultUploadResponse
+
+func (response GetVaultUpload200JSONResponse) VisitGetVaultUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVaultUpload401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetVaultUpload401JSONResponse) VisitGetVaultUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVaultUpload404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetVaultUpload404JSONResponse) VisitGetVaultUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVaultUpload500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetVaultUpload500JSONResponse) VisitGetVaultUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AppendVaultUploadRequestObject struct {
+	UploadId string `json:"uploadId"`
+	Params   AppendVaultUploadParams
+	Body     io.Reader
+}
+
+type AppendVaultUploadResponseObject interface {
+	VisitAppendVaultUploadResponse(w http.ResponseWriter) error
+}
+
+type AppendVaultUpload200JSONResponse VaultUploadResponse
+
+func (response AppendVaultUpload200JSONResponse) VisitAppendVaultUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AppendVaultUpload400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response AppendVaultUpload400JSONResponse) VisitAppendVaultUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AppendVaultUpload401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response AppendVaultUpload401JSONResponse) VisitAppendVaultUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AppendVaultUpload404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response AppendVaultUpload404JSONResponse) VisitAppendVaultUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AppendVaultUpload409JSONResponse VaultUploadResponse
+
+func (response AppendVaultUpload409JSONResponse) VisitAppendVaultUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AppendVaultUpload413JSONResponse struct{ PayloadTooLargeJSONResponse }
+
+func (response AppendVaultUpload413JSONResponse) VisitAppendVaultUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AppendVaultUpload500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response AppendVaultUpload500JSONResponse) VisitAppendVaultUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CommitVaultUploadRequestObject struct {
+	UploadId string `json:"uploadId"`
+	Params   CommitVaultUploadParams
+}
+
+type CommitVaultUploadResponseObject interface {
+	VisitCommitVaultUploadResponse(w http.ResponseWriter) error
+}
+
+type CommitVaultUpload204ResponseHeaders struct {
+	ETag string
+}
+
+type CommitVaultUpload204Response struct {
+	Headers CommitVaultUpload204ResponseHeaders
+}
+
+func (response CommitVaultUpload204Response) VisitCommitVaultUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(204)
+	return nil
+}
+
+type CommitVaultUpload400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CommitVaultUpload400JSONResponse) VisitCommitVaultUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CommitVaultUpload401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CommitVaultUpload401JSONResponse) VisitCommitVaultUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CommitVaultUpload404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CommitVaultUpload404JSONResponse) VisitCommitVaultUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CommitVaultUpload409JSONResponse VaultUploadResponse
+
+func (response CommitVaultUpload409JSONResponse) VisitCommitVaultUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CommitVaultUpload412ResponseHeaders struct {
+	ETag string
+}
+
+type CommitVaultUpload412JSONResponse struct {
+	Body    VaultConflictResponse
+	Headers CommitVaultUpload412ResponseHeaders
+}
+
+func (response CommitVaultUpload412JSONResponse) VisitCommitVaultUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type CommitVaultUpload422JSONResponse ErrorResponse
+
+func (response CommitVaultUpload422JSONResponse) VisitCommitVaultUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CommitVaultUpload428JSONResponse ErrorResponse
+
+func (response CommitVaultUpload428JSONResponse) VisitCommitVaultUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(428)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CommitVaultUpload500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CommitVaultUpload500JSONResponse) VisitCommitVaultUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CommitVaultUpload507JSONResponse struct{ QuotaExceededJSONResponse }
+
+func (response CommitVaultUpload507JSONResponse) VisitCommitVaultUploadResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(507)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVaultUsageRequestObject struct {
+}
+
+type GetVaultUsageResponseObject interface {
+	VisitGetVaultUsageResponse(w http.ResponseWriter) error
+}
+
+type GetVaultUsage200JSONResponse VaultUsageResponse
+
+func (response GetVaultUsage200JSONResponse) VisitGetVaultUsageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVaultUsage401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetVaultUsage401JSONResponse) VisitGetVaultUsageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVaultUsage500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetVaultUsage500JSONResponse) VisitGetVaultUsageResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListVaultVersionsRequestObject struct {
+}
+
+type ListVaultVersionsResponseObject interface {
+	VisitListVaultVersionsResponse(w http.ResponseWriter) error
+}
+
+type ListVaultVersions200JSONResponse []VaultVersionResponse
+
+func (response ListVaultVersions200JSONResponse) VisitListVaultVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListVaultVersions401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListVaultVersions401JSONResponse) VisitListVaultVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListVaultVersions500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListVaultVersions500JSONResponse) VisitListVaultVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVaultVersionRequestObject struct {
+	Revision VaultRevision `json:"revision"`
+}
+
+type GetVaultVersionResponseObject interface {
+	VisitGetVaultVersionResponse(w http.ResponseWriter) error
+}
+
+type GetVaultVersion200ResponseHeaders struct {
+	CacheControl  string
+	ContentDigest string
+}
+
+type GetVaultVersion200ApplicationoctetStreamResponse struct {
+	Body          io.Reader
+	Headers       GetVaultVersion200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetVaultVersion200ApplicationoctetStreamResponse) VisitGetVaultVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("Content-Digest", fmt.Sprint(response.Headers.ContentDigest))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetVaultVersion401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetVaultVersion401JSONResponse) VisitGetVaultVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVaultVersion404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetVaultVersion404JSONResponse) VisitGetVaultVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVaultVersion500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetVaultVersion500JSONResponse) VisitGetVaultVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreVaultVersionRequestObject struct {
+	Revision VaultRevision `json:"revision"`
+	Params   RestoreVaultVersionParams
+}
+
+type RestoreVaultVersionResponseObject interface {
+	VisitRestoreVaultVersionResponse(w http.ResponseWriter) error
+}
+
+type RestoreVaultVersion204ResponseHeaders struct {
+	ETag string
+}
+
+type RestoreVaultVersion204Response struct {
+	Headers RestoreVaultVersion204ResponseHeaders
+}
+
+func (response RestoreVaultVersion204Response) VisitRestoreVaultVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(204)
+	return nil
+}
+
+type RestoreVaultVersion400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RestoreVaultVersion400JSONResponse) VisitRestoreVaultVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreVaultVersion401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RestoreVaultVersion401JSONResponse) VisitRestoreVaultVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreVaultVersion404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RestoreVaultVersion404JSONResponse) VisitRestoreVaultVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreVaultVersion412ResponseHeaders struct {
+	ETag string
+}
+
+type RestoreVaultVersion412JSONResponse struct {
+	Body    VaultConflictResponse
+	Headers RestoreVaultVersion412ResponseHeaders
+}
+
+func (response RestoreVaultVersion412JSONResponse) VisitRestoreVaultVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type RestoreVaultVersion428JSONResponse ErrorResponse
+
+func (response RestoreVaultVersion428JSONResponse) VisitRestoreVaultVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(428)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreVaultVersion500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response RestoreVaultVersion500JSONResponse) VisitRestoreVaultVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreVaultVersion507JSONResponse struct{ QuotaExceededJSONResponse }
+
+func (response RestoreVaultVersion507JSONResponse) VisitRestoreVaultVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(507)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListVaultsRequestObject struct {
+}
+
+type ListVaultsResponseObject interface {
+	VisitListVaultsResponse(w http.ResponseWriter) error
+}
+
+type ListVaults200JSONResponse []VaultResponse
+
+func (response ListVaults200JSONResponse) VisitListVaultsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListVaults401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListVaults401JSONResponse) VisitListVaultsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListVaults500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListVaults500JSONResponse) VisitListVaultsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateVaultRequestObject struct {
+	Body *CreateVaultJSONRequestBody
+}
+
+type CreateVaultResponseObject interface {
+	VisitCreateVaultResponse(w http.ResponseWriter) error
+}
+
+type CreateVault201JSONResponse VaultResponse
+
+func (response CreateVault201JSONResponse) VisitCreateVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateVault400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateVault400JSONResponse) VisitCreateVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateVault401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateVault401JSONResponse) VisitCreateVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateVault500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CreateVault500JSONResponse) VisitCreateVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteVaultRequestObject struct {
+	VaultID VaultID `json:"vaultID"`
+}
+
+type DeleteVaultResponseObject interface {
+	VisitDeleteVaultResponse(w http.ResponseWriter) error
+}
+
+type DeleteVault204Response struct {
+}
+
+func (response DeleteVault204Response) VisitDeleteVaultResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteVault401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteVault401JSONResponse) VisitDeleteVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteVault403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteVault403JSONResponse) VisitDeleteVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteVault404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteVault404JSONResponse) VisitDeleteVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteVault409JSONResponse ErrorResponse
+
+func (response DeleteVault409JSONResponse) VisitDeleteVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteVault500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeleteVault500JSONResponse) VisitDeleteVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVaultRequestObject struct {
+	VaultID VaultID `json:"vaultID"`
+}
+
+type GetVaultResponseObject interface {
+	VisitGetVaultResponse(w http.ResponseWriter) error
+}
+
+type GetVault200JSONResponse VaultResponse
+
+func (response GetVault200JSONResponse) VisitGetVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVault401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetVault401JSONResponse) VisitGetVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVault404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetVault404JSONResponse) VisitGetVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVault500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetVault500JSONResponse) VisitGetVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateVaultRequestObject struct {
+	VaultID VaultID `json:"vaultID"`
+	Body    *UpdateVaultJSONRequestBody
+}
+
+type UpdateVaultResponseObject interface {
+	VisitUpdateVaultResponse(w http.ResponseWriter) error
+}
+
+type UpdateVault200JSONResponse VaultResponse
+
+func (response UpdateVault200JSONResponse) VisitUpdateVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateVault400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateVault400JSONResponse) VisitUpdateVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateVault401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateVault401JSONResponse) VisitUpdateVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateVault403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UpdateVault403JSONResponse) VisitUpdateVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateVault404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateVault404JSONResponse) VisitUpdateVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateVault500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response UpdateVault500JSONResponse) VisitUpdateVaultResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptVaultInviteRequestObject struct {
+	VaultID VaultID `json:"vaultID"`
+}
+
+type AcceptVaultInviteResponseObject interface {
+	VisitAcceptVaultInviteResponse(w http.ResponseWriter) error
+}
+
+type AcceptVaultInvite200JSONResponse VaultMemberResponse
+
+func (response AcceptVaultInvite200JSONResponse) VisitAcceptVaultInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptVaultInvite401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response AcceptVaultInvite401JSONResponse) VisitAcceptVaultInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptVaultInvite404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response AcceptVaultInvite404JSONResponse) VisitAcceptVaultInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AcceptVaultInvite500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response AcceptVaultInvite500JSONResponse) VisitAcceptVaultInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVaultContentRequestObject struct {
+	VaultID VaultID `json:"vaultID"`
+	Params  GetVaultContentParams
+}
+
+type GetVaultContentResponseObject interface {
+	VisitGetVaultContentResponse(w http.ResponseWriter) error
+}
+
+type GetVaultContent200ResponseHeaders struct {
+	CacheControl  string
+	ContentDigest string
+	ETag          string
+	LastModified  string
+}
+
+type GetVaultContent200ApplicationoctetStreamResponse struct {
+	Body          io.Reader
+	Headers       GetVaultContent200ResponseHeaders
+	ContentLength int64
+}
+
+func (response GetVaultContent200ApplicationoctetStreamResponse) VisitGetVaultContentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("Content-Digest", fmt.Sprint(response.Headers.ContentDigest))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetVaultContent304ResponseHeaders struct {
+	CacheControl string
+	ETag         string
+	LastModified string
+}
+
+type GetVaultContent304Response struct {
+	Headers GetVaultContent304ResponseHeaders
+}
+
+func (response GetVaultContent304Response) VisitGetVaultContentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Cache-Control", fmt.Sprint(response.Headers.CacheControl))
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.Header().Set("Last-Modified", fmt.Sprint(response.Headers.LastModified))
+	w.WriteHeader(304)
+	return nil
+}
+
+type GetVaultContent401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetVaultContent401JSONResponse) VisitGetVaultContentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVaultContent404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetVaultContent404JSONResponse) VisitGetVaultContentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVaultContent500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetVaultContent500JSONResponse) VisitGetVaultContentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutVaultContentRequestObject struct {
+	VaultID VaultID `json:"vaultID"`
+	Params  PutVaultContentParams
+	Body    io.Reader
+}
+
+type PutVaultContentResponseObject interface {
+	VisitPutVaultContentResponse(w http.ResponseWriter) error
+}
+
+type PutVaultContent204ResponseHeaders struct {
+	ETag string
+}
+
+type PutVaultContent204Response struct {
+	Headers PutVaultContent204ResponseHeaders
+}
+
+func (response PutVaultContent204Response) VisitPutVaultContentResponse(w http.ResponseWriter) error {
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(204)
+	return nil
+}
+
+type PutVaultContent400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PutVaultContent400JSONResponse) VisitPutVaultContentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutVaultContent401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PutVaultContent401JSONResponse) VisitPutVaultContentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutVaultContent403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PutVaultContent403JSONResponse) VisitPutVaultContentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutVaultContent404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response PutVaultContent404JSONResponse) VisitPutVaultContentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutVaultContent412ResponseHeaders struct {
+	ETag string
+}
+
+type PutVaultContent412JSONResponse struct {
+	Body    VaultConflictResponse
+	Headers PutVaultContent412ResponseHeaders
+}
+
+func (response PutVaultContent412JSONResponse) VisitPutVaultContentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PutVaultContent413JSONResponse struct{ PayloadTooLargeJSONResponse }
+
+func (response PutVaultContent413JSONResponse) VisitPutVaultContentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutVaultContent428JSONResponse ErrorResponse
+
+func (response PutVaultContent428JSONResponse) VisitPutVaultContentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(428)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutVaultContent500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response PutVaultContent500JSONResponse) VisitPutVaultContentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutVaultContent507JSONResponse struct{ QuotaExceededJSONResponse }
+
+func (response PutVaultContent507JSONResponse) VisitPutVaultContentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(507)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVaultKeyRequestObject struct {
+	VaultID VaultID `json:"vaultID"`
+}
+
+type GetVaultKeyResponseObject interface {
+	VisitGetVaultKeyResponse(w http.ResponseWriter) error
+}
+
+type GetVaultKey200JSONResponse VaultKeyResponse
+
+func (response GetVaultKey200JSONResponse) VisitGetVaultKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVaultKey401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetVaultKey401JSONResponse) VisitGetVaultKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVaultKey404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetVaultKey404JSONResponse) VisitGetVaultKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetVaultKey500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetVaultKey500JSONResponse) VisitGetVaultKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateVaultKeyRequestObject struct {
+	VaultID VaultID `json:"vaultID"`
+	Body    *RotateVaultKeyJSONRequestBody
+}
+
+type RotateVaultKeyResponseObject interface {
+	VisitRotateVaultKeyResponse(w http.ResponseWriter) error
+}
+
+type RotateVaultKey204Response struct {
+}
+
+func (response RotateVaultKey204Response) VisitRotateVaultKeyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RotateVaultKey400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RotateVaultKey400JSONResponse) VisitRotateVaultKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateVaultKey401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RotateVaultKey401JSONResponse) VisitRotateVaultKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateVaultKey403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RotateVaultKey403JSONResponse) VisitRotateVaultKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateVaultKey404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RotateVaultKey404JSONResponse) VisitRotateVaultKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateVaultKey409JSONResponse ErrorResponse
+
+func (response RotateVaultKey409JSONResponse) VisitRotateVaultKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateVaultKey500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response RotateVaultKey500JSONResponse) VisitRotateVaultKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListVaultMembersRequestObject struct {
+	VaultID VaultID `json:"vaultID"`
+}
+
+type ListVaultMembersResponseObject interface {
+	VisitListVaultMembersResponse(w http.ResponseWriter) error
+}
+
+type ListVaultMembers200JSONResponse []VaultMemberResponse
+
+func (response ListVaultMembers200JSONResponse) VisitListVaultMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListVaultMembers401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListVaultMembers401JSONResponse) VisitListVaultMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListVaultMembers404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListVaultMembers404JSONResponse) VisitListVaultMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListVaultMembers500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListVaultMembers500JSONResponse) VisitListVaultMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InviteVaultMemberRequestObject struct {
+	VaultID VaultID `json:"vaultID"`
+	Body    *InviteVaultMemberJSONRequestBody
+}
+
+type InviteVaultMemberResponseObject interface {
+	VisitInviteVaultMemberResponse(w http.ResponseWriter) error
+}
+
+type InviteVaultMember201JSONResponse VaultMemberResponse
+
+func (response InviteVaultMember201JSONResponse) VisitInviteVaultMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InviteVaultMember400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response InviteVaultMember400JSONResponse) VisitInviteVaultMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InviteVaultMember401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response InviteVaultMember401JSONResponse) VisitInviteVaultMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InviteVaultMember403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response InviteVaultMember403JSONResponse) VisitInviteVaultMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InviteVaultMember404JSONResponse ErrorResponse
+
+func (response InviteVaultMember404JSONResponse) VisitInviteVaultMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InviteVaultMember409JSONResponse ErrorResponse
+
+func (response InviteVaultMember409JSONResponse) VisitInviteVaultMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InviteVaultMember500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response InviteVaultMember500JSONResponse) VisitInviteVaultMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveVaultMemberRequestObject struct {
+	VaultID  VaultID       `json:"vaultID"`
+	MemberID VaultMemberID `json:"memberID"`
+}
+
+type RemoveVaultMemberResponseObject interface {
+	VisitRemoveVaultMemberResponse(w http.ResponseWriter) error
+}
+
+type RemoveVaultMember204Response struct {
+}
+
+func (response RemoveVaultMember204Response) VisitRemoveVaultMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RemoveVaultMember401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RemoveVaultMember401JSONResponse) VisitRemoveVaultMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveVaultMember403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RemoveVaultMember403JSONResponse) VisitRemoveVaultMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveVaultMember404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RemoveVaultMember404JSONResponse) VisitRemoveVaultMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveVaultMember409JSONResponse ErrorResponse
+
+func (response RemoveVaultMember409JSONResponse) VisitRemoveVaultMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RemoveVaultMember500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response RemoveVaultMember500JSONResponse) VisitRemoveVaultMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateVaultMemberRequestObject struct {
+	VaultID  VaultID       `json:"vaultID"`
+	MemberID VaultMemberID `json:"memberID"`
+	Body     *UpdateVaultMemberJSONRequestBody
+}
+
+type UpdateVaultMemberResponseObject interface {
+	VisitUpdateVaultMemberResponse(w http.ResponseWriter) error
+}
+
+type UpdateVaultMember200JSONResponse VaultMemberResponse
+
+func (response UpdateVaultMember200JSONResponse) VisitUpdateVaultMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateVaultMember400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateVaultMember400JSONResponse) VisitUpdateVaultMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateVaultMember401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateVaultMember401JSONResponse) VisitUpdateVaultMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateVaultMember403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UpdateVaultMember403JSONResponse) VisitUpdateVaultMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateVaultMember404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateVaultMember404JSONResponse) VisitUpdateVaultMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateVaultMember409JSONResponse ErrorResponse
+
+func (response UpdateVaultMember409JSONResponse) VisitUpdateVaultMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateVaultMember500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response UpdateVaultMember500JSONResponse) VisitUpdateVaultMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhooksRequestObject struct {
+}
+
+type ListWebhooksResponseObject interface {
+	VisitListWebhooksResponse(w http.ResponseWriter) error
+}
+
+type ListWebhooks200JSONResponse []WebhookResponse
+
+func (response ListWebhooks200JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhooks401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListWebhooks401JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhooks500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListWebhooks500JSONResponse) VisitListWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhookRequestObject struct {
+	Body *CreateWebhookJSONRequestBody
+}
+
+type CreateWebhookResponseObject interface {
+	VisitCreateWebhookResponse(w http.ResponseWriter) error
+}
+
+type CreateWebhook201JSONResponse WebhookResponse
+
+func (response CreateWebhook201JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateWebhook400JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateWebhook401JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateWebhook403JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhook500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CreateWebhook500JSONResponse) VisitCreateWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhookRequestObject struct {
+	WebhookID openapi_types.UUID `json:"webhookID"`
+}
+
+type DeleteWebhookResponseObject interface {
+	VisitDeleteWebhookResponse(w http.ResponseWriter) error
+}
+
+type DeleteWebhook204Response struct {
+}
+
+func (response DeleteWebhook204Response) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteWebhook401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteWebhook401JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteWebhook404JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhook500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeleteWebhook500JSONResponse) VisitDeleteWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveriesRequestObject struct {
+	WebhookID openapi_types.UUID `json:"webhookID"`
+	Params    ListWebhookDeliveriesParams
+}
+
+type ListWebhookDeliveriesResponseObject interface {
+	VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error
+}
+
+type ListWebhookDeliveries200JSONResponse []WebhookDeliveryResponse
+
+func (response ListWebhookDeliveries200JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListWebhookDeliveries401JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListWebhookDeliveries404JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListWebhookDeliveries500JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUsersRequestObject struct {
+}
+
+type ListUsersResponseObject interface {
+	VisitListUsersResponse(w http.ResponseWriter) error
+}
+
+type ListUsers200JSONResponse []UserResponse
+
+func (response ListUsers200JSONResponse) VisitListUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUsers500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListUsers500JSONResponse) VisitListUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LookupPublicKeyRequestObject struct {
+	Params LookupPublicKeyParams
+}
+
+type LookupPublicKeyResponseObject interface {
+	VisitLookupPublicKeyResponse(w http.ResponseWriter) error
+}
+
+type LookupPublicKey200JSONResponse PublicKeyResponse
+
+func (response LookupPublicKey200JSONResponse) VisitLookupPublicKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LookupPublicKey400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response LookupPublicKey400JSONResponse) VisitLookupPublicKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LookupPublicKey401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response LookupPublicKey401JSONResponse) VisitLookupPublicKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LookupPublicKey404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response LookupPublicKey404JSONResponse) VisitLookupPublicKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LookupPublicKey500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response LookupPublicKey500JSONResponse) VisitLookupPublicKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// List email outbox messages by status
+	// (GET /admin/outbox)
+	ListOutboxMessages(ctx context.Context, request ListOutboxMessagesRequestObject) (ListOutboxMessagesResponseObject, error)
+	// Requeue a dead-lettered outbox message
+	// (POST /admin/outbox/{messageID}/requeue)
+	RequeueOutboxMessage(ctx context.Context, request RequeueOutboxMessageRequestObject) (RequeueOutboxMessageResponseObject, error)
+	// Verify the integrity of stored vaults
+	// (POST /admin/vaults/verify)
+	VerifyVaults(ctx context.Context, request VerifyVaultsRequestObject) (VerifyVaultsResponseObject, error)
+	// List invites created by the current user, or all invites for admins
+	// (GET /invites)
+	ListInvites(ctx context.Context, request ListInvitesRequestObject) (ListInvitesResponseObject, error)
+	// Create a single-use registration invite
+	// (POST /invites)
+	CreateInvite(ctx context.Context, request CreateInviteRequestObject) (CreateInviteResponseObject, error)
+	// Revoke an unused invite
+	// (DELETE /invites/{inviteID})
+	RevokeInvite(ctx context.Context, request RevokeInviteRequestObject) (RevokeInviteResponseObject, error)
+	// Logout current user
+	// (POST /logout)
+	LogoutUser(ctx context.Context, request LogoutUserRequestObject) (LogoutUserResponseObject, error)
+	// List the organizations of the current user
+	// (GET /organizations)
+	ListOrganizations(ctx context.Context, request ListOrganizationsRequestObject) (ListOrganizationsResponseObject, error)
+	// Create an organization
+	// (POST /organizations)
+	CreateOrganization(ctx context.Context, request CreateOrganizationRequestObject) (CreateOrganizationResponseObject, error)
+	// Join an organization with an emailed invite
+	// (POST /organizations/invites/accept)
+	AcceptOrganizationInvite(ctx context.Context, request AcceptOrganizationInviteRequestObject) (AcceptOrganizationInviteResponseObject, error)
+	// Delete an organization
+	// (DELETE /organizations/{organizationID})
+	DeleteOrganization(ctx context.Context, request DeleteOrganizationRequestObject) (DeleteOrganizationResponseObject, error)
+	// Get an organization of the current user
+	// (GET /organizations/{organizationID})
+	GetOrganization(ctx context.Context, request GetOrganizationRequestObject) (GetOrganizationResponseObject, error)
+	// Rename an organization
+	// (PATCH /organizations/{organizationID})
+	UpdateOrganization(ctx context.Context, request UpdateOrganizationRequestObject) (UpdateOrganizationResponseObject, error)
+	// List the groups of an organization
+	// (GET /organizations/{organizationID}/groups)
+	ListOrganizationGroups(ctx context.Context, request ListOrganizationGroupsRequestObject) (ListOrganizationGroupsResponseObject, error)
+	// Create a group
+	// (POST /organizations/{organizationID}/groups)
+	CreateOrganizationGroup(ctx context.Context, request CreateOrganizationGroupRequestObject) (CreateOrganizationGroupResponseObject, error)
+	// Delete a group
+	// (DELETE /organizations/{organizationID}/groups/{groupID})
+	DeleteOrganizationGroup(ctx context.Context, request DeleteOrganizationGroupRequestObject) (DeleteOrganizationGroupResponseObject, error)
+	// Rename a group and replace its members
+	// (PUT /organizations/{organizationID}/groups/{groupID})
+	UpdateOrganizationGroup(ctx context.Context, request UpdateOrganizationGroupRequestObject) (UpdateOrganizationGroupResponseObject, error)
+	// List the pending invites of an organization
+	// (GET /organizations/{organizationID}/invites)
+	ListOrganizationInvites(ctx context.Context, request ListOrganizationInvitesRequestObject) (ListOrganizationInvitesResponseObject, error)
+	// Invite someone to an organization by email
+	// (POST /organizations/{organizationID}/invites)
+	CreateOrganizationInvite(ctx context.Context, request CreateOrganizationInviteRequestObject) (CreateOrganizationInviteResponseObject, error)
+	// Revoke a pending invite
+	// (DELETE /organizations/{organizationID}/invites/{inviteID})
+	RevokeOrganizationInvite(ctx context.Context, request RevokeOrganizationInviteRequestObject) (RevokeOrganizationInviteResponseObject, error)
+	// List the members of an organization
+	// (GET /organizations/{organizationID}/members)
+	ListOrganizationMembers(ctx context.Context, request ListOrganizationMembersRequestObject) (ListOrganizationMembersResponseObject, error)
+	// Remove a member from an organization
+	// (DELETE /organizations/{organizationID}/members/{memberID})
+	RemoveOrganizationMember(ctx context.Context, request RemoveOrganizationMemberRequestObject) (RemoveOrganizationMemberResponseObject, error)
+	// Change the role of a member
+	// (PATCH /organizations/{organizationID}/members/{memberID})
+	UpdateOrganizationMember(ctx context.Context, request UpdateOrganizationMemberRequestObject) (UpdateOrganizationMemberResponseObject, error)
+	// Get the policies of an organization
+	// (GET /organizations/{organizationID}/policies)
+	GetOrganizationPolicies(ctx context.Context, request GetOrganizationPoliciesRequestObject) (GetOrganizationPoliciesResponseObject, error)
+	// Replace the policies of an organization
+	// (PUT /organizations/{organizationID}/policies)
+	UpdateOrganizationPolicies(ctx context.Context, request UpdateOrganizationPoliciesRequestObject) (UpdateOrganizationPoliciesResponseObject, error)
+	// Create a vault of an organization
+	// (POST /organizations/{organizationID}/vaults)
+	CreateOrganizationVault(ctx context.Context, request CreateOrganizationVaultRequestObject) (CreateOrganizationVaultResponseObject, error)
+	// Refresh access and refresh tokens
+	// (POST /refresh)
+	RefreshToken(ctx context.Context, request RefreshTokenRequestObject) (RefreshTokenResponseObject, error)
+	// List the active sends of the current user
+	// (GET /sends)
+	ListSends(ctx context.Context, request ListSendsRequestObject) (ListSendsResponseObject, error)
+	// Share a secret through a link
+	// (POST /sends)
+	CreateSend(ctx context.Context, request CreateSendRequestObject) (CreateSendResponseObject, error)
+	// Revoke a send
+	// (DELETE /sends/{sendID})
+	RevokeSend(ctx context.Context, request RevokeSendRequestObject) (RevokeSendResponseObject, error)
+	// View a send
+	// (GET /sends/{sendID})
+	ViewSend(ctx context.Context, request ViewSendRequestObject) (ViewSendResponseObject, error)
+	// Issue access and refresh tokens
+	// (POST /token)
+	IssueToken(ctx context.Context, request IssueTokenRequestObject) (IssueTokenResponseObject, error)
+	// Get current user
+	// (GET /user)
+	GetCurrentUser(ctx context.Context, request GetCurrentUserRequestObject) (GetCurrentUserResponseObject, error)
+	// Create a new user
+	// (POST /user)
+	CreateUser(ctx context.Context, request CreateUserRequestObject) (CreateUserResponseObject, error)
+	// Create a new user
+	// (POST /user/confirm)
+	ConfirmUser(ctx context.Context, request ConfirmUserRequestObject) (ConfirmUserResponseObject, error)
+	// List the emergency accesses of the current user
+	// (GET /user/emergency-access)
+	ListEmergencyAccess(ctx context.Context, request ListEmergencyAccessRequestObject) (ListEmergencyAccessResponseObject, error)
+	// Make a user a trusted contact for a vault
+	// (POST /user/emergency-access)
+	InviteEmergencyContact(ctx context.Context, request InviteEmergencyContactRequestObject) (InviteEmergencyContactResponseObject, error)
+	// Revoke an emergency access
+	// (DELETE /user/emergency-access/{emergencyAccessID})
+	RevokeEmergencyAccess(ctx context.Context, request RevokeEmergencyAccessRequestObject) (RevokeEmergencyAccessResponseObject, error)
+	// Accept to be a trusted contact
+	// (POST /user/emergency-access/{emergencyAccessID}/accept)
+	AcceptEmergencyAccess(ctx context.Context, request AcceptEmergencyAccessRequestObject) (AcceptEmergencyAccessResponseObject, error)
+	// Grant a requested emergency access without waiting
+	// (POST /user/emergency-access/{emergencyAccessID}/approve)
+	ApproveEmergencyAccess(ctx context.Context, request ApproveEmergencyAccessRequestObject) (ApproveEmergencyAccessResponseObject, error)
+	// Wrap the vault key for the contact again
+	// (PUT /user/emergency-access/{emergencyAccessID}/key)
+	UpdateEmergencyAccessKey(ctx context.Context, request UpdateEmergencyAccessKeyRequestObject) (UpdateEmergencyAccessKeyResponseObject, error)
+	// Reject a requested or granted emergency access
+	// (POST /user/emergency-access/{emergencyAccessID}/reject)
+	RejectEmergencyAccess(ctx context.Context, request RejectEmergencyAccessRequestObject) (RejectEmergencyAccessResponseObject, error)
+	// Request an emergency access
+	// (POST /user/emergency-access/{emergencyAccessID}/request)
+	RequestEmergencyAccess(ctx context.Context, request RequestEmergencyAccessRequestObject) (RequestEmergencyAccessResponseObject, error)
+	// List the security events of the current user
+	// (GET /user/events)
+	ListUserEvents(ctx context.Context, request ListUserEventsRequestObject) (ListUserEventsResponseObject, error)
+	// List the keypairs of the current user
+	// (GET /user/keys)
+	ListUserKeys(ctx context.Context, request ListUserKeysRequestObject) (ListUserKeysResponseObject, error)
+	// Set up or rotate the keypair of the current user
+	// (POST /user/keys)
+	PublishUserKey(ctx context.Context, request PublishUserKeyRequestObject) (PublishUserKeyResponseObject, error)
+	// Get a version of the keypair of the current user
+	// (GET /user/keys/{version})
+	GetUserKey(ctx context.Context, request GetUserKeyRequestObject) (GetUserKeyResponseObject, error)
+	// Get the policies the current user has to follow
+	// (GET /user/policies)
+	GetUserPolicies(ctx context.Context, request GetUserPoliciesRequestObject) (GetUserPoliciesResponseObject, error)
+	// Get current user's vault
+	// (GET /user/vault)
+	GetUserVault(ctx context.Context, request GetUserVaultRequestObject) (GetUserVaultResponseObject, error)
+	// Create or update current user's vault
+	// (POST /user/vault)
+	InsertUserVault(ctx context.Context, request InsertUserVaultRequestObject) (InsertUserVaultResponseObject, error)
+	// Stream the changes of the current user's vault
+	// (GET /user/vault/events)
+	StreamVaultEvents(ctx context.Context, request StreamVaultEventsRequestObject) (StreamVaultEventsResponseObject, error)
+	// Get current user's vault
+	// (GET /user/vault/poll)
+	PollUserVault(ctx context.Context, request PollUserVaultRequestObject) (PollUserVaultResponseObject, error)
+	// Sync the items of the current user's vault
+	// (POST /user/vault/sync)
+	SyncUserVault(ctx context.Context, request SyncUserVaultRequestObject) (SyncUserVaultResponseObject, error)
+	// Start a resumable vault upload
+	// (POST /user/vault/uploads)
+	CreateVaultUpload(ctx context.Context, request CreateVaultUploadRequestObject) (CreateVaultUploadResponseObject, error)
+	// Abandon a vault upload
+	// (DELETE /user/vault/uploads/{uploadId})
+	CancelVaultUpload(ctx context.Context, request CancelVaultUploadRequestObject) (CancelVaultUploadResponseObject, error)
+	// Get the offset of a vault upload
+	// (GET /user/vault/uploads/{uploadId})
+	GetVaultUpload(ctx context.Context, request GetVaultUploadRequestObject) (GetVaultUploadResponseObject, error)
+	// Send a chunk of a vault upload
+	// (PATCH /user/vault/uploads/{uploadId})
+	AppendVaultUpload(ctx context.Context, request AppendVaultUploadRequestObject) (AppendVaultUploadResponseObject, error)
+	// Store a completed vault upload
+	// (POST /user/vault/uploads/{uploadId}/commit)
+	CommitVaultUpload(ctx context.Context, request CommitVaultUploadRequestObject) (CommitVaultUploadResponseObject, error)
+	// Get the storage used by the current user
+	// (GET /user/vault/usage)
+	GetVaultUsage(ctx context.Context, request GetVaultUsageRequestObject) (GetVaultUsageResponseObject, error)
+	// List the stored versions of the current user's vault
+	// (GET /user/vault/versions)
+	ListVaultVersions(ctx context.Context, request ListVaultVersionsRequestObject) (ListVaultVersionsResponseObject, error)
+	// Download a version of the current user's vault
+	// (GET /user/vault/versions/{revision})
+	GetVaultVersion(ctx context.Context, request GetVaultVersionRequestObject) (GetVaultVersionResponseObject, error)
+	// Restore a version as the current vault
+	// (POST /user/vault/versions/{revision}/restore)
+	RestoreVaultVersion(ctx context.Context, request RestoreVaultVersionRequestObject) (RestoreVaultVersionResponseObject, error)
+	// List the vaults of the current user
+	// (GET /user/vaults)
+	ListVaults(ctx context.Context, request ListVaultsRequestObject) (ListVaultsResponseObject, error)
+	// Create a named vault
+	// (POST /user/vaults)
+	CreateVault(ctx context.Context, request CreateVaultRequestObject) (CreateVaultResponseObject, error)
+	// Delete a vault with its versions
+	// (DELETE /user/vaults/{vaultID})
+	DeleteVault(ctx context.Context, request DeleteVaultRequestObject) (DeleteVaultResponseObject, error)
+	// Get a vault of the current user
+	// (GET /user/vaults/{vaultID})
+	GetVault(ctx context.Context, request GetVaultRequestObject) (GetVaultResponseObject, error)
+	// Rename a vault or change its type
+	// (PATCH /user/vaults/{vaultID})
+	UpdateVault(ctx context.Context, request UpdateVaultRequestObject) (UpdateVaultResponseObject, error)
+	// Accept an invitation to a vault
+	// (POST /user/vaults/{vaultID}/accept)
+	AcceptVaultInvite(ctx context.Context, request AcceptVaultInviteRequestObject) (AcceptVaultInviteResponseObject, error)
+	// Download the content of a vault
+	// (GET /user/vaults/{vaultID}/content)
+	GetVaultContent(ctx context.Context, request GetVaultContentRequestObject) (GetVaultContentResponseObject, error)
+	// Replace the content of a vault
+	// (PUT /user/vaults/{vaultID}/content)
+	PutVaultContent(ctx context.Context, request PutVaultContentRequestObject) (PutVaultContentResponseObject, error)
+	// Get the vault key wrapped for the current user
+	// (GET /user/vaults/{vaultID}/key)
+	GetVaultKey(ctx context.Context, request GetVaultKeyRequestObject) (GetVaultKeyResponseObject, error)
+	// Set or rotate the vault key
+	// (PUT /user/vaults/{vaultID}/key)
+	RotateVaultKey(ctx context.Context, request RotateVaultKeyRequestObject) (RotateVaultKeyResponseObject, error)
+	// List the members of a vault
+	// (GET /user/vaults/{vaultID}/members)
+	ListVaultMembers(ctx context.Context, request ListVaultMembersRequestObject) (ListVaultMembersResponseObject, error)
+	// Invite a user to a vault
+	// (POST /user/vaults/{vaultID}/members)
+	InviteVaultMember(ctx context.Context, request InviteVaultMemberRequestObject) (InviteVaultMemberResponseObject, error)
+	// Remove a member or revoke an invitation
+	// (DELETE /user/vaults/{vaultID}/members/{memberID})
+	RemoveVaultMember(ctx context.Context, request RemoveVaultMemberRequestObject) (RemoveVaultMemberResponseObject, error)
+	// Change the role of a member
+	// (PATCH /user/vaults/{vaultID}/members/{memberID})
+	UpdateVaultMember(ctx context.Context, request UpdateVaultMemberRequestObject) (UpdateVaultMemberResponseObject, error)
+	// List the webhooks of the current user
+	// (GET /user/webhooks)
+	ListWebhooks(ctx context.Context, request ListWebhooksRequestObject) (ListWebhooksResponseObject, error)
+	// Subscribe a URL to security events
+	// (POST /user/webhooks)
+	CreateWebhook(ctx context.Context, request CreateWebhookRequestObject) (CreateWebhookResponseObject, error)
+	// Delete a webhook
+	// (DELETE /user/webhooks/{webhookID})
+	DeleteWebhook(ctx context.Context, request DeleteWebhookRequestObject) (DeleteWebhookResponseObject, error)
+	// List the recent deliveries of a webhook
+	// (GET /user/webhooks/{webhookID}/deliveries)
+	ListWebhookDeliveries(ctx context.Context, request ListWebhookDeliveriesRequestObject) (ListWebhookDeliveriesResponseObject, error)
+	// List all users
+	// (GET /users)
+	ListUsers(ctx context.Context, request ListUsersRequestObject) (ListUsersResponseObject, error)
+	// Look up the public key of a user
+	// (GET /users/keys)
+	LookupPublicKey(ctx context.Context, request LookupPublicKeyRequestObject) (LookupPublicKeyResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// ListOutboxMessages operation middleware
+func (sh *strictHandler) ListOutboxMessages(w http.ResponseWriter, r *http.Request, params ListOutboxMessagesParams) {
+	var request ListOutboxMessagesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListOutboxMessages(ctx, request.(ListOutboxMessagesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListOutboxMessages")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListOutboxMessagesResponseObject); ok {
+		if err := validResponse.VisitListOutboxMessagesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RequeueOutboxMessage operation middleware
+func (sh *strictHandler) RequeueOutboxMessage(w http.ResponseWriter, r *http.Request, messageID openapi_types.UUID) {
+	var request RequeueOutboxMessageRequestObject
+
+	request.MessageID = messageID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RequeueOutboxMessage(ctx, request.(RequeueOutboxMessageRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RequeueOutboxMessage")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RequeueOutboxMessageResponseObject); ok {
+		if err := validResponse.VisitRequeueOutboxMessageResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// VerifyVaults operation middleware
+func (sh *strictHandler) VerifyVaults(w http.ResponseWriter, r *http.Request, params VerifyVaultsParams) {
+	var request VerifyVaultsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.VerifyVaults(ctx, request.(VerifyVaultsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "VerifyVaults")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(VerifyVaultsResponseObject); ok {
+		if err := validResponse.VisitVerifyVaultsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListInvites operation middleware
+func (sh *strictHandler) ListInvites(w http.ResponseWriter, r *http.Request) {
+	var request ListInvitesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListInvites(ctx, request.(ListInvitesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListInvites")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListInvitesResponseObject); ok {
+		if err := validResponse.VisitListInvitesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateInvite operation middleware
+func (sh *strictHandler) CreateInvite(w http.ResponseWriter, r *http.Request) {
+	var request CreateInviteRequestObject
+
+	var body CreateInviteJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateInvite(ctx, request.(CreateInviteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateInvite")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateInviteResponseObject); ok {
+		if err := validResponse.VisitCreateInviteResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RevokeInvite operation middleware
+func (sh *strictHandler) RevokeInvite(w http.ResponseWriter, r *http.Request, inviteID openapi_types.UUID) {
+	var request RevokeInviteRequestObject
+
+	request.InviteID = inviteID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RevokeInvite(ctx, request.(RevokeInviteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevokeInvite")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RevokeInviteResponseObject); ok {
+		if err := validResponse.VisitRevokeInviteResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// LogoutUser operation middleware
+func (sh *strictHandler) LogoutUser(w http.ResponseWriter, r *http.Request) {
+	var request LogoutUserRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.LogoutUser(ctx, request.(LogoutUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "LogoutUser")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(LogoutUserResponseObject); ok {
+		if err := validResponse.VisitLogoutUserResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListOrganizations operation middleware
+func (sh *strictHandler) ListOrganizations(w http.ResponseWriter, r *http.Request) {
+	var request ListOrganizationsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListOrganizations(ctx, request.(ListOrganizationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListOrganizations")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListOrganizationsResponseObject); ok {
+		if err := validResponse.VisitListOrganizationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateOrganization operation middleware
+func (sh *strictHandler) CreateOrganization(w http.ResponseWriter, r *http.Request) {
+	var request CreateOrganizationRequestObject
+
+	var body CreateOrganizationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateOrganization(ctx, request.(CreateOrganizationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateOrganization")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateOrganizationResponseObject); ok {
+		if err := validResponse.VisitCreateOrganizationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AcceptOrganizationInvite operation middleware
+func (sh *strictHandler) AcceptOrganizationInvite(w http.ResponseWriter, r *http.Request) {
+	var request AcceptOrganizationInviteRequestObject
+
+	var body AcceptOrganizationInviteJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AcceptOrganizationInvite(ctx, request.(AcceptOrganizationInviteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AcceptOrganizationInvite")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AcceptOrganizationInviteResponseObject); ok {
+		if err := validResponse.VisitAcceptOrganizationInviteResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteOrganization operation middleware
+func (sh *strictHandler) DeleteOrganization(w http.ResponseWriter, r *http.Request, organizationID OrganizationID) {
+	var request DeleteOrganizationRequestObject
+
+	request.OrganizationID = organizationID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteOrganization(ctx, request.(DeleteOrganizationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteOrganization")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteOrganizationResponseObject); ok {
+		if err := validResponse.VisitDeleteOrganizationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetOrganization operation middleware
+func (sh *strictHandler) GetOrganization(w http.ResponseWriter, r *http.Request, organizationID OrganizationID) {
+	var request GetOrganizationRequestObject
+
+	request.OrganizationID = organizationID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOrganization(ctx, request.(GetOrganizationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOrganization")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetOrganizationResponseObject); ok {
+		if err := validResponse.VisitGetOrganizationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateOrganization operation middleware
+func (sh *strictHandler) UpdateOrganization(w http.ResponseWriter, r *http.Request, organizationID OrganizationID) {
+	var request UpdateOrganizationRequestObject
+
+	request.OrganizationID = organizationID
+
+	var body UpdateOrganizationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateOrganization(ctx, request.(UpdateOrganizationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateOrganization")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateOrganizationResponseObject); ok {
+		if err := validResponse.VisitUpdateOrganizationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListOrganizationGroups operation middleware
+func (sh *strictHandler) ListOrganizationGroups(w http.ResponseWriter, r *http.Request, organizationID OrganizationID) {
+	var request ListOrganizationGroupsRequestObject
+
+	request.OrganizationID = organizationID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListOrganizationGroups(ctx, request.(ListOrganizationGroupsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListOrganizationGroups")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListOrganizationGroupsResponseObject); ok {
+		if err := validResponse.VisitListOrganizationGroupsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateOrganizationGroup operation middleware
+func (sh *strictHandler) CreateOrganizationGroup(w http.ResponseWriter, r *http.Request, organizationID OrganizationID) {
+	var request CreateOrganizationGroupRequestObject
+
+	request.OrganizationID = organizationID
+
+	var body CreateOrganizationGroupJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateOrganizationGroup(ctx, request.(CreateOrganizationGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateOrganizationGroup")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateOrganizationGroupResponseObject); ok {
+		if err := validResponse.VisitCreateOrganizationGroupResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteOrganizationGroup operation middleware
+func (sh *strictHandler) DeleteOrganizationGroup(w http.ResponseWriter, r *http.Request, organizationID OrganizationID, groupID OrganizationGroupID) {
+	var request DeleteOrganizationGroupRequestObject
+
+	request.OrganizationID = organizationID
+	request.GroupID = groupID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteOrganizationGroup(ctx, request.(DeleteOrganizationGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteOrganizationGroup")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteOrganizationGroupResponseObject); ok {
+		if err := validResponse.VisitDeleteOrganizationGroupResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateOrganizationGroup operation middleware
+func (sh *strictHandler) UpdateOrganizationGroup(w http.ResponseWriter, r *http.Request, organizationID OrganizationID, groupID OrganizationGroupID) {
+	var request UpdateOrganizationGroupRequestObject
+
+	request.OrganizationID = organizationID
+	request.GroupID = groupID
+
+	var body UpdateOrganizationGroupJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateOrganizationGroup(ctx, request.(UpdateOrganizationGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateOrganizationGroup")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateOrganizationGroupResponseObject); ok {
+		if err := validResponse.VisitUpdateOrganizationGroupResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListOrganizationInvites operation middleware
+func (sh *strictHandler) ListOrganizationInvites(w http.ResponseWriter, r *http.Request, organizationID OrganizationID) {
+	var request ListOrganizationInvitesRequestObject
+
+	request.OrganizationID = organizationID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListOrganizationInvites(ctx, request.(ListOrganizationInvitesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListOrganizationInvites")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListOrganizationInvitesResponseObject); ok {
+		if err := validResponse.VisitListOrganizationInvitesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateOrganizationInvite operation middleware
+func (sh *strictHandler) CreateOrganizationInvite(w http.ResponseWriter, r *http.Request, organizationID OrganizationID) {
+	var request CreateOrganizationInviteRequestObject
+
+	request.OrganizationID = organizationID
+
+	var body CreateOrganizationInviteJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateOrganizationInvite(ctx, request.(CreateOrganizationInviteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateOrganizationInvite")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateOrganizationInviteResponseObject); ok {
+		if err := validResponse.VisitCreateOrganizationInviteResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RevokeOrganizationInvite operation middleware
+func (sh *strictHandler) RevokeOrganizationInvite(w http.ResponseWriter, r *http.Request, organizationID OrganizationID, inviteID OrganizationInviteID) {
+	var request RevokeOrganizationInviteRequestObject
+
+	request.OrganizationID = organizationID
+	request.InviteID = inviteID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RevokeOrganizationInvite(ctx, request.(RevokeOrganizationInviteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevokeOrganizationInvite")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RevokeOrganizationInviteResponseObject); ok {
+		if err := validResponse.VisitRevokeOrganizationInviteResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListOrganizationMembers operation middleware
+func (sh *strictHandler) ListOrganizationMembers(w http.ResponseWriter, r *http.Request, organizationID OrganizationID) {
+	var request ListOrganizationMembersRequestObject
+
+	request.OrganizationID = organizationID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListOrganizationMembers(ctx, request.(ListOrganizationMembersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListOrganizationMembers")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListOrganizationMembersResponseObject); ok {
+		if err := validResponse.VisitListOrganizationMembersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RemoveOrganizationMember operation middleware
+func (sh *strictHandler) RemoveOrganizationMember(w http.ResponseWriter, r *http.Request, organizationID OrganizationID, memberID OrganizationMemberID) {
+	var request RemoveOrganizationMemberRequestObject
+
+	request.OrganizationID = organizationID
+	request.MemberID = memberID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RemoveOrganizationMember(ctx, request.(RemoveOrganizationMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RemoveOrganizationMember")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RemoveOrganizationMemberResponseObject); ok {
+		if err := validResponse.VisitRemoveOrganizationMemberResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateOrganizationMember operation middleware
+func (sh *strictHandler) UpdateOrganizationMember(w http.ResponseWriter, r *http.Request, organizationID OrganizationID, memberID OrganizationMemberID) {
+	var request UpdateOrganizationMemberRequestObject
+
+	request.OrganizationID = organizationID
+	request.MemberID = memberID
+
+	var body UpdateOrganizationMemberJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateOrganizationMember(ctx, request.(UpdateOrganizationMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateOrganizationMember")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateOrganizationMemberResponseObject); ok {
+		if err := validResponse.VisitUpdateOrganizationMemberResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetOrganizationPolicies operation middleware
+func (sh *strictHandler) GetOrganizationPolicies(w http.ResponseWriter, r *http.Request, organizationID OrganizationID) {
+	var request GetOrganizationPoliciesRequestObject
+
+	request.OrganizationID = organizationID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOrganizationPolicies(ctx, request.(GetOrganizationPoliciesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOrganizationPolicies")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetOrganizationPoliciesResponseObject); ok {
+		if err := validResponse.VisitGetOrganizationPoliciesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateOrganizationPolicies operation middleware
+func (sh *strictHandler) UpdateOrganizationPolicies(w http.ResponseWriter, r *http.Request, organizationID OrganizationID) {
+	var request UpdateOrganizationPoliciesRequestObject
+
+	request.OrganizationID = organizationID
+
+	var body UpdateOrganizationPoliciesJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateOrganizationPolicies(ctx, request.(UpdateOrganizationPoliciesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateOrganizationPolicies")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateOrganizationPoliciesResponseObject); ok {
+		if err := validResponse.VisitUpdateOrganizationPoliciesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateOrganizationVault operation middleware
+func (sh *strictHandler) CreateOrganizationVault(w http.ResponseWriter, r *http.Request, organizationID OrganizationID) {
+	var request CreateOrganizationVaultRequestObject
+
+	request.OrganizationID = organizationID
+
+	var body CreateOrganizationVaultJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateOrganizationVault(ctx, request.(CreateOrganizationVaultRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateOrganizationVault")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateOrganizationVaultResponseObject); ok {
+		if err := validResponse.VisitCreateOrganizationVaultResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RefreshToken operation middleware
+func (sh *strictHandler) RefreshToken(w http.ResponseWriter, r *http.Request) {
+	var request RefreshTokenRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RefreshToken(ctx, request.(RefreshTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RefreshToken")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RefreshTokenResponseObject); ok {
+		if err := validResponse.VisitRefreshTokenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListSends operation middleware
+func (sh *strictHandler) ListSends(w http.ResponseWriter, r *http.Request) {
+	var request ListSendsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListSends(ctx, request.(ListSendsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListSends")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListSendsResponseObject); ok {
+		if err := validResponse.VisitListSendsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateSend operation middleware
+func (sh *strictHandler) CreateSend(w http.ResponseWriter, r *http.Request) {
+	var request CreateSendRequestObject
+
+	var body CreateSendJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateSend(ctx, request.(CreateSendRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateSend")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateSendResponseObject); ok {
+		if err := validResponse.VisitCreateSendResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RevokeSend operation middleware
+func (sh *strictHandler) RevokeSend(w http.ResponseWriter, r *http.Request, sendID SendID) {
+	var request RevokeSendRequestObject
+
+	request.SendID = sendID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RevokeSend(ctx, request.(RevokeSendRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevokeSend")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RevokeSendResponseObject); ok {
+		if err := validResponse.VisitRevokeSendResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ViewSend operation middleware
+func (sh *strictHandler) ViewSend(w http.ResponseWriter, r *http.Request, sendID SendID, params ViewSendParams) {
+	var request ViewSendRequestObject
+
+	request.SendID = sendID
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ViewSend(ctx, request.(ViewSendRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ViewSend")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ViewSendResponseObject); ok {
+		if err := validResponse.VisitViewSendResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// IssueToken operation middleware
+func (sh *strictHandler) IssueToken(w http.ResponseWriter, r *http.Request) {
+	var request IssueTokenRequestObject
+
+	var body IssueTokenJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.IssueToken(ctx, request.(IssueTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "IssueToken")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(IssueTokenResponseObject); ok {
+		if err := validResponse.VisitIssueTokenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCurrentUser operation middleware
+func (sh *strictHandler) GetCurrentUser(w http.ResponseWriter, r *http.Request) {
+	var request GetCurrentUserRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCurrentUser(ctx, request.(GetCurrentUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCurrentUser")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCurrentUserResponseObject); ok {
+		if err := validResponse.VisitGetCurrentUserResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateUser operation middleware
+func (sh *strictHandler) CreateUser(w http.ResponseWriter, r *http.Request) {
+	var request CreateUserRequestObject
+
+	var body CreateUserJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateUser(ctx, request.(CreateUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateUser")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateUserResponseObject); ok {
+		if err := validResponse.VisitCreateUserResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ConfirmUser operation middleware
+func (sh *strictHandler) ConfirmUser(w http.ResponseWriter, r *http.Request, params ConfirmUserParams) {
+	var request ConfirmUserRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ConfirmUser(ctx, request.(ConfirmUserRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ConfirmUser")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ConfirmUserResponseObject); ok {
+		if err := validResponse.VisitConfirmUserResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListEmergencyAccess operation middleware
+func (sh *strictHandler) ListEmergencyAccess(w http.ResponseWriter, r *http.Request) {
+	var request ListEmergencyAccessRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListEmergencyAccess(ctx, request.(ListEmergencyAccessRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListEmergencyAccess")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListEmergencyAccessResponseObject); ok {
+		if err := validResponse.VisitListEmergencyAccessResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// InviteEmergencyContact operation middleware
+func (sh *strictHandler) InviteEmergencyContact(w http.ResponseWriter, r *http.Request) {
+	var request InviteEmergencyContactRequestObject
+
+	var body InviteEmergencyContactJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.InviteEmergencyContact(ctx, request.(InviteEmergencyContactRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "InviteEmergencyContact")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(InviteEmergencyContactResponseObject); ok {
+		if err := validResponse.VisitInviteEmergencyContactResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RevokeEmergencyAccess operation middleware
+func (sh *strictHandler) RevokeEmergencyAccess(w http.ResponseWriter, r *http.Request, emergencyAccessID EmergencyAccessID) {
+	var request RevokeEmergencyAccessRequestObject
+
+	request.EmergencyAccessID = emergencyAccessID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RevokeEmergencyAccess(ctx, request.(RevokeEmergencyAccessRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevokeEmergencyAccess")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RevokeEmergencyAccessResponseObject); ok {
+		if err := validResponse.VisitRevokeEmergencyAccessResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AcceptEmergencyAccess operation middleware
+func (sh *strictHandler) AcceptEmergencyAccess(w http.ResponseWriter, r *http.Request, emergencyAccessID EmergencyAccessID) {
+	var request AcceptEmergencyAccessRequestObject
+
+	request.EmergencyAccessID = emergencyAccessID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AcceptEmergencyAccess(ctx, request.(AcceptEmergencyAccessRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AcceptEmergencyAccess")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AcceptEmergencyAccessResponseObject); ok {
+		if err := validResponse.VisitAcceptEmergencyAccessResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ApproveEmergencyAccess operation middleware
+func (sh *strictHandler) ApproveEmergencyAccess(w http.ResponseWriter, r *http.Request, emergencyAccessID EmergencyAccessID) {
+	var request ApproveEmergencyAccessRequestObject
+
+	request.EmergencyAccessID = emergencyAccessID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ApproveEmergencyAccess(ctx, request.(ApproveEmergencyAccessRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ApproveEmergencyAccess")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ApproveEmergencyAccessResponseObject); ok {
+		if err := validResponse.VisitApproveEmergencyAccessResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateEmergencyAccessKey operation middleware
+func (sh *strictHandler) UpdateEmergencyAccessKey(w http.ResponseWriter, r *http.Request, emergencyAccessID EmergencyAccessID) {
+	var request UpdateEmergencyAccessKeyRequestObject
+
+	request.EmergencyAccessID = emergencyAccessID
+
+	var body UpdateEmergencyAccessKeyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateEmergencyAccessKey(ctx, request.(UpdateEmergencyAccessKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateEmergencyAccessKey")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateEmergencyAccessKeyResponseObject); ok {
+		if err := validResponse.VisitUpdateEmergencyAccessKeyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RejectEmergencyAccess operation middleware
+func (sh *strictHandler) RejectEmergencyAccess(w http.ResponseWriter, r *http.Request, emergencyAccessID EmergencyAccessID) {
+	var request RejectEmergencyAccessRequestObject
+
+	request.EmergencyAccessID = emergencyAccessID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RejectEmergencyAccess(ctx, request.(RejectEmergencyAccessRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RejectEmergencyAccess")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RejectEmergencyAccessResponseObject); ok {
+		if err := validResponse.VisitRejectEmergencyAccessResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RequestEmergencyAccess operation middleware
+func (sh *strictHandler) RequestEmergencyAccess(w http.ResponseWriter, r *http.Request, emergencyAccessID EmergencyAccessID) {
+	var request RequestEmergencyAccessRequestObject
+
+	request.EmergencyAccessID = emergencyAccessID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RequestEmergencyAccess(ctx, request.(RequestEmergencyAccessRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RequestEmergencyAccess")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RequestEmergencyAccessResponseObject); ok {
+		if err := validResponse.VisitRequestEmergencyAccessResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListUserEvents operation middleware
+func (sh *strictHandler) ListUserEvents(w http.ResponseWriter, r *http.Request, params ListUserEventsParams) {
+	var request ListUserEventsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListUserEvents(ctx, request.(ListUserEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListUserEvents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListUserEventsResponseObject); ok {
+		if err := validResponse.VisitListUserEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListUserKeys operation middleware
+func (sh *strictHandler) ListUserKeys(w http.ResponseWriter, r *http.Request) {
+	var request ListUserKeysRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListUserKeys(ctx, request.(ListUserKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListUserKeys")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListUserKeysResponseObject); ok {
+		if err := validResponse.VisitListUserKeysResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PublishUserKey operation middleware
+func (sh *strictHandler) PublishUserKey(w http.ResponseWriter, r *http.Request) {
+	var request PublishUserKeyRequestObject
+
+	var body PublishUserKeyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PublishUserKey(ctx, request.(PublishUserKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PublishUserKey")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PublishUserKeyResponseObject); ok {
+		if err := validResponse.VisitPublishUserKeyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetUserKey operation middleware
+func (sh *strictHandler) GetUserKey(w http.ResponseWriter, r *http.Request, version int) {
+	var request GetUserKeyRequestObject
+
+	request.Version = version
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUserKey(ctx, request.(GetUserKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUserKey")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetUserKeyResponseObject); ok {
+		if err := validResponse.VisitGetUserKeyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetUserPolicies operation middleware
+func (sh *strictHandler) GetUserPolicies(w http.ResponseWriter, r *http.Request) {
+	var request GetUserPoliciesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUserPolicies(ctx, request.(GetUserPoliciesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUserPolicies")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetUserPoliciesResponseObject); ok {
+		if err := validResponse.VisitGetUserPoliciesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetUserVault operation middleware
+func (sh *strictHandler) GetUserVault(w http.ResponseWriter, r *http.Request, params GetUserVaultParams) {
+	var request GetUserVaultRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUserVault(ctx, request.(GetUserVaultRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUserVault")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetUserVaultResponseObject); ok {
+		if err := validResponse.VisitGetUserVaultResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// InsertUserVault operation middleware
+func (sh *strictHandler) InsertUserVault(w http.ResponseWriter, r *http.Request, params InsertUserVaultParams) {
+	var request InsertUserVaultRequestObject
+
+	request.Params = params
+
+	request.Body = r.Body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.InsertUserVault(ctx, request.(InsertUserVaultRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "InsertUserVault")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(InsertUserVaultResponseObject); ok {
+		if err := validResponse.VisitInsertUserVaultResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// StreamVaultEvents operation middleware
+func (sh *strictHandler) StreamVaultEvents(w http.ResponseWriter, r *http.Request, params StreamVaultEventsParams) {
+	var request StreamVaultEventsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.StreamVaultEvents(ctx, request.(StreamVaultEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StreamVaultEvents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(StreamVaultEventsResponseObject); ok {
+		if err := validResponse.VisitStreamVaultEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PollUserVault operation middleware
+func (sh *strictHandler) PollUserVault(w http.ResponseWriter, r *http.Request, params PollUserVaultParams) {
+	var request PollUserVaultRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PollUserVault(ctx, request.(PollUserVaultRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PollUserVault")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PollUserVaultResponseObject); ok {
+		if err := validResponse.VisitPollUserVaultResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SyncUserVault operation middleware
+func (sh *strictHandler) SyncUserVault(w http.ResponseWriter, r *http.Request) {
+	var request SyncUserVaultRequestObject
+
+	var body SyncUserVaultJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SyncUserVault(ctx, request.(SyncUserVaultRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SyncUserVault")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SyncUserVaultResponseObject); ok {
+		if err := validResponse.VisitSyncUserVaultResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateVaultUpload operation middleware
+func (sh *strictHandler) CreateVaultUpload(w http.ResponseWriter, r *http.Request) {
+	var request CreateVaultUploadRequestObject
+
+	var body CreateVaultUploadJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateVaultUpload(ctx, request.(CreateVaultUploadRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateVaultUpload")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateVaultUploadResponseObject); ok {
+		if err := validResponse.VisitCreateVaultUploadResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CancelVaultUpload operation middleware
+func (sh *strictHandler) CancelVaultUpload(w http.ResponseWriter, r *http.Request, uploadId string) {
+	var request CancelVaultUploadRequestObject
+
+	request.UploadId = uploadId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CancelVaultUpload(ctx, request.(CancelVaultUploadRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CancelVaultUpload")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CancelVaultUploadResponseObject); ok {
+		if err := validResponse.VisitCancelVaultUploadResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetVaultUpload operation middleware
+func (sh *strictHandler) GetVaultUpload(w http.ResponseWriter, r *http.Request, uploadId string) {
+	var request GetVaultUploadRequestObject
+
+	request.UploadId = uploadId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetVaultUpload(ctx, request.(GetVaultUploadRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetVaultUpload")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetVaultUploadResponseObject); ok {
+		if err := validResponse.VisitGetVaultUploadResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AppendVaultUpload operation middleware
+func (sh *strictHandler) AppendVaultUpload(w http.ResponseWriter, r *http.Request, uploadId string, params AppendVaultUploadParams) {
+	var request AppendVaultUploadRequestObject
+
+	request.UploadId = uploadId
+	request.Params = params
+
+	request.Body = r.Body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AppendVaultUpload(ctx, request.(AppendVaultUploadRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AppendVaultUpload")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AppendVaultUploadResponseObject); ok {
+		if err := validResponse.VisitAppendVaultUploadResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CommitVaultUpload operation middleware
+func (sh *strictHandler) CommitVaultUpload(w http.ResponseWriter, r *http.Request, uploadId string, params CommitVaultUploadParams) {
+	var request CommitVaultUploadRequestObject
+
+	request.UploadId = uploadId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CommitVaultUpload(ctx, request.(CommitVaultUploadRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CommitVaultUpload")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CommitVaultUploadResponseObject); ok {
+		if err := validResponse.VisitCommitVaultUploadResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetVaultUsage operation middleware
+func (sh *strictHandler) GetVaultUsage(w http.ResponseWriter, r *http.Request) {
+	var request GetVaultUsageRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetVaultUsage(ctx, request.(GetVaultUsageRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetVaultUsage")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetVaultUsageResponseObject); ok {
+		if err := validResponse.VisitGetVaultUsageResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListVaultVersions operation middleware
+func (sh *strictHandler) ListVaultVersions(w http.ResponseWriter, r *http.Request) {
+	var request ListVaultVersionsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListVaultVersions(ctx, request.(ListVaultVersionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListVaultVersions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListVaultVersionsResponseObject); ok {
+		if err := validResponse.VisitListVaultVersionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetVaultVersion operation middleware
+func (sh *strictHandler) GetVaultVersion(w http.ResponseWriter, r *http.Request, revision VaultRevision) {
+	var request GetVaultVersionRequestObject
+
+	request.Revision = revision
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetVaultVersion(ctx, request.(GetVaultVersionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetVaultVersion")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetVaultVersionResponseObject); ok {
+		if err := validResponse.VisitGetVaultVersionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RestoreVaultVersion operation middleware
+func (sh *strictHandler) RestoreVaultVersion(w http.ResponseWriter, r *http.Request, revision VaultRevision, params RestoreVaultVersionParams) {
+	var request RestoreVaultVersionRequestObject
+
+	request.Revision = revision
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RestoreVaultVersion(ctx, request.(RestoreVaultVersionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RestoreVaultVersion")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RestoreVaultVersionResponseObject); ok {
+		if err := validResponse.VisitRestoreVaultVersionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListVaults operation middleware
+func (sh *strictHandler) ListVaults(w http.ResponseWriter, r *http.Request) {
+	var request ListVaultsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListVaults(ctx, request.(ListVaultsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListVaults")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListVaultsResponseObject); ok {
+		if err := validResponse.VisitListVaultsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateVault operation middleware
+func (sh *strictHandler) CreateVault(w http.ResponseWriter, r *http.Request) {
+	var request CreateVaultRequestObject
+
+	var body CreateVaultJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateVault(ctx, request.(CreateVaultRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateVault")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateVaultResponseObject); ok {
+		if err := validResponse.VisitCreateVaultResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteVault operation middleware
+func (sh *strictHandler) DeleteVault(w http.ResponseWriter, r *http.Request, vaultID VaultID) {
+	var request DeleteVaultRequestObject
+
+	request.VaultID = vaultID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteVault(ctx, request.(DeleteVaultRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteVault")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteVaultResponseObject); ok {
+		if err := validResponse.VisitDeleteVaultResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetVault operation middleware
+func (sh *strictHandler) GetVault(w http.ResponseWriter, r *http.Request, vaultID VaultID) {
+	var request GetVaultRequestObject
+
+	request.VaultID = vaultID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetVault(ctx, request.(GetVaultRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetVault")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetVaultResponseObject); ok {
+		if err := validResponse.VisitGetVaultResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateVault operation middleware
+func (sh *strictHandler) UpdateVault(w http.ResponseWriter, r *http.Request, vaultID VaultID) {
+	var request UpdateVaultRequestObject
+
+	request.VaultID = vaultID
+
+	var body UpdateVaultJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateVault(ctx, request.(UpdateVaultRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateVault")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type PollUserVault401JSONResponse ErrorResponse
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateVaultResponseObject); ok {
+		if err := validResponse.VisitUpdateVaultResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-func (response PollUserVault401JSONResponse) VisitPollUserVaultResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// AcceptVaultInvite operation middleware
+func (sh *strictHandler) AcceptVaultInvite(w http.ResponseWriter, r *http.Request, vaultID VaultID) {
+	var request AcceptVaultInviteRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.VaultID = vaultID
 
-type PollUserVault404JSONResponse ErrorResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.AcceptVaultInvite(ctx, request.(AcceptVaultInviteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AcceptVaultInvite")
+	}
 
-func (response PollUserVault404JSONResponse) VisitPollUserVaultResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(AcceptVaultInviteResponseObject); ok {
+		if err := validResponse.VisitAcceptVaultInviteResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PollUserVault500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+// GetVaultContent operation middleware
+func (sh *strictHandler) GetVaultContent(w http.ResponseWriter, r *http.Request, vaultID VaultID, params GetVaultContentParams) {
+	var request GetVaultContentRequestObject
 
-func (response PollUserVault500JSONResponse) VisitPollUserVaultResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.VaultID = vaultID
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetVaultContent(ctx, request.(GetVaultContentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetVaultContent")
+	}
 
-type ListUsersRequestObject struct {
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type ListUsersResponseObject interface {
-	VisitListUsersResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetVaultContentResponseObject); ok {
+		if err := validResponse.VisitGetVaultContentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ListUsers200JSONResponse []UserResponse
+// PutVaultContent operation middleware
+func (sh *strictHandler) PutVaultContent(w http.ResponseWriter, r *http.Request, vaultID VaultID, params PutVaultContentParams) {
+	var request PutVaultContentRequestObject
 
-func (response ListUsers200JSONResponse) VisitListUsersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	request.VaultID = vaultID
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Body = r.Body
 
-type ListUsers500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PutVaultContent(ctx, request.(PutVaultContentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutVaultContent")
+	}
 
-func (response ListUsers500JSONResponse) VisitListUsersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PutVaultContentResponseObject); ok {
+		if err := validResponse.VisitPutVaultContentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Logout current user
-	// (POST /logout)
-	LogoutUser(ctx context.Context, request LogoutUserRequestObject) (LogoutUserResponseObject, error)
-	// Refresh access and refresh tokens
-	// (POST /refresh)
-	RefreshToken(ctx context.Context, request RefreshTokenRequestObject) (RefreshTokenResponseObject, error)
-	// Issue access and refresh tokens
-	// (POST /token)
-	IssueToken(ctx context.Context, request IssueTokenRequestObject) (IssueTokenResponseObject, error)
-	// Get current user
-	// (GET /user)
-	GetCurrentUser(ctx context.Context, request GetCurrentUserRequestObject) (GetCurrentUserResponseObject, error)
-	// Create a new user
-	// (POST /user)
-	CreateUser(ctx context.Context, request CreateUserRequestObject) (CreateUserResponseObject, error)
-	// Create a new user
-	// (POST /user/confirm)
-	ConfirmUser(ctx context.Context, request ConfirmUserRequestObject) (ConfirmUserResponseObject, error)
-	// Get current user's vault
-	// (GET /user/vault)
-	GetUserVault(ctx context.Context, request GetUserVaultRequestObject) (GetUserVaultResponseObject, error)
-	// Create or update current user's vault
-	// (POST /user/vault)
-	InsertUserVault(ctx context.Context, request InsertUserVaultRequestObject) (InsertUserVaultResponseObject, error)
-	// Get current user's vault
-	// (GET /user/vault/poll)
-	PollUserVault(ctx context.Context, request PollUserVaultRequestObject) (PollUserVaultResponseObject, error)
-	// List all users
-	// (GET /users)
-	ListUsers(ctx context.Context, request ListUsersRequestObject) (ListUsersResponseObject, error)
-}
+// GetVaultKey operation middleware
+func (sh *strictHandler) GetVaultKey(w http.ResponseWriter, r *http.Request, vaultID VaultID) {
+	var request GetVaultKeyRequestObject
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+	request.VaultID = vaultID
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetVaultKey(ctx, request.(GetVaultKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetVaultKey")
+	}
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetVaultKeyResponseObject); ok {
+		if err := validResponse.VisitGetVaultKeyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
-}
+// RotateVaultKey operation middleware
+func (sh *strictHandler) RotateVaultKey(w http.ResponseWriter, r *http.Request, vaultID VaultID) {
+	var request RotateVaultKeyRequestObject
 
-// LogoutUser operation middleware
-func (sh *strictHandler) LogoutUser(w http.ResponseWriter, r *http.Request) {
-	var request LogoutUserRequestObject
+	request.VaultID = vaultID
+
+	var body RotateVaultKeyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.LogoutUser(ctx, request.(LogoutUserRequestObject))
+		return sh.ssi.RotateVaultKey(ctx, request.(RotateVaultKeyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "LogoutUser")
+		handler = middleware(handler, "RotateVaultKey")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(LogoutUserResponseObject); ok {
-		if err := validResponse.VisitLogoutUserResponse(w); err != nil {
+	} else if validResponse, ok := response.(RotateVaultKeyResponseObject); ok {
+		if err := validResponse.VisitRotateVaultKeyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -991,23 +11271,25 @@ func (sh *strictHandler) LogoutUser(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// RefreshToken operation middleware
-func (sh *strictHandler) RefreshToken(w http.ResponseWriter, r *http.Request) {
-	var request RefreshTokenRequestObject
+// ListVaultMembers operation middleware
+func (sh *strictHandler) ListVaultMembers(w http.ResponseWriter, r *http.Request, vaultID VaultID) {
+	var request ListVaultMembersRequestObject
+
+	request.VaultID = vaultID
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RefreshToken(ctx, request.(RefreshTokenRequestObject))
+		return sh.ssi.ListVaultMembers(ctx, request.(ListVaultMembersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RefreshToken")
+		handler = middleware(handler, "ListVaultMembers")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RefreshTokenResponseObject); ok {
-		if err := validResponse.VisitRefreshTokenResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListVaultMembersResponseObject); ok {
+		if err := validResponse.VisitListVaultMembersResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1015,11 +11297,13 @@ func (sh *strictHandler) RefreshToken(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// IssueToken operation middleware
-func (sh *strictHandler) IssueToken(w http.ResponseWriter, r *http.Request) {
-	var request IssueTokenRequestObject
+// InviteVaultMember operation middleware
+func (sh *strictHandler) InviteVaultMember(w http.ResponseWriter, r *http.Request, vaultID VaultID) {
+	var request InviteVaultMemberRequestObject
 
-	var body IssueTokenJSONRequestBody
+	request.VaultID = vaultID
+
+	var body InviteVaultMemberJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1027,18 +11311,18 @@ func (sh *strictHandler) IssueToken(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.IssueToken(ctx, request.(IssueTokenRequestObject))
+		return sh.ssi.InviteVaultMember(ctx, request.(InviteVaultMemberRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "IssueToken")
+		handler = middleware(handler, "InviteVaultMember")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(IssueTokenResponseObject); ok {
-		if err := validResponse.VisitIssueTokenResponse(w); err != nil {
+	} else if validResponse, ok := response.(InviteVaultMemberResponseObject); ok {
+		if err := validResponse.VisitInviteVaultMemberResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1046,23 +11330,26 @@ func (sh *strictHandler) IssueToken(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetCurrentUser operation middleware
-func (sh *strictHandler) GetCurrentUser(w http.ResponseWriter, r *http.Request) {
-	var request GetCurrentUserRequestObject
+// RemoveVaultMember operation middleware
+func (sh *strictHandler) RemoveVaultMember(w http.ResponseWriter, r *http.Request, vaultID VaultID, memberID VaultMemberID) {
+	var request RemoveVaultMemberRequestObject
+
+	request.VaultID = vaultID
+	request.MemberID = memberID
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCurrentUser(ctx, request.(GetCurrentUserRequestObject))
+		return sh.ssi.RemoveVaultMember(ctx, request.(RemoveVaultMemberRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCurrentUser")
+		handler = middleware(handler, "RemoveVaultMember")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetCurrentUserResponseObject); ok {
-		if err := validResponse.VisitGetCurrentUserResponse(w); err != nil {
+	} else if validResponse, ok := response.(RemoveVaultMemberResponseObject); ok {
+		if err := validResponse.VisitRemoveVaultMemberResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1070,11 +11357,14 @@ func (sh *strictHandler) GetCurrentUser(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// CreateUser operation middleware
-func (sh *strictHandler) CreateUser(w http.ResponseWriter, r *http.Request) {
-	var request CreateUserRequestObject
+// UpdateVaultMember operation middleware
+func (sh *strictHandler) UpdateVaultMember(w http.ResponseWriter, r *http.Request, vaultID VaultID, memberID VaultMemberID) {
+	var request UpdateVaultMemberRequestObject
 
-	var body CreateUserJSONRequestBody
+	request.VaultID = vaultID
+	request.MemberID = memberID
+
+	var body UpdateVaultMemberJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1082,18 +11372,18 @@ func (sh *strictHandler) CreateUser(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateUser(ctx, request.(CreateUserRequestObject))
+		return sh.ssi.UpdateVaultMember(ctx, request.(UpdateVaultMemberRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateUser")
+		handler = middleware(handler, "UpdateVaultMember")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateUserResponseObject); ok {
-		if err := validResponse.VisitCreateUserResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateVaultMemberResponseObject); ok {
+		if err := validResponse.VisitUpdateVaultMemberResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1101,25 +11391,23 @@ func (sh *strictHandler) CreateUser(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// ConfirmUser operation middleware
-func (sh *strictHandler) ConfirmUser(w http.ResponseWriter, r *http.Request, params ConfirmUserParams) {
-	var request ConfirmUserRequestObject
-
-	request.Params = params
+// ListWebhooks operation middleware
+func (sh *strictHandler) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+	var request ListWebhooksRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ConfirmUser(ctx, request.(ConfirmUserRequestObject))
+		return sh.ssi.ListWebhooks(ctx, request.(ListWebhooksRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ConfirmUser")
+		handler = middleware(handler, "ListWebhooks")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ConfirmUserResponseObject); ok {
-		if err := validResponse.VisitConfirmUserResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListWebhooksResponseObject); ok {
+		if err := validResponse.VisitListWebhooksResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1127,23 +11415,30 @@ func (sh *strictHandler) ConfirmUser(w http.ResponseWriter, r *http.Request, par
 	}
 }
 
-// GetUserVault operation middleware
-func (sh *strictHandler) GetUserVault(w http.ResponseWriter, r *http.Request) {
-	var request GetUserVaultRequestObject
+// CreateWebhook operation middleware
+func (sh *strictHandler) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+	var request CreateWebhookRequestObject
+
+	var body CreateWebhookJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetUserVault(ctx, request.(GetUserVaultRequestObject))
+		return sh.ssi.CreateWebhook(ctx, request.(CreateWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetUserVault")
+		handler = middleware(handler, "CreateWebhook")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetUserVaultResponseObject); ok {
-		if err := validResponse.VisitGetUserVaultResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateWebhookResponseObject); ok {
+		if err := validResponse.VisitCreateWebhookResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1151,25 +11446,25 @@ func (sh *strictHandler) GetUserVault(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// InsertUserVault operation middleware
-func (sh *strictHandler) InsertUserVault(w http.ResponseWriter, r *http.Request) {
-	var request InsertUserVaultRequestObject
+// DeleteWebhook operation middleware
+func (sh *strictHandler) DeleteWebhook(w http.ResponseWriter, r *http.Request, webhookID openapi_types.UUID) {
+	var request DeleteWebhookRequestObject
 
-	request.Body = r.Body
+	request.WebhookID = webhookID
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.InsertUserVault(ctx, request.(InsertUserVaultRequestObject))
+		return sh.ssi.DeleteWebhook(ctx, request.(DeleteWebhookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "InsertUserVault")
+		handler = middleware(handler, "DeleteWebhook")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(InsertUserVaultResponseObject); ok {
-		if err := validResponse.VisitInsertUserVaultResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteWebhookResponseObject); ok {
+		if err := validResponse.VisitDeleteWebhookResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1177,23 +11472,26 @@ func (sh *strictHandler) InsertUserVault(w http.ResponseWriter, r *http.Request)
 	}
 }
 
-// PollUserVault operation middleware
-func (sh *strictHandler) PollUserVault(w http.ResponseWriter, r *http.Request) {
-	var request PollUserVaultRequestObject
+// ListWebhookDeliveries operation middleware
+func (sh *strictHandler) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request, webhookID openapi_types.UUID, params ListWebhookDeliveriesParams) {
+	var request ListWebhookDeliveriesRequestObject
+
+	request.WebhookID = webhookID
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PollUserVault(ctx, request.(PollUserVaultRequestObject))
+		return sh.ssi.ListWebhookDeliveries(ctx, request.(ListWebhookDeliveriesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PollUserVault")
+		handler = middleware(handler, "ListWebhookDeliveries")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PollUserVaultResponseObject); ok {
-		if err := validResponse.VisitPollUserVaultResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListWebhookDeliveriesResponseObject); ok {
+		if err := validResponse.VisitListWebhookDeliveriesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1225,33 +11523,251 @@ func (sh *strictHandler) ListUsers(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// LookupPublicKey operation middleware
+func (sh *strictHandler) LookupPublicKey(w http.ResponseWriter, r *http.Request, params LookupPublicKeyParams) {
+	var request LookupPublicKeyRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.LookupPublicKey(ctx, request.(LookupPublicKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "LookupPublicKey")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(LookupPublicKeyResponseObject); ok {
+		if err := validResponse.VisitLookupPublicKeyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xYUW/bOBL+KwTvgLaAEsutm7Z6S9Nez3fdbVGn+7BBsGClsc1WIhly6NQb6L8vSMqS",
-	"ZUtx0no36WIBP8gSNZyZ75tvOLqiqSyUFCDQ0OSKajBKCgP+z0uWfYALCwbdv1QKBOEvmVI5TxlyKQaf",
-	"jRTuHnxlhcohrMyAJqM4jmgBxrAZ0IT+xI3hYkY0XFiuISNTDnlGHghWwANaRtSkcyiYe//fGqY0of8a",
-	"NL4NwlMzeK211B8qL2lZlhHNwKSaK+cNTehYLFjOM8KFsujsjgWCFiyfgF6A9u9/SzhP2+FMZAE4dwFd",
-	"gkByqaWYESkIzoEYv9NeYwohVJYJ+CDKegOP1okGhvDRgF4DTWmpQCMPgELBeO4uplIXDGlS3YkoLpWL",
-	"yqDmYuY8d7C4lVsPFDPmUuqsZaa+GdGCi7cgZjinyfMtu2VEV/jT5CxsEtVO1FbO6xflp8+QehjbSdoK",
-	"LYC0hpvHq7LCBcIsIFIjuLa0J7+7vPdbNha7nH4rZ1z0wpHBgqcwfhWu1wH/KPiFBcIzEMinHDSZSu25",
-	"lebc8S28Sh5a4yrJPZNfQJCCCTaDAgQ+6gL1Fvjvgvn61GwhGjXBduXp1HnfD64PbjtLL5mBoxHRoDQY",
-	"EOgrmMipT1R4Z5ejYVWXS6GQ+jy6RSZ5O4fW8uy6gmtY+T85F+SVhJ1BeIPWOAqv1dN2UE4vILWa43Li",
-	"dKNSeWAa9LF1FXtFP/l//1l5KxW7sM6mFxpnLCxofJojKuf/iZRfOKzMcIdP6m/RVWx08noyGb/7+bfx",
-	"q+Z1pvj/YRlEj4up9HBz9AlwEJDj92Ma0QVoE0AfHsaHsdtQKhBMcZrQJ4fx4RPPNpz7kAa5nEkbKk6G",
-	"ynPgeX6MM5q4upQWnX0atXve43i0TbSwnBibpmDM1OZRoJchGhbyC7j8z4FloL2NCeBBSEdHZauMIWTk",
-	"v4jqnciXJCTJ0PVmsQm3S84oHn5bFx6uty2fUiGRMItzJy6p82aPreqjcJal5r9DFhHbu93TOO7bq8Zj",
-	"0NW611lMk7M2f8/Oy+iqRcWz8/I8osYWBdPLBsrUau101DnoTQ40TDWYeT9nPoQFp5WubLAmvgE4N0tx",
-	"Wws7Uny6op73B7I1XubLH4aJq3Oa1AS+Kn8irCKqpHv/R8LrtrorPlasIsxDSJjY8M0EdtYdsJubY2Ms",
-	"NMz0542XMlvujZStg0zZbkCoLZT3oCC4S8K3VsNmFfhDVT8ou2vkBnRam6/2Xlb+DOAYXx/B9l9PqQZ/",
-	"QGW5+f4SqivCc3lXPXjdTq7oDDqq4Q3gSdD37g6/P2q2Dold3dD1Pw2oOSw2qflj9vS71Mo3sNm4ox49",
-	"bGbhP0kPt4ftG4nisKMNO/RSb66LILeWkT1VYQiQMCLgcu2U5K4GqRRTrov+dnQSFlT5V0yzAtCL8Nlm",
-	"+K+9TlUWwwBXzdV+hLiwoJfNBFE9aqf5Oi0+v+vi/7GQXTCb43W66mL6xS/amdjC5sgV0zhws+9BxtBl",
-	"tKVK7ZnahgPpMXZ+D/lKQMl0TpAXYJAVijw0kEqRGWK4SIEMXzyLD+LhQTw8jePE/3595KbhlXwOj549",
-	"P3rx/PHoadSM41zg0Yh2fSmqc7Fx2Ji75oSW5cSvIJ+4YJ6jtc36zvbYvj2Rt6373H53x9iX7vc2llGY",
-	"kv8aN0JOnB9TacW96kAPTCBBfycaCwN6o2xu0o5kioAHBjWwop3L3TS7QSPq+MgR0mxQ6vvLu7vCvdJM",
-	"qUkQqR4StHV0oGSe94rpe5nnt1DT69N9j4V041tl41vH98l/5PDvIoerSjC9/H/LjZdF873c5wiFud1Z",
-	"rfn6rDVbdiX5mOTcIJFTEqK4yy+WzhGW5ytPyrL8IwAA///OFPvBth0AAA==",
+	"H4sIAAAAAAAC/+y9CW8bR7Yo/FcK/C4wMx9aFuUttoHBgyI5iSZW7GspyeAmeUGx+5Csq2ZXp6oommPo",
+	"vz/UOVW9VpNNiSLlBRhMZHZ3rWdfPw5iOctlBpnRg1cfB1PgCSj884THUziRmVEytf9OQMdK5EbIbPBq",
+	"8Aufp4bFMjOQGSY0y5W45gYYzxKWwTUopo1UkLDRksV2KD2IBjqewozb0eADn+UpDF4N3IcRy+QBfjKI",
+	"BmaZ20faKJFNBjc30eCEZjoVE9CmvZyLH44PHj97zhJ8zuSYmSmwa1zk399/d8JePnsy/EfHCvSU24//",
+	"+erfT1+8fvnXW3n111/qOjH6Rfb2X+//9dOTt7+e/iyXv374dvzN1Wj+8vTbd6//+Sq4zDdcm3OZiLGA",
+	"pL3Kn/PEHpERM6gtMWJcM56xHy4v3zH7SsdCz2UWsaMn7Jwr9nj4+Akbvnw1fPpq+Ix9f34ZXA9e0+tL",
+	"PgkcmVEymzDIjDBLZviksST7p4JroYXM2FimqVzQbfLKKQujCyCYcj3F65+X23zEfh8Mfx8wbXiWaDaW",
+	"imWSZnj0e9axzd8HTw5ejl88T4Yvjl68eBp/kzx/9vsgsL+baJBzxWdgHMy+noGaQBYvj+MYtD47tT8K",
+	"u9ucm+kgGmR8ZkeA1nvRQMFfc6HstRk1h+rKxlLNuBm8GsznIgke81s14Zn4D7dH+72S87xz5ol7ur35",
+	"OqeS9Ze2OGN2LQx0ziv84+3NeA6zEajOGWf+8d1mvIAs6ZxD08NVM3SgX+eQ1+7p3VaNc+zmgHCq944m",
+	"dEzlSUa/qURmnj8dRIOZyMRsPhu8OirmFZmBCShCcwU6l5kGxPJvefIe/po7RuDIj/2T53kqYoSYw//V",
+	"MquRFftmAoNXT4fDaDADrfkEaarQWmQT5hfLxgLShP3Nbudvg5vqqv9LwXjwavD/HZY885Ce6sPXSkn1",
+	"3q2S1lwnt2fZNU9FwkSWz40d9zupRiJJILvdJp5UN3GczESGLFikMAFd7GaLG/hZg7KMPpOGcccNjGQ5",
+	"KHubzEyFZjIHhSu3855lBlTG0wtQ16Bw/Nts9Vn9vi7kDMzU3tjCMp0FMjGZIbvSONNWL4224EZmgJu4",
+	"iQY/SfOdnGfJ7e7uaXVDP0nDxjjW9tb9HrScqxhYVh38HV+mkieXUr7hagK3WvtRDe5IChSapXZAxcyU",
+	"003M+AeLz0yL/8BW94Voz0YyWdbm5HEMuSF4/++5NPz1hxgggeR2EPdNe5PaSMUnwP6yozPww29vb5dT",
+	"YAslDLCFnKeJm4Lguja3E9PmmkD9Uspzni3d0ehb3erjl9UNX0rJZjxbshyyxGJaLtOUhDfEcuRb29y5",
+	"n0/5PdxEg58zPjdTqcR/4JZYdlTdE1IvJF1zM4XM2O+3Tx4DExQz4M0cI5y2hakKP8uVpaJGEK8z8oo4",
+	"RJsbl+z1N/faHwXzlKP/hRjv6EQBN7BmFphxEVDy3mbpkq4cX2ALkaZsBIyPUrC0X0ECMENoJKFvEJWs",
+	"ncYMiREda9zgVIr1rpstGiiZwrrLrc783r7fPF4/Og7WfcpWgOxe84dcKNBnWUAVg1ha9WieGZE6TpYl",
+	"lq4mkIIFo2iFyPR82JaZosGMf/hFwAKnXiVdWRVK64VUAX31nXti75rrKzaCsVTAOLsWsIiQ68rc06Mr",
+	"WDJB1D8V2VXoLnJiQO2JLO2DLFZLS8OZhliBqe55tDRhs0D1kvzoUeWoK+fQfW0WdbcBaoQDlx5jW/Kf",
+	"MBZrriCLSmlzMYWMKZgIbUhysrdOAx3ILF2G5kllzFNoT/FOwRiUHTXl2WRuOYal2bheHbEExpZwa3ub",
+	"kA2ikmYO8J85NwaUHej//sYP/vPHx8c3f//t4M8/fjs++B/373/8n/8KLYhE/4+hCy9Bqzi/4keE4TeQ",
+	"Tcx08OrFuuvFSaLi9ItRuu/VqSsdF+sXPeMf/BqOhsPamo4Ce6UfVtMTnPjSvhjcxJoV/5xbMO5ct57y",
+	"x8+et2//B/hgcUgmkDBvDnOouZjK1Nl1Gvc8PHjJD8Z/fHz+9CZ4syi/temV+A8ExrbobzFVryRXQRpE",
+	"qnDSZWdEeQePxaJHLGczYQzqH2SncqDtloE4xUcashoR6VRrqxeEG478IXff1K8wmkp51U02rr1Jtb6f",
+	"1/Z3ZgdFPEwgFdegIqtRuROd0fphlhuL/cLATK8DuAuI50qYJY5OgFesnCvFlyToyNxdJp6WPRINatCU",
+	"ZESmDc9iYApiENd2oZY844bsGuEa1BLFT7T2udPTjDeU0EE0gMze+G9+Hj/y4I/WNUSDuUrrZsCpMbl+",
+	"dXjofnkUy9mhPXN9mElzIDM4qFCS8paVWHvJdqrQ1TbMh2+ENoXI17rhieKZgQ5mxnEAd3TxXCl763hk",
+	"E34Nfa+1sZ5S/GxfrlFzfYvVLLhmE3EN2daX1Dhyf1rlSntcwDlK8s0dWeGDKeCJrpmr+RVIq6PPeMYn",
+	"oJkwFQC039i53UtBAOzaWuvmY6QAybEJmrLaxI32Dq+9JNGa2b3wUxcbxedSrRlAqtUD6OOA4+RXpJWF",
+	"puc0MEtl3Y2xeZaC1kyBvaSwKNreskiC67iC5S+gvPWwQenpQd13Y4XKheJ5DonTPwH9DTw2wXlnDmI2",
+	"AGEEMgetoDe5V224mW+KMRf0kSV4GtSP/Q8kn49SEeOJyHH1IJgwf9PFKS2EmQZXW2GxrXvBZ53Qs+DC",
+	"nPKlrjwsxm2gOXLYGrjWgbOBDHXQL9dYXZG71OK4K+upAVTrQKMKovYgNhfFbXqiQWK4XU1hXqqASbH4",
+	"JExNagaDNg1xgFqwOzRzBgDaWzAqr3aYJddxvZiO0Y8YOhJSUoqDOSEA24ZutArzTxxHuu6gANvG9HWI",
+	"17GeTgRch25rJNA6gjnL6eDVy+E6odmh/I+wrM3SS1n2F1VinMOyCnJVxl+Nad2g1JOJNkxpmfjAIJfx",
+	"FH3I2vBZzv6unZFECyubHr38ZngwPDoYHl0Oh6/wf//zj368aQOodYaEPSxS9IMc3SJajpPTJRHBupZX",
+	"UJpFwuTK9LFaWIMEU2DmKvOmi9L2h5oZXepa6MPNlBBQPehiT91AVfF4PkjS1MfkSLYJmW6XIDk9635I",
+	"BW7rjlThjZyIrPPSErgWsQssaGHbX3NgIoHMiLEAVUqDqbBHQ5+yv1ugp2cWZJ0yMIPM/COI3/2hZJ0p",
+	"q98ZVj4oNhs6p1ZASeeZOdd+osOqHz3WU5Frb53FEBS0m1hkzlMeQ00xlBnoqjrYYQIrdc9bWdL6GsYC",
+	"J7EtvaxDSamd6Oan0IPyOVNmOdE6UTXkGtnWKUCnVlnjfrc/z9t5YPbCkhqkLsyh1l2Q50z3f0EdB95p",
+	"jr/tTVjC3kuUDZ2p+7g8XAf9rTNed67vZCpid4b1E3XhKKhTnsoZF9lqashmc23jFa/BRl3iuixxlJm3",
+	"amtgCY3DiM0IxfR85H6LmHVW0z86jLUV10ppvRxE66nITGQ/JuMz4+JoAvs4J52AieKdQh7w7jobiRkx",
+	"ZeM+rAEnR/8dy+UCFL67kI+Y9+1pxhUwBfYbSBgnY3sGHwxLLbOO2LDgtc5wTJGbhaJy9PzJi6cVXSWo",
+	"xzqweDzmAfGGZ38z1q8MmfUsJ2wJLv7U6beJBG1f0fM8l8rYDRyMeWykqnrbSQhxU4+kTIFjHJIGbeWR",
+	"c/7hjRiDETPo9sBy2jRLuTaa8VhJrZ0ooWCsQE9B+xPJJEvFTBi0gnPDUuDasCfPh0MmzRTUQmgYrD6X",
+	"Br5UDikACVEQzoP7W4dK23WK3YaV74yLrxOLCl2+YhbfyMe5lQCDqljgCGOxgU1o5HsZcgy/Ro+NHZeJ",
+	"LE7niXMFyAy0d+rXzOU0t4U469MZRAO5yDqs5m/nZiQ/nJNRqftWuTGWSAZtiNEejQE99ewrQbF+JV2v",
+	"+uz/FBSbFPjQEpIi9LH11JLZYzqZPexdQSxyAVlg5vf+EXHHiMUpcAUJk5nTFpwZ0YpYumPv9vc9bGuV",
+	"TcQtNQEeMoOEkBJvvmJ8LgC5eXvr0PQdaus/wnIFkqQTqYSZztbRk59J0z4u3m/iUI9jGotsAipXIjMb",
+	"BROUVoegmux32cPOsIFgGQ2uS+PIGi5aCJzXhWWiPNjqCutn0Ov+9NQdfScHvdMdFjFQ7ygpyp1jg5r7",
+	"l2wyTmkCiZjMuTWSGFkNho7W38Jmd3bdZaW6rHBR9xLL07m2bCZiR4UUORZKG6bBzPN1kf/1i11/n6Hz",
+	"C93le2l8tM2qu6xbBlcb469gWTcYrLX+kZ5ol7jOeV6zsuFEoU3VgkBWxy6U0Smbh5esikKwFPFkrrRU",
+	"bfCg3z0VsW+ynE8gYjOXgeGC+C3HtCCzceiO29Tao3mIPglnDExWqPlrKaTIOwRhwxNuUO/iSSLshnj6",
+	"rrbxLrW0PME+YW/BKCRLjY8nTsjowW/xFdxM9dvKCVV2tI5ktxdUEQpQ0RtE9N8/x1ykZCWyyt6fTtmj",
+	"x3JuF1AV+Lzj7E8M2A/KxTYW2CWtrsDDDS1slQDa9XRawEK/gXEAmIfeUCY0RgAVaOfDZFbT4TLQtpxj",
+	"nYGMQqO3ZhLb8NymXL+r2O/bNoIuJbISRN0etXbCfQIjiuHqJ1ddXd0Vthq8MdK4+1A77Kffcg3PnzIF",
+	"uQINmaGoY0eW6Zvo1tkGlOHc8Lv3Z7FbcL7txLl/F3fanfxndL4ha3PH+W7FOtGZ9kDL6eOP3dAneosF",
+	"7D/Qu726pojfgsYPj589O3pZgT4yx1r4U3zBnjymgGqyhqJVUbPT1+9L3ezdj2eMawYfcqmcSvArjNiJ",
+	"FYMl2mk9v6Op7A9xMj3IbXhzNFCaH0gO+UE92rk8BbeFE5mNUxGbDQKang5frglo6q9aNJDWWmCd2aqt",
+	"UKwhxI0oqHLSP7ov8IFo7B3a4VpJ4CFo+v3V99tpeZvp8pTx03Wpm6T89BPNy4ydRs5Np5m5fO9fcpqx",
+	"Uwn9vW21TJnQ9pFs3Qaljx73jlH8hVIxuGYzVw8Fa4dkSMRcuEboAFSlvECYCPg3avJANZ3paQ+Vax1V",
+	"KJbReX42CHNitYt3So5SmAXgqNPuq4CK43yn5Kw78LcUduwxKpla39iIx1fMyL4WXprnfeeh+ic4mX+9",
+	"KOli57UqjoGMcd13zrVz1QQ5M+WGkfpl6bsYl768jYy99bmKrVxTztsCFFSPMGLzTAFPMJUV/SCxzbp2",
+	"jkj7hI2VnPnU64gtpiKeshlf2ucGZrlUXC1rHDaWSs2LMGGa36JkMVE476WwgYbDsoPPmjTTvVjxtlfq",
+	"cKwIqCNINiHo9TaDLuOjMDCrG2/kbKSNix5ayxB8ZusKdWwtWa2CWg9YobpEuzfvhOh05XrKHN9ygSvv",
+	"6mTKs0mAXo+4hp6YHuMQRJ55AoxkKmuh5SyDBV7uyiy+oJO/H8j4s/CZJYEc5zsATX3u7yEDxVu2crJ/",
+	"oIxggdc+aO64f4BL7dhXXtx70Jh312K0xNl6aRt2nFuiSP1ofsIsKnpK54F1FSCJqpGAFrE5i52sYIFE",
+	"jIvzKgIz4IPQ5rbeOTftIBr4aXq65gqf3HpmvVKIr9sgQvG8poiaoAWEwG/DdJ4rWOZcKOutmWfWJsCE",
+	"6U7euYvBYXWOTGtznWdY+is6g1CDXKx9MNvd36wMlKjZVnqYU2qWi04dzyX/9NbT7j3AbytpdEWMR3tB",
+	"d4p1qUW290uRq1xDPT2up2/4zpl0dBa9EuluEeJY0Km7ZKu1z2h1plpI1KSrWaHv3aLGpvuoUmUzcrn3",
+	"eIJyXlQJDd3cloK9hD71Oe0hrzQhgVvwoT34Q/oJsiSXIjM6GDh4GzTzYpRTO1C54QowenHKFSTBbZXh",
+	"aBe3R5i26eBXqYJ1WGqVITsi5KrvVLYotNsHE9nd1Hd7UmXBm0olWTLnObWzp865KV0KsfT6co89UVhM",
+	"JZvypKhxk8LYRJZZYxCxVRbtWJAEQcjXz+ixhw3tzQ115lZKiCNT3uVaIFEjBrEClw0a1jrGut5JtTSq",
+	"UfQ9NJwthTBanRslAipLRYlA3VTxYpnFnR4E0pY2DO+oaGrkSTyjT6uJvkXgRNwraAJ9tHqZxZ7KYCkO",
+	"rIa4ubKGscuB8HJXuS+bI/TLsdMVSXvTVPbLzFVWLyj0bDisxYQPh8ONYnvcAXRCBd1PJ+dafXxGUkEt",
+	"mZWxJ/YY+xGXKdfnUkGH0unhYTPACAXPKNQOA7ast3MTSyoZDTyeuguJmMiKeg1SJVRhZaNVOH10XfiR",
+	"O93yJPw85Zo7781HXQQKy3E2FZlpJPLpCH8oatAYyXIRX9k8DRHLqrUtB6VtJItFcuJzaFruRnFfV6lX",
+	"MEbAPFQYhiKWz/XUGRKtYQHRkMXTeXbVD6Q6xBg5HmsITP/t0oD25XgSpiUbc2VNkqCghGicn2nDlelp",
+	"qt2AO3UWaDomWa+RIlIY5fuo8cQm3ObXBZLQVa4ONp8KbaRa4rGtrl51BXnhVXRe1qrxAz4gq+lb9MTA",
+	"rMeclvJ4ctpvYBs3YrfdMThWc9XuzH2drErJih4zYGXRYvgeH8w1JB3LuZSGp9acnlGJrgVXrlQPztJv",
+	"Qdcr9ls9zCLg1MHbpnJQZZ6oDjfV+6zut3ZYzbvphNhfQInxChtUPIX4KiSH/iAXVBy16sggP0mHMmGJ",
+	"Qa/wyxE38bTQ1jCynyhZ6XRqT1RxAJPfa1Pu13SbreU+7mQqE646Zd0/uWhvEZ71iU/xCamKhLyu7G8R",
+	"7YDphWSjnWdXmVysVb0KHfBJP0aweU3BDgLfp2Rgq1jg2rS4pkrh1luLCl1tPnH1+k6p3t7y00tUcpUC",
+	"9zI3RlbfKvq3b3zEg86SIlC56PA0Y9MWMvHV9DQHSKWL1CenK/K7ZZL5oTdOYyqAYbNcJrrHbSQzFeUv",
+	"Hx6hvUtuQ1fpzJ5gXFTY3KTupat23Iar8+OTg4sfji3NrZiq/31wISYZN3MFjLo1NYsgSO/ZlEE24eps",
+	"9oklUunAb6o419WQQfvBA72wB+wadgBXoI7nZmr/NcJ/fecPk7KWfCsgVK/xhXLtU2NyrLwq5ZUAP4yw",
+	"hxTjT2X3kYvXFxdnb3/68+y0/JznApNs7OJENpa4e2HSoiz88buzShzgq8HRo+GjoZ1Q5pDxXAxeDZ48",
+	"Gj56QrVzp7ilQ8yMPZSY/Wp/mNAdFh0wLKcf2CycWoKsHtRbFv3WMr/Qe46g1G0sCZm0cON/zUEty30X",
+	"GF3pp7Rx8uPH4NBkJ6qOXNSPfTasmHyerbX4/NHo4/J4OOxR2b+ctxc+h/OR20Jmq4D/MUsFuTXoUn2G",
+	"K9rWnw6PuiYutnRYa1mAHz1Z/1HZBuYmGjwbDtd/EeqrUkU8hKoqyv32h73bKvb89oe9DD2fzbhaOjh1",
+	"dTAam7fmDQdcdo4a2B9+dG+dnd4coiVqTpxA6gAuvKcXavfTxoZg8yI3yZ26F7WB72nA+Omwz20m2eHV",
+	"Px0+3QgZ7tSswu+zaA/DJDWvsLThIAVjwDUP2hdEOmhhvL6kBnhWYZL040PUWJdVMGyGQR1gqRNddFYp",
+	"gwTlmHHSiu2flnlrV+I6lwq9eVI7RS2R1qU3w3ftQM4pikW1UEX3YZPWCfqI/SrMFMMP0WTIWREqWLrV",
+	"iohFjDsURttALDumoCqvhTbItQvS8noRFXNxEZxOCCCLIOn89oOxAeXcbejldkq/kaTiL6uaP9V3qWMv",
+	"2S9QyV7Lw5ypoViLC73K7XrlXNMJdzAyXOhgZVe1jdnU0XAzPhW1YQavpnlrmq6KygMFL6tjkx4Qwssd",
+	"81RD25l4Z/a51jTTsFEF6MYvlfhchxVeLqVL/fxZJR2SKzLmTFn2DGqUhOgSRWXoldLhmXtnF6JRo3Lc",
+	"RjKR30t5wbvhVN19m/YqK7nj8NXliujSShGhyPJU29bBv4vRtZZV4SmGhaRqt6ay2PS3Mllu7chDDaFu",
+	"bm6astVNCyK3d+tNQAx2SxQG/PFGZW6q5ZRNdTcGAsse8FBpHfkVkgkSGGfWPJXCwVxDoxURwWGVmB1+",
+	"9O1db4hppWAgJOzbosMFHK8X8rfSNLaXjO9Ay5dF/qQhx7HN3SzDHdwIUplN0CDhs7l8K8Kd6jBuObUO",
+	"l/vTViww2SCFeYYViKuY4ypIdCrGb/D5z2QqXA+/9DrTc8ytH8/TiCijZmWl70ob9wswB7T0rn7kCfvB",
+	"mBxDMsicplfK3ze9wX//PRkrUmbE5p3T7U2UoKusSg0EMtXgy9Xy49vamzsxsIWqOG5mX6ut+bYqw17l",
+	"P9OIkNXBMpIVMa+7VBaC5QhshJdGtR9rLT5qaeDtJpn3JCCGCoXuWEAMw1gbpqrveWHxbhz904BBL7ll",
+	"NSAM0I5CbKNwoG6z2GVZNNvHNfuQLmcXHgc0HEqKtb/nrjizf6+6ChoRi9eGTEtdPXHvCbzXteDtBerD",
+	"nYN6Kyb/f6XI9mGhXv1F0RgdP3i5O3Hw0rW/sAoiTxXwxEaYuiSnPaLqv6TImoiKRuGi9HhDXKzj78fq",
+	"P1tKVzOjE1ygHbIQjJZ/xIoEmDBucuWiIMmEIRR9rEOYeooTt1jQOom1+kGR7fuA4XZfoELH26bqUVj8",
+	"+x7M6qvYP5G6wy1/Inf2PZgWdneJgnUjSGip5Su1Iz87HdhZczSxr0B76sCKaN/C3XblsIcmPg73Kz66",
+	"1KRdio+fMS17D9aw10dCbXK4Q+xP1F/r/Z5e37XqW+9FtJH+SxuMLMe1h/T5E8lCZaado5+9zeTuTh+D",
+	"mk0/8tjWrvGCd0Aja+299qhnNwC6DcD4wj607M9RVakJDFMM6UDkINUAQ0w8bdi7ewgXtgHpPvyI/+2t",
+	"razCy7bKUeLlOr2DAParwrFW4fA3fFcaHG30xfcEJES652Z7gu3DpdzDfVLuz1PA/Uq5u4VvtzAXwoiN",
+	"PoXRvvlVL4rejmG6DY42ZfZdRj2t6J15mwioyOVPYrQflir5yllWSPwu+8Cf3v5Ff5ctQ0ZOFnMf5eLN",
+	"nuyy1j7UW2gtDrnEdCYy9g1L+DJoI20rEjuI5rqjN+N+FIoHFuX1lXM9RPeIAwEtZyAzoKCiOosdLQkJ",
+	"N2FXnVFqt2FeFNzTgdG3iTb7yipCsVMNVrFrbeTMAczg5o8ecOZFqL5GynP3/q4FnkZty40EnkJM/GLM",
+	"k27H9yOk9Ieqw4/0xxrqdYyx5CjCKJhJbGa9tER0NDelF7jaO6TcoOWtZgozDek1OKGHHjKeaslS4Ncu",
+	"Rarbd0wju3KAWIgN05+qxfLkmGVgxSf8ly8XF5Kb3uMW2rA76Je3h0unY/gaE9HudGzZftlcz8LFfkk+",
+	"gatfHKZRbR3jNqP/5w7lNvW0tvWJGb+yeMLmGf1V0SvK47dv2pqgBnKWyEUQJbqaPd2TKrGut9QeLVxN",
+	"NhbKaEVI+mrj+myJBtXSxAVZvKNkXa/A9GCuPkKxU2ZrxNO88+/vCMaL+TrupFj/FxFT04wpvRejUW+n",
+	"A6teAPZJWBa5v5RqjV1E6+XEneSFca+QuDDaRM64ldqMrL5JZmMb0UnDZnLBbF735UIejHlspKomLWCw",
+	"My9a1bhuGkswUaXxc9GbQc9zzNMVph+PqcH9/TpQ6iC/H9ayCu38s6+xQVsW/8gXsRbHe1B1l2/96uNO",
+	"w0dYK4FDLrKKuuQj4604iOLhqNCT0EtUUTSbSlU/m/Ivvk73vZmUaz01d2xFrvcpCNUhsC98pqEoew/3",
+	"KLolBPHRd8ZeUecHX7h0nYTvjYjXux+HhCafFYnrgaSSMpkuP5kkybPsmqcCC/SQ/ynxO3LNmreXLtln",
+	"qv3xDFoIxyt0Lu3K2pw3W0OWdDurf3UNSSjPwbUx9wayDDRt29qgqQcF/n/Ym32BE+3CnFvrnL6RAZcO",
+	"45PNr+SxEddAu7hFeiU1enO9Yok3uxt36TdM8SyRM7RMWnhCBq0ZZ6nIrryAbmf3kR1k0hQZBlGMFZ/M",
+	"IDMR07J4lsE1miF5XBSYwoMhgQHHEkXDO1eUyTeFx+b7ukQ+fZYxVxTTiRM4OOWJEmR2igoWZu5VOiCg",
+	"3ItwUMeHNvzb53sRDR7vw51rA5CMlFQivIox+0ThC4tJjDMqbcrMVMn5ZOowq0KoDz/a//QqJVJA9DpX",
+	"AF7/3d2tn5rzVAMtJMj33mHDM+JzSNycYS9WkEBmBE8fMWqwU4pILOapq+KvqeScpU8RMwFCJokkkp0w",
+	"g0fsuOwLq9hCyWzCcq71QqqksEvg0OXIwaJzAhbu4lcWnHvnx5bjYn0R1VsWBlFEZuBrsZHYV1ae+feB",
+	"neLAj7FSrLvPEmx2FSc00DrMLxqge45Wl2ZPLPs5sGMpmYa5o/vOXiLxLFe+bLRksf26Lt0WMuogp47r",
+	"EcvkAX4SqMJzs+v6OAVvzVBX5wWsYQkuYvz0g90vguPOq9UUa6y1TI0KQVsqRCGSASz1xDIy8/zuxKWs",
+	"XCdgUaEUm9lLLpBQOzc2aQOdGuCZ1nMo9b/tyyBvrLF1T26pvmqnsIdwW52zqWtS7bhO1We9JnpPBa76",
+	"Kq+uhIQqEPEetNaSmektIg3C8jqtE7WRFW4l1/owXOJpe6Bpx19bR0yBUQKum6D5aRZ12reXqksdDelk",
+	"xe3fl05G17+pThYAEV/Usg0gG5ORLWFhYZu0ZY/LMln2r0PbNFyoWTc7OqEX3PmvFCRfI51yIzpHm0wK",
+	"0bFRxtc96q5XuEvxsRfyf1o3CzNQE8ji5QHR30673uXUk2hndbGfswm/xoBio+babtoeM48NkfHC5Nd6",
+	"WnzmxomYTJMi2SOkpliL1Wu/1mNa6j3edGMqO/tahcF/UhzTp2sWbO9lM+MgylW+tlXRpjngznvEKnZE",
+	"2xVctzvOUWd1bx70j9oNxh10RUwDsFTKq3lOSvmPsKyU6MFPfP0tDQ7m6CtqM4yer+IjN6yLAUXcdKZT",
+	"lFewpRvGZHIsehVR7feJ4tiaDxVknufKBk1itWI0SC64MKd8qdk8S+0oCmxvF0jYDHi2mIoUQkhA8dMF",
+	"bJ7Qyu6J4YUn25NBsoGPaxNe9lat7oE3pSixoKEjY28Kwktnub/CMGOLjz5F64Ek1DS4SZ3O4E5kVmk+",
+	"utRM4E4bjUcjVv0Ot0vvaTD2UaP1+O97dc2dc7RA4mGET8D5lFew9sOPUMeiNbHvl1hMhGcYGeXYeZUY",
+	"jqSZMrRSmkfsNeWV8ILwOfLoSIRuRKfX2xQWvcX/VnS+H8+VmYIKR7Fbe+xaYSBgt37dYGtfng07a7H2",
+	"zc1Ur5tQ5CxWvYGuWijzzjN3KYTHOMf+RMZNxcXdweDOSXhzq47OisxaSg03rt0PBnESv9knqhDgWO1k",
+	"BG1auyF9PXSi3z3DOk3yFdi/AvumBjbLrRn3ig0k7fUvXECPVVl8G8sNEOAKllsD/lAs+THqU7xDskD1",
+	"UNWEwaa22NIs0WQhxyjfdIdzN5Zou1/eZ8pQe7o9+Wa2gvm70sUeMrF420dJ2Sdt+FXxvIFKXtXyagCf",
+	"cJFtShHI1rFtjhiITnOL1MYaWjwft5pMhykHd/OIvcf17U6bsbN9Zd1fWffGKp0FnBrvlqoA2Pa1bYqk",
+	"OOj9YumF4cpZhp10wXJQQhKWos2JnjozxCP2Novdv6BukJA5VJNDSq5Oe3aNSz/if89Ob6zdIpciM7oi",
+	"ACjgCeU9FiYlG84xkwkUBpAZz7B5bfUtw69AXoPCN8M4jof5Fcm/IvltWvRqEzbclBhdtOPvqB9XFlKr",
+	"9LG1OZWujSzm+eYWsLn2HW2NZBMwxZsYdBh0y1mv52vfuH6l6/dtWW6K0Zod59TAzDIHOwB8yFOMeSBH",
+	"b8gnbF8dRJvH+NMt4FIvl3kg0D8aaLPEGDzbBW9wEwU34FbOTenOQRv5z5n4wCCXtluLmIE2fJZ3eLVt",
+	"Auog2HVPZOb500GfhrnVtTiRY9NlGLn5IkLjEMQMNmkjeMdG+I/vvRF+b1Ba5xs+JsTCXBH6zN3al9BE",
+	"qfAoN/YedicXBM0qRJ3kjKKoi1bd1TKRFWbuomhJtyqDeX0TWfQ4P2LfgYmnRZoIJZgbidIEW1g+sOC6",
+	"llVaRizALDdL1+7b/ooLYBrMPO8KYLCU8ke7tV3kNLnJbpfWdAXLnAv1CYcw+B1sntXkvmRC+4ht7qRQ",
+	"cQ1ZCXiUfkzJIWwsrcDQ9jEeFVJiFT7YsevqmcC1iJ2PscyJm4DR7OnwZds25SYPARjGOuipu/Z7MkbV",
+	"J9lTMEALstuQ/KO7Q7rA3RqdXm57oza4LhXx2ggkBxyFaz8Mk3tNWgLD5rmVmxDioYqpPVjC4Ue3xZtV",
+	"kcAlBqxviezGWxlhuDcxowecV+jVF9IHqoDy0nbaA3oChYnaB6mNErEBbfxgqxouFj/a8SlSLFyFhitg",
+	"KYwNk3MTMZu2WhuHxVOIr5DBzHySV0etMgfbD61oEuqlyd1rJz2YUkitSMUaj69AFUXcdIHUcaYXoDR7",
+	"MnxaONE4G8lkSVGBZ+ODn2QGB+e2EB6byjSpT/36kk8wSMm/fS4TMRaQHFwIawYTGnMACt3PFRGjOjre",
+	"YKXce/XZVkCXr3qyOob7kk903epdStVFqNaUa1z//9+VFlhbU0c23O+DJwcvxy+eJ8MXRy9ePI2/SZ4/",
+	"+30Q1ihDRSVQCe63VK6tneWHy8t3zH65YtX1m+hY+bkVEo+esHOu2OPh4yds+PLV8Omr4TP2/fllr876",
+	"TXyezVMjcq7MoVWvDxJu7JS1tI9c2Vs1jtQpuBbI36oLexKt1/SjgSvHdBwA7JCFgf3d5dEzjcB59PKb",
+	"4cHw6GB4dDkcvsL//c8/BlG5iqPn37x4/vLF46fPei2nQLVQVLqZ89Q7X0TG0aBQjFn80gIY/4scoUOq",
+	"qwROOJunElH8nUiB2WthMVcKq5cxl2V6cComoA0jGCrl+Ysfjg8eP3teg0tCylU5piHS6N4/xJf9uzcR",
+	"oui6b3B/+OJNNHjDtSkAe92X9uXiXeQLT0IBd5dBTGuHeH/C+95pFm534tdOo4gJL+w6xg9BJqzy6r9p",
+	"Hwe7SsVfKOxGYS2oiNOQ6JLLNtixhZOmdOnpasR+Hwx/H9C3plovIpOOIGHJQMsE0a50DYrmtqUk/Gju",
+	"0mxKvzY8dVPOgFvpsW4omOc2pRy7GbvahjxDBmfZPZkNjh63zQbleiu8DwOcUXjDrxUYtbRrQAkFnSBe",
+	"kZzhkTRImrWMJXzGJ1ScgJx4VOVFYEUozK7GpTwdDsPpDBrUZkKHvwi/IfwHHYqddcQ1rcYeeFTUbVzF",
+	"yVeLHv0kDU/NEzoZt0Y8x7+//+6EvXz2ZPiPrkXUj7VjKXrK7Qz/fPXvpy9ev/zrrbz66y91nRj9Inv7",
+	"r/f/+unJ219Pf5bLXz98O/7majR/efrtu9f/fNUlZPSxC8nYgDnQRgGf1UnHes7awxoUYBZEVZzJrTun",
+	"e0MCf6+52fdP1o8eb7fiX29bEl5GjPWAEyfT1RBPlMTy7vdz1CNp5h2V07iU8g1XE2pU8/jF7i6pYA1C",
+	"+8ord2N89ttv1n/733Np+OsPlklBcld26VJBpa/i3cE76xruOv8y7cqWeDGMHMGM6IblJiTaOm2CvD+F",
+	"mFzJ2hFGY3pqAV9YrANmTC+z2LukfRCGZaHWWk5M8RHToNEq7tI5is5G2k7mi4zhigqllT4lNmc5WSon",
+	"yMjmVOB3yfRUztOEpTK+iioDMKyUpsRkapzTyGa/nMjZzM6VigzI4oNTc20Ff2VGwI1LdayOUkgNLhKB",
+	"2jK5ymSWe8UyyyB2wSwop+LxHpyd2l0piMGWorIjOK+ahcu2cBDivRe4DsLDXr77s1N/dGhloIt0S0gi",
+	"2u9oSfd/IecqBsuHiz10cb/aru5YF8jABwerQb4V4FItx2Jm3A19Cc5ZggESCZHQB51lnVQhl2naSRPe",
+	"Y5SHrvMMi7/zDqvMI2YrRzpOY1+0EWHuczxei9VTSJOKyxU/tL978kIYSWM4txsOo+aZJty23jiSf11B",
+	"cCryfQWQY5BNBQTQCxz0tMk07S21howlkRNbnYWlwyDVEbShu01OK20qhRtj2Ce65Ae5YKmtKWYkHeEY",
+	"6a9bdMRinuelO50qMHYs2H4eXu/gyVDfyhC2ms3XbWDuMydev+onu3uulDW1Hnes0T3I5tFnY66ryf+/",
+	"VdZW2WLUuJg/2ua4jexxX7KSsGfbj6vKufrkLqU855kPGdAP02bU4HBW9qzWvGn4d+whg67yTyTsmQtx",
+	"pCcYq1MoUsSgSJyNmJGzkTaYbySyOJ0nkLj4zKHL6bagTSKwl32Lbp00CMVmWryOiInZl61sjEUkLBs5",
+	"l+QDMmqOVSNpKaWphDujU6nZaaYgl8pQ5AtnsdMZ23Ylu7lq7zMvRqAEbESaYh8PAUlQBF1mcZWN3ke8",
+	"Co5tJ9pTtlRl/hXVdO39KtDObPnwxc59aK54SCVCbSKokn1Qd2PydxWhUGZsnilIBR+lwJz2IqQvVVOI",
+	"nKjwCOu+n2dXlCXJ3h1fnvwQOSTnFQXu+9eXzECaos6ngLQ3PbdIi/lIcjYTzvSlmbB65BUwUTePUqUZ",
+	"e9ApGHjELkjpdQXVoZjVZVB4jzOtr7uMNY79c+4qnN5zrwuaZ58dL/wKVvBZfMPbFPZTQ2bTJIpb2892",
+	"zbj3QjkMV5QnpeczxGpCYiILXdTi8CP9cZasrJx9wrMY0iYSrTN1N2CMBv8CypAcj3iWoMxRv4KoM5hv",
+	"5ckO90sbIibHYw3IDhp0/ctpIOeOQI4Dl7o+/tLj2KYlHjuah2K8gWV49kqshSJDX+m4clG1aNhi7aUb",
+	"MSJRdiE0YAh2VZZ37xe3jI3kQqz1GKeuw27oMBqWEhp/5VlsZtZ5KN6+nSPqCQKBh4AvpuhAz9O5LGE5",
+	"UHUggBR3ETL2F2ue2Z7YRA4C5Gkt2z8ksTyQAr1NQhZUSE6KmGC3XKpN4MsVZJmcZzEkVr2fUl+dQnXg",
+	"9RZ5XmPXfAZldIuGGc+MiLHrREPP8H10SDQhR5UfhU4kYtypS2RCT6X2lnq6TzYW9jOrfhQdPKvRG5Vw",
+	"t2YZYTv+Srr5QGNC/vhUIxw+T+pWXr/IvMr8BcVTPH684yKddNz1eLE2qfoas3E7/VVipycPx8kaRkZN",
+	"AlbkudRCbyn04gpyw6ZCG6mWhanZmpKt+EwWbGwlZOSCKxcY+ZfdpLUoU0QjhVJg0ATgzi03akYCPht+",
+	"05F7QMiNa7938dHOstIga6SyOdt0lJ9qPosudlHJew6nRxHkuMyqfoUkokpSq8ygqVoRiw9mQeMd/OLn",
+	"2kUqdHXGTfKh8TtWHMunm3xPEoffyEbGc//R4UfPqlYmX1aPerBpZVnXk5imGWwYB3BHFbaLZdM3dGS8",
+	"AIYtJiw04n/XfV1zmlczED5TO9OpXGSkATUzP28Lvna9FiG6/UG+ehypw6SyuLmxuZkwBjLyjVZjFh+x",
+	"N4KKLHtTkjBlybhCFim0qVWZBeEyUrjubaJYtEqhqndheADqk7sDd3/JZ6w1fSFqyldVYNNyYNopA54e",
+	"BbPpGkRwdda7K7DktQHXtMfTgMow1Zp51Q7J3ULeDqW725W5oeOJ2KLWtNwv75OV92hbm1e+KYIbfA8r",
+	"qnHES7bEhqQveu5YYYfI1vK58fQGU9pWhx3cf8DBPkMN1kfR7SG2YF+wWfYg4zNvwWjRqbI+56q+JFj4",
+	"DsnUIgOFxXzpVcarRKxG11yY9wjcq0mbap3igxIu+xlz7+66v2W/oAdcYrMgI61r2KsiUYMRIlfYDrii",
+	"3q/Uau/dLtXrXEtmVaHDX0ztH9JEOvja5oqQr07sowlChf7vk1NVZthngOp6TuVC+D+JTmqfQjcmywVL",
+	"ePaB04jSKL12c8ZbN0+qAfyKlkn0Hjb/u3eKdw6zEazlJzN8S09F3oX6n3nUHN6LtSuJsrehkYGWby1g",
+	"qVxVUAE8kVki7N88paDjCVQjAY4rzBKDiWk4tqRYqiILZJVPx6kCey8p9UDrRd0t+e1ejd4Pxcj9tZjR",
+	"josZfe6uBFO37/NKyaDbs9RQc6oLy+ZzzAMhMhsKuIpuW3WoXlkYvfDhwsAbE+IdhFTtrajO14o4n5cQ",
+	"/7VEztcSOdv1seQpd1cZYhLdwrZrdbjSiORLs9+nVtWjZvRCUf2GK1hGjI8wmTFQV4M6H3w5ySxlpzt/",
+	"QEXHu+0Zm+bByCpIdLPB1CO7kl/KwINGIXeWp3PtuwwYSZdV2UZdPMBCQBjabUMWqNt5bZeAbTVI18YQ",
+	"wByop3epdEYVs7tPWHclrVup4bntFBDjRNVC2aGwBiwFX8OO7Vva6pNsZGwLMOYfffO/z84ktnNnASIb",
+	"1/44sWgV1i4gYHO2n4LN1rv7l0DW0T/z92zf7Q7qvQ4KArOKj7g9r/TaExK6SMw2plYKSnS758/dPDtz",
+	"0jfNfZu46v2hfPa8qPDie9DfmoYqdX/OUzF2WCYRMHMQ5vYj+xHTACyV8mqeYwuZ+EeoVtDG97Ee7QiY",
+	"hiLE2H6lapQ7XEf2Wrg3CMLuiYe05tlndMF60/lZaSzeSxGDW/KqXfupfdIKfBCaSjVnEoGWgJs6/ZKh",
+	"T2jq7bEXRolLErqwPXJHIKIGT+xmhpGviVfiHL2niU95BHSsuPH6CGw9OGyEwbN6CxNTLs8O51fG5Hi/",
+	"/JcQlnFaXD+PiaO6hx/pjzWxKOdIM5V2LaVn8hpLe9vrGFE8GXHqapGkgq67OJaZhvQatG08PZPX1Hfa",
+	"HeBiKhn5+yDpbD5daQDSUNxW9522i20SzXWCL73qdvo16qXtpfR6Gt76fn3MBIwFLipG5XHrfsQ7iBRR",
+	"v1fPHR4NVtRtCAohhDP2L0/aioAvCuVyOkFbwq2EVdyrQNCaZ59BHOsFAoe8n2csx5eO7ycUQVJFGY/7",
+	"FXa3gNFUyivdaae02sev/qVdKIZustsphcV2PtlQbb+DzYO1NcQKDGV3Gsm0mGQu4DIV14D11X0d9KJm",
+	"5BRUoIk3xea6i7jXmOzisveiN7VArQ1a7pVPQ2Ham1lrPrJnNrLSxc/v3yD0NVtbtyjO4Uf3V0uiDoVh",
+	"l8C4vvRKMW6/wkkdDcl7ZaB58PhiCrYVQdPukNdc7GFJfPpwmNPy7d1d9IPvPL8J23QnuFGX7/LU683T",
+	"vyDTqoLYMtkKq0RxqQXkq6H4Z70r67md6XYSEu1i32fO09SvpDja1U3+v126NrpSeZvy2WnE4AOPTbqs",
+	"2Ltm1m5SluhrNEqPaFrfzcVu2jtJxyKbgMqVyLA1rx3OVqf05hRrQcGO7G0vaqi7f9203a/cH25wEKRd",
+	"/lFP4mU3eZaEh+rN74bb7RIf9whDKL0FD7pE395wx8oa87zh6iRa5Sq43Nz8vwEAJ84RDF9wAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -1,7 +1,10 @@
 package server
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"expvar"
 	"log"
 	"main/internal/adapters/middleware"
 	"main/internal/bootstrap"
@@ -9,10 +12,15 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"time"
 
 	httpSwagger "github.com/swaggo/http-swagger"
 )
 
+// shutdownTimeout bounds how long Start waits for the requests in flight,
+// streams like the vault events are cut when it expires
+const shutdownTimeout = 10 * time.Second
+
 type Server struct {
 	port string
 	mux  *http.ServeMux
@@ -34,11 +42,21 @@ func (s *Server) RegisterHandlersAndMiddlewares(handlers *bootstrap.Handlers, mi
 	si := oapi.NewStrictHandler(handlers, []oapi.StrictMiddlewareFunc{middlewares.AuthMiddleware})
 	handler := oapi.HandlerFromMux(si, http.NewServeMux())
 	handler = middlewares.OapiRequestValidatorMiddleware(handler, spec)
+	handler = middlewares.MaxBytesMiddleware(handler)
 	handler = middlewares.ClientInfoMiddleware(handler)
 	handler = middlewares.CORSMiddleware(handler)
 	s.mux.Handle("/api/", http.StripPrefix("/api", handler))
 }
 
+// RegisterVaultSocketRoute serves the vault sync WebSocket, it takes the
+// same access tokens as the API
+func (s *Server) RegisterVaultSocketRoute(handlers *bootstrap.Handlers, middlewares *bootstrap.Middlewares) {
+	var handler http.Handler = handlers.VaultSocket
+	handler = middlewares.RequireAccessToken(handler)
+	handler = middlewares.ClientInfoMiddleware(handler)
+	s.mux.Handle("GET /api/user/vault/ws", handler)
+}
+
 func (s *Server) RegisterStaticRoute() {
 	s.mux.Handle("/assets/",
 		http.StripPrefix("/assets/",
@@ -74,9 +92,39 @@ func (s *Server) RegisterSwaggerRoute() {
 	))
 }
 
-func (s *Server) Start(withLogging bool) error {
+// RegisterMetricsRoute exposes the expvar counters, e.g. the email outbox
+// ones, to the instance admins
+func (s *Server) RegisterMetricsRoute(middlewares *bootstrap.Middlewares) {
+	s.mux.Handle("GET /debug/vars", middlewares.RequireAdminAccessToken(expvar.Handler()))
+}
+
+// Start serves until ctx is done, then stops accepting connections and
+// waits up to shutdownTimeout for the requests in flight
+func (s *Server) Start(ctx context.Context, withLogging bool) error {
+	var handler http.Handler = s.mux
 	if withLogging {
-		return http.ListenAndServe(":"+s.port, middleware.LoggerMiddleware(s.mux))
+		handler = middleware.LoggerMiddleware(s.mux)
+	}
+	httpServer := &http.Server{Addr: ":" + s.port, Handler: handler}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- httpServer.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := httpServer.Shutdown(shutdownCtx); err != nil {
+		return err
+	}
+	if err := <-errCh; !errors.Is(err, http.ErrServerClosed) {
+		return err
 	}
-	return http.ListenAndServe(":"+s.port, s.mux)
+	return nil
 }
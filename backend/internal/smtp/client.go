@@ -1,53 +1,303 @@
 package smtp
 
 import (
+	"crypto/tls"
+	"fmt"
+	"log"
 	"main/internal/config"
+	"net"
+	"net/mail"
 	"net/smtp"
+	"strings"
+	"sync"
+	"time"
 )
 
+const (
+	// TLSModeNone never encrypts the connection
+	TLSModeNone = "none"
+	// TLSModeOpportunistic upgrades with STARTTLS when the server offers it
+	TLSModeOpportunistic = "opportunistic"
+	// TLSModeStartTLS fails when the server doesn't offer STARTTLS
+	TLSModeStartTLS = "starttls"
+	// TLSModeImplicit speaks TLS from the first byte, usually on port 465
+	TLSModeImplicit = "tls"
+)
+
+const (
+	dialTimeout = 10 * time.Second
+	sendTimeout = 30 * time.Second
+)
+
+// SMTPClient sends emails over a single connection that is reused between
+// messages and closed after IdleTimeout without traffic
 type SMTPClient struct {
-	address  string
-	from     string
-	password string
-	host     string
+	address     string
+	host        string
+	from        *mail.Address
+	username    string
+	password    string
+	tlsMode     string
+	tlsConfig   *tls.Config
+	idleTimeout time.Duration
+	dkim        *dkimSigner
+	now         func() time.Time
+
+	mu        sync.Mutex
+	conn      net.Conn
+	client    *smtp.Client
+	lastUsed  time.Time
+	idleTimer *time.Timer
 }
 
 func NewSMTPclient(cfg config.SMTPConfig) *SMTPClient {
-	address := cfg.Host + ":" + cfg.Port
+	client, err := newSMTPClient(cfg)
+	if err != nil {
+		log.Fatalf("failed to create SMTP client: %v", err)
+	}
+	return client
+}
 
-	return &SMTPClient{
-		host:     cfg.Host,
-		address:  address,
-		from:     cfg.From,
-		password: cfg.Password,
+func newSMTPClient(cfg config.SMTPConfig) (*SMTPClient, error) {
+	from, err := mail.ParseAddress(cfg.From)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid from address %q: %v", cfg.From, err)
 	}
+
+	// Without a separate user the from address is the login, as before
+	username := cfg.User
+	if username == "" {
+		username = from.Address
+	}
+
+	tlsMode := cfg.TLSMode
+	if tlsMode == "" {
+		tlsMode = TLSModeOpportunistic
+	}
+
+	c := &SMTPClient{
+		address:     net.JoinHostPort(cfg.Host, cfg.Port),
+		host:        cfg.Host,
+		from:        from,
+		username:    username,
+		password:    cfg.Password,
+		tlsMode:     tlsMode,
+		tlsConfig:   &tls.Config{ServerName: cfg.Host, MinVersion: tls.VersionTLS12},
+		idleTimeout: cfg.IdleTimeout,
+		now:         time.Now,
+	}
+
+	if cfg.DKIM.KeyFile != "" {
+		domain := cfg.DKIM.Domain
+		if domain == "" {
+			domain = c.fromDomain()
+		}
+		if c.dkim, err = loadDKIMSigner(domain, cfg.DKIM.Selector, cfg.DKIM.KeyFile); err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
 }
 
 func (c *SMTPClient) SendEmail(to, subject, body string) error {
-	message := c.BuildEmail(to, subject, body)
-	var auth smtp.Auth
-
-	if c.password != "" {
-		auth = smtp.PlainAuth("", c.from, c.password, c.host)
-	}
-	return smtp.SendMail(
-		c.address,
-		auth,
-		c.from,
-		[]string{to},
-		message,
+	message, err := c.BuildEmail(to, subject, body)
+	if err != nil {
+		return err
+	}
+	return c.send(to, message)
+}
+
+// SendMultipartEmail sends a multipart/alternative email with a plain text
+// and an HTML version of the same message. A non empty messageID is used as
+// the Message-ID header, so a retried delivery keeps the same id.
+func (c *SMTPClient) SendMultipartEmail(messageID, to, subject, text, html string) error {
+	message, err := c.BuildMultipartEmail(messageID, to, subject, text, html)
+	if err != nil {
+		return err
+	}
+	return c.send(to, message)
+}
+
+func (c *SMTPClient) BuildEmail(to, subject, body string) ([]byte, error) {
+	return c.buildMessage("", to, subject, bodyPart{"text/html; charset=\"UTF-8\"", body})
+}
+
+func (c *SMTPClient) BuildMultipartEmail(messageID, to, subject, text, html string) ([]byte, error) {
+	return c.buildMessage(messageID, to, subject,
+		bodyPart{"text/plain; charset=\"UTF-8\"", text},
+		bodyPart{"text/html; charset=\"UTF-8\"", html},
 	)
 }
 
-func (c *SMTPClient) BuildEmail(to, subject, body string) []byte {
-	msg := ""
-	msg += "From: " + c.from + "\r\n"
-	msg += "To: " + to + "\r\n"
-	msg += "Subject: " + subject + "\r\n"
-	msg += "MIME-Version: 1.0\r\n"
-	msg += "Content-Type: text/html; charset=\"UTF-8\"\r\n"
-	msg += "\r\n"
-	msg += body
+// Close ends the open connection, if any
+func (c *SMTPClient) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.client == nil {
+		return nil
+	}
+	err := c.client.Quit()
+	c.closeConnection()
+	return err
+}
+
+func (c *SMTPClient) send(to string, message []byte) error {
+	recipient, err := mail.ParseAddress(to)
+	if err != nil {
+		return err
+	}
 
-	return []byte(msg)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	client, err := c.connection()
+	if err != nil {
+		return err
+	}
+
+	c.conn.SetDeadline(time.Now().Add(sendTimeout))
+	if err := c.transmit(client, recipient.Address, message); err != nil {
+		// The session state is unknown after an error, the next send redials
+		c.closeConnection()
+		return err
+	}
+
+	c.lastUsed = time.Now()
+	c.scheduleIdleClose()
+	return nil
+}
+
+func (c *SMTPClient) transmit(client *smtp.Client, to string, message []byte) error {
+	if err := client.Mail(c.from.Address); err != nil {
+		return err
+	}
+	if err := client.Rcpt(to); err != nil {
+		return err
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(message); err != nil {
+		return err
+	}
+	return w.Close()
+}
+
+// connection returns the open connection when it's still usable, otherwise
+// it dials a new one. The caller must hold the lock.
+func (c *SMTPClient) connection() (*smtp.Client, error) {
+	if c.client != nil {
+		c.conn.SetDeadline(time.Now().Add(sendTimeout))
+		// RSET both checks the server is still there and clears any leftover state
+		if time.Since(c.lastUsed) < c.idleTimeout && c.client.Reset() == nil {
+			return c.client, nil
+		}
+		c.closeConnection()
+	}
+
+	conn, client, err := c.dial()
+	if err != nil {
+		return nil, err
+	}
+	c.conn = conn
+	c.client = client
+	return client, nil
+}
+
+func (c *SMTPClient) dial() (net.Conn, *smtp.Client, error) {
+	dialer := &net.Dialer{Timeout: dialTimeout}
+
+	var conn net.Conn
+	var err error
+	if c.tlsMode == TLSModeImplicit {
+		conn, err = tls.DialWithDialer(dialer, "tcp", c.address, c.tlsConfig)
+	} else {
+		conn, err = dialer.Dial("tcp", c.address)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	conn.SetDeadline(time.Now().Add(sendTimeout))
+
+	client, err := smtp.NewClient(conn, c.host)
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+
+	if err := c.handshake(client); err != nil {
+		client.Close()
+		return nil, nil, err
+	}
+
+	return conn, client, nil
+}
+
+// handshake upgrades the connection to TLS according to the mode and logs in
+func (c *SMTPClient) handshake(client *smtp.Client) error {
+	if c.tlsMode == TLSModeOpportunistic || c.tlsMode == TLSModeStartTLS {
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err := client.StartTLS(c.tlsConfig); err != nil {
+				return err
+			}
+		} else if c.tlsMode == TLSModeStartTLS {
+			return fmt.Errorf("SMTP server doesn't support STARTTLS")
+		}
+	}
+
+	if c.password == "" {
+		return nil
+	}
+	if ok, _ := client.Extension("AUTH"); !ok {
+		return fmt.Errorf("SMTP server doesn't support authentication")
+	}
+	return client.Auth(smtp.PlainAuth("", c.username, c.password, c.host))
+}
+
+// scheduleIdleClose closes the connection once it's been idle for
+// idleTimeout, a zero timeout closes it right away. The caller must hold the lock.
+func (c *SMTPClient) scheduleIdleClose() {
+	if c.idleTimeout <= 0 {
+		c.client.Quit()
+		c.closeConnection()
+		return
+	}
+
+	if c.idleTimer != nil {
+		c.idleTimer.Stop()
+	}
+	c.idleTimer = time.AfterFunc(c.idleTimeout, func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		if c.client != nil && time.Since(c.lastUsed) >= c.idleTimeout {
+			c.client.Quit()
+			c.closeConnection()
+		}
+	})
+}
+
+// closeConnection drops the connection without saying goodbye. The caller
+// must hold the lock.
+func (c *SMTPClient) closeConnection() {
+	if c.idleTimer != nil {
+		c.idleTimer.Stop()
+		c.idleTimer = nil
+	}
+	if c.client != nil {
+		c.client.Close()
+	}
+	c.conn = nil
+	c.client = nil
+}
+
+func (c *SMTPClient) fromDomain() string {
+	if at := strings.LastIndex(c.from.Address, "@"); at >= 0 {
+		return c.from.Address[at+1:]
+	}
+	return c.host
 }
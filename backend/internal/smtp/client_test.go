@@ -0,0 +1,349 @@
+package smtp
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"main/internal/config"
+	"math/big"
+	"mime"
+	"net"
+	"net/mail"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type testMessage struct {
+	from string
+	to   string
+	data string
+}
+
+// testServer is a minimal in-process SMTP server, enough to exercise the
+// client: EHLO, STARTTLS, AUTH PLAIN, MAIL, RCPT, DATA, RSET, NOOP and QUIT
+type testServer struct {
+	listener       net.Listener
+	tlsConfig      *tls.Config
+	offerStartTLS  bool
+	dropAfterData  bool
+	mu             sync.Mutex
+	messages       []testMessage
+	auths          []string
+	connections    int
+	tlsConnections int
+}
+
+func newTestServer(t *testing.T, implicitTLS, offerStartTLS bool) (*testServer, *x509.CertPool) {
+	t.Helper()
+
+	cert, pool := newTestCertificate(t)
+	s := &testServer{
+		tlsConfig:     &tls.Config{Certificates: []tls.Certificate{cert}},
+		offerStartTLS: offerStartTLS,
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if implicitTLS {
+		listener = tls.NewListener(listener, s.tlsConfig)
+	}
+	s.listener = listener
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn, implicitTLS)
+		}
+	}()
+
+	return s, pool
+}
+
+func (s *testServer) port() string {
+	return strconv.Itoa(s.listener.Addr().(*net.TCPAddr).Port)
+}
+
+func (s *testServer) serve(conn net.Conn, secure bool) {
+	defer conn.Close()
+
+	s.mu.Lock()
+	s.connections++
+	if secure {
+		s.tlsConnections++
+	}
+	s.mu.Unlock()
+
+	text := textproto.NewConn(conn)
+	text.PrintfLine("220 localhost ESMTP test")
+
+	var message testMessage
+	for {
+		line, err := text.ReadLine()
+		if err != nil {
+			return
+		}
+		command, arg, _ := strings.Cut(line, " ")
+
+		switch strings.ToUpper(command) {
+		case "EHLO", "HELO":
+			lines := []string{"localhost"}
+			if s.offerStartTLS && !secure {
+				lines = append(lines, "STARTTLS")
+			}
+			lines = append(lines, "AUTH PLAIN")
+			for i, l := range lines {
+				sep := "-"
+				if i == len(lines)-1 {
+					sep = " "
+				}
+				text.PrintfLine("250%s%s", sep, l)
+			}
+		case "STARTTLS":
+			text.PrintfLine("220 Ready to start TLS")
+			tlsConn := tls.Server(conn, s.tlsConfig)
+			if err := tlsConn.Handshake(); err != nil {
+				return
+			}
+			conn = tlsConn
+			text = textproto.NewConn(conn)
+			secure = true
+			s.mu.Lock()
+			s.tlsConnections++
+			s.mu.Unlock()
+		case "AUTH":
+			_, initial, _ := strings.Cut(arg, " ")
+			decoded, _ := base64.StdEncoding.DecodeString(initial)
+			s.mu.Lock()
+			s.auths = append(s.auths, string(decoded))
+			s.mu.Unlock()
+			text.PrintfLine("235 Authenticated")
+		case "MAIL":
+			message = testMessage{from: addressArg(arg)}
+			text.PrintfLine("250 OK")
+		case "RCPT":
+			message.to = addressArg(arg)
+			text.PrintfLine("250 OK")
+		case "DATA":
+			text.PrintfLine("354 Go ahead")
+			data, err := text.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			message.data = string(data)
+			s.mu.Lock()
+			s.messages = append(s.messages, message)
+			s.mu.Unlock()
+			text.PrintfLine("250 Queued")
+			if s.dropAfterData {
+				return
+			}
+		case "RSET", "NOOP":
+			text.PrintfLine("250 OK")
+		case "QUIT":
+			text.PrintfLine("221 Bye")
+			return
+		default:
+			text.PrintfLine("502 Not implemented")
+		}
+	}
+}
+
+// addressArg extracts the address from "FROM:<a@b> ..." or "TO:<a@b>"
+func addressArg(arg string) string {
+	start := strings.Index(arg, "<")
+	end := strings.Index(arg, ">")
+	if start < 0 || end < start {
+		return ""
+	}
+	return arg[start+1 : end]
+}
+
+func newTestCertificate(t *testing.T) (tls.Certificate, *x509.CertPool) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, pool
+}
+
+func newTestClient(t *testing.T, server *testServer, pool *x509.CertPool, cfg config.SMTPConfig) *SMTPClient {
+	t.Helper()
+
+	cfg.Host = "127.0.0.1"
+	cfg.Port = server.port()
+	if cfg.From == "" {
+		cfg.From = "Not One Password <noreply@example.com>"
+	}
+	if cfg.IdleTimeout == 0 {
+		cfg.IdleTimeout = time.Minute
+	}
+
+	client, err := newSMTPClient(cfg)
+	if err != nil {
+		t.Fatalf("failed to create client: %v", err)
+	}
+	client.tlsConfig.RootCAs = pool
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func TestSMTPClient_StartTLSAuthAndReuse(t *testing.T) {
+	server, pool := newTestServer(t, false, true)
+	client := newTestClient(t, server, pool, config.SMTPConfig{
+		User:     "mailer",
+		Password: "secret",
+		TLSMode:  TLSModeStartTLS,
+	})
+
+	for _, to := range []string{"jane@example.com", "john@example.com"} {
+		if err := client.SendMultipartEmail("", to, "Hello", "text", "<p>html</p>"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	if server.connections != 1 || server.tlsConnections != 1 {
+		t.Errorf("expected a single TLS connection, got %d connections and %d upgraded", server.connections, server.tlsConnections)
+	}
+	if len(server.auths) != 1 || server.auths[0] != "\x00mailer\x00secret" {
+		t.Errorf("expected a single login as mailer, got %q", server.auths)
+	}
+	if len(server.messages) != 2 {
+		t.Fatalf("expected 2 messages, got %d", len(server.messages))
+	}
+	if server.messages[0].from != "noreply@example.com" || server.messages[1].to != "john@example.com" {
+		t.Errorf("unexpected envelope %+v", server.messages)
+	}
+}
+
+func TestSMTPClient_RequiredStartTLSNotOffered(t *testing.T) {
+	server, pool := newTestServer(t, false, false)
+	client := newTestClient(t, server, pool, config.SMTPConfig{TLSMode: TLSModeStartTLS})
+
+	if err := client.SendEmail("jane@example.com", "Hello", "<p>html</p>"); err == nil {
+		t.Fatal("expected an error when STARTTLS is required but not offered")
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	if len(server.messages) != 0 {
+		t.Errorf("expected nothing to be sent, got %d messages", len(server.messages))
+	}
+}
+
+func TestSMTPClient_ImplicitTLS(t *testing.T) {
+	server, pool := newTestServer(t, true, false)
+	client := newTestClient(t, server, pool, config.SMTPConfig{TLSMode: TLSModeImplicit})
+
+	if err := client.SendEmail("jane@example.com", "Hello", "<p>html</p>"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	if server.tlsConnections != 1 || len(server.messages) != 1 {
+		t.Errorf("expected one message over TLS, got %d messages over %d TLS connections", len(server.messages), server.tlsConnections)
+	}
+}
+
+func TestSMTPClient_RedialsDroppedConnection(t *testing.T) {
+	server, pool := newTestServer(t, false, false)
+	server.dropAfterData = true
+	client := newTestClient(t, server, pool, config.SMTPConfig{TLSMode: TLSModeNone})
+
+	for range 2 {
+		if err := client.SendEmail("jane@example.com", "Hello", "<p>html</p>"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	server.mu.Lock()
+	defer server.mu.Unlock()
+	if server.connections != 2 || len(server.messages) != 2 {
+		t.Errorf("expected 2 messages over 2 connections, got %d over %d", len(server.messages), server.connections)
+	}
+}
+
+func TestSMTPClient_Headers(t *testing.T) {
+	server, pool := newTestServer(t, false, false)
+	client := newTestClient(t, server, pool, config.SMTPConfig{From: "Nöt One Password <noreply@example.com>"})
+	client.now = func() time.Time { return time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC) }
+
+	subject := "Conferma il tuo account — " + strings.Repeat("è molto lungo ", 6)
+	raw, err := client.BuildMultipartEmail("delivery-1", "jane@example.com", subject, "text", "<p>html</p>")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// An encoded word can't be split, so only the hard RFC 5322 limit holds
+	// for every line, the long subject must be folded though
+	headerEnd := strings.Index(string(raw), "\r\n\r\n")
+	for _, line := range strings.Split(string(raw[:headerEnd]), "\r\n") {
+		if len(line) > 998 {
+			t.Errorf("header line longer than 998 characters: %q", line)
+		}
+	}
+	if !strings.Contains(string(raw[:headerEnd]), "?=\r\n =?UTF-8?q?") {
+		t.Errorf("expected the subject to be folded, got %q", raw[:headerEnd])
+	}
+
+	message, err := mail.ReadMessage(strings.NewReader(string(raw)))
+	if err != nil {
+		t.Fatalf("failed to parse message: %v", err)
+	}
+
+	decoder := new(mime.WordDecoder)
+	decoded, err := decoder.DecodeHeader(message.Header.Get("Subject"))
+	if err != nil || decoded != subject {
+		t.Errorf("expected subject %q, got %q (%v)", subject, decoded, err)
+	}
+	from, err := message.Header.AddressList("From")
+	if err != nil || from[0].Name != "Nöt One Password" || from[0].Address != "noreply@example.com" {
+		t.Errorf("unexpected from %v (%v)", from, err)
+	}
+	if message.Header.Get("Message-ID") != "<delivery-1@example.com>" {
+		t.Errorf("unexpected Message-ID %q", message.Header.Get("Message-ID"))
+	}
+	if date, err := message.Header.Date(); err != nil || !date.Equal(client.now()) {
+		t.Errorf("unexpected Date %v (%v)", date, err)
+	}
+}
@@ -0,0 +1,148 @@
+package smtp
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// dkimSignedHeaders are signed when present, in this order
+var dkimSignedHeaders = []string{"From", "To", "Subject", "Date", "Message-ID", "MIME-Version", "Content-Type"}
+
+// dkimSigner signs messages per RFC 6376 with relaxed/relaxed
+// canonicalization, using an RSA or an Ed25519 key
+type dkimSigner struct {
+	domain    string
+	selector  string
+	key       crypto.Signer
+	algorithm string
+}
+
+func newDKIMSigner(domain, selector string, key crypto.Signer) (*dkimSigner, error) {
+	s := &dkimSigner{domain: domain, selector: selector, key: key}
+	switch key.(type) {
+	case *rsa.PrivateKey:
+		s.algorithm = "rsa-sha256"
+	case ed25519.PrivateKey:
+		s.algorithm = "ed25519-sha256"
+	default:
+		return nil, fmt.Errorf("Unsupported DKIM key type %T", key)
+	}
+	return s, nil
+}
+
+// loadDKIMSigner reads a PEM encoded PKCS#1 or PKCS#8 private key
+func loadDKIMSigner(domain, selector, keyFile string) (*dkimSigner, error) {
+	data, err := os.ReadFile(keyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("DKIM key file %s is not PEM encoded", keyFile)
+	}
+
+	var key any
+	if key, err = x509.ParsePKCS8PrivateKey(block.Bytes); err != nil {
+		if key, err = x509.ParsePKCS1PrivateKey(block.Bytes); err != nil {
+			return nil, fmt.Errorf("DKIM key file %s is not a PKCS#1 or PKCS#8 private key", keyFile)
+		}
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("Unsupported DKIM key type %T", key)
+	}
+	return newDKIMSigner(domain, selector, signer)
+}
+
+// sign returns the value of the DKIM-Signature header for the message
+func (s *dkimSigner) sign(h header, body []byte, now time.Time) (string, error) {
+	bodyHash := sha256.Sum256(canonicalizeBodyRelaxed(body))
+
+	hash := sha256.New()
+	var signed []string
+	for _, key := range dkimSignedHeaders {
+		value, ok := h.get(key)
+		if !ok {
+			continue
+		}
+		signed = append(signed, strings.ToLower(key))
+		hash.Write([]byte(canonicalizeHeaderRelaxed(key, value) + "\r\n"))
+	}
+
+	value := fmt.Sprintf(
+		"v=1; a=%s; c=relaxed/relaxed; d=%s; s=%s; t=%d; h=%s; bh=%s; b=",
+		s.algorithm, s.domain, s.selector, now.Unix(), strings.Join(signed, ":"),
+		base64.StdEncoding.EncodeToString(bodyHash[:]),
+	)
+	// The signature header itself is hashed with an empty b= and no CRLF
+	hash.Write([]byte(canonicalizeHeaderRelaxed("DKIM-Signature", value)))
+	digest := hash.Sum(nil)
+
+	var signature []byte
+	var err error
+	switch key := s.key.(type) {
+	case *rsa.PrivateKey:
+		signature, err = rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest)
+	case ed25519.PrivateKey:
+		signature = ed25519.Sign(key, digest)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return value + base64.StdEncoding.EncodeToString(signature), nil
+}
+
+// canonicalizeHeaderRelaxed implements RFC 6376 3.4.2
+func canonicalizeHeaderRelaxed(key, value string) string {
+	value = strings.NewReplacer("\r\n", "", "\n", "").Replace(value)
+	return strings.ToLower(strings.TrimSpace(key)) + ":" + strings.TrimSpace(collapseWhitespace(value))
+}
+
+// canonicalizeBodyRelaxed implements RFC 6376 3.4.4
+func canonicalizeBodyRelaxed(body []byte) []byte {
+	lines := strings.Split(strings.ReplaceAll(string(body), "\r\n", "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(collapseWhitespace(line), " ")
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) == 0 {
+		return nil
+	}
+	return []byte(strings.Join(lines, "\r\n") + "\r\n")
+}
+
+// collapseWhitespace reduces every run of spaces and tabs to a single space
+func collapseWhitespace(s string) string {
+	var out strings.Builder
+	space := false
+	for _, r := range s {
+		if r == ' ' || r == '\t' {
+			space = true
+			continue
+		}
+		if space {
+			out.WriteByte(' ')
+			space = false
+		}
+		out.WriteRune(r)
+	}
+	if space {
+		out.WriteByte(' ')
+	}
+	return out.String()
+}
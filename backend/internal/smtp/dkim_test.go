@@ -0,0 +1,104 @@
+package smtp
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"main/internal/config"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCanonicalizeRelaxed(t *testing.T) {
+	// Examples from RFC 6376 3.4.5
+	if got := canonicalizeHeaderRelaxed("A", " X"); got != "a:X" {
+		t.Errorf("expected %q, got %q", "a:X", got)
+	}
+	if got := canonicalizeHeaderRelaxed("B ", " Y\t\r\n\tZ  "); got != "b:Y Z" {
+		t.Errorf("expected %q, got %q", "b:Y Z", got)
+	}
+	if got := string(canonicalizeBodyRelaxed([]byte(" C \r\nD \t E\r\n\r\n\r\n"))); got != " C\r\nD E\r\n" {
+		t.Errorf("expected %q, got %q", " C\r\nD E\r\n", got)
+	}
+	if got := canonicalizeBodyRelaxed([]byte("\r\n\r\n")); len(got) != 0 {
+		t.Errorf("expected an empty body, got %q", got)
+	}
+}
+
+func TestSMTPClient_DKIMSignature(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyFile := filepath.Join(t.TempDir(), "dkim.pem")
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	if err := os.WriteFile(keyFile, keyPEM, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := newSMTPClient(config.SMTPConfig{
+		Host: "localhost",
+		Port: "25",
+		From: "noreply@example.com",
+		DKIM: config.DKIMConfig{Selector: "mail", KeyFile: keyFile},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	raw, err := client.BuildMultipartEmail("", "jane@example.com", "Hello", "text", "<p>html</p>")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Verify the signature the way a receiver would, from the raw message
+	rawHeader, body, _ := strings.Cut(string(raw), "\r\n\r\n")
+	fields := map[string]string{}
+	var signatureField string
+	for _, field := range strings.Split(strings.ReplaceAll(rawHeader, "\r\n ", " "), "\r\n") {
+		key, value, _ := strings.Cut(field, ":")
+		if strings.EqualFold(key, "DKIM-Signature") {
+			signatureField = value
+			continue
+		}
+		fields[strings.ToLower(key)] = value
+	}
+	if !strings.HasPrefix(rawHeader, "DKIM-Signature:") {
+		t.Fatalf("expected the message to start with a DKIM-Signature, got %q", rawHeader)
+	}
+
+	tags := map[string]string{}
+	for _, tag := range strings.Split(signatureField, ";") {
+		name, value, _ := strings.Cut(strings.TrimSpace(tag), "=")
+		tags[name] = value
+	}
+	if tags["d"] != "example.com" || tags["s"] != "mail" || tags["a"] != "rsa-sha256" {
+		t.Errorf("unexpected signature tags %v", tags)
+	}
+
+	bodyHash := sha256.Sum256(canonicalizeBodyRelaxed([]byte(body)))
+	if tags["bh"] != base64.StdEncoding.EncodeToString(bodyHash[:]) {
+		t.Errorf("body hash mismatch")
+	}
+
+	hash := sha256.New()
+	for _, name := range strings.Split(tags["h"], ":") {
+		hash.Write([]byte(canonicalizeHeaderRelaxed(name, fields[name]) + "\r\n"))
+	}
+	unsigned := signatureField[:strings.LastIndex(signatureField, "b=")+2]
+	hash.Write([]byte(canonicalizeHeaderRelaxed("DKIM-Signature", unsigned)))
+
+	signature, err := base64.StdEncoding.DecodeString(tags["b"])
+	if err != nil {
+		t.Fatalf("invalid signature encoding: %v", err)
+	}
+	if err := rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, hash.Sum(nil), signature); err != nil {
+		t.Errorf("signature doesn't verify: %v", err)
+	}
+}
@@ -0,0 +1,144 @@
+package smtp
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// maxHeaderLineLength is the recommended line limit of RFC 5322, longer
+// header values are folded on whitespace
+const maxHeaderLineLength = 78
+
+type headerField struct {
+	key   string
+	value string
+}
+
+// header keeps the fields in order, DKIM signs them as they are written
+type header []headerField
+
+func (h *header) add(key, value string) {
+	*h = append(*h, headerField{key: key, value: value})
+}
+
+func (h header) get(key string) (string, bool) {
+	for _, f := range h {
+		if strings.EqualFold(f.key, key) {
+			return f.value, true
+		}
+	}
+	return "", false
+}
+
+func (h header) bytes() []byte {
+	var buf bytes.Buffer
+	for _, f := range h {
+		buf.WriteString(foldHeader(f.key, f.value))
+	}
+	return buf.Bytes()
+}
+
+// foldHeader writes a header line, breaking it on spaces when it exceeds the
+// line limit. A value without spaces is left as is.
+func foldHeader(key, value string) string {
+	var out strings.Builder
+	line := key + ":"
+	for _, word := range strings.Split(value, " ") {
+		if len(line)+1+len(word) > maxHeaderLineLength && strings.TrimSpace(line) != key+":" {
+			out.WriteString(line + "\r\n")
+			line = ""
+		}
+		line += " " + word
+	}
+	out.WriteString(line + "\r\n")
+	return out.String()
+}
+
+type bodyPart struct {
+	contentType string
+	content     string
+}
+
+// buildMessage assembles the headers and a quoted-printable body. With more
+// than one part the body is multipart/alternative, the last part being the
+// preferred one.
+func (c *SMTPClient) buildMessage(messageID, to, subject string, parts ...bodyPart) ([]byte, error) {
+	recipient, err := mail.ParseAddress(to)
+	if err != nil {
+		return nil, err
+	}
+
+	if messageID == "" {
+		messageID = uuid.NewString()
+	}
+
+	h := header{}
+	h.add("From", formatAddress(c.from))
+	h.add("To", formatAddress(recipient))
+	h.add("Subject", mime.QEncoding.Encode("UTF-8", subject))
+	h.add("Date", c.now().Format("Mon, 02 Jan 2006 15:04:05 -0700"))
+	h.add("Message-ID", "<"+messageID+"@"+c.fromDomain()+">")
+	h.add("MIME-Version", "1.0")
+
+	var body bytes.Buffer
+	if len(parts) == 1 {
+		h.add("Content-Type", parts[0].contentType)
+		h.add("Content-Transfer-Encoding", "quoted-printable")
+		if err := writeQuotedPrintable(&body, parts[0].content); err != nil {
+			return nil, err
+		}
+	} else {
+		writer := multipart.NewWriter(&body)
+		for _, p := range parts {
+			part, err := writer.CreatePart(textproto.MIMEHeader{
+				"Content-Type":              {p.contentType},
+				"Content-Transfer-Encoding": {"quoted-printable"},
+			})
+			if err != nil {
+				return nil, err
+			}
+			if err := writeQuotedPrintable(part, p.content); err != nil {
+				return nil, err
+			}
+		}
+		if err := writer.Close(); err != nil {
+			return nil, err
+		}
+		h.add("Content-Type", "multipart/alternative; boundary=\""+writer.Boundary()+"\"")
+	}
+
+	if c.dkim != nil {
+		signature, err := c.dkim.sign(h, body.Bytes(), c.now())
+		if err != nil {
+			return nil, err
+		}
+		h = append(header{{key: "DKIM-Signature", value: signature}}, h...)
+	}
+
+	message := append(h.bytes(), "\r\n"...)
+	return append(message, body.Bytes()...), nil
+}
+
+// formatAddress encodes the display name when there is one
+func formatAddress(address *mail.Address) string {
+	if address.Name == "" {
+		return address.Address
+	}
+	return address.String()
+}
+
+func writeQuotedPrintable(w io.Writer, content string) error {
+	qp := quotedprintable.NewWriter(w)
+	if _, err := qp.Write([]byte(content)); err != nil {
+		return err
+	}
+	return qp.Close()
+}
@@ -0,0 +1,25 @@
+package utils
+
+import (
+	"math/rand/v2"
+	"time"
+)
+
+// ExponentialBackoff returns base * 2^(attempt-1) capped at max, with up to
+// 20% of random jitter so retries from many workers don't line up.
+func ExponentialBackoff(attempt int, base, max time.Duration) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+
+	delay := base
+	for i := 1; i < attempt && delay < max; i++ {
+		delay *= 2
+	}
+	if delay > max {
+		delay = max
+	}
+
+	jitter := time.Duration(rand.Int64N(int64(delay)/5 + 1))
+	return delay + jitter
+}
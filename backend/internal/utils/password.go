@@ -1,16 +1,43 @@
 package utils
 
-import "golang.org/x/crypto/bcrypt"
+import (
+	"math/bits"
+
+	"golang.org/x/crypto/bcrypt"
+)
 
 func CheckPassword(hash, password string) error {
 	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
 }
 
 func NewPassword(password string) (string, error) {
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	return NewPasswordWithIterations(password, 0)
+}
+
+// NewPasswordWithIterations hashes the password with at least the given
+// number of iterations, rounded up to the next power of two bcrypt uses
+func NewPasswordWithIterations(password string, iterations int) (string, error) {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), max(bcrypt.DefaultCost, costForIterations(iterations)))
 	if err != nil {
 		return "", err
 	}
 
 	return string(hashedPassword), nil
 }
+
+// PasswordIterations returns the number of iterations of a bcrypt hash, 0
+// when it isn't one
+func PasswordIterations(hash string) int {
+	cost, err := bcrypt.Cost([]byte(hash))
+	if err != nil {
+		return 0
+	}
+	return 1 << cost
+}
+
+func costForIterations(iterations int) int {
+	if iterations <= 1 {
+		return 0
+	}
+	return bits.Len(uint(iterations - 1))
+}